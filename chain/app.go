@@ -6,7 +6,6 @@ import (
 	"fmt"
 
 	"io"
-	"net/http"
 
 	"os"
 	"time"
@@ -99,7 +98,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
-	"github.com/cosmos/cosmos-sdk/x/auth/posthandler"
 	authsims "github.com/cosmos/cosmos-sdk/x/auth/simulation"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	txmodule "github.com/cosmos/cosmos-sdk/x/auth/tx/config"
@@ -136,7 +134,11 @@ import (
 	cosmosevmserver "github.com/cosmos/evm/server"
 
 	ynxconfig "github.com/JiahaoAlbus/YNX/chain/config"
+	"github.com/JiahaoAlbus/YNX/chain/precompiles/circuitbreaker"
+	"github.com/JiahaoAlbus/YNX/chain/precompiles/nyxtvotes"
+	"github.com/JiahaoAlbus/YNX/chain/precompiles/stakevotes"
 	"github.com/JiahaoAlbus/YNX/chain/precompiles/ynxprotocol"
+	"github.com/JiahaoAlbus/YNX/chain/precompiles/ynxsponsor"
 	ynxkeeper "github.com/JiahaoAlbus/YNX/chain/x/ynx/keeper"
 	ynxmodule "github.com/JiahaoAlbus/YNX/chain/x/ynx/module"
 	ynxmodtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
@@ -217,6 +219,27 @@ type App struct {
 	configurator module.Configurator
 }
 
+// GetMaccPerms returns the module account permissions: the cosmos/evm defaults plus the x/ynx
+// module account, which holds batched protocol fee shares and burns them on settlement, the gas
+// sponsorship pool and the vote escrow of native NYXT locked for voting.
+func GetMaccPerms() map[string][]string {
+	perms := cosmosevmconfig.GetMaccPerms()
+	perms[ynxmodtypes.ModuleName] = []string{authtypes.Burner}
+	perms[ynxmodtypes.SponsorshipPoolName] = nil
+	perms[ynxmodtypes.VoteEscrowName] = nil
+	return perms
+}
+
+// BlockedAddresses returns the addresses that cannot receive funds through bank sends: the
+// cosmos/evm defaults plus the x/ynx module accounts.
+func BlockedAddresses() map[string]bool {
+	blocked := cosmosevmconfig.BlockedAddresses()
+	blocked[authtypes.NewModuleAddress(ynxmodtypes.ModuleName).String()] = true
+	blocked[authtypes.NewModuleAddress(ynxmodtypes.SponsorshipPoolName).String()] = true
+	blocked[authtypes.NewModuleAddress(ynxmodtypes.VoteEscrowName).String()] = true
+	return blocked
+}
+
 // NewApp returns a reference to an initialized App.
 func NewApp(
 	logger log.Logger,
@@ -299,7 +322,7 @@ func NewApp(
 	// add keepers
 	app.AccountKeeper = authkeeper.NewAccountKeeper(
 		appCodec, runtime.NewKVStoreService(keys[authtypes.StoreKey]),
-		authtypes.ProtoBaseAccount, cosmosevmconfig.GetMaccPerms(),
+		authtypes.ProtoBaseAccount, GetMaccPerms(),
 		evmaddress.NewEvmCodec(sdk.GetConfig().GetBech32AccountAddrPrefix()),
 		sdk.GetConfig().GetBech32AccountAddrPrefix(),
 		authAddr,
@@ -309,7 +332,7 @@ func NewApp(
 		appCodec,
 		runtime.NewKVStoreService(keys[banktypes.StoreKey]),
 		app.AccountKeeper,
-		cosmosevmconfig.BlockedAddresses(),
+		BlockedAddresses(),
 		authAddr,
 		logger,
 	)
@@ -371,8 +394,9 @@ func NewApp(
 
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
+	// The x/ynx hooks hold &app.YNXKeeper, which is only built further below.
 	app.StakingKeeper.SetHooks(
-		stakingtypes.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks()),
+		stakingtypes.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks(), app.YNXKeeper.StakingHooks()),
 	)
 
 	app.AuthzKeeper = authzkeeper.NewKeeper(
@@ -563,6 +587,8 @@ func NewApp(
 		app.AccountKeeper,
 		app.BankKeeper,
 		app.MintKeeper,
+		app.DistrKeeper,
+		app.StakingKeeper,
 		app.EVMKeeper,
 		app.FeeMarketKeeper,
 	)
@@ -572,6 +598,25 @@ func NewApp(
 		common.HexToAddress(ynxprotocol.PrecompileAddress),
 		ynxprotocol.NewPrecompile(app.YNXKeeper),
 	)
+	app.EVMKeeper.RegisterStaticPrecompile(
+		common.HexToAddress(ynxsponsor.PrecompileAddress),
+		ynxsponsor.NewPrecompile(app.YNXKeeper, app.BankKeeper),
+	)
+	app.EVMKeeper.RegisterStaticPrecompile(
+		common.HexToAddress(nyxtvotes.PrecompileAddress),
+		nyxtvotes.NewPrecompile(app.YNXKeeper, app.BankKeeper),
+	)
+	app.EVMKeeper.RegisterStaticPrecompile(
+		common.HexToAddress(stakevotes.PrecompileAddress),
+		stakevotes.NewPrecompile(app.YNXKeeper, app.BankKeeper),
+	)
+	app.EVMKeeper.RegisterStaticPrecompile(
+		common.HexToAddress(circuitbreaker.PrecompileAddress),
+		circuitbreaker.NewPrecompile(app.YNXKeeper),
+	)
+
+	// Redeem standalone NYXT ERC20 tokens sent to the x/ynx module address for native NYXT.
+	app.EVMKeeper.SetHooks(evmkeeper.NewMultiEvmHooks(app.YNXKeeper.EVMHooks()))
 
 	// NOTE: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.
@@ -585,7 +630,7 @@ func NewApp(
 		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
 		gov.NewAppModule(appCodec, &app.GovKeeper, app.AccountKeeper, app.BankKeeper, nil),
 		mint.NewAppModule(appCodec, app.MintKeeper, app.AccountKeeper, nil, nil),
-		ynxmodule.NewAppModule(appCodec, app.YNXKeeper),
+		ynxmodule.NewAppModule(appCodec, app.YNXKeeper, app.AccountKeeper, app.BankKeeper),
 		slashing.NewAppModule(appCodec, app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper, nil, app.interfaceRegistry),
 		distr.NewAppModule(appCodec, app.DistrKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper, nil),
 		staking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper, nil),
@@ -659,6 +704,8 @@ func NewApp(
 	// to get the full block gas used.
 	app.ModuleManager.SetOrderEndBlockers(
 		govtypes.ModuleName, stakingtypes.ModuleName,
+		// x/ynx snapshots the stake-weighted votes after the validator set updates.
+		ynxmodtypes.ModuleName,
 		authtypes.ModuleName, banktypes.ModuleName,
 
 		// Cosmos EVM EndBlockers
@@ -820,24 +867,45 @@ func (app *App) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64) {
 	baseAnte := evmante.NewAnteHandler(options)
 	feeCollectorAddr := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
 
+	// EVM calls to a contract and Cosmos transactions with message types covered by a gas sponsorship
+	// have their fee paid by the sponsor.
+	sponsoredAnte := func(ctx sdk.Context, tx sdk.Tx, sim bool) (sdk.Context, error) {
+		return sponsorTx(ctx, tx, sim, app.BankKeeper, app.YNXKeeper, baseAnte)
+	}
+
 	app.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, sim bool) (sdk.Context, error) {
+		// Transactions blocked by a circuit breaker are rejected before they are charged any fee.
+		if err := checkCircuitBreakers(ctx, tx.GetMsgs(), app.YNXKeeper); err != nil {
+			return ctx, err
+		}
+
 		if ctx.IsCheckTx() || ctx.IsReCheckTx() || sim {
-			return baseAnte(ctx, tx, sim)
+			return sponsoredAnte(ctx, tx, sim)
 		}
 
-		before := app.BankKeeper.GetBalance(ctx, feeCollectorAddr, ynxconfig.BaseDenom).Amount
+		before := app.BankKeeper.GetAllBalances(ctx, feeCollectorAddr)
 
-		newCtx, err := baseAnte(ctx, tx, sim)
+		newCtx, err := sponsoredAnte(ctx, tx, sim)
 		if err != nil {
 			return newCtx, err
 		}
 
-		after := app.BankKeeper.GetBalance(newCtx, feeCollectorAddr, ynxconfig.BaseDenom).Amount
-		delta := after.Sub(before)
-		if delta.IsPositive() {
-			if err := app.YNXKeeper.SplitTxFee(newCtx, sdk.NewCoin(ynxconfig.BaseDenom, delta)); err != nil {
+		after := app.BankKeeper.GetAllBalances(newCtx, feeCollectorAddr)
+		charged := feeCollectorDelta(before, after)
+
+		// EVM transactions pay for the full gas limit here and are refunded the unused gas after
+		// execution; their fee is split by the post handler once it is final. The up-front charge
+		// is held in the ante state, which survives a failed transaction, so that x/ynx splits it
+		// at the end of the block if the post handler never does.
+		if isEthereumTx(tx) {
+			if err := app.YNXKeeper.HoldTxFee(newCtx, charged); err != nil {
 				return newCtx, err
 			}
+			return withFeeCollectorSnapshot(newCtx, before, charged), nil
+		}
+
+		if err := app.YNXKeeper.SplitTxFee(newCtx, charged); err != nil {
+			return newCtx, err
 		}
 
 		return newCtx, nil
@@ -856,14 +924,10 @@ func (app *App) RegisterPendingTxListener(listener func(common.Hash)) {
 }
 
 func (app *App) setPostHandler() {
-	postHandler, err := posthandler.NewPostHandler(
-		posthandler.HandlerOptions{},
-	)
-	if err != nil {
-		panic(err)
-	}
-
-	app.SetPostHandler(postHandler)
+	app.SetPostHandler(sdk.ChainPostDecorators(
+		NewSponsorshipPostDecorator(app.BankKeeper, app.YNXKeeper),
+		NewFeeSplitPostDecorator(app.BankKeeper, app.YNXKeeper),
+	))
 }
 
 // Name returns the name of the App
@@ -1056,7 +1120,6 @@ func (app *App) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APIConfig
 
 	// Register grpc-gateway routes for all modules.
 	app.BasicModuleManager.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
-	app.registerYNXHTTPRoutes(apiSvr)
 
 	// register swagger API from root so that other applications can override easily
 	if err := sdkserver.RegisterSwaggerAPI(apiSvr.ClientCtx, apiSvr.Router, apiConfig.Swagger); err != nil {
@@ -1064,80 +1127,6 @@ func (app *App) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APIConfig
 	}
 }
 
-func (app *App) registerYNXHTTPRoutes(apiSvr *api.Server) {
-	apiSvr.Router.HandleFunc("/ynx/ynx/v1/params", func(w http.ResponseWriter, r *http.Request) {
-		if r.Method != http.MethodGet && r.Method != http.MethodHead {
-			writeYNXAPIError(w, http.StatusMethodNotAllowed, "method_not_allowed")
-			return
-		}
-
-		ctx, err := app.CreateQueryContext(app.LastBlockHeight(), false)
-		if err != nil {
-			writeYNXAPIError(w, http.StatusInternalServerError, err.Error())
-			return
-		}
-
-		params, err := app.YNXKeeper.Params.Get(ctx)
-		if err != nil {
-			writeYNXAPIError(w, http.StatusInternalServerError, err.Error())
-			return
-		}
-
-		writeYNXAPIJSON(w, http.StatusOK, map[string]any{"params": params})
-	}).Methods(http.MethodGet, http.MethodHead)
-
-	apiSvr.Router.HandleFunc("/ynx/ynx/v1/system_contracts", func(w http.ResponseWriter, r *http.Request) {
-		if r.Method != http.MethodGet && r.Method != http.MethodHead {
-			writeYNXAPIError(w, http.StatusMethodNotAllowed, "method_not_allowed")
-			return
-		}
-
-		ctx, err := app.CreateQueryContext(app.LastBlockHeight(), false)
-		if err != nil {
-			writeYNXAPIError(w, http.StatusInternalServerError, err.Error())
-			return
-		}
-
-		system, err := app.YNXKeeper.SystemConfig.Get(ctx)
-		if err != nil {
-			writeYNXAPIError(w, http.StatusInternalServerError, err.Error())
-			return
-		}
-
-		contracts, err := app.YNXKeeper.SystemContracts.Get(ctx)
-		if err != nil {
-			writeYNXAPIError(w, http.StatusInternalServerError, err.Error())
-			return
-		}
-
-		writeYNXAPIJSON(w, http.StatusOK, map[string]any{
-			"system":           system,
-			"system_contracts": contracts,
-		})
-	}).Methods(http.MethodGet, http.MethodHead)
-}
-
-func writeYNXAPIJSON(w http.ResponseWriter, status int, payload any) {
-	body, err := json.Marshal(payload)
-	if err != nil {
-		http.Error(w, `{"code":13,"message":"marshal_failed","details":[]}`, http.StatusInternalServerError)
-		return
-	}
-
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("Content-Length", cast.ToString(len(body)))
-	w.WriteHeader(status)
-	_, _ = w.Write(body)
-}
-
-func writeYNXAPIError(w http.ResponseWriter, status int, message string) {
-	writeYNXAPIJSON(w, status, map[string]any{
-		"code":    status,
-		"message": message,
-		"details": []any{},
-	})
-}
-
 // RegisterTxService implements the Application.RegisterTxService method.
 func (app *App) RegisterTxService(clientCtx client.Context) {
 	authtx.RegisterTxService(app.GRPCQueryRouter(), clientCtx, app.Simulate, app.interfaceRegistry)
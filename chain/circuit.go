@@ -0,0 +1,63 @@
+package ynx
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	ynxkeeper "github.com/JiahaoAlbus/YNX/chain/x/ynx/keeper"
+)
+
+// checkCircuitBreakers rejects msgs if a tripped circuit breaker blocks any of them: its message
+// type, the type of a message nested in an authz MsgExec, or the target and function selector of
+// an EVM call.
+//
+// Only the call made by an EVM transaction is checked here, which rejects it before it pays fees.
+// The calls it makes in turn are blocked when they run: EVM call breakers swap the code of their
+// target for a reverting stub and precompile breakers deactivate the precompile.
+func checkCircuitBreakers(ctx sdk.Context, msgs []sdk.Msg, ynxKeeper ynxkeeper.Keeper) error {
+	for _, msg := range msgs {
+		typeURL := sdk.MsgTypeURL(msg)
+		b, blocked, err := ynxKeeper.MsgCircuitBreaker(ctx, typeURL)
+		if err != nil {
+			return err
+		}
+		if blocked {
+			return errorsmod.Wrapf(errortypes.ErrUnauthorized, "%s is blocked by a circuit breaker until height %d", typeURL, b.ExpiryHeight)
+		}
+
+		switch msg := msg.(type) {
+		case *evmtypes.MsgEthereumTx:
+			if msg.Raw.Transaction == nil {
+				continue
+			}
+			ethTx := msg.AsTransaction()
+			if ethTx.To() == nil {
+				continue
+			}
+			b, blocked, err := ynxKeeper.CallCircuitBreaker(ctx, *ethTx.To(), ethTx.Data())
+			if err != nil {
+				return err
+			}
+			if blocked {
+				return errorsmod.Wrapf(
+					errortypes.ErrUnauthorized,
+					"call to %s is blocked by a circuit breaker until height %d", ethTx.To().Hex(), b.ExpiryHeight,
+				)
+			}
+		case *authz.MsgExec:
+			nested, err := msg.GetMessages()
+			if err != nil {
+				return err
+			}
+			if err := checkCircuitBreakers(ctx, nested, ynxKeeper); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
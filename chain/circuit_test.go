@@ -0,0 +1,67 @@
+package ynx
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
+)
+
+func TestCheckCircuitBreakers(t *testing.T) {
+	app, ctx := newFeeSplitTestApp(t)
+
+	guardian := sdk.AccAddress(common.HexToAddress("0x00000000000000000000000000000000000000BB").Bytes())
+	params, err := app.YNXKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.CircuitGuardianAddress = guardian.String()
+	require.NoError(t, app.YNXKeeper.Params.Set(ctx, params))
+
+	bridge := common.HexToAddress("0x3333333333333333333333333333333333333333")
+	app.EVMKeeper.SetCode(ctx, crypto.Keccak256([]byte{0x00}), []byte{0x00})
+	app.EVMKeeper.SetCodeHash(ctx, bridge.Bytes(), crypto.Keccak256([]byte{0x00}))
+	_, err = app.YNXKeeper.TripCircuitBreaker(ctx, guardian, ynxtypes.CircuitBreakerKind_CIRCUIT_BREAKER_KIND_MSG, sdk.MsgTypeURL(&banktypes.MsgSend{}), "", 10, "")
+	require.NoError(t, err)
+	_, err = app.YNXKeeper.TripCircuitBreaker(ctx, guardian, ynxtypes.CircuitBreakerKind_CIRCUIT_BREAKER_KIND_EVM_CALL, bridge.Hex(), "0xa9059cbb", 10, "")
+	require.NoError(t, err)
+
+	ethMsg := func(to common.Address, data []byte) sdk.Msg {
+		var msg evmtypes.MsgEthereumTx
+		msg.FromEthereumTx(ethtypes.NewTx(&ethtypes.LegacyTx{To: &to, Gas: 100_000, Data: data}))
+		return &msg
+	}
+	send := banktypes.NewMsgSend(guardian, guardian, sdk.NewCoins())
+	exec := authz.NewMsgExec(guardian, []sdk.Msg{send})
+	deposit := &banktypes.MsgMultiSend{}
+
+	for _, tc := range []struct {
+		name    string
+		msgs    []sdk.Msg
+		blocked bool
+	}{
+		{"blocked message type", []sdk.Msg{deposit, send}, true},
+		{"nested in authz", []sdk.Msg{&exec}, true},
+		{"blocked selector", []sdk.Msg{ethMsg(bridge, common.FromHex("0xa9059cbb00"))}, true},
+		{"other selector", []sdk.Msg{ethMsg(bridge, common.FromHex("0x23b872dd00"))}, false},
+		{"other message type", []sdk.Msg{deposit}, false},
+	} {
+		err := checkCircuitBreakers(ctx, tc.msgs, app.YNXKeeper)
+		if tc.blocked {
+			require.ErrorContains(t, err, "blocked by a circuit breaker", tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+
+	// The breakers stop blocking at their expiry height.
+	require.NoError(t, checkCircuitBreakers(ctx.WithBlockHeight(ctx.BlockHeight()+10), []sdk.Msg{send}, app.YNXKeeper))
+}
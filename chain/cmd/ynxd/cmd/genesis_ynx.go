@@ -1,18 +1,40 @@
 package cmd
 
 import (
+	"bytes"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
+	"io"
+	"math/big"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/spf13/cobra"
 
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v2"
+
+	dbm "github.com/cosmos/cosmos-db"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 
+	ynx "github.com/JiahaoAlbus/YNX/chain"
+	ynxkeeper "github.com/JiahaoAlbus/YNX/chain/x/ynx/keeper"
 	ynxmodtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
 )
 
@@ -33,13 +55,39 @@ const (
 	flagYNXSystemTimelockDelaySeconds   = "ynx.system.timelock-delay-seconds"
 	flagYNXSystemVestingCliffSeconds    = "ynx.system.vesting-cliff-seconds"
 	flagYNXSystemVestingDurationSeconds = "ynx.system.vesting-duration-seconds"
+	flagYNXSystemVestingReferenceTime   = "ynx.system.vesting-reference-time"
+	flagYNXSystemDeployMode             = "ynx.system.deploy-mode"
+	flagYNXSystemManifest               = "ynx.system.manifest"
+	flagYNXSystemNativeNYXT             = "ynx.system.native-nyxt"
+	flagYNXSystemStakeWeightedVotes     = "ynx.system.stake-weighted-votes"
+
+	flagYNXSystemAirdropMerkleRoot     = "ynx.system.airdrop.merkle-root"
+	flagYNXSystemAirdropTotalAmount    = "ynx.system.airdrop.total-amount"
+	flagYNXSystemAirdropClaimDeadline  = "ynx.system.airdrop.claim-deadline"
+	flagYNXSystemAirdropSweepRecipient = "ynx.system.airdrop.sweep-recipient"
 
 	flagYNXParamsFounder              = "ynx.params.founder"
 	flagYNXParamsTreasury             = "ynx.params.treasury"
 	flagYNXParamsFeeBurnBps           = "ynx.params.fee-burn-bps"
 	flagYNXParamsFeeTreasuryBps       = "ynx.params.fee-treasury-bps"
 	flagYNXParamsFeeFounderBps        = "ynx.params.fee-founder-bps"
+	flagYNXParamsFeeDeveloperBps      = "ynx.params.fee-developer-bps"
 	flagYNXParamsInflationTreasuryBps = "ynx.params.inflation-treasury-bps"
+	flagYNXParamsEpochLengthBlocks    = "ynx.params.epoch-length-blocks"
+	flagYNXParamsInflationRecipients  = "ynx.params.inflation-recipient"
+	flagYNXParamsFeeSettlementBlocks  = "ynx.params.fee-settlement-interval-blocks"
+	flagYNXParamsCircuitGuardian      = "ynx.params.circuit-guardian"
+	flagYNXParamsCircuitMaxBlocks     = "ynx.params.circuit-breaker-max-blocks"
+
+	flagYNXParamsFounderDecayStartHeight = "ynx.params.founder-decay.start-height"
+	flagYNXParamsFounderDecayEndHeight   = "ynx.params.founder-decay.end-height"
+	flagYNXParamsFounderDecayStartBps    = "ynx.params.founder-decay.start-bps"
+	flagYNXParamsFounderDecayEndBps      = "ynx.params.founder-decay.end-bps"
+	flagYNXParamsFounderDecayMode        = "ynx.params.founder-decay.mode"
+	flagYNXParamsFounderDecayStepBlocks  = "ynx.params.founder-decay.step-blocks"
+
+	flagYNXPreconfirmSigners   = "ynx.preconfirm.signer"
+	flagYNXPreconfirmThreshold = "ynx.preconfirm.threshold"
 )
 
 func ynxGenesisCmd() *cobra.Command {
@@ -48,7 +96,7 @@ func ynxGenesisCmd() *cobra.Command {
 		Short: "YNX genesis helpers",
 	}
 
-	cmd.AddCommand(ynxGenesisSetCmd())
+	cmd.AddCommand(ynxGenesisSetCmd(), ynxGenesisPredictCmd(), ynxGenesisManifestCmd(), ynxGenesisSimulateCmd(), ynxGenesisAirdropCmd())
 	return cmd
 }
 
@@ -58,34 +106,11 @@ func ynxGenesisSetCmd() *cobra.Command {
 		Short: "Set x/ynx genesis values in genesis.json",
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
-			home, err := cmd.Flags().GetString(flags.FlagHome)
-			if err != nil {
-				return err
-			}
-			if home == "" {
-				home = clientCtx.HomeDir
-			}
-			if home == "" {
-				return fmt.Errorf("home directory is required")
-			}
-
-			genFile := filepath.Join(home, "config", "genesis.json")
-
-			appGenesis, err := genutiltypes.AppGenesisFromFile(genFile)
+			genFile, appGenesis, appState, gs, err := readYNXGenesis(cmd, clientCtx)
 			if err != nil {
 				return err
 			}
 
-			var appState map[string]json.RawMessage
-			if err := json.Unmarshal(appGenesis.AppState, &appState); err != nil {
-				return fmt.Errorf("failed to unmarshal app state: %w", err)
-			}
-
-			gs := ynxmodtypes.DefaultGenesis()
-			if bz, ok := appState[ynxmodtypes.ModuleName]; ok && len(bz) > 0 {
-				clientCtx.Codec.MustUnmarshalJSON(bz, gs)
-			}
-
 			// system config
 			if cmd.Flags().Changed(flagYNXSystemEnabled) {
 				enabled, _ := cmd.Flags().GetBool(flagYNXSystemEnabled)
@@ -151,6 +176,59 @@ func ynxGenesisSetCmd() *cobra.Command {
 				v, _ := cmd.Flags().GetUint64(flagYNXSystemVestingDurationSeconds)
 				gs.System.VestingDurationSeconds = v
 			}
+			if cmd.Flags().Changed(flagYNXSystemVestingReferenceTime) {
+				v, _ := cmd.Flags().GetUint64(flagYNXSystemVestingReferenceTime)
+				gs.System.VestingReferenceTime = v
+			}
+			if cmd.Flags().Changed(flagYNXSystemDeployMode) {
+				v, _ := cmd.Flags().GetString(flagYNXSystemDeployMode)
+				switch v {
+				case "create":
+					gs.System.DeployMode = ynxmodtypes.SystemDeployMode_SYSTEM_DEPLOY_MODE_CREATE
+				case "create2":
+					gs.System.DeployMode = ynxmodtypes.SystemDeployMode_SYSTEM_DEPLOY_MODE_CREATE2
+				default:
+					return fmt.Errorf("invalid --%s %q (expected create or create2)", flagYNXSystemDeployMode, v)
+				}
+			}
+			if cmd.Flags().Changed(flagYNXSystemNativeNYXT) {
+				v, _ := cmd.Flags().GetBool(flagYNXSystemNativeNYXT)
+				gs.System.NativeNyxt = v
+			}
+			if cmd.Flags().Changed(flagYNXSystemStakeWeightedVotes) {
+				v, _ := cmd.Flags().GetBool(flagYNXSystemStakeWeightedVotes)
+				gs.System.StakeWeightedVotes = v
+			}
+			if cmd.Flags().Changed(flagYNXSystemManifest) {
+				v, _ := cmd.Flags().GetString(flagYNXSystemManifest)
+				gs.System.Manifest = ynxmodtypes.SystemManifest{}
+				if v != "" {
+					bz, err := os.ReadFile(v)
+					if err != nil {
+						return err
+					}
+					if err := clientCtx.Codec.UnmarshalJSON(bz, &gs.System.Manifest); err != nil {
+						return fmt.Errorf("invalid --%s %s: %w", flagYNXSystemManifest, v, err)
+					}
+				}
+			}
+
+			if cmd.Flags().Changed(flagYNXSystemAirdropMerkleRoot) {
+				v, _ := cmd.Flags().GetString(flagYNXSystemAirdropMerkleRoot)
+				gs.System.Airdrop.MerkleRoot = v
+			}
+			if cmd.Flags().Changed(flagYNXSystemAirdropTotalAmount) {
+				v, _ := cmd.Flags().GetString(flagYNXSystemAirdropTotalAmount)
+				gs.System.Airdrop.TotalAmount = v
+			}
+			if cmd.Flags().Changed(flagYNXSystemAirdropClaimDeadline) {
+				v, _ := cmd.Flags().GetUint64(flagYNXSystemAirdropClaimDeadline)
+				gs.System.Airdrop.ClaimDeadline = v
+			}
+			if cmd.Flags().Changed(flagYNXSystemAirdropSweepRecipient) {
+				v, _ := cmd.Flags().GetString(flagYNXSystemAirdropSweepRecipient)
+				gs.System.Airdrop.SweepRecipientAddress = v
+			}
 
 			// params
 			if cmd.Flags().Changed(flagYNXParamsFounder) {
@@ -173,9 +251,44 @@ func ynxGenesisSetCmd() *cobra.Command {
 				v, _ := cmd.Flags().GetUint32(flagYNXParamsFeeFounderBps)
 				gs.Params.FeeFounderBps = v
 			}
+			if cmd.Flags().Changed(flagYNXParamsFeeDeveloperBps) {
+				v, _ := cmd.Flags().GetUint32(flagYNXParamsFeeDeveloperBps)
+				gs.Params.FeeDeveloperBps = v
+			}
+			if cmd.Flags().Changed(flagYNXParamsEpochLengthBlocks) {
+				v, _ := cmd.Flags().GetUint64(flagYNXParamsEpochLengthBlocks)
+				gs.Params.EpochLengthBlocks = v
+			}
+			if cmd.Flags().Changed(flagYNXParamsFeeSettlementBlocks) {
+				v, _ := cmd.Flags().GetUint64(flagYNXParamsFeeSettlementBlocks)
+				gs.Params.FeeSettlementIntervalBlocks = v
+			}
+			if cmd.Flags().Changed(flagYNXParamsCircuitGuardian) {
+				v, _ := cmd.Flags().GetString(flagYNXParamsCircuitGuardian)
+				gs.Params.CircuitGuardianAddress = v
+			}
+			if cmd.Flags().Changed(flagYNXParamsCircuitMaxBlocks) {
+				v, _ := cmd.Flags().GetUint64(flagYNXParamsCircuitMaxBlocks)
+				gs.Params.CircuitBreakerMaxBlocks = v
+			}
+			if cmd.Flags().Changed(flagYNXParamsInflationRecipients) {
+				v, _ := cmd.Flags().GetStringArray(flagYNXParamsInflationRecipients)
+				recipients, err := parseInflationRecipients(v)
+				if err != nil {
+					return err
+				}
+				gs.Params.InflationRecipients = recipients
+			}
+			// Applied after the recipient list, which it adjusts rather than replaces.
 			if cmd.Flags().Changed(flagYNXParamsInflationTreasuryBps) {
 				v, _ := cmd.Flags().GetUint32(flagYNXParamsInflationTreasuryBps)
-				gs.Params.InflationTreasuryBps = v
+				gs.Params.SetInflationTreasuryShareBps(v)
+			}
+			if err := applyFounderFeeDecayFlags(cmd, &gs.Params); err != nil {
+				return err
+			}
+			if err := applyPreconfirmSignerFlags(cmd, gs); err != nil {
+				return err
 			}
 
 			// Clear previously exported addresses if system deploy is enabled.
@@ -198,7 +311,15 @@ func ynxGenesisSetCmd() *cobra.Command {
 			}
 
 			_, _ = fmt.Fprintf(os.Stderr, "Updated %s\n", genFile)
-			return nil
+
+			if !gs.System.Enabled {
+				return nil
+			}
+			contracts, err := predictSystemContracts(clientCtx, appGenesis, appState, gs.System)
+			if err != nil {
+				return err
+			}
+			return printSystemContracts(cmd, contracts)
 		},
 	}
 
@@ -220,13 +341,545 @@ func ynxGenesisSetCmd() *cobra.Command {
 	cmd.Flags().Uint64(flagYNXSystemTimelockDelaySeconds, 0, "timelock delay (in seconds)")
 	cmd.Flags().Uint64(flagYNXSystemVestingCliffSeconds, 0, "team vesting cliff (in seconds)")
 	cmd.Flags().Uint64(flagYNXSystemVestingDurationSeconds, 0, "team vesting duration (in seconds)")
+	cmd.Flags().Uint64(flagYNXSystemVestingReferenceTime, 0, "unix time the team vesting cliff counts from (0 uses the genesis time)")
+	cmd.Flags().String(flagYNXSystemDeployMode, "", "system contract address derivation (create|create2; create2 gives the same addresses on every chain)")
+	cmd.Flags().String(flagYNXSystemManifest, "", "JSON file with the system contract deployment manifest (empty deploys the default manifest of the deploy mode)")
+	cmd.Flags().Bool(flagYNXSystemNativeNYXT, false, "pay the NYXT allocations in the native denom and govern with the votes precompile instead of deploying a standalone NYXT ERC20")
+	cmd.Flags().Bool(flagYNXSystemStakeWeightedVotes, false, "make the governor count bonded stake through the stake votes precompile instead of NYXT votes")
+	cmd.Flags().String(flagYNXSystemAirdropMerkleRoot, "", "airdrop claim tree root printed by genesis ynx airdrop build (empty disables the airdrop)")
+	cmd.Flags().String(flagYNXSystemAirdropTotalAmount, "", "NYXT amount the airdrop distributor is funded with, out of the community allocation (uint256)")
+	cmd.Flags().Uint64(flagYNXSystemAirdropClaimDeadline, 0, "unix time after which airdrop claims close and the rest can be swept")
+	cmd.Flags().String(flagYNXSystemAirdropSweepRecipient, "", "recipient of the unclaimed airdrop balance (0x or bech32)")
 
 	cmd.Flags().String(flagYNXParamsFounder, "", "founder fee recipient (bech32)")
 	cmd.Flags().String(flagYNXParamsTreasury, "", "treasury recipient (bech32; optional, defaults to deployed treasury contract)")
 	cmd.Flags().Uint32(flagYNXParamsFeeBurnBps, 0, "fee burn basis points (0-10000)")
 	cmd.Flags().Uint32(flagYNXParamsFeeTreasuryBps, 0, "fee treasury basis points (0-10000)")
 	cmd.Flags().Uint32(flagYNXParamsFeeFounderBps, 0, "fee founder basis points (0-10000)")
-	cmd.Flags().Uint32(flagYNXParamsInflationTreasuryBps, 0, "inflation treasury basis points (0-10000)")
+	cmd.Flags().Uint32(flagYNXParamsFeeDeveloperBps, 0, "fee basis points rebated to registered contracts (0-10000)")
+	cmd.Flags().Uint32(flagYNXParamsInflationTreasuryBps, 0, "inflation treasury basis points (0-10000); sets the treasury inflation recipient")
+	cmd.Flags().Uint64(flagYNXParamsEpochLengthBlocks, 0, "revenue accounting epoch length (in blocks)")
+	cmd.Flags().Uint64(flagYNXParamsFeeSettlementBlocks, 0, "settle protocol fee shares every N blocks (0 pays them per transaction)")
+	cmd.Flags().String(flagYNXParamsCircuitGuardian, "", "account allowed to trip circuit breakers, e.g. an emergency multisig (bech32)")
+	cmd.Flags().Uint64(flagYNXParamsCircuitMaxBlocks, 0, "longest a circuit breaker tripped by the guardian stays tripped (in blocks)")
+	cmd.Flags().StringArray(flagYNXParamsInflationRecipients, nil, "inflation recipient as <name>:<bech32 address|community-pool|treasury>:<bps> (repeatable; replaces the existing list)")
+	cmd.Flags().Int64(flagYNXParamsFounderDecayStartHeight, 0, "founder fee decay start height")
+	cmd.Flags().Int64(flagYNXParamsFounderDecayEndHeight, 0, "founder fee decay end height")
+	cmd.Flags().Uint32(flagYNXParamsFounderDecayStartBps, 0, "founder fee basis points up to the decay start height (0-10000)")
+	cmd.Flags().Uint32(flagYNXParamsFounderDecayEndBps, 0, "founder fee basis points from the decay end height (0-10000)")
+	cmd.Flags().String(flagYNXParamsFounderDecayMode, "", "founder fee decay mode (linear|step)")
+	cmd.Flags().Uint64(flagYNXParamsFounderDecayStepBlocks, 0, "founder fee decay step length in step mode (in blocks)")
+	cmd.Flags().StringArray(flagYNXPreconfirmSigners, nil, "0x address of a preconfirm signer registered from the genesis epoch (repeatable; replaces the registered sets)")
+	cmd.Flags().Uint32(flagYNXPreconfirmThreshold, 0, "signatures a preconfirm receipt needs from the registered signers (default: number of signers)")
 
 	return cmd
 }
+
+func ynxGenesisPredictCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "predict",
+		Short: "Print the addresses InitGenesis deploys the system contracts at",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			genFile, appGenesis, appState, gs, err := readYNXGenesis(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+			if !gs.System.Enabled {
+				return fmt.Errorf("system contract deployment is disabled in %s", genFile)
+			}
+
+			contracts, err := predictSystemContracts(clientCtx, appGenesis, appState, gs.System)
+			if err != nil {
+				return err
+			}
+			return printSystemContracts(cmd, contracts)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, "", "node's home directory")
+	return cmd
+}
+
+func ynxGenesisManifestCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "manifest",
+		Short: "Print the system contract deployment manifest InitGenesis deploys",
+		Long: `Print the system contract deployment manifest InitGenesis deploys, as JSON. It is the manifest
+set with --ynx.system.manifest, or the default manifest of the deploy mode. The output is a
+starting point for a custom manifest.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			_, _, _, gs, err := readYNXGenesis(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+
+			manifest := gs.System.DeployManifest()
+			bz, err := clientCtx.Codec.MarshalJSON(&manifest)
+			if err != nil {
+				return err
+			}
+			var out bytes.Buffer
+			if err := json.Indent(&out, bz, "", "  "); err != nil {
+				return err
+			}
+			cmd.Println(out.String())
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, "", "node's home directory")
+	return cmd
+}
+
+func ynxGenesisSimulateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate",
+		Short: "Dry-run the genesis system contract deployment",
+		Long: `Load genesis.json into an in-memory app and run the x/ynx system contract deployment the
+way InitGenesis does. Print each contract with its gas used and code hash, the NYXT allocations by
+recipient, the governor and timelock wiring and the effective treasury_address.
+
+The command fails if the deployment fails or a wiring check does not hold.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			genFile, appGenesis, appState, gs, err := readYNXGenesis(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+			if !gs.System.Enabled {
+				return fmt.Errorf("system contract deployment is disabled in %s", genFile)
+			}
+			output, err := cmd.Flags().GetString(flags.FlagOutput)
+			if err != nil {
+				return err
+			}
+
+			report, err := simulateGenesisSystem(appGenesis, appState, gs)
+			if err != nil {
+				return fmt.Errorf("system contract deployment fails: %w", err)
+			}
+
+			switch output {
+			case flags.OutputFormatJSON:
+				bz, err := json.MarshalIndent(report, "", "  ")
+				if err != nil {
+					return err
+				}
+				cmd.Println(string(bz))
+			case flags.OutputFormatText:
+				writeSystemDeployReport(cmd.OutOrStdout(), report)
+			default:
+				return fmt.Errorf("invalid --%s %q (expected text or json)", flags.FlagOutput, output)
+			}
+
+			if !report.OK() {
+				return fmt.Errorf("system contract wiring checks failed")
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, "", "node's home directory")
+	cmd.Flags().StringP(flags.FlagOutput, "o", flags.OutputFormatText, "output format (text|json)")
+	return cmd
+}
+
+func ynxGenesisAirdropCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "airdrop",
+		Short: "Genesis NYXT airdrop helpers",
+	}
+
+	cmd.AddCommand(ynxGenesisAirdropBuildCmd())
+	return cmd
+}
+
+// airdropBuild is the output of `ynxd genesis ynx airdrop build`.
+type airdropBuild struct {
+	MerkleRoot  string              `json:"merkle_root"`
+	TotalAmount string              `json:"total_amount"`
+	Claims      []airdropClaimProof `json:"claims"`
+}
+
+// airdropClaimProof holds the arguments of a YNXMerkleDistributor claim.
+type airdropClaimProof struct {
+	Index   uint64   `json:"index"`
+	Account string   `json:"account"`
+	Amount  string   `json:"amount"`
+	Proof   []string `json:"proof"`
+}
+
+func ynxGenesisAirdropBuildCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "build <csv>",
+		Short: "Build the airdrop claim tree from a CSV of recipients",
+		Long: `Build the airdrop claim tree from a CSV file of <address>,<amount> rows, where the address is
+0x hex or bech32 and the amount is in the NYXT base unit. A header row is skipped. Claim indexes
+follow the row order.
+
+Print the merkle root, the total amount and the claim of every recipient with its proof, as JSON.
+With --output-document the claims are written to that file and only the root and the total are
+printed. Set the root and the total with 'ynxd genesis ynx set --ynx.system.airdrop.merkle-root
+--ynx.system.airdrop.total-amount'.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			f, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			claims, err := parseAirdropCSV(f)
+			if err != nil {
+				return fmt.Errorf("%s: %w", args[0], err)
+			}
+			tree, err := ynxmodtypes.NewAirdropTree(claims)
+			if err != nil {
+				return fmt.Errorf("%s: %w", args[0], err)
+			}
+
+			out := airdropBuild{MerkleRoot: tree.Root().Hex(), Claims: make([]airdropClaimProof, 0, len(claims))}
+			total := new(big.Int)
+			for _, c := range claims {
+				proof, err := tree.Proof(c.Index)
+				if err != nil {
+					return err
+				}
+				claim := airdropClaimProof{Index: c.Index, Account: c.Account.Hex(), Amount: c.Amount.String(), Proof: make([]string, len(proof))}
+				for i, p := range proof {
+					claim.Proof[i] = p.Hex()
+				}
+				out.Claims = append(out.Claims, claim)
+				total.Add(total, c.Amount)
+			}
+			out.TotalAmount = total.String()
+
+			outputDocument, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+			if outputDocument != "" {
+				bz, err := json.MarshalIndent(out, "", "  ")
+				if err != nil {
+					return err
+				}
+				if err := os.WriteFile(outputDocument, bz, 0o644); err != nil {
+					return err
+				}
+				out.Claims = nil
+			}
+
+			bz, err := json.MarshalIndent(out, "", "  ")
+			if err != nil {
+				return err
+			}
+			cmd.Println(string(bz))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagOutputDocument, "", "write the claims with their proofs to this file")
+	return cmd
+}
+
+// parseAirdropCSV reads <address>,<amount> rows. The first row is skipped when its address is not
+// an address, so a header row is allowed.
+func parseAirdropCSV(r io.Reader) ([]ynxmodtypes.AirdropClaim, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 2
+	reader.TrimLeadingSpace = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	claims := make([]ynxmodtypes.AirdropClaim, 0, len(records))
+	for i, record := range records {
+		account, err := parseAirdropAccount(strings.TrimSpace(record[0]))
+		if err != nil {
+			if i == 0 {
+				continue
+			}
+			return nil, fmt.Errorf("row %d: %w", i+1, err)
+		}
+		amount, ok := new(big.Int).SetString(strings.TrimSpace(record[1]), 10)
+		if !ok {
+			return nil, fmt.Errorf("row %d: invalid amount %q", i+1, record[1])
+		}
+		claims = append(claims, ynxmodtypes.AirdropClaim{Index: uint64(len(claims)), Account: account, Amount: amount})
+	}
+	return claims, nil
+}
+
+func parseAirdropAccount(s string) (common.Address, error) {
+	if common.IsHexAddress(s) {
+		return common.HexToAddress(s), nil
+	}
+	acc, err := sdk.AccAddressFromBech32(s)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("invalid address %q", s)
+	}
+	return common.BytesToAddress(acc.Bytes()), nil
+}
+
+// readYNXGenesis reads <home>/config/genesis.json and its x/ynx genesis state.
+func readYNXGenesis(
+	cmd *cobra.Command,
+	clientCtx client.Context,
+) (string, *genutiltypes.AppGenesis, map[string]json.RawMessage, *ynxmodtypes.GenesisState, error) {
+	home, err := cmd.Flags().GetString(flags.FlagHome)
+	if err != nil {
+		return "", nil, nil, nil, err
+	}
+	if home == "" {
+		home = clientCtx.HomeDir
+	}
+	if home == "" {
+		return "", nil, nil, nil, fmt.Errorf("home directory is required")
+	}
+
+	genFile := filepath.Join(home, "config", "genesis.json")
+
+	appGenesis, err := genutiltypes.AppGenesisFromFile(genFile)
+	if err != nil {
+		return "", nil, nil, nil, err
+	}
+
+	var appState map[string]json.RawMessage
+	if err := json.Unmarshal(appGenesis.AppState, &appState); err != nil {
+		return "", nil, nil, nil, fmt.Errorf("failed to unmarshal app state: %w", err)
+	}
+
+	gs := ynxmodtypes.DefaultGenesis()
+	if bz, ok := appState[ynxmodtypes.ModuleName]; ok && len(bz) > 0 {
+		clientCtx.Codec.MustUnmarshalJSON(bz, gs)
+	}
+	return genFile, appGenesis, appState, gs, nil
+}
+
+// simulateGenesisSystem runs the system contract deployment of gs in an in-memory app that holds
+// the genesis state the deployment reads: the deployer account, the bank denom metadata behind the
+// EVM coin info, the EVM params and preinstalls and the fee market params.
+func simulateGenesisSystem(
+	appGenesis *genutiltypes.AppGenesis,
+	appState map[string]json.RawMessage,
+	gs *ynxmodtypes.GenesisState,
+) (report *ynxkeeper.SystemDeployReport, err error) {
+	// Module InitGenesis reports invalid state by panicking.
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	app := ynx.NewApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{})
+	ctx := app.NewUncachedContext(false, cmtproto.Header{
+		ChainID: appGenesis.ChainID,
+		Height:  appGenesis.InitialHeight,
+		Time:    appGenesis.GenesisTime,
+	})
+
+	for _, name := range []string{authtypes.ModuleName, banktypes.ModuleName, evmtypes.ModuleName, feemarkettypes.ModuleName} {
+		bz, ok := appState[name]
+		if !ok {
+			continue
+		}
+		switch m := app.ModuleManager.Modules[name].(type) {
+		case module.HasGenesis:
+			m.InitGenesis(ctx, app.AppCodec(), bz)
+		case module.HasABCIGenesis:
+			m.InitGenesis(ctx, app.AppCodec(), bz)
+		default:
+			return nil, fmt.Errorf("module %s has no genesis", name)
+		}
+	}
+
+	return app.YNXKeeper.SimulateGenesisSystem(ctx, gs)
+}
+
+func writeSystemDeployReport(w io.Writer, report *ynxkeeper.SystemDeployReport) {
+	fmt.Fprintf(w, "deploy mode: %s\n", report.DeployMode)
+	fmt.Fprintf(w, "deployer: %s\n", report.Deployer)
+	fmt.Fprintf(w, "gas used: %d\n", report.GasUsed)
+	fmt.Fprintf(w, "treasury_address: %s\n", report.TreasuryAddress)
+
+	fmt.Fprintln(w, "contracts:")
+	for _, c := range report.Contracts {
+		fmt.Fprintf(w, "  %s\t%s\t%s\tgas %d\tcode hash %s\n", c.Name, c.Artifact, c.Address, c.GasUsed, c.CodeHash)
+	}
+	fmt.Fprintln(w, "allocations:")
+	for _, a := range report.Allocations {
+		fmt.Fprintf(w, "  %s\t%s\t%s\n", a.Recipient, a.Address, a.Amount)
+	}
+	fmt.Fprintln(w, "wiring:")
+	for _, c := range report.Wiring {
+		status := "ok"
+		if !c.OK {
+			status = "FAILED"
+		}
+		fmt.Fprintf(w, "  %s: %s (expected %s, got %s)\n", c.Check, status, c.Expected, c.Actual)
+	}
+}
+
+// predictSystemContracts returns the system contract addresses InitGenesis of appGenesis deploys.
+// In CREATE mode they depend on the deployer sequence in the auth genesis accounts, since x/ynx
+// InitGenesis runs before the gentxs are delivered.
+func predictSystemContracts(
+	clientCtx client.Context,
+	appGenesis *genutiltypes.AppGenesis,
+	appState map[string]json.RawMessage,
+	cfg ynxmodtypes.SystemConfig,
+) (ynxmodtypes.SystemContracts, error) {
+	var nonce uint64
+	if cfg.DeployMode == ynxmodtypes.SystemDeployMode_SYSTEM_DEPLOY_MODE_CREATE {
+		var deployer sdk.AccAddress
+		if common.IsHexAddress(cfg.DeployerAddress) {
+			deployer = common.HexToAddress(cfg.DeployerAddress).Bytes()
+		} else {
+			var err error
+			if deployer, err = sdk.AccAddressFromBech32(cfg.DeployerAddress); err != nil {
+				return ynxmodtypes.SystemContracts{}, fmt.Errorf("invalid system.deployer_address: %w", err)
+			}
+		}
+
+		authGenState := authtypes.GetGenesisStateFromAppState(clientCtx.Codec, appState)
+		accounts, err := authtypes.UnpackAccounts(authGenState.Accounts)
+		if err != nil {
+			return ynxmodtypes.SystemContracts{}, err
+		}
+		for _, acc := range accounts {
+			if acc.GetAddress().Equals(deployer) {
+				nonce = acc.GetSequence()
+			}
+		}
+	}
+
+	return ynxkeeper.PredictSystemContracts(cfg, nonce, appGenesis.GenesisTime)
+}
+
+func printSystemContracts(cmd *cobra.Command, contracts ynxmodtypes.SystemContracts) error {
+	for _, e := range contracts.Contracts {
+		if _, err := fmt.Fprintf(cmd.OutOrStdout(), "%s\t%s\n", e.Name, e.Address); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyFounderFeeDecayFlags sets params.FounderFeeDecay from the founder-decay flags. The schedule
+// is only created when at least one of them is set.
+func applyFounderFeeDecayFlags(cmd *cobra.Command, params *ynxmodtypes.Params) error {
+	flagNames := []string{
+		flagYNXParamsFounderDecayStartHeight,
+		flagYNXParamsFounderDecayEndHeight,
+		flagYNXParamsFounderDecayStartBps,
+		flagYNXParamsFounderDecayEndBps,
+		flagYNXParamsFounderDecayMode,
+		flagYNXParamsFounderDecayStepBlocks,
+	}
+	changed := false
+	for _, name := range flagNames {
+		changed = changed || cmd.Flags().Changed(name)
+	}
+	if !changed {
+		return nil
+	}
+
+	if params.FounderFeeDecay == nil {
+		params.FounderFeeDecay = &ynxmodtypes.FounderFeeDecay{
+			Mode: ynxmodtypes.FounderFeeDecayMode_FOUNDER_FEE_DECAY_MODE_LINEAR,
+		}
+	}
+	decay := params.FounderFeeDecay
+
+	if cmd.Flags().Changed(flagYNXParamsFounderDecayStartHeight) {
+		v, _ := cmd.Flags().GetInt64(flagYNXParamsFounderDecayStartHeight)
+		decay.StartHeight = v
+	}
+	if cmd.Flags().Changed(flagYNXParamsFounderDecayEndHeight) {
+		v, _ := cmd.Flags().GetInt64(flagYNXParamsFounderDecayEndHeight)
+		decay.EndHeight = v
+	}
+	if cmd.Flags().Changed(flagYNXParamsFounderDecayStartBps) {
+		v, _ := cmd.Flags().GetUint32(flagYNXParamsFounderDecayStartBps)
+		decay.StartBps = v
+	}
+	if cmd.Flags().Changed(flagYNXParamsFounderDecayEndBps) {
+		v, _ := cmd.Flags().GetUint32(flagYNXParamsFounderDecayEndBps)
+		decay.EndBps = v
+	}
+	if cmd.Flags().Changed(flagYNXParamsFounderDecayMode) {
+		v, _ := cmd.Flags().GetString(flagYNXParamsFounderDecayMode)
+		switch v {
+		case "linear":
+			decay.Mode = ynxmodtypes.FounderFeeDecayMode_FOUNDER_FEE_DECAY_MODE_LINEAR
+		case "step":
+			decay.Mode = ynxmodtypes.FounderFeeDecayMode_FOUNDER_FEE_DECAY_MODE_STEP
+		default:
+			return fmt.Errorf("invalid --%s %q (expected linear or step)", flagYNXParamsFounderDecayMode, v)
+		}
+	}
+	if cmd.Flags().Changed(flagYNXParamsFounderDecayStepBlocks) {
+		v, _ := cmd.Flags().GetUint64(flagYNXParamsFounderDecayStepBlocks)
+		decay.StepBlocks = v
+	}
+
+	return nil
+}
+
+// applyPreconfirmSignerFlags replaces the registered preconfirm signer sets with a single set active
+// from the genesis epoch.
+func applyPreconfirmSignerFlags(cmd *cobra.Command, gs *ynxmodtypes.GenesisState) error {
+	if !cmd.Flags().Changed(flagYNXPreconfirmSigners) && !cmd.Flags().Changed(flagYNXPreconfirmThreshold) {
+		return nil
+	}
+
+	signers, _ := cmd.Flags().GetStringArray(flagYNXPreconfirmSigners)
+	threshold, _ := cmd.Flags().GetUint32(flagYNXPreconfirmThreshold)
+	if threshold == 0 {
+		threshold = uint32(len(signers)) //nolint:gosec // bounded by MaxPreconfirmSigners
+	}
+
+	set, err := ynxmodtypes.PreconfirmSignerSet{
+		ActivationEpoch: gs.Epoch.Number,
+		Signers:         signers,
+		Threshold:       threshold,
+	}.Normalize()
+	if err != nil {
+		return fmt.Errorf("invalid --%s: %w", flagYNXPreconfirmSigners, err)
+	}
+	gs.PreconfirmSignerSets = []ynxmodtypes.PreconfirmSignerSet{set}
+	return nil
+}
+
+// parseInflationRecipients parses <name>:<bech32 address|community-pool|treasury>:<bps> values.
+func parseInflationRecipients(values []string) ([]ynxmodtypes.InflationRecipient, error) {
+	recipients := make([]ynxmodtypes.InflationRecipient, 0, len(values))
+	for _, v := range values {
+		parts := strings.Split(v, ":")
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("invalid --%s %q (expected <name>:<address|community-pool|treasury>:<bps>)", flagYNXParamsInflationRecipients, v)
+		}
+
+		bps, err := strconv.ParseUint(parts[2], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --%s %q bps: %w", flagYNXParamsInflationRecipients, v, err)
+		}
+
+		recipient := ynxmodtypes.InflationRecipient{
+			Name:    parts[0],
+			Kind:    ynxmodtypes.InflationRecipientKind_INFLATION_RECIPIENT_KIND_ACCOUNT,
+			Address: parts[1],
+			Bps:     uint32(bps),
+		}
+		switch parts[1] {
+		case "community-pool":
+			recipient.Kind = ynxmodtypes.InflationRecipientKind_INFLATION_RECIPIENT_KIND_COMMUNITY_POOL
+			recipient.Address = ""
+		case "treasury":
+			recipient.Kind = ynxmodtypes.InflationRecipientKind_INFLATION_RECIPIENT_KIND_TREASURY
+			recipient.Address = ""
+		}
+		recipients = append(recipients, recipient)
+	}
+	return recipients, nil
+}
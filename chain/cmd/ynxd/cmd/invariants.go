@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v2"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdkserver "github.com/cosmos/cosmos-sdk/server"
+
+	ynx "github.com/JiahaoAlbus/YNX/chain"
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
+)
+
+const flagInvariantsHeight = "height"
+
+func invariantsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "invariants [route]",
+		Short: "Run the x/ynx invariants against the node's state",
+		Long: `Load the state committed under <home>/data and run the x/ynx invariants against it, or only
+the invariant registered under [route]. Nothing is written to the state.
+
+The invariants walk the whole x/ynx state, so they are not served to clients; run them on the
+node's host instead. Stop the node (or point --home at a snapshot of it) first, as its databases
+cannot be opened while it runs.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := ""
+			if len(args) > 0 {
+				route = args[0]
+			}
+
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			home, err := cmd.Flags().GetString(flags.FlagHome)
+			if err != nil {
+				return err
+			}
+			if home == "" {
+				home = clientCtx.HomeDir
+			}
+			height, err := cmd.Flags().GetInt64(flagInvariantsHeight)
+			if err != nil {
+				return err
+			}
+
+			return runInvariants(cmd, home, height, route)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, "", "node's home directory")
+	cmd.Flags().Int64(flagInvariantsHeight, 0, "run against the state committed at this height instead of the latest")
+	return cmd
+}
+
+// runInvariants loads the state committed under home at height, or the latest state if height is
+// zero, and reports the x/ynx invariants. Nothing is committed.
+func runInvariants(cmd *cobra.Command, home string, height int64, route string) error {
+	serverCtx := sdkserver.GetServerContextFromCmd(cmd)
+	appOpts := serverCtx.Viper
+	appOpts.Set(flags.FlagHome, home)
+
+	chainID, err := getChainIDFromOpts(appOpts)
+	if err != nil {
+		return err
+	}
+
+	db, err := dbm.NewDB("application", sdkserver.GetAppDBBackend(appOpts), filepath.Join(home, "data"))
+	if err != nil {
+		return fmt.Errorf("open application database (is the node running?): %w", err)
+	}
+	defer db.Close()
+
+	app := ynx.NewApp(serverCtx.Logger, db, nil, false, appOpts, baseapp.SetChainID(chainID))
+	if height > 0 {
+		err = app.LoadVersion(height)
+	} else {
+		err = app.LoadLatestVersion()
+	}
+	if err != nil {
+		return err
+	}
+	if app.LastBlockHeight() == 0 {
+		return fmt.Errorf("no committed state under %s", home)
+	}
+
+	ctx := app.NewUncachedContext(false, cmtproto.Header{ChainID: chainID, Height: app.LastBlockHeight()})
+	results, err := app.YNXKeeper.RunInvariants(ctx, route)
+	if err != nil {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	fmt.Fprintf(out, "state at height %d\n", app.LastBlockHeight())
+	if writeInvariants(out, results) {
+		return fmt.Errorf("x/ynx invariants are broken at height %d", app.LastBlockHeight())
+	}
+	return nil
+}
+
+// writeInvariants lists the invariant results, with the message of each broken one, and reports
+// whether any is broken.
+func writeInvariants(w io.Writer, results []ynxtypes.InvariantResult) bool {
+	broken := false
+	for _, r := range results {
+		status := "ok"
+		if r.Broken {
+			status = "BROKEN"
+			broken = true
+		}
+		fmt.Fprintf(w, "invariant %s: %s\n", r.Route, status)
+		if r.Broken {
+			fmt.Fprintln(w, r.Message)
+		}
+	}
+	return broken
+}
@@ -72,6 +72,7 @@ func preconfirmKeygenCmd() *cobra.Command {
 			}
 
 			_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Wrote %s\n", out)
+			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "%s\n", crypto.PubkeyToAddress(key.PublicKey).Hex())
 			return nil
 		},
 	}
@@ -240,6 +240,8 @@ func initRootCmd(rootCmd *cobra.Command, evmApp *ynx.App) {
 		initCmd(evmApp, defaultNodeHome),
 		genesisCmd,
 		preconfirmCmd(),
+		upgradeCmd(),
+		invariantsCmd(),
 		cmtcli.NewCompletionCmd(rootCmd, true),
 		evmdebug.Cmd(),
 		confixcmd.ConfigCommand(),
@@ -0,0 +1,230 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v2"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/core/header"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdkserver "github.com/cosmos/cosmos-sdk/server"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	ynx "github.com/JiahaoAlbus/YNX/chain"
+)
+
+const flagUpgradeKeep = "keep"
+
+func upgradeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade",
+		Short: "Software upgrade helpers",
+	}
+
+	cmd.AddCommand(upgradeCheckCmd())
+	return cmd
+}
+
+func upgradeCheckCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check <name>",
+		Short: "Dry-run a registered upgrade against a copy of the data directory",
+		Long: `Copy <home>/data and <home>/config to a temporary home, load the copied state with the
+upgrade's store changes and run the upgrade handler at the next height: the module migrations,
+the post-upgrade hooks and the x/ynx invariants. The node's own data directory is never written.
+
+Stop the node (or point --home at a snapshot of it) before running the check, so the copied
+databases are consistent.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			u, ok := ynx.GetUpgrade(args[0])
+			if !ok {
+				names := make([]string, 0, len(ynx.Upgrades))
+				for _, u := range ynx.Upgrades {
+					names = append(names, u.Name)
+				}
+				return fmt.Errorf("unknown upgrade %q (registered: %s)", args[0], strings.Join(names, ", "))
+			}
+
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			home, err := cmd.Flags().GetString(flags.FlagHome)
+			if err != nil {
+				return err
+			}
+			if home == "" {
+				home = clientCtx.HomeDir
+			}
+			keep, err := cmd.Flags().GetBool(flagUpgradeKeep)
+			if err != nil {
+				return err
+			}
+
+			tmpHome, err := os.MkdirTemp("", "ynxd-upgrade-check-")
+			if err != nil {
+				return err
+			}
+			if keep {
+				cmd.Printf("upgraded copy kept at %s\n", tmpHome)
+			} else {
+				defer os.RemoveAll(tmpHome)
+			}
+
+			for _, dir := range []string{"data", "config"} {
+				if err := copyDir(filepath.Join(home, dir), filepath.Join(tmpHome, dir)); err != nil {
+					return fmt.Errorf("copy %s: %w", dir, err)
+				}
+			}
+
+			return checkUpgrade(cmd, u, tmpHome)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, "", "node's home directory")
+	cmd.Flags().Bool(flagUpgradeKeep, false, "keep the upgraded copy of the home directory")
+	return cmd
+}
+
+// checkUpgrade applies u to the state under home and reports the module versions and the x/ynx
+// invariants. Nothing is committed.
+func checkUpgrade(cmd *cobra.Command, u ynx.Upgrade, home string) error {
+	serverCtx := sdkserver.GetServerContextFromCmd(cmd)
+	appOpts := serverCtx.Viper
+	appOpts.Set(flags.FlagHome, home)
+
+	chainID, err := getChainIDFromOpts(appOpts)
+	if err != nil {
+		return err
+	}
+
+	db, err := dbm.NewDB("application", sdkserver.GetAppDBBackend(appOpts), filepath.Join(home, "data"))
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	app := ynx.NewApp(serverCtx.Logger, db, nil, false, appOpts, baseapp.SetChainID(chainID))
+	app.SetStoreLoader(func(ms storetypes.CommitMultiStore) error {
+		return ms.LoadLatestVersionAndUpgrade(&u.StoreUpgrades)
+	})
+	if err := app.LoadLatestVersion(); err != nil {
+		return err
+	}
+	if app.LastBlockHeight() == 0 {
+		return fmt.Errorf("no committed state under %s", home)
+	}
+
+	height := app.LastBlockHeight() + 1
+	now := time.Now().UTC()
+	ctx := app.NewUncachedContext(false, cmtproto.Header{ChainID: chainID, Height: height, Time: now}).
+		WithHeaderInfo(header.Info{ChainID: chainID, Height: height, Time: now})
+
+	if done, err := app.UpgradeKeeper.GetDoneHeight(ctx, u.Name); err != nil {
+		return err
+	} else if done > 0 {
+		return fmt.Errorf("upgrade %s was already applied at height %d", u.Name, done)
+	}
+
+	fromVM, err := app.UpgradeKeeper.GetModuleVersionMap(ctx)
+	if err != nil {
+		return err
+	}
+	if err := app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: u.Name, Height: height}); err != nil {
+		return fmt.Errorf("upgrade %s failed at height %d: %w", u.Name, height, err)
+	}
+	toVM, err := app.UpgradeKeeper.GetModuleVersionMap(ctx)
+	if err != nil {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	fmt.Fprintf(out, "upgrade %s applied at height %d\n", u.Name, height)
+	writeModuleVersions(out, fromVM, toVM)
+
+	results, err := app.YNXKeeper.RunInvariants(ctx, "")
+	if err != nil {
+		return err
+	}
+	if writeInvariants(out, results) {
+		return fmt.Errorf("upgrade %s breaks x/ynx invariants", u.Name)
+	}
+	return nil
+}
+
+// writeModuleVersions lists the modules whose consensus version the upgrade changed.
+func writeModuleVersions(w io.Writer, fromVM, toVM map[string]uint64) {
+	names := make([]string, 0, len(toVM))
+	for name, v := range toVM {
+		if fromVM[name] != v {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	if len(names) == 0 {
+		fmt.Fprintln(w, "no module migrations")
+		return
+	}
+	for _, name := range names {
+		if from, ok := fromVM[name]; ok {
+			fmt.Fprintf(w, "module %s: %d -> %d\n", name, from, toVM[name])
+		} else {
+			fmt.Fprintf(w, "module %s: added at %d\n", name, toVM[name])
+		}
+	}
+}
+
+// copyDir copies the regular files and directories under src to dst.
+func copyDir(src, dst string) error {
+	return filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+
+		switch {
+		case d.IsDir():
+			return os.MkdirAll(target, 0o700)
+		case d.Type().IsRegular():
+			return copyFile(path, target)
+		default:
+			return fmt.Errorf("unsupported file type: %s", path)
+		}
+	})
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
@@ -0,0 +1,172 @@
+package ynx
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v2"
+	abci "github.com/cometbft/cometbft/v2/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/log"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	ynxconfig "github.com/JiahaoAlbus/YNX/chain/config"
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
+)
+
+const exportTestChainID = "ynx_9001-1"
+
+// newSystemContractsChain starts a chain whose genesis deploys the system contracts, commits its
+// first block and returns the app.
+func newSystemContractsChain(t *testing.T) *App {
+	t.Helper()
+
+	// The validator set helper bonds in sdk.DefaultBondDenom, which must be the staking bond denom.
+	sdk.DefaultBondDenom = ynxconfig.BaseDenom
+	ynxconfig.SetBech32Prefixes(sdk.GetConfig())
+
+	app := NewApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{})
+	cdc := app.AppCodec()
+
+	deployer := common.BytesToAddress(append(make([]byte, 19), 0x81))
+	genesis := app.DefaultGenesis()
+	var ynxGenesis ynxtypes.GenesisState
+	cdc.MustUnmarshalJSON(genesis[ynxtypes.ModuleName], &ynxGenesis)
+	ynxGenesis.System.Enabled = true
+	ynxGenesis.System.DeployMode = ynxtypes.SystemDeployMode_SYSTEM_DEPLOY_MODE_CREATE2
+	ynxGenesis.System.DeployerAddress = deployer.Hex()
+	ynxGenesis.System.TeamBeneficiaryAddress = common.BytesToAddress(append(make([]byte, 19), 0x82)).Hex()
+	ynxGenesis.System.CommunityRecipientAddress = common.BytesToAddress(append(make([]byte, 19), 0x83)).Hex()
+	ynxGenesis.System.VestingReferenceTime = 1_700_000_000
+	genesis[ynxtypes.ModuleName] = cdc.MustMarshalJSON(&ynxGenesis)
+
+	valSet, err := simtestutil.CreateRandomValidatorSet()
+	require.NoError(t, err)
+	genAccounts := []authtypes.GenesisAccount{authtypes.NewBaseAccountWithAddress(deployer.Bytes())}
+	genesis, err = simtestutil.GenesisStateWithValSet(cdc, genesis, valSet, genAccounts)
+	require.NoError(t, err)
+	appState, err := json.Marshal(genesis)
+	require.NoError(t, err)
+
+	blockTime := time.Unix(1_700_000_000, 0).UTC()
+	_, err = app.InitChain(&abci.InitChainRequest{
+		ChainId:         exportTestChainID,
+		Time:            blockTime,
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+		AppStateBytes:   appState,
+	})
+	require.NoError(t, err)
+	_, err = app.FinalizeBlock(&abci.FinalizeBlockRequest{
+		Height:             1,
+		Time:               blockTime,
+		NextValidatorsHash: valSet.Hash(),
+	})
+	require.NoError(t, err)
+	_, err = app.Commit()
+	require.NoError(t, err)
+	return app
+}
+
+// importExported runs InitGenesis of every module of a fresh app with the exported app state.
+func importExported(t *testing.T, exported servertypes.ExportedApp, mutate func(GenesisState)) (*App, sdk.Context) {
+	t.Helper()
+
+	newApp := NewApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{})
+	var genesisState GenesisState
+	require.NoError(t, json.Unmarshal(exported.AppState, &genesisState))
+	if mutate != nil {
+		mutate(genesisState)
+	}
+
+	ctx := newApp.NewContextLegacy(true, cmtproto.Header{ChainID: exportTestChainID, Height: exported.Height})
+	_, err := newApp.ModuleManager.InitGenesis(ctx, newApp.AppCodec(), genesisState)
+	require.NoError(t, err)
+	return newApp, ctx
+}
+
+func TestExportImportKeepsSystemContracts(t *testing.T) {
+	app := newSystemContractsChain(t)
+	ctx := app.NewContextLegacy(true, cmtproto.Header{ChainID: exportTestChainID, Height: app.LastBlockHeight()})
+	contracts, err := app.YNXKeeper.SystemContracts.Get(ctx)
+	require.NoError(t, err)
+	require.Len(t, contracts.Contracts, len(ynxtypes.LegacySystemContractNames))
+	deployer := sdk.AccAddress(append(make([]byte, 19), 0x81))
+	sequence, err := app.AccountKeeper.GetSequence(ctx, deployer)
+	require.NoError(t, err)
+
+	exported, err := app.ExportAppStateAndValidators(false, nil, nil)
+	require.NoError(t, err)
+
+	// The exported genesis still has deployment enabled. Importing it keeps the contracts of the
+	// EVM state instead of deploying them again.
+	newApp, newCtx := importExported(t, exported, nil)
+	imported, err := newApp.YNXKeeper.SystemContracts.Get(newCtx)
+	require.NoError(t, err)
+	require.Equal(t, contracts, imported)
+
+	// A redeployment would have advanced the deployer nonce.
+	newSequence, err := newApp.AccountKeeper.GetSequence(newCtx, deployer)
+	require.NoError(t, err)
+	require.Equal(t, sequence, newSequence)
+
+	system, err := newApp.YNXKeeper.SystemConfig.Get(newCtx)
+	require.NoError(t, err)
+	require.True(t, system.Enabled)
+
+	for _, e := range contracts.Contracts {
+		contract := common.HexToAddress(e.Address)
+		require.Equal(t, app.EVMKeeper.GetCodeHash(ctx, contract), newApp.EVMKeeper.GetCodeHash(newCtx, contract), e.Name)
+	}
+	results, err := newApp.YNXKeeper.VerifySystemContracts(newCtx)
+	require.NoError(t, err)
+	for _, r := range results {
+		require.True(t, r.CodeHashMatches, r.Name)
+		require.True(t, r.ArtifactMatches, r.Name)
+	}
+}
+
+func TestImportRejectsSystemContractCodeHashMismatch(t *testing.T) {
+	app := newSystemContractsChain(t)
+	exported, err := app.ExportAppStateAndValidators(false, nil, nil)
+	require.NoError(t, err)
+
+	cdc := app.AppCodec()
+	var ynxGenesis ynxtypes.GenesisState
+	var genesisState GenesisState
+	require.NoError(t, json.Unmarshal(exported.AppState, &genesisState))
+	cdc.MustUnmarshalJSON(genesisState[ynxtypes.ModuleName], &ynxGenesis)
+	nyxt := ynxGenesis.SystemContracts.Get("nyxt")
+	live, ok := ynxGenesis.SystemContracts.Attestation("nyxt")
+	require.True(t, ok)
+
+	tampered := common.BytesToHash([]byte{0xbe, 0xef}).Hex()
+	expected := fmt.Sprintf(
+		"system contract nyxt: code hash mismatch at %s: attested %s, imported EVM state has %s",
+		nyxt, tampered, live.CodeHash,
+	)
+	require.PanicsWithError(t, expected, func() {
+		importExported(t, exported, func(gs GenesisState) {
+			live.CodeHash = tampered
+			require.NoError(t, ynxGenesis.SystemContracts.Attest(live))
+			gs[ynxtypes.ModuleName] = cdc.MustMarshalJSON(&ynxGenesis)
+		})
+	})
+
+	// Code missing from the imported EVM state is an error too.
+	require.Panics(t, func() {
+		importExported(t, exported, func(gs GenesisState) {
+			require.NoError(t, ynxGenesis.SystemContracts.Set("nyxt", common.BytesToAddress([]byte{0x99}).Hex()))
+			gs[ynxtypes.ModuleName] = cdc.MustMarshalJSON(&ynxGenesis)
+		})
+	})
+}
@@ -0,0 +1,122 @@
+package ynx
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	ynxkeeper "github.com/JiahaoAlbus/YNX/chain/x/ynx/keeper"
+)
+
+// feeCollectorSnapshotKey carries the feeCollectorSnapshot of a transaction from the ante handler
+// to the post handler.
+type feeCollectorSnapshotKey struct{}
+
+// feeCollectorSnapshot records the fee collector balance observed before the ante handler ran and
+// the up-front fee the ante handler moved into it, which x/ynx holds until it is split.
+type feeCollectorSnapshot struct {
+	before  sdk.Coins
+	charged sdk.Coins
+}
+
+// isEthereumTx reports whether tx is an EVM transaction. EVM transactions are charged for their
+// full gas limit up front and refunded the unused gas after execution, so their fee is only final
+// once the messages have run.
+func isEthereumTx(tx sdk.Tx) bool {
+	for _, msg := range tx.GetMsgs() {
+		if _, ok := msg.(*evmtypes.MsgEthereumTx); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// ethereumTxContract returns the address called by the EVM transaction in tx. Contract creations
+// have no callee and report false.
+func ethereumTxContract(tx sdk.Tx) (common.Address, bool) {
+	if tx == nil {
+		return common.Address{}, false
+	}
+	for _, msg := range tx.GetMsgs() {
+		ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			continue
+		}
+		if to := ethMsg.AsTransaction().To(); to != nil {
+			return *to, true
+		}
+		return common.Address{}, false
+	}
+	return common.Address{}, false
+}
+
+// withFeeCollectorSnapshot records the pre-ante fee collector balance and the up-front fee charged
+// by the ante handler for the fee split post decorator.
+func withFeeCollectorSnapshot(ctx sdk.Context, before, charged sdk.Coins) sdk.Context {
+	return ctx.WithValue(feeCollectorSnapshotKey{}, feeCollectorSnapshot{before: before, charged: charged})
+}
+
+// feeCollectorDelta returns the per-denom increase of the fee collector balance from before to
+// after. Denoms whose balance did not grow are omitted.
+func feeCollectorDelta(before, after sdk.Coins) sdk.Coins {
+	delta := sdk.NewCoins()
+	for _, coin := range after {
+		if diff := coin.Amount.Sub(before.AmountOf(coin.Denom)); diff.IsPositive() {
+			delta = delta.Add(sdk.NewCoin(coin.Denom, diff))
+		}
+	}
+	return delta
+}
+
+// FeeSplitPostDecorator splits the fee an EVM transaction actually paid, i.e. the up-front charge
+// deducted by the ante handler minus the gas refunded by the EVM, and releases the up-front charge
+// held by x/ynx.
+type FeeSplitPostDecorator struct {
+	bankKeeper bankkeeper.Keeper
+	ynxKeeper  ynxkeeper.Keeper
+}
+
+func NewFeeSplitPostDecorator(bankKeeper bankkeeper.Keeper, ynxKeeper ynxkeeper.Keeper) FeeSplitPostDecorator {
+	return FeeSplitPostDecorator{
+		bankKeeper: bankKeeper,
+		ynxKeeper:  ynxKeeper,
+	}
+}
+
+func (d FeeSplitPostDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	snapshot, ok := ctx.Value(feeCollectorSnapshotKey{}).(feeCollectorSnapshot)
+	// Baseapp discards the post handler state of failed transactions together with their message
+	// state, so a split written here would be lost. Their up-front fee stays held by x/ynx instead,
+	// which splits it at the end of the block. EVM reverts are not failures: they are split like
+	// any other call.
+	if !ok || simulate || !success {
+		return next(ctx, tx, simulate, success)
+	}
+
+	if err := d.ynxKeeper.ReleaseTxFee(ctx, snapshot.charged); err != nil {
+		return ctx, err
+	}
+
+	feeCollectorAddr := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	after := d.bankKeeper.GetAllBalances(ctx, feeCollectorAddr)
+	fees := feeCollectorDelta(snapshot.before, after)
+
+	// Calls to a contract registered for developer fee rebates pay part of the fee to its
+	// withdraw address.
+	if contract, ok := ethereumTxContract(tx); ok {
+		if err := d.ynxKeeper.SplitContractTxFee(ctx, fees, contract); err != nil {
+			return ctx, err
+		}
+		return next(ctx, tx, simulate, success)
+	}
+
+	if err := d.ynxKeeper.SplitTxFee(ctx, fees); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate, success)
+}
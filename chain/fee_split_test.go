@@ -0,0 +1,277 @@
+package ynx
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v2"
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	ynxconfig "github.com/JiahaoAlbus/YNX/chain/config"
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
+)
+
+// chargeEVMFee simulates the ante handler of an EVM transaction: it snapshots the fee collector,
+// moves the up-front fee (gas limit * price) into it, holds it in x/ynx and returns the context the
+// post handler sees.
+func chargeEVMFee(t *testing.T, app *App, ctx sdk.Context, upfront sdkmath.Int) sdk.Context {
+	t.Helper()
+
+	feeCollectorAddr := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	before := app.BankKeeper.GetAllBalances(ctx, feeCollectorAddr)
+
+	coins := sdk.NewCoins(sdk.NewCoin(ynxconfig.BaseDenom, upfront))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, authtypes.FeeCollectorName, coins))
+	require.NoError(t, app.YNXKeeper.HoldTxFee(ctx, coins))
+
+	return withFeeCollectorSnapshot(ctx, before, coins)
+}
+
+// refundGas simulates the EVM refunding unused gas to the sender from the fee collector.
+func refundGas(t *testing.T, app *App, ctx sdk.Context, refund sdkmath.Int) {
+	t.Helper()
+
+	sender := sdk.AccAddress(make([]byte, 20))
+	coins := sdk.NewCoins(sdk.NewCoin(ynxconfig.BaseDenom, refund))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, sender, coins))
+}
+
+func newFeeSplitTestApp(t *testing.T) (*App, sdk.Context) {
+	t.Helper()
+
+	ynxconfig.SetBech32Prefixes(sdk.GetConfig())
+
+	app := NewApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{})
+	ctx := app.BaseApp.NewUncachedContext(false, cmtproto.Header{
+		ChainID: "ynx_test-1",
+		Height:  1,
+		Time:    time.Unix(1, 0).UTC(),
+	})
+
+	mintParams := minttypes.DefaultParams()
+	mintParams.MintDenom = ynxconfig.BaseDenom
+	require.NoError(t, app.MintKeeper.Params.Set(ctx, mintParams))
+
+	params := ynxtypes.DefaultParams()
+	params.TreasuryAddress = sdk.AccAddress(append(make([]byte, 19), 0x22)).String()
+	require.NoError(t, app.YNXKeeper.Params.Set(ctx, params))
+
+	return app, ctx
+}
+
+func TestFeeSplitPostDecoratorSplitsNetOfRefund(t *testing.T) {
+	app, ctx := newFeeSplitTestApp(t)
+	noop := func(ctx sdk.Context, _ sdk.Tx, _, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	// 21k gas used out of a 1M gas limit: almost all of the up-front charge is refunded.
+	ctx = chargeEVMFee(t, app, ctx, sdkmath.NewInt(1_000_000))
+	refundGas(t, app, ctx, sdkmath.NewInt(979_000))
+
+	_, err := NewFeeSplitPostDecorator(app.BankKeeper, app.YNXKeeper).PostHandle(ctx, nil, false, true, noop)
+	require.NoError(t, err)
+
+	total, err := app.YNXKeeper.Revenue.Get(ctx, ynxconfig.BaseDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(8_400), total.FeeBurned)
+	require.Equal(t, sdkmath.NewInt(2_100), total.FeeTreasury)
+	require.Equal(t, sdkmath.NewInt(10_500), total.FeeValidators)
+
+	// The fee collector keeps only the validator share of the net fee.
+	feeCollectorAddr := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	require.Equal(t, sdkmath.NewInt(10_500), app.BankKeeper.GetBalance(ctx, feeCollectorAddr, ynxconfig.BaseDenom).Amount)
+}
+
+func TestFeeSplitPostDecoratorSkips(t *testing.T) {
+	noop := func(ctx sdk.Context, _ sdk.Tx, _, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	for _, tc := range []struct {
+		name     string
+		snapshot bool
+		simulate bool
+		success  bool
+	}{
+		{name: "no snapshot", snapshot: false, success: true},
+		{name: "simulate", snapshot: true, simulate: true, success: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			app, ctx := newFeeSplitTestApp(t)
+
+			postCtx := chargeEVMFee(t, app, ctx, sdkmath.NewInt(1_000))
+			if !tc.snapshot {
+				postCtx = ctx
+			}
+
+			_, err := NewFeeSplitPostDecorator(app.BankKeeper, app.YNXKeeper).PostHandle(postCtx, nil, tc.simulate, tc.success, noop)
+			require.NoError(t, err)
+
+			has, err := app.YNXKeeper.Revenue.Has(ctx, ynxconfig.BaseDenom)
+			require.NoError(t, err)
+			require.False(t, has)
+		})
+	}
+}
+
+func TestFeeSplitPostDecoratorSplitsFailedTxFeeAtEndBlock(t *testing.T) {
+	app, ctx := newFeeSplitTestApp(t)
+	noop := func(ctx sdk.Context, _ sdk.Tx, _, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	// A successful transaction releases its hold when the post handler splits its fee.
+	ctx = chargeEVMFee(t, app, ctx, sdkmath.NewInt(1_000_000))
+	refundGas(t, app, ctx, sdkmath.NewInt(979_000))
+	_, err := NewFeeSplitPostDecorator(app.BankKeeper, app.YNXKeeper).PostHandle(ctx, nil, false, true, noop)
+	require.NoError(t, err)
+
+	has, err := app.YNXKeeper.HeldTxFees.Has(ctx, ynxconfig.BaseDenom)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// A failed transaction pays its full up-front fee. Baseapp discards the post handler state of
+	// failed transactions, so the post handler does not run here; the hold from the ante state
+	// remains.
+	ctx = chargeEVMFee(t, app, ctx, sdkmath.NewInt(1_000_000))
+	held, err := app.YNXKeeper.HeldTxFees.Get(ctx, ynxconfig.BaseDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(1_000_000), held)
+
+	require.NoError(t, app.YNXKeeper.SplitHeldTxFees(ctx))
+
+	// Both fees were split: 21k net and 1M up front.
+	total, err := app.YNXKeeper.Revenue.Get(ctx, ynxconfig.BaseDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(8_400+400_000), total.FeeBurned)
+	require.Equal(t, sdkmath.NewInt(2_100+100_000), total.FeeTreasury)
+	require.Equal(t, sdkmath.NewInt(10_500+500_000), total.FeeValidators)
+
+	feeCollectorAddr := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	require.Equal(t, sdkmath.NewInt(510_500), app.BankKeeper.GetBalance(ctx, feeCollectorAddr, ynxconfig.BaseDenom).Amount)
+
+	has, err = app.YNXKeeper.HeldTxFees.Has(ctx, ynxconfig.BaseDenom)
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestSponsorshipPostDecoratorReturnsGasRefund(t *testing.T) {
+	app, ctx := newFeeSplitTestApp(t)
+	sponsor := sdk.AccAddress(append(make([]byte, 19), 0x61))
+	sender := sdk.AccAddress(make([]byte, 20))
+	budget := sdk.NewCoins(sdk.NewCoin(ynxconfig.BaseDenom, sdkmath.NewInt(2_000_000)))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, budget))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, sponsor, budget))
+
+	id, err := app.YNXKeeper.CreateSponsorship(ctx, sponsor, ynxtypes.SponsorshipPolicy{
+		AllowedContracts: []string{"0x7777777777777777777777777777777777777777"},
+		DailyCap:         sdkmath.ZeroInt(),
+	}, budget.AmountOf(ynxconfig.BaseDenom))
+	require.NoError(t, err)
+
+	// The ante handler funds the sender with the up-front fee, which it then pays.
+	feeCollectorAddr := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	before := app.BankKeeper.GetAllBalances(ctx, feeCollectorAddr)
+	upfront := sdkmath.NewInt(1_000_000)
+	require.NoError(t, app.YNXKeeper.FundSponsoredTx(ctx, id, sender, upfront))
+	require.NoError(t, app.BankKeeper.SendCoinsFromAccountToModule(ctx, sender, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewCoin(ynxconfig.BaseDenom, upfront))))
+
+	ctx = withFeeCollectorSnapshot(ctx, before, sdk.NewCoins(sdk.NewCoin(ynxconfig.BaseDenom, upfront)))
+	require.NoError(t, app.YNXKeeper.HoldTxFee(ctx, sdk.NewCoins(sdk.NewCoin(ynxconfig.BaseDenom, upfront))))
+	ctx = ctx.WithValue(sponsoredTxKey{}, sponsoredTx{
+		id:      id,
+		sponsor: sponsor,
+		sender:  sender,
+		charged: upfront,
+	})
+	refundGas(t, app, ctx, sdkmath.NewInt(979_000))
+
+	_, err = sdk.ChainPostDecorators(
+		NewSponsorshipPostDecorator(app.BankKeeper, app.YNXKeeper),
+		NewFeeSplitPostDecorator(app.BankKeeper, app.YNXKeeper),
+	)(ctx, nil, false, true)
+	require.NoError(t, err)
+
+	// The sponsorship paid the net fee only, and the sender keeps nothing.
+	sp, found, err := app.YNXKeeper.GetSponsorship(ctx, id)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, sdkmath.NewInt(1_979_000), sp.Budget)
+	require.True(t, app.BankKeeper.GetBalance(ctx, sender, ynxconfig.BaseDenom).Amount.IsZero())
+
+	// The fee split still sees the full net fee.
+	total, err := app.YNXKeeper.Revenue.Get(ctx, ynxconfig.BaseDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(8_400), total.FeeBurned)
+}
+
+func TestSponsorTxPaysCosmosTxFee(t *testing.T) {
+	app, ctx := newFeeSplitTestApp(t)
+	sponsor := sdk.AccAddress(append(make([]byte, 19), 0x61))
+	sender := sdk.AccAddress(append(make([]byte, 19), 0x62))
+	budget := sdk.NewCoins(sdk.NewCoin(ynxconfig.BaseDenom, sdkmath.NewInt(2_000_000)))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, budget))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, sponsor, budget))
+
+	msgSend := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	id, err := app.YNXKeeper.CreateSponsorship(ctx, sponsor, ynxtypes.SponsorshipPolicy{
+		AllowedMsgTypeUrls: []string{msgSend},
+		MaxGasPerTx:        200_000,
+		DailyCap:           sdkmath.ZeroInt(),
+	}, budget.AmountOf(ynxconfig.BaseDenom))
+	require.NoError(t, err)
+
+	newTx := func(msg sdk.Msg, gas uint64, granter sdk.AccAddress) sdk.Tx {
+		builder := app.TxConfig().NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(msg))
+		builder.SetGasLimit(gas)
+		builder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(ynxconfig.BaseDenom, sdkmath.NewInt(1_000))))
+		builder.SetFeePayer(sender)
+		builder.SetFeeGranter(granter)
+		return builder.GetTx()
+	}
+	// The ante handler deducts the fee from the fee payer.
+	deductFee := func(ctx sdk.Context, tx sdk.Tx, _ bool) (sdk.Context, error) {
+		feeTx := tx.(sdk.FeeTx)
+		return ctx, app.BankKeeper.SendCoinsFromAccountToModule(ctx, feeTx.FeePayer(), authtypes.FeeCollectorName, feeTx.GetFee())
+	}
+	send := &banktypes.MsgSend{FromAddress: sender.String(), ToAddress: sponsor.String(), Amount: sdk.NewCoins(sdk.NewCoin(ynxconfig.BaseDenom, sdkmath.NewInt(1)))}
+
+	newCtx, err := sponsorTx(ctx, newTx(send, 100_000, nil), false, app.BankKeeper, app.YNXKeeper, deductFee)
+	require.NoError(t, err)
+	sponsored, ok := newCtx.Value(sponsoredTxKey{}).(sponsoredTx)
+	require.True(t, ok)
+	require.Equal(t, []string{msgSend}, sponsored.msgTypeURLs)
+	require.Empty(t, sponsored.contract)
+	require.Equal(t, sdkmath.NewInt(1_000), sponsored.charged)
+
+	sp, found, err := app.YNXKeeper.GetSponsorship(ctx, id)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, sdkmath.NewInt(1_999_000), sp.Budget)
+	require.True(t, app.BankKeeper.GetBalance(ctx, sender, ynxconfig.BaseDenom).Amount.IsZero())
+
+	// Without a fee collector snapshot the post handler attributes the charged fee.
+	_, err = NewSponsorshipPostDecorator(app.BankKeeper, app.YNXKeeper).PostHandle(newCtx, nil, false, true,
+		func(ctx sdk.Context, _ sdk.Tx, _, _ bool) (sdk.Context, error) { return ctx, nil })
+	require.NoError(t, err)
+
+	// Other message types, gas limits above the policy and fee grants are not sponsored, so the
+	// sender has to pay the fee itself.
+	multiSend := &banktypes.MsgMultiSend{}
+	for _, tx := range []sdk.Tx{
+		newTx(multiSend, 100_000, nil),
+		newTx(send, 300_000, nil),
+		newTx(send, 100_000, sponsor),
+	} {
+		_, err = sponsorTx(ctx, tx, false, app.BankKeeper, app.YNXKeeper, deductFee)
+		require.ErrorContains(t, err, "insufficient funds")
+	}
+}
@@ -6,7 +6,12 @@ import (
 	"cosmossdk.io/math"
 
 	ynxconfig "github.com/JiahaoAlbus/YNX/chain/config"
+	"github.com/JiahaoAlbus/YNX/chain/precompiles/circuitbreaker"
+	"github.com/JiahaoAlbus/YNX/chain/precompiles/nyxtvotes"
+	"github.com/JiahaoAlbus/YNX/chain/precompiles/stakevotes"
 	"github.com/JiahaoAlbus/YNX/chain/precompiles/ynxprotocol"
+	"github.com/JiahaoAlbus/YNX/chain/precompiles/ynxsponsor"
+	ynxmodtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
 
 	erc20types "github.com/cosmos/evm/x/erc20/types"
 	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
@@ -35,14 +40,31 @@ func NewEVMGenesisState() *evmtypes.GenesisState {
 		ExtendedDenom: ynxconfig.BaseDenom,
 	}
 	evmGenState.Params.ActiveStaticPrecompiles = append([]string{}, evmtypes.AvailableStaticPrecompiles...)
-	evmGenState.Params.ActiveStaticPrecompiles = append(evmGenState.Params.ActiveStaticPrecompiles, ynxprotocol.PrecompileAddress)
+	evmGenState.Params.ActiveStaticPrecompiles = append(evmGenState.Params.ActiveStaticPrecompiles, ynxprotocol.PrecompileAddress, ynxsponsor.PrecompileAddress, nyxtvotes.PrecompileAddress, stakevotes.PrecompileAddress, circuitbreaker.PrecompileAddress)
 	evmGenState.Preinstalls = evmtypes.DefaultPreinstalls
 
 	return evmGenState
 }
 
-// NewErc20GenesisState returns the default genesis state for the ERC20 module.
-func NewErc20GenesisState() *erc20types.GenesisState { return erc20types.DefaultGenesisState() }
+// NewErc20GenesisState returns the default genesis state for the ERC20 module, with the native
+// token pair that serves native NYXT through an ERC20 interface.
+func NewErc20GenesisState() *erc20types.GenesisState {
+	erc20GenState := erc20types.DefaultGenesisState()
+	erc20GenState.TokenPairs = []erc20types.TokenPair{NativeNYXTTokenPair()}
+	erc20GenState.NativePrecompiles = []string{ynxmodtypes.NativeNYXTContract}
+
+	return erc20GenState
+}
+
+// NativeNYXTTokenPair returns the x/erc20 native token pair of the EVM denom.
+func NativeNYXTTokenPair() erc20types.TokenPair {
+	return erc20types.TokenPair{
+		Erc20Address:  ynxmodtypes.NativeNYXTContract,
+		Denom:         ynxconfig.BaseDenom,
+		Enabled:       true,
+		ContractOwner: erc20types.OWNER_MODULE,
+	}
+}
 
 // NewMintGenesisState returns the default genesis state for the mint module.
 //
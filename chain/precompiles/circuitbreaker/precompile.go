@@ -0,0 +1,385 @@
+package circuitbreaker
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ynxkeeper "github.com/JiahaoAlbus/YNX/chain/x/ynx/keeper"
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+const (
+	PrecompileAddress = ynxtypes.CircuitBreakerPrecompileAddress
+
+	GuardianMethod           = "guardian"
+	MaxDurationBlocksMethod  = "maxDurationBlocks"
+	IsMsgBlockedMethod       = "isMsgBlocked"
+	IsCallBlockedMethod      = "isCallBlocked"
+	GetCircuitBreakersMethod = "getCircuitBreakers"
+	TripMethod               = "trip"
+	ResetMethod              = "reset"
+
+	// CircuitBreakerTrippedEvent is logged by trip, CircuitBreakerResetEvent by reset.
+	CircuitBreakerTrippedEvent = "CircuitBreakerTripped"
+	CircuitBreakerResetEvent   = "CircuitBreakerReset"
+)
+
+var (
+	//go:embed abi.json
+	f   embed.FS
+	ABI abi.ABI
+)
+
+func init() {
+	var err error
+	ABI, err = cmn.LoadABI(f, "abi.json")
+	if err != nil {
+		panic(err)
+	}
+}
+
+// circuitBreakerABI is the CircuitBreaker tuple of the ABI.
+type circuitBreakerABI struct {
+	Kind          uint8
+	Target        string
+	Selector      [4]byte
+	TrippedBy     common.Address
+	TrippedHeight uint64
+	ExpiryHeight  uint64
+	Reason        string
+}
+
+// Precompile exposes the x/ynx circuit breakers to the EVM, so that contracts can check whether a
+// message type or a call is blocked, a guardian contract can trip breakers and the timelock can
+// reset them.
+//
+// Security model:
+// - trip is restricted to the circuit_guardian_address param (msg.sender) and bounded by circuit_breaker_max_blocks.
+// - reset is restricted to the timelock system contract (msg.sender); x/gov resets through MsgResetCircuitBreaker.
+// - breakers cannot block x/gov messages, this precompile or the governor and timelock system contracts.
+// - a zero selector stands for every call to the target.
+// - EVM call breakers swap the code of the target, which trip and reset sync into the state DB.
+// - reads are permissionless.
+// - trip and reset log CircuitBreakerTripped and CircuitBreakerReset.
+type Precompile struct {
+	cmn.Precompile
+
+	abi.ABI
+	ynxKeeper ynxkeeper.Keeper
+}
+
+func NewPrecompile(ynxKeeper ynxkeeper.Keeper) *Precompile {
+	return &Precompile{
+		Precompile: cmn.Precompile{
+			KvGasConfig:          storetypes.KVGasConfig(),
+			TransientKVGasConfig: storetypes.TransientGasConfig(),
+			ContractAddress:      common.HexToAddress(PrecompileAddress),
+		},
+		ABI:       ABI,
+		ynxKeeper: ynxKeeper,
+	}
+}
+
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	if len(input) < 4 {
+		return 0
+	}
+	methodID := input[:4]
+
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		return 0
+	}
+
+	return p.Precompile.RequiredGas(input, p.IsTransaction(method))
+}
+
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error) {
+	return p.RunNativeAction(evm, contract, func(ctx sdk.Context) ([]byte, error) {
+		return p.Execute(ctx, evm.StateDB, contract, readonly)
+	})
+}
+
+func (p Precompile) Execute(ctx sdk.Context, stateDB vm.StateDB, contract *vm.Contract, readOnly bool) ([]byte, error) {
+	method, args, err := cmn.SetupABI(p.ABI, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	switch method.Name {
+	case GuardianMethod:
+		return p.guardian(ctx, method)
+	case MaxDurationBlocksMethod:
+		return p.maxDurationBlocks(ctx, method)
+	case IsMsgBlockedMethod:
+		return p.isMsgBlocked(ctx, method, args)
+	case IsCallBlockedMethod:
+		return p.isCallBlocked(ctx, method, args)
+	case GetCircuitBreakersMethod:
+		return p.getCircuitBreakers(ctx, method)
+	case TripMethod:
+		return p.trip(ctx, stateDB, contract, method, args)
+	case ResetMethod:
+		return p.reset(ctx, stateDB, contract, method, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+}
+
+func (Precompile) IsTransaction(method *abi.Method) bool {
+	switch method.Name {
+	case TripMethod, ResetMethod:
+		return true
+	default:
+		return false
+	}
+}
+
+func (p Precompile) guardian(ctx sdk.Context, method *abi.Method) ([]byte, error) {
+	params, err := p.ynxKeeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if params.CircuitGuardianAddress == "" {
+		return method.Outputs.Pack(common.Address{})
+	}
+	guardian, err := sdk.AccAddressFromBech32(params.CircuitGuardianAddress)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(common.BytesToAddress(guardian))
+}
+
+func (p Precompile) maxDurationBlocks(ctx sdk.Context, method *abi.Method) ([]byte, error) {
+	params, err := p.ynxKeeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(params.CircuitBreakerMaxBlocks)
+}
+
+func (p Precompile) isMsgBlocked(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("invalid args length: got %d, expected 1", len(args))
+	}
+	typeURL, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type url type: %T", args[0])
+	}
+
+	b, blocked, err := p.ynxKeeper.MsgCircuitBreaker(ctx, typeURL)
+	if err != nil {
+		return nil, err
+	}
+	return packBlocked(method, b, blocked)
+}
+
+func (p Precompile) isCallBlocked(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf("invalid args length: got %d, expected 2", len(args))
+	}
+	target, ok := args[0].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf("unexpected target type: %T", args[0])
+	}
+	selector, ok := args[1].([4]byte)
+	if !ok {
+		return nil, fmt.Errorf("unexpected selector type: %T", args[1])
+	}
+
+	var input []byte
+	if selector != ([4]byte{}) {
+		input = selector[:]
+	}
+	b, blocked, err := p.ynxKeeper.CallCircuitBreaker(ctx, target, input)
+	if err != nil {
+		return nil, err
+	}
+	return packBlocked(method, b, blocked)
+}
+
+func (p Precompile) getCircuitBreakers(ctx sdk.Context, method *abi.Method) ([]byte, error) {
+	breakers, err := p.ynxKeeper.GetCircuitBreakers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]circuitBreakerABI, 0, len(breakers))
+	for _, b := range breakers {
+		if !b.Active(ctx.BlockHeight()) {
+			continue
+		}
+		trippedBy, err := sdk.AccAddressFromBech32(b.TrippedBy)
+		if err != nil {
+			return nil, err
+		}
+		var selector [4]byte
+		copy(selector[:], common.FromHex(b.Selector))
+		out = append(out, circuitBreakerABI{
+			Kind:          uint8(b.Kind),
+			Target:        b.Target,
+			Selector:      selector,
+			TrippedBy:     common.BytesToAddress(trippedBy),
+			TrippedHeight: uint64(b.TrippedHeight), //nolint:gosec // block heights are positive
+			ExpiryHeight:  uint64(b.ExpiryHeight),  //nolint:gosec // block heights are positive
+			Reason:        b.Reason,
+		})
+	}
+	return method.Outputs.Pack(out)
+}
+
+func (p Precompile) trip(ctx sdk.Context, stateDB vm.StateDB, contract *vm.Contract, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 5 {
+		return nil, fmt.Errorf("invalid args length: got %d, expected 5", len(args))
+	}
+	kind, target, selector, err := breakerArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	duration, ok := args[3].(uint64)
+	if !ok {
+		return nil, fmt.Errorf("unexpected duration type: %T", args[3])
+	}
+	reason, ok := args[4].(string)
+	if !ok {
+		return nil, fmt.Errorf("unexpected reason type: %T", args[4])
+	}
+
+	guardian := sdk.AccAddress(contract.Caller().Bytes())
+	b, err := p.ynxKeeper.TripCircuitBreaker(ctx, guardian, kind, target, selector, duration, reason)
+	if err != nil {
+		return nil, err
+	}
+	if kind == ynxtypes.CircuitBreakerKind_CIRCUIT_BREAKER_KIND_EVM_CALL {
+		p.syncCode(ctx, stateDB, common.HexToAddress(b.Target))
+	}
+
+	expiry := uint64(b.ExpiryHeight) //nolint:gosec // block heights are positive
+	if err := p.emitLog(ctx, stateDB, CircuitBreakerTrippedEvent, b, expiry, b.Reason); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(expiry)
+}
+
+func (p Precompile) reset(ctx sdk.Context, stateDB vm.StateDB, contract *vm.Contract, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf("invalid args length: got %d, expected 3", len(args))
+	}
+	kind, target, selector, err := breakerArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	timelock, err := p.requireTimelock(ctx, contract)
+	if err != nil {
+		return nil, err
+	}
+
+	target, selector, err = ynxtypes.NormalizeCircuitBreaker(kind, target, selector)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.ynxKeeper.ResetCircuitBreaker(ctx, sdk.AccAddress(timelock.Bytes()).String(), kind, target, selector); err != nil {
+		return nil, err
+	}
+	if kind == ynxtypes.CircuitBreakerKind_CIRCUIT_BREAKER_KIND_EVM_CALL {
+		p.syncCode(ctx, stateDB, common.HexToAddress(target))
+	}
+
+	b := ynxtypes.CircuitBreaker{Kind: kind, Target: target, Selector: selector}
+	if err := p.emitLog(ctx, stateDB, CircuitBreakerResetEvent, b); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(true)
+}
+
+// emitLog logs event for breaker b through the state DB, so that the log is reverted with the
+// call. extra are the non-indexed values following target and selector.
+func (p Precompile) emitLog(ctx sdk.Context, stateDB vm.StateDB, name string, b ynxtypes.CircuitBreaker, extra ...interface{}) error {
+	event := p.Events[name]
+
+	var selector [4]byte
+	copy(selector[:], common.FromHex(b.Selector))
+	data, err := event.Inputs.NonIndexed().Pack(append([]interface{}{b.Target, selector}, extra...)...)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      []common.Hash{event.ID, common.BytesToHash([]byte{uint8(b.Kind)})},
+		Data:        data,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // block heights are positive
+	})
+	return nil
+}
+
+// syncCode updates the code stateDB holds for target and its shadow address once an EVM call
+// breaker swapped it, so that the rest of the transaction runs the new code and committing stateDB
+// does not write the old one back.
+func (p Precompile) syncCode(ctx sdk.Context, stateDB vm.StateDB, target common.Address) {
+	for _, addr := range []common.Address{target, ynxkeeper.CircuitBreakerShadowAddress(target)} {
+		stateDB.SetCode(addr, p.ynxKeeper.GetCode(ctx, addr))
+	}
+}
+
+// requireTimelock returns the configured timelock address, failing unless it is the caller.
+func (p Precompile) requireTimelock(ctx sdk.Context, contract *vm.Contract) (common.Address, error) {
+	systemContracts, err := p.ynxKeeper.SystemContracts.Get(ctx)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	addr := systemContracts.Get("timelock")
+	if addr == "" {
+		return common.Address{}, fmt.Errorf("timelock is not configured")
+	}
+	timelock := common.HexToAddress(addr)
+
+	if caller := contract.Caller(); caller != timelock {
+		return common.Address{}, fmt.Errorf("unauthorized caller %s (expected timelock %s)", caller.Hex(), timelock.Hex())
+	}
+	return timelock, nil
+}
+
+// breakerArgs decodes the leading (kind, target, selector) arguments of trip and reset. A zero
+// selector decodes to the empty selector.
+func breakerArgs(args []interface{}) (ynxtypes.CircuitBreakerKind, string, string, error) {
+	kind, ok := args[0].(uint8)
+	if !ok {
+		return 0, "", "", fmt.Errorf("unexpected kind type: %T", args[0])
+	}
+	target, ok := args[1].(string)
+	if !ok {
+		return 0, "", "", fmt.Errorf("unexpected target type: %T", args[1])
+	}
+	selector, ok := args[2].([4]byte)
+	if !ok {
+		return 0, "", "", fmt.Errorf("unexpected selector type: %T", args[2])
+	}
+
+	if selector == ([4]byte{}) {
+		return ynxtypes.CircuitBreakerKind(kind), target, "", nil
+	}
+	return ynxtypes.CircuitBreakerKind(kind), target, ynxtypes.FormatSelector(selector[:]), nil
+}
+
+func packBlocked(method *abi.Method, b ynxtypes.CircuitBreaker, blocked bool) ([]byte, error) {
+	if !blocked {
+		return method.Outputs.Pack(false, uint64(0))
+	}
+	return method.Outputs.Pack(true, uint64(b.ExpiryHeight)) //nolint:gosec // block heights are positive
+}
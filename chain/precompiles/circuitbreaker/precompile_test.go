@@ -0,0 +1,159 @@
+package circuitbreaker_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v2"
+	dbm "github.com/cosmos/cosmos-db"
+
+	"github.com/cosmos/evm/x/vm/statedb"
+
+	"cosmossdk.io/log"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ynx "github.com/JiahaoAlbus/YNX/chain"
+	ynxconfig "github.com/JiahaoAlbus/YNX/chain/config"
+	"github.com/JiahaoAlbus/YNX/chain/precompiles/circuitbreaker"
+	ynxkeeper "github.com/JiahaoAlbus/YNX/chain/x/ynx/keeper"
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
+)
+
+func init() {
+	cfg := sdk.GetConfig()
+	ynxconfig.SetBech32Prefixes(cfg)
+	ynxconfig.SetBip44CoinType(cfg)
+	ynxconfig.RegisterDenoms()
+	cfg.Seal()
+}
+
+func TestPrecompileRegisteredInApp(t *testing.T) {
+	app := ynx.NewApp(
+		log.NewNopLogger(),
+		dbm.NewMemDB(),
+		nil,
+		true,
+		simtestutil.EmptyAppOptions{},
+	)
+
+	params := ynx.NewEVMGenesisState().Params
+	require.Contains(t, params.ActiveStaticPrecompiles, circuitbreaker.PrecompileAddress)
+
+	pc, ok, err := app.EVMKeeper.GetStaticPrecompileInstance(&params, common.HexToAddress(circuitbreaker.PrecompileAddress))
+	require.NoError(t, err)
+	require.True(t, ok)
+	_, is := pc.(*circuitbreaker.Precompile)
+	require.True(t, is)
+}
+
+func TestTripCheckAndReset(t *testing.T) {
+	app := ynx.NewApp(
+		log.NewNopLogger(),
+		dbm.NewMemDB(),
+		nil,
+		true,
+		simtestutil.EmptyAppOptions{},
+	)
+
+	ctx := app.BaseApp.NewUncachedContext(false, cmtproto.Header{
+		ChainID: "ynx_test-1",
+		Height:  5,
+		Time:    time.Unix(1, 0).UTC(),
+	})
+
+	guardian := common.HexToAddress("0x00000000000000000000000000000000000000BB")
+	timelock := common.HexToAddress("0x00000000000000000000000000000000000000AA")
+	bridge := common.HexToAddress("0x3333333333333333333333333333333333333333")
+	selector := [4]byte{0xa9, 0x05, 0x9c, 0xbb}
+	bridgeCode := []byte{0x00}
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, bridge.Bytes()))
+	app.EVMKeeper.SetCode(ctx, crypto.Keccak256(bridgeCode), bridgeCode)
+	app.EVMKeeper.SetCodeHash(ctx, bridge.Bytes(), crypto.Keccak256(bridgeCode))
+
+	require.NoError(t, app.YNXKeeper.SystemContracts.Set(ctx, ynxtypes.SystemContracts{
+		Contracts: []ynxtypes.SystemContractEntry{{Name: "timelock", Address: timelock.Hex()}},
+	}))
+	params := ynxtypes.DefaultParams()
+	params.CircuitGuardianAddress = sdk.AccAddress(guardian.Bytes()).String()
+	require.NoError(t, app.YNXKeeper.Params.Set(ctx, params))
+
+	pc := circuitbreaker.NewPrecompile(app.YNXKeeper)
+	stateDB := statedb.New(ctx, app.EVMKeeper, statedb.NewEmptyTxConfig())
+	call := func(caller common.Address, readOnly bool, method string, args ...interface{}) ([]interface{}, error) {
+		input, err := circuitbreaker.ABI.Pack(method, args...)
+		require.NoError(t, err)
+		contract := vm.NewContract(caller, common.HexToAddress(circuitbreaker.PrecompileAddress), uint256.NewInt(0), 10_000_000, nil)
+		contract.Input = input
+		out, err := pc.Execute(ctx, stateDB, contract, readOnly)
+		if err != nil {
+			return nil, err
+		}
+		return circuitbreaker.ABI.Methods[method].Outputs.Unpack(out)
+	}
+	kind := uint8(ynxtypes.CircuitBreakerKind_CIRCUIT_BREAKER_KIND_EVM_CALL)
+
+	out, err := call(guardian, true, circuitbreaker.GuardianMethod)
+	require.NoError(t, err)
+	require.Equal(t, guardian, out[0])
+
+	// Only the guardian trips, and not from a static call.
+	_, err = call(timelock, false, circuitbreaker.TripMethod, kind, bridge.Hex(), selector, uint64(100), "exploit")
+	require.ErrorContains(t, err, "not the circuit guardian")
+	_, err = call(guardian, true, circuitbreaker.TripMethod, kind, bridge.Hex(), selector, uint64(100), "exploit")
+	require.Error(t, err)
+
+	out, err = call(guardian, false, circuitbreaker.TripMethod, kind, bridge.Hex(), selector, uint64(100), "exploit")
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{uint64(105)}, out)
+
+	// The state DB runs the swapped code for the rest of the transaction.
+	require.NotEqual(t, bridgeCode, stateDB.GetCode(bridge))
+	require.Equal(t, bridgeCode, stateDB.GetCode(ynxkeeper.CircuitBreakerShadowAddress(bridge)))
+
+	event := circuitbreaker.ABI.Events[circuitbreaker.CircuitBreakerTrippedEvent]
+	logs := stateDB.Logs()
+	require.Len(t, logs, 1)
+	require.Equal(t, []common.Hash{event.ID, common.BytesToHash([]byte{kind})}, logs[0].Topics)
+	values, err := event.Inputs.NonIndexed().Unpack(logs[0].Data)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{bridge.Hex(), selector, uint64(105), "exploit"}, values)
+
+	for _, tc := range []struct {
+		selector [4]byte
+		blocked  bool
+	}{
+		{selector, true},
+		{[4]byte{0x23, 0xb8, 0x72, 0xdd}, false},
+		{[4]byte{}, false},
+	} {
+		out, err := call(guardian, true, circuitbreaker.IsCallBlockedMethod, bridge, tc.selector)
+		require.NoError(t, err)
+		require.Equal(t, tc.blocked, out[0], "%x", tc.selector)
+	}
+
+	out, err = call(guardian, true, circuitbreaker.GetCircuitBreakersMethod)
+	require.NoError(t, err)
+	require.Len(t, out[0], 1)
+
+	// Only the timelock resets.
+	_, err = call(guardian, false, circuitbreaker.ResetMethod, kind, bridge.Hex(), selector)
+	require.ErrorContains(t, err, "expected timelock")
+	out, err = call(timelock, false, circuitbreaker.ResetMethod, kind, bridge.Hex(), selector)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{true}, out)
+	require.Len(t, stateDB.Logs(), 2)
+
+	out, err = call(guardian, true, circuitbreaker.IsCallBlockedMethod, bridge, selector)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{false, uint64(0)}, out)
+	require.Equal(t, bridgeCode, stateDB.GetCode(bridge))
+	require.Empty(t, stateDB.GetCode(ynxkeeper.CircuitBreakerShadowAddress(bridge)))
+}
@@ -0,0 +1,133 @@
+package nyxtvotes_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v2"
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	ynx "github.com/JiahaoAlbus/YNX/chain"
+	ynxconfig "github.com/JiahaoAlbus/YNX/chain/config"
+	"github.com/JiahaoAlbus/YNX/chain/precompiles/nyxtvotes"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+func init() {
+	cfg := sdk.GetConfig()
+	ynxconfig.SetBech32Prefixes(cfg)
+	ynxconfig.SetBip44CoinType(cfg)
+	ynxconfig.RegisterDenoms()
+	cfg.Seal()
+}
+
+func TestPrecompileRegisteredInApp(t *testing.T) {
+	app := ynx.NewApp(
+		log.NewNopLogger(),
+		dbm.NewMemDB(),
+		nil,
+		true,
+		simtestutil.EmptyAppOptions{},
+	)
+
+	params := ynx.NewEVMGenesisState().Params
+	require.Contains(t, params.ActiveStaticPrecompiles, nyxtvotes.PrecompileAddress)
+
+	pc, ok, err := app.EVMKeeper.GetStaticPrecompileInstance(&params, common.HexToAddress(nyxtvotes.PrecompileAddress))
+	require.NoError(t, err)
+	require.True(t, ok)
+	_, is := pc.(*nyxtvotes.Precompile)
+	require.True(t, is)
+}
+
+func TestLockDelegateAndPastVotes(t *testing.T) {
+	app := ynx.NewApp(
+		log.NewNopLogger(),
+		dbm.NewMemDB(),
+		nil,
+		true,
+		simtestutil.EmptyAppOptions{},
+	)
+
+	ctx := app.BaseApp.NewUncachedContext(false, cmtproto.Header{
+		ChainID: "ynx_test-1",
+		Height:  5,
+		Time:    time.Unix(1, 0).UTC(),
+	})
+
+	voter := common.HexToAddress("0x6666666666666666666666666666666666666666")
+	denom := evmtypes.GetEVMCoinDenom()
+
+	coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(5_000)))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, sdk.AccAddress(voter.Bytes()), coins))
+
+	pc := nyxtvotes.NewPrecompile(app.YNXKeeper, app.BankKeeper)
+	contract := vm.NewContract(voter, common.HexToAddress(nyxtvotes.PrecompileAddress), uint256.NewInt(0), 10_000_000, nil)
+
+	call := func(ctx sdk.Context, readOnly bool, method string, args ...interface{}) ([]interface{}, error) {
+		input, err := nyxtvotes.ABI.Pack(method, args...)
+		require.NoError(t, err)
+		contract.Input = input
+
+		out, err := pc.Execute(ctx, contract, readOnly)
+		if err != nil {
+			return nil, err
+		}
+		return nyxtvotes.ABI.Methods[method].Outputs.Unpack(out)
+	}
+
+	_, err := call(ctx, false, nyxtvotes.LockMethod, big.NewInt(3_000))
+	require.NoError(t, err)
+	_, err = call(ctx, false, nyxtvotes.DelegateMethod, voter)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(2_000), app.BankKeeper.GetBalance(ctx, sdk.AccAddress(voter.Bytes()), denom).Amount)
+
+	out, err := call(ctx, true, nyxtvotes.DelegatesMethod, voter)
+	require.NoError(t, err)
+	require.Equal(t, voter, out[0])
+	out, err = call(ctx, true, nyxtvotes.GetVotesMethod, voter)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(3_000), out[0])
+	out, err = call(ctx, true, nyxtvotes.ClockModeMethod)
+	require.NoError(t, err)
+	require.Equal(t, nyxtvotes.ClockMode, out[0])
+
+	// The current block cannot be looked up yet.
+	_, err = call(ctx, true, nyxtvotes.GetPastVotesMethod, voter, big.NewInt(5))
+	require.ErrorContains(t, err, "future lookup")
+
+	ctx = ctx.WithBlockHeight(6)
+	out, err = call(ctx, true, nyxtvotes.ClockMethod)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(6), out[0])
+	out, err = call(ctx, true, nyxtvotes.GetPastVotesMethod, voter, big.NewInt(5))
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(3_000), out[0])
+	out, err = call(ctx, true, nyxtvotes.GetPastTotalSupplyMethod, big.NewInt(4))
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(0), out[0])
+
+	// Only the locked amount can be unlocked.
+	_, err = call(ctx, false, nyxtvotes.UnlockMethod, big.NewInt(4_000))
+	require.Error(t, err)
+	_, err = call(ctx, false, nyxtvotes.UnlockMethod, big.NewInt(1_000))
+	require.NoError(t, err)
+	out, err = call(ctx, true, nyxtvotes.LockedBalanceOfMethod, voter)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(2_000), out[0])
+}
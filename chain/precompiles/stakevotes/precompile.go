@@ -0,0 +1,239 @@
+package stakevotes
+
+import (
+	"embed"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ynxkeeper "github.com/JiahaoAlbus/YNX/chain/x/ynx/keeper"
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+const (
+	PrecompileAddress = ynxtypes.StakeVotesPrecompileAddress
+
+	// ClockMode is the ERC-6372 clock of the checkpoints: block numbers.
+	ClockMode = "mode=blocknumber&from=default"
+
+	ClockMethod              = "clock"
+	ClockModeMethod          = "CLOCK_MODE"
+	GetVotesMethod           = "getVotes"
+	GetPastVotesMethod       = "getPastVotes"
+	GetPastTotalSupplyMethod = "getPastTotalSupply"
+	DelegatesMethod          = "delegates"
+	DelegateMethod           = "delegate"
+)
+
+var (
+	//go:embed abi.json
+	f   embed.FS
+	ABI abi.ABI
+)
+
+func init() {
+	var err error
+	ABI, err = cmn.LoadABI(f, "abi.json")
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Precompile exposes IERC5805 checkpoints of the stake bonded through x/staking, so that
+// OpenZeppelin governors can count votes by bonded stake. The stake of each delegation to a bonded
+// validator counts for the validator's operator account unless the delegator delegates its votes.
+//
+// Security model:
+// - the checkpoints are snapshotted by x/ynx at the end of each block from x/staking state.
+// - delegate only changes the delegatee of msg.sender's stake; the zero address gives the votes
+// back to the validators.
+// - getPastVotes and getPastTotalSupply only answer for finished blocks, as in ERC20Votes.
+// - reads are permissionless.
+type Precompile struct {
+	cmn.Precompile
+
+	abi.ABI
+	ynxKeeper ynxkeeper.Keeper
+}
+
+func NewPrecompile(ynxKeeper ynxkeeper.Keeper, bankKeeper cmn.BankKeeper) *Precompile {
+	return &Precompile{
+		Precompile: cmn.Precompile{
+			KvGasConfig:           storetypes.KVGasConfig(),
+			TransientKVGasConfig:  storetypes.TransientGasConfig(),
+			ContractAddress:       common.HexToAddress(PrecompileAddress),
+			BalanceHandlerFactory: cmn.NewBalanceHandlerFactory(bankKeeper),
+		},
+		ABI:       ABI,
+		ynxKeeper: ynxKeeper,
+	}
+}
+
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	if len(input) < 4 {
+		return 0
+	}
+	methodID := input[:4]
+
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		return 0
+	}
+
+	return p.Precompile.RequiredGas(input, p.IsTransaction(method))
+}
+
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error) {
+	return p.RunNativeAction(evm, contract, func(ctx sdk.Context) ([]byte, error) {
+		return p.Execute(ctx, contract, readonly)
+	})
+}
+
+func (p Precompile) Execute(ctx sdk.Context, contract *vm.Contract, readOnly bool) ([]byte, error) {
+	method, args, err := cmn.SetupABI(p.ABI, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	switch method.Name {
+	case ClockMethod:
+		return method.Outputs.Pack(big.NewInt(ctx.BlockHeight()))
+	case ClockModeMethod:
+		return method.Outputs.Pack(ClockMode)
+	case GetVotesMethod:
+		return p.getVotes(ctx, method, args)
+	case GetPastVotesMethod:
+		return p.getPastVotes(ctx, method, args)
+	case GetPastTotalSupplyMethod:
+		return p.getPastTotalSupply(ctx, method, args)
+	case DelegatesMethod:
+		return p.delegates(ctx, method, args)
+	case DelegateMethod:
+		return p.delegate(ctx, contract, method, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+}
+
+func (Precompile) IsTransaction(method *abi.Method) bool {
+	return method.Name == DelegateMethod
+}
+
+func (p Precompile) getVotes(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	account, err := accountArg(args, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	votes, err := p.ynxKeeper.GetStakeVotes(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(votes.BigInt())
+}
+
+func (p Precompile) getPastVotes(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	account, err := accountArg(args, 2)
+	if err != nil {
+		return nil, err
+	}
+	height, err := pastTimepoint(ctx, args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	votes, err := p.ynxKeeper.GetPastStakeVotes(ctx, account, height)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(votes.BigInt())
+}
+
+func (p Precompile) getPastTotalSupply(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("invalid args length: got %d, expected 1", len(args))
+	}
+	height, err := pastTimepoint(ctx, args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := p.ynxKeeper.GetPastTotalStakeVotes(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(total.BigInt())
+}
+
+// delegates returns the delegatee account has chosen, or the zero address while its votes go to
+// the validators it delegates to.
+func (p Precompile) delegates(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	account, err := accountArg(args, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	voter, err := p.ynxKeeper.GetStakeVoter(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	if voter.Delegatee == "" {
+		return method.Outputs.Pack(common.Address{})
+	}
+	delegatee, err := sdk.AccAddressFromBech32(voter.Delegatee)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(common.BytesToAddress(delegatee))
+}
+
+func (p Precompile) delegate(ctx sdk.Context, contract *vm.Contract, method *abi.Method, args []interface{}) ([]byte, error) {
+	delegatee, err := accountArg(args, 1)
+	if err != nil {
+		return nil, err
+	}
+	if common.BytesToAddress(delegatee) == (common.Address{}) {
+		delegatee = nil
+	}
+
+	if err := p.ynxKeeper.DelegateStakeVotes(ctx, sdk.AccAddress(contract.Caller().Bytes()), delegatee); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack()
+}
+
+// accountArg decodes the leading address argument of a call taking n arguments.
+func accountArg(args []interface{}, n int) (sdk.AccAddress, error) {
+	if len(args) != n {
+		return nil, fmt.Errorf("invalid args length: got %d, expected %d", len(args), n)
+	}
+	addr, ok := args[0].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf("unexpected address type: %T", args[0])
+	}
+	return sdk.AccAddress(addr.Bytes()), nil
+}
+
+// pastTimepoint decodes a block number timepoint, which like in ERC20Votes must be before the
+// current block: the votes of the current block are only snapshotted at its end.
+func pastTimepoint(ctx sdk.Context, v interface{}) (int64, error) {
+	t, ok := v.(*big.Int)
+	if !ok || t == nil {
+		return 0, fmt.Errorf("unexpected timepoint type: %T", v)
+	}
+	if !t.IsInt64() || t.Int64() >= ctx.BlockHeight() {
+		return 0, fmt.Errorf("future lookup: timepoint %s, clock %d", t, ctx.BlockHeight())
+	}
+	return t.Int64(), nil
+}
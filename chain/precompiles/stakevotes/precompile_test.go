@@ -0,0 +1,143 @@
+package stakevotes_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v2"
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	ynx "github.com/JiahaoAlbus/YNX/chain"
+	ynxconfig "github.com/JiahaoAlbus/YNX/chain/config"
+	"github.com/JiahaoAlbus/YNX/chain/precompiles/stakevotes"
+)
+
+func init() {
+	cfg := sdk.GetConfig()
+	ynxconfig.SetBech32Prefixes(cfg)
+	ynxconfig.SetBip44CoinType(cfg)
+	ynxconfig.RegisterDenoms()
+	cfg.Seal()
+}
+
+func TestPrecompileRegisteredInApp(t *testing.T) {
+	app := ynx.NewApp(
+		log.NewNopLogger(),
+		dbm.NewMemDB(),
+		nil,
+		true,
+		simtestutil.EmptyAppOptions{},
+	)
+
+	params := ynx.NewEVMGenesisState().Params
+	require.Contains(t, params.ActiveStaticPrecompiles, stakevotes.PrecompileAddress)
+
+	pc, ok, err := app.EVMKeeper.GetStaticPrecompileInstance(&params, common.HexToAddress(stakevotes.PrecompileAddress))
+	require.NoError(t, err)
+	require.True(t, ok)
+	_, is := pc.(*stakevotes.Precompile)
+	require.True(t, is)
+}
+
+func TestDelegateAndPastVotes(t *testing.T) {
+	app := ynx.NewApp(
+		log.NewNopLogger(),
+		dbm.NewMemDB(),
+		nil,
+		true,
+		simtestutil.EmptyAppOptions{},
+	)
+
+	ctx := app.BaseApp.NewUncachedContext(false, cmtproto.Header{
+		ChainID: "ynx_test-1",
+		Height:  5,
+		Time:    time.Unix(1, 0).UTC(),
+	})
+
+	voter := common.HexToAddress("0x6666666666666666666666666666666666666666")
+	delegatee := common.HexToAddress("0x7777777777777777777777777777777777777777")
+	operator := common.HexToAddress("0x8888888888888888888888888888888888888888")
+	val := sdk.ValAddress(operator.Bytes())
+
+	require.NoError(t, app.StakingKeeper.SetValidator(ctx, stakingtypes.Validator{
+		OperatorAddress: val.String(),
+		Status:          stakingtypes.Bonded,
+		Tokens:          sdkmath.NewInt(3_000),
+		DelegatorShares: sdkmath.LegacyNewDec(3_000),
+	}))
+	require.NoError(t, app.StakingKeeper.SetDelegation(ctx, stakingtypes.NewDelegation(sdk.AccAddress(voter.Bytes()).String(), val.String(), sdkmath.LegacyNewDec(3_000))))
+	require.NoError(t, app.YNXKeeper.StakingHooks().AfterDelegationModified(ctx, sdk.AccAddress(voter.Bytes()), val))
+	require.NoError(t, app.YNXKeeper.SnapshotStakeVotes(ctx))
+
+	pc := stakevotes.NewPrecompile(app.YNXKeeper, app.BankKeeper)
+	contract := vm.NewContract(voter, common.HexToAddress(stakevotes.PrecompileAddress), uint256.NewInt(0), 10_000_000, nil)
+
+	call := func(ctx sdk.Context, readOnly bool, method string, args ...interface{}) ([]interface{}, error) {
+		input, err := stakevotes.ABI.Pack(method, args...)
+		require.NoError(t, err)
+		contract.Input = input
+
+		out, err := pc.Execute(ctx, contract, readOnly)
+		if err != nil {
+			return nil, err
+		}
+		return stakevotes.ABI.Methods[method].Outputs.Unpack(out)
+	}
+
+	// Undelegated stake votes for the validator's operator.
+	out, err := call(ctx, true, stakevotes.GetVotesMethod, operator)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(3_000), out[0])
+	out, err = call(ctx, true, stakevotes.DelegatesMethod, voter)
+	require.NoError(t, err)
+	require.Equal(t, common.Address{}, out[0])
+
+	// delegate is a transaction.
+	_, err = call(ctx, true, stakevotes.DelegateMethod, delegatee)
+	require.Error(t, err)
+
+	ctx = ctx.WithBlockHeight(6)
+	_, err = call(ctx, false, stakevotes.DelegateMethod, delegatee)
+	require.NoError(t, err)
+	out, err = call(ctx, true, stakevotes.DelegatesMethod, voter)
+	require.NoError(t, err)
+	require.Equal(t, delegatee, out[0])
+
+	// The current block cannot be looked up yet.
+	_, err = call(ctx, true, stakevotes.GetPastVotesMethod, delegatee, big.NewInt(6))
+	require.ErrorContains(t, err, "future lookup")
+
+	ctx = ctx.WithBlockHeight(7)
+	out, err = call(ctx, true, stakevotes.GetPastVotesMethod, delegatee, big.NewInt(6))
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(3_000), out[0])
+	out, err = call(ctx, true, stakevotes.GetPastVotesMethod, operator, big.NewInt(6))
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(0), out[0])
+	out, err = call(ctx, true, stakevotes.GetPastTotalSupplyMethod, big.NewInt(4))
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(0), out[0])
+	out, err = call(ctx, true, stakevotes.GetPastTotalSupplyMethod, big.NewInt(5))
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(3_000), out[0])
+
+	// The zero address gives the votes back to the validator.
+	_, err = call(ctx, false, stakevotes.DelegateMethod, common.Address{})
+	require.NoError(t, err)
+	out, err = call(ctx, true, stakevotes.GetVotesMethod, operator)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(3_000), out[0])
+}
@@ -9,10 +9,12 @@ import (
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 
 	cmn "github.com/cosmos/evm/precompiles/common"
 
+	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -26,9 +28,36 @@ var _ vm.PrecompiledContract = &Precompile{}
 const (
 	PrecompileAddress = "0x0000000000000000000000000000000000000810"
 
-	GetParamsMethod          = "getParams"
-	GetSystemContractsMethod = "getSystemContracts"
-	UpdateParamsMethod       = "updateParams"
+	GetParamsMethod           = "getParams"
+	GetSystemContractsMethod  = "getSystemContracts"
+	GetSystemContractMethod   = "getSystemContract"
+	GetPendingParamsMethod    = "getPendingParams"
+	UpdateParamsMethod        = "updateParams"
+	ScheduleParamsMethod      = "scheduleParams"
+	CancelPendingParamsMethod = "cancelPendingParams"
+
+	GetInflationRecipientsMethod    = "getInflationRecipients"
+	UpdateInflationRecipientsMethod = "updateInflationRecipients"
+
+	GetContractRevenueMethod      = "getContractRevenue"
+	RegisterContractRevenueMethod = "registerContractRevenue"
+	UpdateContractRevenueMethod   = "updateContractRevenue"
+	CancelContractRevenueMethod   = "cancelContractRevenue"
+
+	DeploySystemContractMethod = "deploySystemContract"
+	SetSystemContractMethod    = "setSystemContract"
+	IsSystemContractMethod     = "isSystemContract"
+
+	GetRevenueMethod        = "getRevenue"
+	PreviewFeeSplitMethod   = "previewFeeSplit"
+	GetBlockProvisionMethod = "getBlockProvision"
+
+	GetPreconfirmSignersMethod = "getPreconfirmSigners"
+	IsPreconfirmSignerMethod   = "isPreconfirmSigner"
+	SetPreconfirmSignersMethod = "setPreconfirmSigners"
+
+	// ParamsUpdatedEvent is logged by updateParams with the params before and after the update.
+	ParamsUpdatedEvent = "ParamsUpdated"
 )
 
 var (
@@ -48,8 +77,12 @@ func init() {
 // Precompile exposes protocol parameter control to the EVM.
 //
 // Security model:
-// - updateParams is restricted to the v0 timelock system contract (msg.sender).
+// - updateParams, scheduleParams, cancelPendingParams, updateInflationRecipients, deploySystemContract, setSystemContract and setPreconfirmSigners are restricted to the v0 timelock system contract (msg.sender).
+// - the timelock can only cancel params changes it scheduled itself.
+// - registerContractRevenue registers msg.sender itself unless it is tx.origin, or a contract msg.sender created at the given nonce.
+// - updateContractRevenue and cancelContractRevenue are restricted to the address that registered the contract.
 // - reads are permissionless.
+// - updateParams logs ParamsUpdated with the params before and after the update.
 type Precompile struct {
 	cmn.Precompile
 
@@ -85,11 +118,12 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 
 func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error) {
 	return p.RunNativeAction(evm, contract, func(ctx sdk.Context) ([]byte, error) {
-		return p.Execute(ctx, contract, readonly)
+		return p.Execute(ctx, evm.StateDB, evm.Origin, contract, readonly)
 	})
 }
 
-func (p Precompile) Execute(ctx sdk.Context, contract *vm.Contract, readOnly bool) ([]byte, error) {
+// Execute runs the call in contract. origin is the sender of the transaction making the call.
+func (p Precompile) Execute(ctx sdk.Context, stateDB vm.StateDB, origin common.Address, contract *vm.Contract, readOnly bool) ([]byte, error) {
 	method, args, err := cmn.SetupABI(p.ABI, contract, readOnly, p.IsTransaction)
 	if err != nil {
 		return nil, err
@@ -100,8 +134,46 @@ func (p Precompile) Execute(ctx sdk.Context, contract *vm.Contract, readOnly boo
 		return p.getParams(ctx, method)
 	case GetSystemContractsMethod:
 		return p.getSystemContracts(ctx, method)
+	case GetSystemContractMethod:
+		return p.getSystemContract(ctx, method, args)
+	case GetPendingParamsMethod:
+		return p.getPendingParams(ctx, method)
 	case UpdateParamsMethod:
-		return p.updateParams(ctx, contract, method, args)
+		return p.updateParams(ctx, stateDB, contract, method, args)
+	case ScheduleParamsMethod:
+		return p.scheduleParams(ctx, contract, method, args)
+	case CancelPendingParamsMethod:
+		return p.cancelPendingParams(ctx, contract, method, args)
+	case GetInflationRecipientsMethod:
+		return p.getInflationRecipients(ctx, method)
+	case UpdateInflationRecipientsMethod:
+		return p.updateInflationRecipients(ctx, contract, method, args)
+	case GetContractRevenueMethod:
+		return p.getContractRevenue(ctx, method, args)
+	case RegisterContractRevenueMethod:
+		return p.registerContractRevenue(ctx, origin, contract, method, args)
+	case UpdateContractRevenueMethod:
+		return p.updateContractRevenue(ctx, contract, method, args)
+	case CancelContractRevenueMethod:
+		return p.cancelContractRevenue(ctx, contract, method, args)
+	case DeploySystemContractMethod:
+		return p.deploySystemContract(ctx, contract, method, args)
+	case SetSystemContractMethod:
+		return p.setSystemContract(ctx, contract, method, args)
+	case IsSystemContractMethod:
+		return p.isSystemContract(ctx, method, args)
+	case GetRevenueMethod:
+		return p.getRevenue(ctx, method, args)
+	case PreviewFeeSplitMethod:
+		return p.previewFeeSplit(ctx, method, args)
+	case GetBlockProvisionMethod:
+		return p.getBlockProvision(ctx, method)
+	case GetPreconfirmSignersMethod:
+		return p.getPreconfirmSigners(ctx, method)
+	case IsPreconfirmSignerMethod:
+		return p.isPreconfirmSigner(ctx, method, args)
+	case SetPreconfirmSignersMethod:
+		return p.setPreconfirmSigners(ctx, contract, method, args)
 	default:
 		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
 	}
@@ -109,7 +181,9 @@ func (p Precompile) Execute(ctx sdk.Context, contract *vm.Contract, readOnly boo
 
 func (Precompile) IsTransaction(method *abi.Method) bool {
 	switch method.Name {
-	case UpdateParamsMethod:
+	case UpdateParamsMethod, ScheduleParamsMethod, CancelPendingParamsMethod, UpdateInflationRecipientsMethod,
+		RegisterContractRevenueMethod, UpdateContractRevenueMethod, CancelContractRevenueMethod,
+		DeploySystemContractMethod, SetSystemContractMethod, SetPreconfirmSignersMethod:
 		return true
 	default:
 		return false
@@ -145,8 +219,8 @@ func (p Precompile) getParams(ctx sdk.Context, method *abi.Method) ([]byte, erro
 		treasury,
 		params.FeeBurnBps,
 		params.FeeTreasuryBps,
-		params.FeeFounderBps,
-		params.InflationTreasuryBps,
+		params.EffectiveFeeFounderBps(ctx.BlockHeight()),
+		params.InflationTreasuryShareBps(),
 	)
 }
 
@@ -156,74 +230,256 @@ func (p Precompile) getSystemContracts(ctx sdk.Context, method *abi.Method) ([]b
 		return nil, err
 	}
 
-	return method.Outputs.Pack(
-		hexToAddress(contracts.Nyxt),
-		hexToAddress(contracts.Timelock),
-		hexToAddress(contracts.Treasury),
-		hexToAddress(contracts.Governor),
-		hexToAddress(contracts.TeamVesting),
-		hexToAddress(contracts.OrgRegistry),
-		hexToAddress(contracts.SubjectRegistry),
-		hexToAddress(contracts.Arbitration),
-		hexToAddress(contracts.DomainInbox),
-	)
+	// The v0 entries, in the output order of getSystemContracts.
+	out := make([]interface{}, 0, len(ynxtypes.LegacySystemContractNames))
+	for _, name := range ynxtypes.LegacySystemContractNames {
+		out = append(out, hexToAddress(contracts.Get(name)))
+	}
+	return method.Outputs.Pack(out...)
+}
+
+func (p Precompile) getSystemContract(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("invalid args length: got %d, expected 1", len(args))
+	}
+
+	name, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("unexpected name type: %T", args[0])
+	}
+
+	contracts, err := p.ynxKeeper.SystemContracts.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(hexToAddress(contracts.Get(name)))
+}
+
+// pendingParamsOutput mirrors the IYNXProtocol.PendingParams ABI tuple.
+type pendingParamsOutput struct {
+	ActivationHeight     uint64
+	Authority            common.Address
+	Founder              common.Address
+	Treasury             common.Address
+	FeeBurnBps           uint32
+	FeeTreasuryBps       uint32
+	FeeFounderBps        uint32
+	InflationTreasuryBps uint32
+}
+
+func (p Precompile) getPendingParams(ctx sdk.Context, method *abi.Method) ([]byte, error) {
+	pending, err := p.ynxKeeper.GetPendingParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	current, err := p.ynxKeeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Each change is reported as the params it yields on top of the current params and the
+	// changes activating before it.
+	out := make([]pendingParamsOutput, 0, len(pending))
+	for _, pp := range pending {
+		current = pp.Apply(current)
+
+		authority, err := bech32ToAddress(pp.Authority)
+		if err != nil {
+			return nil, err
+		}
+		founder, err := bech32ToAddress(current.FounderAddress)
+		if err != nil {
+			return nil, err
+		}
+		treasury, err := bech32ToAddress(current.TreasuryAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(out, pendingParamsOutput{
+			ActivationHeight:     uint64(pp.ActivationHeight),
+			Authority:            authority,
+			Founder:              founder,
+			Treasury:             treasury,
+			FeeBurnBps:           current.FeeBurnBps,
+			FeeTreasuryBps:       current.FeeTreasuryBps,
+			FeeFounderBps:        current.FeeFounderBps,
+			InflationTreasuryBps: current.InflationTreasuryShareBps(),
+		})
+	}
+
+	return method.Outputs.Pack(out)
 }
 
-func (p Precompile) updateParams(ctx sdk.Context, contract *vm.Contract, method *abi.Method, args []interface{}) ([]byte, error) {
+func (p Precompile) updateParams(ctx sdk.Context, stateDB vm.StateDB, contract *vm.Contract, method *abi.Method, args []interface{}) ([]byte, error) {
 	if len(args) != 6 {
 		return nil, fmt.Errorf("invalid args length: got %d, expected 6", len(args))
 	}
 
-	founder, err := asAddress(args[0])
+	if _, err := p.requireTimelock(ctx, contract); err != nil {
+		return nil, err
+	}
+
+	old, err := p.ynxKeeper.Params.Get(ctx)
 	if err != nil {
 		return nil, err
 	}
-	treasury, err := asAddress(args[1])
+	params, err := p.paramsFromArgs(ctx, args)
 	if err != nil {
 		return nil, err
 	}
 
-	feeBurnBps, err := asUint32(args[2])
+	if err := p.ynxKeeper.Params.Set(ctx, params); err != nil {
+		return nil, err
+	}
+	if err := p.emitParamsUpdated(ctx, stateDB, old, params); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// emitParamsUpdated logs ParamsUpdated through the state DB, so that the log is reverted with
+// the call and indexed like any contract log.
+func (p Precompile) emitParamsUpdated(ctx sdk.Context, stateDB vm.StateDB, old, updated ynxtypes.Params) error {
+	event := p.Events[ParamsUpdatedEvent]
+
+	values := make([]interface{}, 0, 12)
+	for _, params := range []ynxtypes.Params{old, updated} {
+		founder, err := bech32ToAddress(params.FounderAddress)
+		if err != nil {
+			return err
+		}
+		treasury, err := bech32ToAddress(params.TreasuryAddress)
+		if err != nil {
+			return err
+		}
+		values = append(values, founder, treasury, params.FeeBurnBps, params.FeeTreasuryBps, params.FeeFounderBps, params.InflationTreasuryShareBps())
+	}
+	data, err := event.Inputs.NonIndexed().Pack(values...)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      []common.Hash{event.ID},
+		Data:        data,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // block heights are positive
+	})
+	return nil
+}
+
+func (p Precompile) scheduleParams(ctx sdk.Context, contract *vm.Contract, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 7 {
+		return nil, fmt.Errorf("invalid args length: got %d, expected 7", len(args))
+	}
+
+	timelock, err := p.requireTimelock(ctx, contract)
 	if err != nil {
 		return nil, err
 	}
-	feeTreasuryBps, err := asUint32(args[3])
+
+	params, err := p.paramsFromArgs(ctx, args[:6])
 	if err != nil {
 		return nil, err
 	}
-	feeFounderBps, err := asUint32(args[4])
+	activationHeight, err := asInt64(args[6])
 	if err != nil {
 		return nil, err
 	}
-	inflationTreasuryBps, err := asUint32(args[5])
+
+	if err := p.ynxKeeper.ScheduleParams(ctx, addressToBech32(timelock), params, activationHeight); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+func (p Precompile) cancelPendingParams(ctx sdk.Context, contract *vm.Contract, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("invalid args length: got %d, expected 1", len(args))
+	}
+
+	timelock, err := p.requireTimelock(ctx, contract)
 	if err != nil {
 		return nil, err
 	}
 
-	systemContracts, err := p.ynxKeeper.SystemContracts.Get(ctx)
+	activationHeight, err := asInt64(args[0])
 	if err != nil {
 		return nil, err
 	}
 
-	timelock := hexToAddress(systemContracts.Timelock)
-	if timelock == (common.Address{}) {
-		return nil, fmt.Errorf("timelock is not configured")
+	if err := p.ynxKeeper.CancelPendingParams(ctx, addressToBech32(timelock), activationHeight); err != nil {
+		return nil, err
 	}
 
-	caller := contract.Caller()
-	if caller != timelock {
-		return nil, fmt.Errorf("unauthorized caller %s (expected timelock %s)", caller.Hex(), timelock.Hex())
+	return method.Outputs.Pack(true)
+}
+
+// inflationRecipientABI mirrors the IYNXProtocol.InflationRecipient ABI tuple. Kind uses the
+// InflationRecipientKind values; Recipient is address(0) for the community pool and the treasury.
+type inflationRecipientABI struct {
+	Name      string
+	Kind      uint8
+	Recipient common.Address
+	Bps       uint32
+}
+
+func (p Precompile) getInflationRecipients(ctx sdk.Context, method *abi.Method) ([]byte, error) {
+	params, err := p.ynxKeeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	params := ynxtypes.Params{
-		FounderAddress:       addressToBech32(founder),
-		TreasuryAddress:      addressToBech32(treasury),
-		FeeBurnBps:           feeBurnBps,
-		FeeTreasuryBps:       feeTreasuryBps,
-		FeeFounderBps:        feeFounderBps,
-		InflationTreasuryBps: inflationTreasuryBps,
+	out := make([]inflationRecipientABI, 0, len(params.InflationRecipients))
+	for _, r := range params.InflationRecipients {
+		recipient, err := bech32ToAddress(r.Address)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, inflationRecipientABI{
+			Name:      r.Name,
+			Kind:      uint8(r.Kind),
+			Recipient: recipient,
+			Bps:       r.Bps,
+		})
 	}
 
+	return method.Outputs.Pack(out)
+}
+
+func (p Precompile) updateInflationRecipients(ctx sdk.Context, contract *vm.Contract, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("invalid args length: got %d, expected 1", len(args))
+	}
+
+	if _, err := p.requireTimelock(ctx, contract); err != nil {
+		return nil, err
+	}
+
+	in, ok := abi.ConvertType(args[0], new([]inflationRecipientABI)).(*[]inflationRecipientABI)
+	if !ok {
+		return nil, fmt.Errorf("unexpected inflation recipients type: %T", args[0])
+	}
+
+	recipients := make([]ynxtypes.InflationRecipient, 0, len(*in))
+	for _, r := range *in {
+		recipients = append(recipients, ynxtypes.InflationRecipient{
+			Name:    r.Name,
+			Kind:    ynxtypes.InflationRecipientKind(r.Kind),
+			Address: addressToBech32(r.Recipient),
+			Bps:     r.Bps,
+		})
+	}
+
+	params, err := p.ynxKeeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	params.InflationRecipients = recipients
+
 	if err := params.Validate(); err != nil {
 		return nil, err
 	}
@@ -235,6 +491,420 @@ func (p Precompile) updateParams(ctx sdk.Context, contract *vm.Contract, method
 	return method.Outputs.Pack(true)
 }
 
+func (p Precompile) getContractRevenue(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("invalid args length: got %d, expected 1", len(args))
+	}
+
+	contractAddr, err := asAddress(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	cr, found, err := p.ynxKeeper.GetContractRevenue(ctx, contractAddr)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return method.Outputs.Pack(common.Address{}, common.Address{})
+	}
+
+	deployer, err := bech32ToAddress(cr.DeployerAddress)
+	if err != nil {
+		return nil, err
+	}
+	withdrawer, err := bech32ToAddress(cr.WithdrawAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(deployer, withdrawer)
+}
+
+func (p Precompile) isSystemContract(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("invalid args length: got %d, expected 1", len(args))
+	}
+
+	account, err := asAddress(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	name, codeHash, official, err := p.ynxKeeper.SystemContractOf(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(official, name, codeHash)
+}
+
+// revenueTotalsABI mirrors the IYNXProtocol.RevenueTotals ABI tuple.
+type revenueTotalsABI struct {
+	FeeBurned           *big.Int
+	FeeTreasury         *big.Int
+	FeeFounder          *big.Int
+	FeeValidators       *big.Int
+	FeeDevelopers       *big.Int
+	InflationTreasury   *big.Int
+	InflationValidators *big.Int
+	InflationRecipients *big.Int
+}
+
+func (p Precompile) getRevenue(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("invalid args length: got %d, expected 1", len(args))
+	}
+
+	denom, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("unexpected denom type: %T", args[0])
+	}
+
+	r, err := p.ynxKeeper.GetRevenue(ctx, denom)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(revenueTotalsABI{
+		FeeBurned:           r.FeeBurned.BigInt(),
+		FeeTreasury:         r.FeeTreasury.BigInt(),
+		FeeFounder:          r.FeeFounder.BigInt(),
+		FeeValidators:       r.FeeValidators.BigInt(),
+		FeeDevelopers:       r.FeeDevelopers.BigInt(),
+		InflationTreasury:   r.InflationTreasury.BigInt(),
+		InflationValidators: r.InflationValidators.BigInt(),
+		InflationRecipients: r.InflationRecipients.BigInt(),
+	})
+}
+
+func (p Precompile) previewFeeSplit(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf("invalid args length: got %d, expected 2", len(args))
+	}
+
+	denom, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("unexpected denom type: %T", args[0])
+	}
+	amount, ok := args[1].(*big.Int)
+	if !ok || amount == nil || amount.Sign() < 0 || amount.BitLen() > sdkmath.MaxBitLen {
+		return nil, fmt.Errorf("invalid amount: %v", args[1])
+	}
+	fee := sdk.Coin{Denom: denom, Amount: sdkmath.NewIntFromBigInt(amount)}
+	if err := fee.Validate(); err != nil {
+		return nil, err
+	}
+
+	split, err := p.ynxKeeper.PreviewTxFeeSplit(ctx, fee)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(split.FeeBurned.BigInt(), split.FeeTreasury.BigInt(), split.FeeFounder.BigInt(), split.FeeValidators.BigInt())
+}
+
+func (p Precompile) getBlockProvision(ctx sdk.Context, method *abi.Method) ([]byte, error) {
+	provision, err := p.ynxKeeper.BlockProvision(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(provision.Denom, provision.Amount.BigInt())
+}
+
+func (p Precompile) getPreconfirmSigners(ctx sdk.Context, method *abi.Method) ([]byte, error) {
+	set, _, err := p.ynxKeeper.ActivePreconfirmSigners(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	signers := make([]common.Address, 0, len(set.Signers))
+	for _, signer := range set.Signers {
+		signers = append(signers, common.HexToAddress(signer))
+	}
+	return method.Outputs.Pack(signers, set.Threshold, set.ActivationEpoch)
+}
+
+func (p Precompile) isPreconfirmSigner(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("invalid args length: got %d, expected 1", len(args))
+	}
+
+	account, err := asAddress(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	registered, err := p.ynxKeeper.IsPreconfirmSigner(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(registered)
+}
+
+func (p Precompile) setPreconfirmSigners(ctx sdk.Context, contract *vm.Contract, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf("invalid args length: got %d, expected 3", len(args))
+	}
+
+	timelock, err := p.requireTimelock(ctx, contract)
+	if err != nil {
+		return nil, err
+	}
+
+	signers, ok := args[0].([]common.Address)
+	if !ok {
+		return nil, fmt.Errorf("unexpected signers type: %T", args[0])
+	}
+	threshold, ok := args[1].(uint32)
+	if !ok {
+		return nil, fmt.Errorf("unexpected threshold type: %T", args[1])
+	}
+	activationEpoch, ok := args[2].(uint64)
+	if !ok {
+		return nil, fmt.Errorf("unexpected activation epoch type: %T", args[2])
+	}
+
+	set := ynxtypes.PreconfirmSignerSet{
+		ActivationEpoch: activationEpoch,
+		Signers:         make([]string, 0, len(signers)),
+		Threshold:       threshold,
+	}
+	for _, signer := range signers {
+		set.Signers = append(set.Signers, signer.Hex())
+	}
+	if err := p.ynxKeeper.SetPreconfirmSigners(ctx, addressToBech32(timelock), set); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+func (p Precompile) registerContractRevenue(ctx sdk.Context, origin common.Address, contract *vm.Contract, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf("invalid args length: got %d, expected 3", len(args))
+	}
+
+	contractAddr, err := asAddress(args[0])
+	if err != nil {
+		return nil, err
+	}
+	nonce, ok := args[1].(uint64)
+	if !ok {
+		return nil, fmt.Errorf("unexpected nonce type: %T", args[1])
+	}
+	withdrawer, err := asAddress(args[2])
+	if err != nil {
+		return nil, err
+	}
+	withdraw := sdk.AccAddress(nil)
+	if withdrawer != (common.Address{}) {
+		withdraw = sdk.AccAddress(withdrawer.Bytes())
+	}
+
+	// A contract registering itself needs no deployment proof; the nonce is ignored.
+	caller := contract.Caller()
+	if caller == contractAddr {
+		err = p.ynxKeeper.RegisterSelfContractRevenue(ctx, contractAddr, origin, withdraw)
+	} else {
+		err = p.ynxKeeper.RegisterContractRevenue(ctx, caller, contractAddr, nonce, withdraw)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+func (p Precompile) updateContractRevenue(ctx sdk.Context, contract *vm.Contract, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf("invalid args length: got %d, expected 2", len(args))
+	}
+
+	contractAddr, err := asAddress(args[0])
+	if err != nil {
+		return nil, err
+	}
+	withdrawer, err := asAddress(args[1])
+	if err != nil {
+		return nil, err
+	}
+	withdraw := sdk.AccAddress(nil)
+	if withdrawer != (common.Address{}) {
+		withdraw = sdk.AccAddress(withdrawer.Bytes())
+	}
+
+	if err := p.ynxKeeper.UpdateContractRevenue(ctx, contract.Caller(), contractAddr, withdraw); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+func (p Precompile) cancelContractRevenue(ctx sdk.Context, contract *vm.Contract, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("invalid args length: got %d, expected 1", len(args))
+	}
+
+	contractAddr, err := asAddress(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.ynxKeeper.CancelContractRevenue(ctx, contract.Caller(), contractAddr); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// systemContractCallABI mirrors the IYNXProtocol.SystemContractCall ABI tuple. Target is address(0)
+// for the deployed contract.
+type systemContractCallABI struct {
+	Target common.Address
+	Data   []byte
+}
+
+func (p Precompile) deploySystemContract(ctx sdk.Context, contract *vm.Contract, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 5 {
+		return nil, fmt.Errorf("invalid args length: got %d, expected 5", len(args))
+	}
+
+	timelock, err := p.requireTimelock(ctx, contract)
+	if err != nil {
+		return nil, err
+	}
+
+	name, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("unexpected name type: %T", args[0])
+	}
+	artifact, ok := args[1].(string)
+	if !ok {
+		return nil, fmt.Errorf("unexpected artifact type: %T", args[1])
+	}
+	bytecode, ok := args[2].([]byte)
+	if !ok {
+		return nil, fmt.Errorf("unexpected bytecode type: %T", args[2])
+	}
+	constructorArgs, ok := args[3].([]byte)
+	if !ok {
+		return nil, fmt.Errorf("unexpected constructor args type: %T", args[3])
+	}
+	in, ok := abi.ConvertType(args[4], new([]systemContractCallABI)).(*[]systemContractCallABI)
+	if !ok {
+		return nil, fmt.Errorf("unexpected migration calls type: %T", args[4])
+	}
+
+	calls := make([]ynxtypes.SystemContractCall, 0, len(*in))
+	for _, c := range *in {
+		call := ynxtypes.SystemContractCall{Data: c.Data}
+		if c.Target != (common.Address{}) {
+			call.To = c.Target.Hex()
+		}
+		calls = append(calls, call)
+	}
+
+	deployed, err := p.ynxKeeper.DeploySystemContract(ctx, addressToBech32(timelock), name, artifact, bytecode, constructorArgs, calls)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(deployed)
+}
+
+func (p Precompile) setSystemContract(ctx sdk.Context, contract *vm.Contract, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf("invalid args length: got %d, expected 2", len(args))
+	}
+
+	timelock, err := p.requireTimelock(ctx, contract)
+	if err != nil {
+		return nil, err
+	}
+
+	name, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("unexpected name type: %T", args[0])
+	}
+	contractAddr, err := asAddress(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.ynxKeeper.SetSystemContract(ctx, addressToBech32(timelock), name, contractAddr); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// requireTimelock returns the configured timelock address, failing unless it is the caller.
+func (p Precompile) requireTimelock(ctx sdk.Context, contract *vm.Contract) (common.Address, error) {
+	systemContracts, err := p.ynxKeeper.SystemContracts.Get(ctx)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	timelock := hexToAddress(systemContracts.Get("timelock"))
+	if timelock == (common.Address{}) {
+		return common.Address{}, fmt.Errorf("timelock is not configured")
+	}
+
+	caller := contract.Caller()
+	if caller != timelock {
+		return common.Address{}, fmt.Errorf("unauthorized caller %s (expected timelock %s)", caller.Hex(), timelock.Hex())
+	}
+
+	return timelock, nil
+}
+
+// paramsFromArgs decodes the (founder, treasury, feeBurnBps, feeTreasuryBps, feeFounderBps,
+// inflationTreasuryBps) ABI arguments on top of the current params and validates the result.
+// inflationTreasuryBps is the bps of the treasury inflation recipient.
+func (p Precompile) paramsFromArgs(ctx sdk.Context, args []interface{}) (ynxtypes.Params, error) {
+	founder, err := asAddress(args[0])
+	if err != nil {
+		return ynxtypes.Params{}, err
+	}
+	treasury, err := asAddress(args[1])
+	if err != nil {
+		return ynxtypes.Params{}, err
+	}
+
+	feeBurnBps, err := asUint32(args[2])
+	if err != nil {
+		return ynxtypes.Params{}, err
+	}
+	feeTreasuryBps, err := asUint32(args[3])
+	if err != nil {
+		return ynxtypes.Params{}, err
+	}
+	feeFounderBps, err := asUint32(args[4])
+	if err != nil {
+		return ynxtypes.Params{}, err
+	}
+	inflationTreasuryBps, err := asUint32(args[5])
+	if err != nil {
+		return ynxtypes.Params{}, err
+	}
+
+	// Params that are not exposed through the ABI keep their current values.
+	params, err := p.ynxKeeper.Params.Get(ctx)
+	if err != nil {
+		return ynxtypes.Params{}, err
+	}
+	params.FounderAddress = addressToBech32(founder)
+	params.TreasuryAddress = addressToBech32(treasury)
+	params.FeeBurnBps = feeBurnBps
+	params.FeeTreasuryBps = feeTreasuryBps
+	params.FeeFounderBps = feeFounderBps
+	params.SetInflationTreasuryShareBps(inflationTreasuryBps)
+
+	if err := params.Validate(); err != nil {
+		return ynxtypes.Params{}, err
+	}
+
+	return params, nil
+}
+
 func hexToAddress(s string) common.Address {
 	s = strings.TrimSpace(s)
 	if !common.IsHexAddress(s) {
@@ -250,6 +920,17 @@ func addressToBech32(addr common.Address) string {
 	return sdk.AccAddress(addr.Bytes()).String()
 }
 
+func bech32ToAddress(s string) (common.Address, error) {
+	if s == "" {
+		return common.Address{}, nil
+	}
+	acc, err := sdk.AccAddressFromBech32(s)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return common.BytesToAddress(acc.Bytes()), nil
+}
+
 func asAddress(v interface{}) (common.Address, error) {
 	switch t := v.(type) {
 	case common.Address:
@@ -296,3 +977,23 @@ func asUint32(v interface{}) (uint32, error) {
 		return 0, fmt.Errorf("unexpected uint32 type: %T", v)
 	}
 }
+
+func asInt64(v interface{}) (int64, error) {
+	switch t := v.(type) {
+	case uint64:
+		if t > math.MaxInt64 {
+			return 0, fmt.Errorf("int64 overflow: %d", t)
+		}
+		return int64(t), nil
+	case *big.Int:
+		if t == nil {
+			return 0, fmt.Errorf("nil big.Int")
+		}
+		if !t.IsInt64() {
+			return 0, fmt.Errorf("int64 overflow: %s", t.String())
+		}
+		return t.Int64(), nil
+	default:
+		return 0, fmt.Errorf("unexpected uint64 type: %T", v)
+	}
+}
@@ -1,11 +1,14 @@
 package ynxprotocol_test
 
 import (
+	"math/big"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/holiman/uint256"
 	"github.com/stretchr/testify/require"
 
@@ -13,18 +16,24 @@ import (
 	dbm "github.com/cosmos/cosmos-db"
 
 	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
 
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 
 	ynx "github.com/JiahaoAlbus/YNX/chain"
 	ynxconfig "github.com/JiahaoAlbus/YNX/chain/config"
 	"github.com/JiahaoAlbus/YNX/chain/precompiles/ynxprotocol"
 	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
 
+	"github.com/cosmos/evm/x/vm/statedb"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 )
 
+// txOrigin is the sender of the transactions calling the precompile in these tests.
+var txOrigin = common.HexToAddress("0x00000000000000000000000000000000000000E0")
+
 func init() {
 	cfg := sdk.GetConfig()
 	ynxconfig.SetBech32Prefixes(cfg)
@@ -69,11 +78,12 @@ func TestUpdateParams_AuthorizedByTimelock(t *testing.T) {
 
 	timelock := common.HexToAddress("0x00000000000000000000000000000000000000AA")
 	require.NoError(t, app.YNXKeeper.SystemContracts.Set(ctx, ynxtypes.SystemContracts{
-		Timelock: timelock.Hex(),
+		Contracts: []ynxtypes.SystemContractEntry{{Name: "timelock", Address: timelock.Hex()}},
 	}))
 	require.NoError(t, app.YNXKeeper.Params.Set(ctx, ynxtypes.DefaultParams()))
 
 	pc := ynxprotocol.NewPrecompile(app.YNXKeeper)
+	stateDB := statedb.New(ctx, app.EVMKeeper, statedb.NewEmptyTxConfig())
 
 	founder := common.HexToAddress("0x1111111111111111111111111111111111111111")
 	treasury := common.HexToAddress("0x2222222222222222222222222222222222222222")
@@ -84,7 +94,7 @@ func TestUpdateParams_AuthorizedByTimelock(t *testing.T) {
 	contract := vm.NewContract(timelock, common.HexToAddress(ynxprotocol.PrecompileAddress), uint256.NewInt(0), 10_000_000, nil)
 	contract.Input = input
 
-	out, err := pc.Execute(ctx, contract, false)
+	out, err := pc.Execute(ctx, stateDB, txOrigin, contract, false)
 	require.NoError(t, err)
 
 	method := ynxprotocol.ABI.Methods[ynxprotocol.UpdateParamsMethod]
@@ -100,7 +110,21 @@ func TestUpdateParams_AuthorizedByTimelock(t *testing.T) {
 	require.Equal(t, uint32(100), updated.FeeBurnBps)
 	require.Equal(t, uint32(200), updated.FeeTreasuryBps)
 	require.Equal(t, uint32(300), updated.FeeFounderBps)
-	require.Equal(t, uint32(400), updated.InflationTreasuryBps)
+	require.Equal(t, uint32(400), updated.InflationTreasuryShareBps())
+
+	// The update is logged with the params before and after it.
+	defaults := ynxtypes.DefaultParams()
+	event := ynxprotocol.ABI.Events[ynxprotocol.ParamsUpdatedEvent]
+	logs := stateDB.Logs()
+	require.Len(t, logs, 1)
+	require.Equal(t, common.HexToAddress(ynxprotocol.PrecompileAddress), logs[0].Address)
+	require.Equal(t, []common.Hash{event.ID}, logs[0].Topics)
+	values, err := event.Inputs.Unpack(logs[0].Data)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{
+		common.Address{}, common.Address{}, defaults.FeeBurnBps, defaults.FeeTreasuryBps, defaults.FeeFounderBps, defaults.InflationTreasuryShareBps(),
+		founder, treasury, uint32(100), uint32(200), uint32(300), uint32(400),
+	}, values)
 }
 
 func TestUpdateParams_UnauthorizedCaller(t *testing.T) {
@@ -120,11 +144,12 @@ func TestUpdateParams_UnauthorizedCaller(t *testing.T) {
 
 	timelock := common.HexToAddress("0x00000000000000000000000000000000000000AA")
 	require.NoError(t, app.YNXKeeper.SystemContracts.Set(ctx, ynxtypes.SystemContracts{
-		Timelock: timelock.Hex(),
+		Contracts: []ynxtypes.SystemContractEntry{{Name: "timelock", Address: timelock.Hex()}},
 	}))
 	require.NoError(t, app.YNXKeeper.Params.Set(ctx, ynxtypes.DefaultParams()))
 
 	pc := ynxprotocol.NewPrecompile(app.YNXKeeper)
+	stateDB := statedb.New(ctx, app.EVMKeeper, statedb.NewEmptyTxConfig())
 
 	input, err := ynxprotocol.ABI.Pack(ynxprotocol.UpdateParamsMethod, common.Address{}, common.Address{}, uint32(0), uint32(0), uint32(0), uint32(0))
 	require.NoError(t, err)
@@ -133,7 +158,7 @@ func TestUpdateParams_UnauthorizedCaller(t *testing.T) {
 	contract := vm.NewContract(attacker, common.HexToAddress(ynxprotocol.PrecompileAddress), uint256.NewInt(0), 10_000_000, nil)
 	contract.Input = input
 
-	_, err = pc.Execute(ctx, contract, false)
+	_, err = pc.Execute(ctx, stateDB, txOrigin, contract, false)
 	require.Error(t, err)
 }
 
@@ -154,11 +179,12 @@ func TestUpdateParams_ReadOnlyProtection(t *testing.T) {
 
 	timelock := common.HexToAddress("0x00000000000000000000000000000000000000AA")
 	require.NoError(t, app.YNXKeeper.SystemContracts.Set(ctx, ynxtypes.SystemContracts{
-		Timelock: timelock.Hex(),
+		Contracts: []ynxtypes.SystemContractEntry{{Name: "timelock", Address: timelock.Hex()}},
 	}))
 	require.NoError(t, app.YNXKeeper.Params.Set(ctx, ynxtypes.DefaultParams()))
 
 	pc := ynxprotocol.NewPrecompile(app.YNXKeeper)
+	stateDB := statedb.New(ctx, app.EVMKeeper, statedb.NewEmptyTxConfig())
 
 	input, err := ynxprotocol.ABI.Pack(ynxprotocol.UpdateParamsMethod, common.Address{}, common.Address{}, uint32(0), uint32(0), uint32(0), uint32(0))
 	require.NoError(t, err)
@@ -166,7 +192,7 @@ func TestUpdateParams_ReadOnlyProtection(t *testing.T) {
 	contract := vm.NewContract(timelock, common.HexToAddress(ynxprotocol.PrecompileAddress), uint256.NewInt(0), 10_000_000, nil)
 	contract.Input = input
 
-	_, err = pc.Execute(ctx, contract, true)
+	_, err = pc.Execute(ctx, stateDB, txOrigin, contract, true)
 	require.Error(t, err)
 }
 
@@ -189,15 +215,18 @@ func TestGetParams_Roundtrip(t *testing.T) {
 	treasury := common.HexToAddress("0x2222222222222222222222222222222222222222")
 
 	require.NoError(t, app.YNXKeeper.Params.Set(ctx, ynxtypes.Params{
-		FounderAddress:       sdk.AccAddress(founder.Bytes()).String(),
-		TreasuryAddress:      sdk.AccAddress(treasury.Bytes()).String(),
-		FeeBurnBps:           1,
-		FeeTreasuryBps:       2,
-		FeeFounderBps:        3,
-		InflationTreasuryBps: 4,
+		FounderAddress:  sdk.AccAddress(founder.Bytes()).String(),
+		TreasuryAddress: sdk.AccAddress(treasury.Bytes()).String(),
+		FeeBurnBps:      1,
+		FeeTreasuryBps:  2,
+		FeeFounderBps:   3,
+		InflationRecipients: []ynxtypes.InflationRecipient{
+			{Name: "treasury", Kind: ynxtypes.InflationRecipientKind_INFLATION_RECIPIENT_KIND_TREASURY, Bps: 4},
+		},
 	}))
 
 	pc := ynxprotocol.NewPrecompile(app.YNXKeeper)
+	stateDB := statedb.New(ctx, app.EVMKeeper, statedb.NewEmptyTxConfig())
 
 	input, err := ynxprotocol.ABI.Pack(ynxprotocol.GetParamsMethod)
 	require.NoError(t, err)
@@ -205,7 +234,7 @@ func TestGetParams_Roundtrip(t *testing.T) {
 	contract := vm.NewContract(common.Address{}, common.HexToAddress(ynxprotocol.PrecompileAddress), uint256.NewInt(0), 10_000_000, nil)
 	contract.Input = input
 
-	out, err := pc.Execute(ctx, contract, true)
+	out, err := pc.Execute(ctx, stateDB, txOrigin, contract, true)
 	require.NoError(t, err)
 
 	method := ynxprotocol.ABI.Methods[ynxprotocol.GetParamsMethod]
@@ -220,3 +249,523 @@ func TestGetParams_Roundtrip(t *testing.T) {
 	require.Equal(t, uint32(3), decoded[4])
 	require.Equal(t, uint32(4), decoded[5])
 }
+
+func TestScheduleParams_TimelockCanCancelOwnChange(t *testing.T) {
+	app := ynx.NewApp(
+		log.NewNopLogger(),
+		dbm.NewMemDB(),
+		nil,
+		true,
+		simtestutil.EmptyAppOptions{},
+	)
+
+	ctx := app.BaseApp.NewUncachedContext(false, cmtproto.Header{
+		ChainID: "ynx_test-1",
+		Height:  1,
+		Time:    time.Unix(1, 0).UTC(),
+	})
+
+	timelock := common.HexToAddress("0x00000000000000000000000000000000000000AA")
+	require.NoError(t, app.YNXKeeper.SystemContracts.Set(ctx, ynxtypes.SystemContracts{
+		Contracts: []ynxtypes.SystemContractEntry{{Name: "timelock", Address: timelock.Hex()}},
+	}))
+	require.NoError(t, app.YNXKeeper.Params.Set(ctx, ynxtypes.DefaultParams()))
+
+	pc := ynxprotocol.NewPrecompile(app.YNXKeeper)
+	stateDB := statedb.New(ctx, app.EVMKeeper, statedb.NewEmptyTxConfig())
+	founder := common.HexToAddress("0x1111111111111111111111111111111111111111")
+
+	input, err := ynxprotocol.ABI.Pack(ynxprotocol.ScheduleParamsMethod, founder, common.Address{}, uint32(100), uint32(200), uint32(300), uint32(400), uint64(5))
+	require.NoError(t, err)
+
+	contract := vm.NewContract(timelock, common.HexToAddress(ynxprotocol.PrecompileAddress), uint256.NewInt(0), 10_000_000, nil)
+	contract.Input = input
+
+	_, err = pc.Execute(ctx, stateDB, txOrigin, contract, false)
+	require.NoError(t, err)
+
+	current, err := app.YNXKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, ynxtypes.DefaultParams().FeeBurnBps, current.FeeBurnBps)
+
+	input, err = ynxprotocol.ABI.Pack(ynxprotocol.GetPendingParamsMethod)
+	require.NoError(t, err)
+	contract.Input = input
+
+	out, err := pc.Execute(ctx, stateDB, txOrigin, contract, true)
+	require.NoError(t, err)
+
+	method := ynxprotocol.ABI.Methods[ynxprotocol.GetPendingParamsMethod]
+	decoded, err := method.Outputs.Unpack(out)
+	require.NoError(t, err)
+	require.Len(t, decoded, 1)
+
+	pending := decoded[0].([]struct {
+		ActivationHeight     uint64         `json:"activationHeight"`
+		Authority            common.Address `json:"authority"`
+		Founder              common.Address `json:"founder"`
+		Treasury             common.Address `json:"treasury"`
+		FeeBurnBps           uint32         `json:"feeBurnBps"`
+		FeeTreasuryBps       uint32         `json:"feeTreasuryBps"`
+		FeeFounderBps        uint32         `json:"feeFounderBps"`
+		InflationTreasuryBps uint32         `json:"inflationTreasuryBps"`
+	})
+	require.Len(t, pending, 1)
+	require.Equal(t, uint64(5), pending[0].ActivationHeight)
+	require.Equal(t, timelock, pending[0].Authority)
+	require.Equal(t, founder, pending[0].Founder)
+	require.Equal(t, uint32(100), pending[0].FeeBurnBps)
+
+	input, err = ynxprotocol.ABI.Pack(ynxprotocol.CancelPendingParamsMethod, uint64(5))
+	require.NoError(t, err)
+	contract.Input = input
+
+	_, err = pc.Execute(ctx, stateDB, txOrigin, contract, false)
+	require.NoError(t, err)
+
+	remaining, err := app.YNXKeeper.GetPendingParams(ctx)
+	require.NoError(t, err)
+	require.Empty(t, remaining)
+}
+
+func TestUpdateInflationRecipients_Roundtrip(t *testing.T) {
+	app := ynx.NewApp(
+		log.NewNopLogger(),
+		dbm.NewMemDB(),
+		nil,
+		true,
+		simtestutil.EmptyAppOptions{},
+	)
+
+	ctx := app.BaseApp.NewUncachedContext(false, cmtproto.Header{
+		ChainID: "ynx_test-1",
+		Height:  1,
+		Time:    time.Unix(1, 0).UTC(),
+	})
+
+	timelock := common.HexToAddress("0x00000000000000000000000000000000000000AA")
+	require.NoError(t, app.YNXKeeper.SystemContracts.Set(ctx, ynxtypes.SystemContracts{
+		Contracts: []ynxtypes.SystemContractEntry{{Name: "timelock", Address: timelock.Hex()}},
+	}))
+	require.NoError(t, app.YNXKeeper.Params.Set(ctx, ynxtypes.DefaultParams()))
+
+	pc := ynxprotocol.NewPrecompile(app.YNXKeeper)
+	stateDB := statedb.New(ctx, app.EVMKeeper, statedb.NewEmptyTxConfig())
+	ecosystem := common.HexToAddress("0x3333333333333333333333333333333333333333")
+
+	type recipient struct {
+		Name      string
+		Kind      uint8
+		Recipient common.Address
+		Bps       uint32
+	}
+	input, err := ynxprotocol.ABI.Pack(ynxprotocol.UpdateInflationRecipientsMethod, []recipient{
+		{Name: "ecosystem_fund", Kind: uint8(ynxtypes.InflationRecipientKind_INFLATION_RECIPIENT_KIND_ACCOUNT), Recipient: ecosystem, Bps: 1_000},
+		{Name: "community_pool", Kind: uint8(ynxtypes.InflationRecipientKind_INFLATION_RECIPIENT_KIND_COMMUNITY_POOL), Bps: 500},
+	})
+	require.NoError(t, err)
+
+	contract := vm.NewContract(timelock, common.HexToAddress(ynxprotocol.PrecompileAddress), uint256.NewInt(0), 10_000_000, nil)
+	contract.Input = input
+
+	_, err = pc.Execute(ctx, stateDB, txOrigin, contract, false)
+	require.NoError(t, err)
+
+	params, err := app.YNXKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []ynxtypes.InflationRecipient{
+		{Name: "ecosystem_fund", Kind: ynxtypes.InflationRecipientKind_INFLATION_RECIPIENT_KIND_ACCOUNT, Address: sdk.AccAddress(ecosystem.Bytes()).String(), Bps: 1_000},
+		{Name: "community_pool", Kind: ynxtypes.InflationRecipientKind_INFLATION_RECIPIENT_KIND_COMMUNITY_POOL, Bps: 500},
+	}, params.InflationRecipients)
+
+	input, err = ynxprotocol.ABI.Pack(ynxprotocol.GetInflationRecipientsMethod)
+	require.NoError(t, err)
+	contract.Input = input
+
+	out, err := pc.Execute(ctx, stateDB, txOrigin, contract, true)
+	require.NoError(t, err)
+
+	method := ynxprotocol.ABI.Methods[ynxprotocol.GetInflationRecipientsMethod]
+	decoded, err := method.Outputs.Unpack(out)
+	require.NoError(t, err)
+	require.Len(t, decoded, 1)
+}
+
+func TestRegisterContractRevenue_SelfRegistration(t *testing.T) {
+	app := ynx.NewApp(
+		log.NewNopLogger(),
+		dbm.NewMemDB(),
+		nil,
+		true,
+		simtestutil.EmptyAppOptions{},
+	)
+
+	ctx := app.BaseApp.NewUncachedContext(false, cmtproto.Header{
+		ChainID: "ynx_test-1",
+		Height:  1,
+		Time:    time.Unix(1, 0).UTC(),
+	})
+	require.NoError(t, app.YNXKeeper.Params.Set(ctx, ynxtypes.DefaultParams()))
+
+	pc := ynxprotocol.NewPrecompile(app.YNXKeeper)
+	stateDB := statedb.New(ctx, app.EVMKeeper, statedb.NewEmptyTxConfig())
+	dapp := common.HexToAddress("0x4444444444444444444444444444444444444444")
+	withdrawer := common.HexToAddress("0x5555555555555555555555555555555555555555")
+
+	input, err := ynxprotocol.ABI.Pack(ynxprotocol.RegisterContractRevenueMethod, dapp, uint64(0), withdrawer)
+	require.NoError(t, err)
+
+	// Another account cannot register the contract without a matching CREATE nonce.
+	attacker := common.HexToAddress("0x00000000000000000000000000000000000000BB")
+	contract := vm.NewContract(attacker, common.HexToAddress(ynxprotocol.PrecompileAddress), uint256.NewInt(0), 10_000_000, nil)
+	contract.Input = input
+	_, err = pc.Execute(ctx, stateDB, txOrigin, contract, false)
+	require.Error(t, err)
+
+	// An externally owned account calling the precompile directly is the transaction origin and
+	// cannot register itself.
+	contract = vm.NewContract(dapp, common.HexToAddress(ynxprotocol.PrecompileAddress), uint256.NewInt(0), 10_000_000, nil)
+	contract.Input = input
+	_, err = pc.Execute(ctx, stateDB, dapp, contract, false)
+	require.ErrorContains(t, err, "is not a contract")
+
+	_, err = pc.Execute(ctx, stateDB, txOrigin, contract, false)
+	require.NoError(t, err)
+
+	input, err = ynxprotocol.ABI.Pack(ynxprotocol.GetContractRevenueMethod, dapp)
+	require.NoError(t, err)
+	contract.Input = input
+
+	out, err := pc.Execute(ctx, stateDB, txOrigin, contract, true)
+	require.NoError(t, err)
+
+	method := ynxprotocol.ABI.Methods[ynxprotocol.GetContractRevenueMethod]
+	decoded, err := method.Outputs.Unpack(out)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{dapp, withdrawer}, decoded)
+
+	input, err = ynxprotocol.ABI.Pack(ynxprotocol.CancelContractRevenueMethod, dapp)
+	require.NoError(t, err)
+	contract.Input = input
+	_, err = pc.Execute(ctx, stateDB, txOrigin, contract, false)
+	require.NoError(t, err)
+
+	_, found, err := app.YNXKeeper.GetContractRevenue(ctx, dapp)
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestSetSystemContract_AuthorizedByTimelock(t *testing.T) {
+	app := ynx.NewApp(
+		log.NewNopLogger(),
+		dbm.NewMemDB(),
+		nil,
+		true,
+		simtestutil.EmptyAppOptions{},
+	)
+
+	ctx := app.BaseApp.NewUncachedContext(false, cmtproto.Header{
+		ChainID: "ynx_test-1",
+		Height:  1,
+		Time:    time.Unix(1, 0).UTC(),
+	})
+
+	timelock := common.HexToAddress("0x00000000000000000000000000000000000000AA")
+	require.NoError(t, app.YNXKeeper.SystemContracts.Set(ctx, ynxtypes.SystemContracts{
+		Contracts: []ynxtypes.SystemContractEntry{{Name: "timelock", Address: timelock.Hex()}},
+	}))
+	require.NoError(t, app.YNXKeeper.Params.Set(ctx, ynxtypes.DefaultParams()))
+
+	inbox := common.HexToAddress("0x6666666666666666666666666666666666666666")
+	code := []byte{0x60, 0x00}
+	codeHash := crypto.Keccak256(code)
+	app.EVMKeeper.SetCode(ctx, codeHash, code)
+	require.NoError(t, app.EVMKeeper.SetAccount(ctx, inbox, statedb.Account{Balance: new(uint256.Int), CodeHash: codeHash}))
+
+	pc := ynxprotocol.NewPrecompile(app.YNXKeeper)
+	stateDB := statedb.New(ctx, app.EVMKeeper, statedb.NewEmptyTxConfig())
+
+	input, err := ynxprotocol.ABI.Pack(ynxprotocol.SetSystemContractMethod, "domain_inbox", inbox)
+	require.NoError(t, err)
+
+	attacker := common.HexToAddress("0x00000000000000000000000000000000000000BB")
+	contract := vm.NewContract(attacker, common.HexToAddress(ynxprotocol.PrecompileAddress), uint256.NewInt(0), 10_000_000, nil)
+	contract.Input = input
+	_, err = pc.Execute(ctx, stateDB, txOrigin, contract, false)
+	require.Error(t, err)
+
+	deployInput, err := ynxprotocol.ABI.Pack(ynxprotocol.DeploySystemContractMethod, "domain_inbox", "YNXDomainInbox", []byte{}, []byte{}, []struct {
+		Target common.Address
+		Data   []byte
+	}{})
+	require.NoError(t, err)
+	contract.Input = deployInput
+	_, err = pc.Execute(ctx, stateDB, txOrigin, contract, false)
+	require.Error(t, err)
+
+	contract = vm.NewContract(timelock, common.HexToAddress(ynxprotocol.PrecompileAddress), uint256.NewInt(0), 10_000_000, nil)
+	contract.Input = input
+	_, err = pc.Execute(ctx, stateDB, txOrigin, contract, false)
+	require.NoError(t, err)
+
+	contracts, err := app.YNXKeeper.SystemContracts.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, inbox.Hex(), contracts.Get("domain_inbox"))
+	require.Equal(t, timelock.Hex(), contracts.Get("timelock"))
+
+	// Handing the timelock entry to another contract revokes the old timelock.
+	input, err = ynxprotocol.ABI.Pack(ynxprotocol.SetSystemContractMethod, "timelock", inbox)
+	require.NoError(t, err)
+	contract.Input = input
+	_, err = pc.Execute(ctx, stateDB, txOrigin, contract, false)
+	require.NoError(t, err)
+	_, err = pc.Execute(ctx, stateDB, txOrigin, contract, false)
+	require.Error(t, err)
+}
+
+func TestIsSystemContract_RequiresAttestedCode(t *testing.T) {
+	app := ynx.NewApp(
+		log.NewNopLogger(),
+		dbm.NewMemDB(),
+		nil,
+		true,
+		simtestutil.EmptyAppOptions{},
+	)
+
+	ctx := app.BaseApp.NewUncachedContext(false, cmtproto.Header{
+		ChainID: "ynx_test-1",
+		Height:  1,
+		Time:    time.Unix(1, 0).UTC(),
+	})
+	require.NoError(t, app.YNXKeeper.SystemContracts.Set(ctx, ynxtypes.SystemContracts{}))
+	require.NoError(t, app.YNXKeeper.Params.Set(ctx, ynxtypes.DefaultParams()))
+
+	setCode := func(addr common.Address, code []byte) common.Hash {
+		codeHash := crypto.Keccak256(code)
+		app.EVMKeeper.SetCode(ctx, codeHash, code)
+		require.NoError(t, app.EVMKeeper.SetAccount(ctx, addr, statedb.Account{Balance: new(uint256.Int), CodeHash: codeHash}))
+		return common.BytesToHash(codeHash)
+	}
+
+	inbox := common.HexToAddress("0x6666666666666666666666666666666666666666")
+	codeHash := setCode(inbox, []byte{0x60, 0x00})
+	require.NoError(t, app.YNXKeeper.SetSystemContract(ctx, "gov", "domain_inbox", inbox))
+
+	pc := ynxprotocol.NewPrecompile(app.YNXKeeper)
+	stateDB := statedb.New(ctx, app.EVMKeeper, statedb.NewEmptyTxConfig())
+	method := ynxprotocol.ABI.Methods[ynxprotocol.IsSystemContractMethod]
+	caller := common.HexToAddress("0x00000000000000000000000000000000000000BB")
+	query := func(account common.Address) []interface{} {
+		input, err := ynxprotocol.ABI.Pack(ynxprotocol.IsSystemContractMethod, account)
+		require.NoError(t, err)
+		contract := vm.NewContract(caller, common.HexToAddress(ynxprotocol.PrecompileAddress), uint256.NewInt(0), 10_000_000, nil)
+		contract.Input = input
+		out, err := pc.Execute(ctx, stateDB, txOrigin, contract, true)
+		require.NoError(t, err)
+		decoded, err := method.Outputs.Unpack(out)
+		require.NoError(t, err)
+		return decoded
+	}
+
+	require.Equal(t, []interface{}{true, "domain_inbox", [32]byte(codeHash)}, query(inbox))
+	require.Equal(t, []interface{}{false, "", [32]byte{}}, query(caller))
+
+	// Code that differs from the attested code is not official.
+	tampered := setCode(inbox, []byte{0x60, 0x01})
+	require.Equal(t, []interface{}{false, "domain_inbox", [32]byte(tampered)}, query(inbox))
+}
+
+func TestGetSystemContract(t *testing.T) {
+	app := ynx.NewApp(
+		log.NewNopLogger(),
+		dbm.NewMemDB(),
+		nil,
+		true,
+		simtestutil.EmptyAppOptions{},
+	)
+
+	ctx := app.BaseApp.NewUncachedContext(false, cmtproto.Header{
+		ChainID: "ynx_test-1",
+		Height:  1,
+		Time:    time.Unix(1, 0).UTC(),
+	})
+
+	timelock := common.HexToAddress("0x00000000000000000000000000000000000000AA")
+	oracle := common.HexToAddress("0x7777777777777777777777777777777777777777")
+	require.NoError(t, app.YNXKeeper.SystemContracts.Set(ctx, ynxtypes.SystemContracts{
+		Contracts: []ynxtypes.SystemContractEntry{
+			{Name: "oracle", Address: oracle.Hex()},
+			{Name: "timelock", Address: timelock.Hex()},
+		},
+	}))
+
+	pc := ynxprotocol.NewPrecompile(app.YNXKeeper)
+	stateDB := statedb.New(ctx, app.EVMKeeper, statedb.NewEmptyTxConfig())
+	caller := common.HexToAddress("0x00000000000000000000000000000000000000BB")
+	call := func(method string, args ...interface{}) []interface{} {
+		input, err := ynxprotocol.ABI.Pack(method, args...)
+		require.NoError(t, err)
+		contract := vm.NewContract(caller, common.HexToAddress(ynxprotocol.PrecompileAddress), uint256.NewInt(0), 10_000_000, nil)
+		contract.Input = input
+		out, err := pc.Execute(ctx, stateDB, txOrigin, contract, true)
+		require.NoError(t, err)
+		decoded, err := ynxprotocol.ABI.Methods[method].Outputs.Unpack(out)
+		require.NoError(t, err)
+		return decoded
+	}
+
+	require.Equal(t, []interface{}{oracle}, call(ynxprotocol.GetSystemContractMethod, "oracle"))
+	require.Equal(t, []interface{}{common.Address{}}, call(ynxprotocol.GetSystemContractMethod, "bridge"))
+
+	// getSystemContracts keeps returning the v0 entries in their fixed order.
+	legacy := call(ynxprotocol.GetSystemContractsMethod)
+	require.Len(t, legacy, len(ynxtypes.LegacySystemContractNames))
+	require.Equal(t, common.Address{}, legacy[0])
+	require.Equal(t, timelock, legacy[1])
+}
+
+func TestRevenueAndProvisionReads(t *testing.T) {
+	app := ynx.NewApp(
+		log.NewNopLogger(),
+		dbm.NewMemDB(),
+		nil,
+		true,
+		simtestutil.EmptyAppOptions{},
+	)
+
+	ctx := app.BaseApp.NewUncachedContext(false, cmtproto.Header{
+		ChainID: "ynx_test-1",
+		Height:  1,
+		Time:    time.Unix(1, 0).UTC(),
+	})
+
+	params := ynxtypes.DefaultParams()
+	params.TreasuryAddress = sdk.AccAddress(common.HexToAddress("0x2222222222222222222222222222222222222222").Bytes()).String()
+	params.FeeBurnBps = 1_000
+	params.FeeTreasuryBps = 2_000
+	params.FeeFounderBps = 500
+	require.NoError(t, params.Validate())
+	require.NoError(t, app.YNXKeeper.Params.Set(ctx, params))
+
+	mintParams := minttypes.DefaultParams()
+	mintParams.MintDenom = ynxconfig.BaseDenom
+	mintParams.BlocksPerYear = 1_000
+	require.NoError(t, app.MintKeeper.Params.Set(ctx, mintParams))
+	minter := minttypes.DefaultInitialMinter()
+	minter.AnnualProvisions = sdkmath.LegacyNewDec(5_000_000)
+	require.NoError(t, app.MintKeeper.Minter.Set(ctx, minter))
+
+	revenue := ynxtypes.NewRevenueRecord(ynxconfig.BaseDenom)
+	revenue.FeeBurned = sdkmath.NewInt(7)
+	revenue.InflationTreasury = sdkmath.NewInt(9)
+	require.NoError(t, app.YNXKeeper.Revenue.Set(ctx, ynxconfig.BaseDenom, revenue))
+
+	pc := ynxprotocol.NewPrecompile(app.YNXKeeper)
+	stateDB := statedb.New(ctx, app.EVMKeeper, statedb.NewEmptyTxConfig())
+	call := func(method string, args ...interface{}) []interface{} {
+		input, err := ynxprotocol.ABI.Pack(method, args...)
+		require.NoError(t, err)
+		contract := vm.NewContract(common.Address{}, common.HexToAddress(ynxprotocol.PrecompileAddress), uint256.NewInt(0), 10_000_000, nil)
+		contract.Input = input
+
+		out, err := pc.Execute(ctx, stateDB, txOrigin, contract, true)
+		require.NoError(t, err)
+		values, err := ynxprotocol.ABI.Methods[method].Outputs.Unpack(out)
+		require.NoError(t, err)
+		return values
+	}
+
+	var totals struct {
+		FeeBurned           *big.Int
+		FeeTreasury         *big.Int
+		FeeFounder          *big.Int
+		FeeValidators       *big.Int
+		FeeDevelopers       *big.Int
+		InflationTreasury   *big.Int
+		InflationValidators *big.Int
+		InflationRecipients *big.Int
+	}
+	abi.ConvertType(call(ynxprotocol.GetRevenueMethod, ynxconfig.BaseDenom)[0], &totals)
+	require.Equal(t, big.NewInt(7), totals.FeeBurned)
+	require.Equal(t, big.NewInt(9), totals.InflationTreasury)
+	require.Equal(t, big.NewInt(0), totals.FeeValidators)
+
+	// Without a founder address the founder share stays with validators.
+	split := call(ynxprotocol.PreviewFeeSplitMethod, ynxconfig.BaseDenom, big.NewInt(10_000))
+	require.Equal(t, []interface{}{big.NewInt(1_000), big.NewInt(2_000), big.NewInt(0), big.NewInt(7_000)}, split)
+
+	provision := call(ynxprotocol.GetBlockProvisionMethod)
+	require.Equal(t, []interface{}{ynxconfig.BaseDenom, big.NewInt(5_000)}, provision)
+
+	// Reads leave no logs.
+	require.Empty(t, stateDB.Logs())
+}
+
+func TestSetPreconfirmSigners_AuthorizedByTimelock(t *testing.T) {
+	app := ynx.NewApp(
+		log.NewNopLogger(),
+		dbm.NewMemDB(),
+		nil,
+		true,
+		simtestutil.EmptyAppOptions{},
+	)
+
+	ctx := app.BaseApp.NewUncachedContext(false, cmtproto.Header{
+		ChainID: "ynx_test-1",
+		Height:  1,
+		Time:    time.Unix(1, 0).UTC(),
+	})
+
+	timelock := common.HexToAddress("0x00000000000000000000000000000000000000AA")
+	require.NoError(t, app.YNXKeeper.SystemContracts.Set(ctx, ynxtypes.SystemContracts{
+		Contracts: []ynxtypes.SystemContractEntry{{Name: "timelock", Address: timelock.Hex()}},
+	}))
+	require.NoError(t, app.YNXKeeper.Params.Set(ctx, ynxtypes.DefaultParams()))
+
+	pc := ynxprotocol.NewPrecompile(app.YNXKeeper)
+	stateDB := statedb.New(ctx, app.EVMKeeper, statedb.NewEmptyTxConfig())
+	call := func(caller common.Address, readOnly bool, method string, args ...interface{}) ([]interface{}, error) {
+		input, err := ynxprotocol.ABI.Pack(method, args...)
+		require.NoError(t, err)
+		contract := vm.NewContract(caller, common.HexToAddress(ynxprotocol.PrecompileAddress), uint256.NewInt(0), 10_000_000, nil)
+		contract.Input = input
+		out, err := pc.Execute(ctx, stateDB, txOrigin, contract, readOnly)
+		if err != nil {
+			return nil, err
+		}
+		return ynxprotocol.ABI.Methods[method].Outputs.Unpack(out)
+	}
+
+	signerA := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	signerB := common.HexToAddress("0x2222222222222222222222222222222222222222")
+
+	// Nothing is registered yet.
+	out, err := call(signerA, true, ynxprotocol.GetPreconfirmSignersMethod)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{[]common.Address{}, uint32(0), uint64(0)}, out)
+
+	_, err = call(signerA, false, ynxprotocol.SetPreconfirmSignersMethod, []common.Address{signerA}, uint32(1), uint64(0))
+	require.ErrorContains(t, err, "unauthorized caller")
+	_, err = call(timelock, false, ynxprotocol.SetPreconfirmSignersMethod, []common.Address{signerA}, uint32(2), uint64(0))
+	require.ErrorContains(t, err, "threshold")
+
+	out, err = call(timelock, false, ynxprotocol.SetPreconfirmSignersMethod, []common.Address{signerA, signerB}, uint32(2), uint64(0))
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{true}, out)
+
+	out, err = call(signerA, true, ynxprotocol.GetPreconfirmSignersMethod)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{[]common.Address{signerA, signerB}, uint32(2), uint64(0)}, out)
+	for _, tc := range []struct {
+		account    common.Address
+		registered bool
+	}{
+		{signerA, true},
+		{signerB, true},
+		{timelock, false},
+	} {
+		out, err := call(signerA, true, ynxprotocol.IsPreconfirmSignerMethod, tc.account)
+		require.NoError(t, err)
+		require.Equal(t, []interface{}{tc.registered}, out, tc.account.Hex())
+	}
+}
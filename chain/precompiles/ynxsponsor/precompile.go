@@ -0,0 +1,363 @@
+package ynxsponsor
+
+import (
+	"embed"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ynxkeeper "github.com/JiahaoAlbus/YNX/chain/x/ynx/keeper"
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+const (
+	PrecompileAddress = "0x0000000000000000000000000000000000000811"
+
+	GetSponsorshipMethod    = "getSponsorship"
+	CreateSponsorshipMethod = "createSponsorship"
+	UpdateSponsorshipMethod = "updateSponsorship"
+	FundSponsorshipMethod   = "fundSponsorship"
+	CloseSponsorshipMethod  = "closeSponsorship"
+
+	GetSponsoredMsgTypesMethod = "getSponsoredMsgTypes"
+	SetSponsoredMsgTypesMethod = "setSponsoredMsgTypes"
+)
+
+var (
+	//go:embed abi.json
+	f   embed.FS
+	ABI abi.ABI
+)
+
+func init() {
+	var err error
+	ABI, err = cmn.LoadABI(f, "abi.json")
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Precompile lets accounts and contracts manage gas sponsorships from the EVM.
+//
+// Security model:
+// - msg.sender is the sponsor: createSponsorship and fundSponsorship move its own balance into the sponsorship pool.
+// - updateSponsorship, setSponsoredMsgTypes, fundSponsorship and closeSponsorship are restricted to the sponsor.
+// - closeSponsorship returns the remaining budget to the sponsor only.
+// - reads are permissionless.
+type Precompile struct {
+	cmn.Precompile
+
+	abi.ABI
+	ynxKeeper ynxkeeper.Keeper
+}
+
+func NewPrecompile(ynxKeeper ynxkeeper.Keeper, bankKeeper cmn.BankKeeper) *Precompile {
+	return &Precompile{
+		Precompile: cmn.Precompile{
+			KvGasConfig:           storetypes.KVGasConfig(),
+			TransientKVGasConfig:  storetypes.TransientGasConfig(),
+			ContractAddress:       common.HexToAddress(PrecompileAddress),
+			BalanceHandlerFactory: cmn.NewBalanceHandlerFactory(bankKeeper),
+		},
+		ABI:       ABI,
+		ynxKeeper: ynxKeeper,
+	}
+}
+
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	if len(input) < 4 {
+		return 0
+	}
+	methodID := input[:4]
+
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		return 0
+	}
+
+	return p.Precompile.RequiredGas(input, p.IsTransaction(method))
+}
+
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error) {
+	return p.RunNativeAction(evm, contract, func(ctx sdk.Context) ([]byte, error) {
+		return p.Execute(ctx, contract, readonly)
+	})
+}
+
+func (p Precompile) Execute(ctx sdk.Context, contract *vm.Contract, readOnly bool) ([]byte, error) {
+	method, args, err := cmn.SetupABI(p.ABI, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	switch method.Name {
+	case GetSponsorshipMethod:
+		return p.getSponsorship(ctx, method, args)
+	case CreateSponsorshipMethod:
+		return p.createSponsorship(ctx, contract, method, args)
+	case UpdateSponsorshipMethod:
+		return p.updateSponsorship(ctx, contract, method, args)
+	case FundSponsorshipMethod:
+		return p.fundSponsorship(ctx, contract, method, args)
+	case CloseSponsorshipMethod:
+		return p.closeSponsorship(ctx, contract, method, args)
+	case GetSponsoredMsgTypesMethod:
+		return p.getSponsoredMsgTypes(ctx, method, args)
+	case SetSponsoredMsgTypesMethod:
+		return p.setSponsoredMsgTypes(ctx, contract, method, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+}
+
+func (Precompile) IsTransaction(method *abi.Method) bool {
+	switch method.Name {
+	case CreateSponsorshipMethod, UpdateSponsorshipMethod, FundSponsorshipMethod, CloseSponsorshipMethod, SetSponsoredMsgTypesMethod:
+		return true
+	default:
+		return false
+	}
+}
+
+func (p Precompile) getSponsorship(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("invalid args length: got %d, expected 1", len(args))
+	}
+
+	id, ok := args[0].(uint64)
+	if !ok {
+		return nil, fmt.Errorf("unexpected id type: %T", args[0])
+	}
+
+	sp, found, err := p.ynxKeeper.GetSponsorship(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return method.Outputs.Pack(common.Address{}, []common.Address{}, uint64(0), new(big.Int), int64(0), new(big.Int), new(big.Int))
+	}
+
+	sponsor, err := sdk.AccAddressFromBech32(sp.Sponsor)
+	if err != nil {
+		return nil, err
+	}
+	contracts := make([]common.Address, len(sp.Policy.AllowedContracts))
+	for i, addr := range sp.Policy.AllowedContracts {
+		contracts[i] = common.HexToAddress(addr)
+	}
+
+	// spent_today only counts towards the daily cap on the day it was recorded.
+	spentToday := sdkmath.ZeroInt()
+	if now := ctx.BlockTime().Unix(); now >= 0 && sp.Day == uint64(now)/ynxtypes.SecondsPerDay {
+		spentToday = sp.SpentToday
+	}
+
+	return method.Outputs.Pack(
+		common.BytesToAddress(sponsor),
+		contracts,
+		sp.Policy.MaxGasPerTx,
+		sp.Policy.DailyCap.BigInt(),
+		sp.Policy.ExpiresAt,
+		sp.Budget.BigInt(),
+		spentToday.BigInt(),
+	)
+}
+
+func (p Precompile) createSponsorship(ctx sdk.Context, contract *vm.Contract, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 5 {
+		return nil, fmt.Errorf("invalid args length: got %d, expected 5", len(args))
+	}
+
+	policy, err := policyFromArgs(args[:4])
+	if err != nil {
+		return nil, err
+	}
+	budget, err := asInt(args[4])
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := p.ynxKeeper.CreateSponsorship(ctx, sdk.AccAddress(contract.Caller().Bytes()), policy, budget)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(id)
+}
+
+func (p Precompile) updateSponsorship(ctx sdk.Context, contract *vm.Contract, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 5 {
+		return nil, fmt.Errorf("invalid args length: got %d, expected 5", len(args))
+	}
+
+	id, ok := args[0].(uint64)
+	if !ok {
+		return nil, fmt.Errorf("unexpected id type: %T", args[0])
+	}
+	policy, err := policyFromArgs(args[1:])
+	if err != nil {
+		return nil, err
+	}
+
+	// The allowed message types are set by setSponsoredMsgTypes and kept here.
+	sp, found, err := p.ynxKeeper.GetSponsorship(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		policy.AllowedMsgTypeUrls = sp.Policy.AllowedMsgTypeUrls
+	}
+
+	if err := p.ynxKeeper.UpdateSponsorship(ctx, sdk.AccAddress(contract.Caller().Bytes()), id, policy); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+func (p Precompile) fundSponsorship(ctx sdk.Context, contract *vm.Contract, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf("invalid args length: got %d, expected 2", len(args))
+	}
+
+	id, ok := args[0].(uint64)
+	if !ok {
+		return nil, fmt.Errorf("unexpected id type: %T", args[0])
+	}
+	amount, err := asInt(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.ynxKeeper.FundSponsorship(ctx, sdk.AccAddress(contract.Caller().Bytes()), id, amount); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+func (p Precompile) closeSponsorship(ctx sdk.Context, contract *vm.Contract, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("invalid args length: got %d, expected 1", len(args))
+	}
+
+	id, ok := args[0].(uint64)
+	if !ok {
+		return nil, fmt.Errorf("unexpected id type: %T", args[0])
+	}
+
+	refund, err := p.ynxKeeper.CloseSponsorship(ctx, sdk.AccAddress(contract.Caller().Bytes()), id)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(refund.BigInt())
+}
+
+func (p Precompile) getSponsoredMsgTypes(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("invalid args length: got %d, expected 1", len(args))
+	}
+
+	id, ok := args[0].(uint64)
+	if !ok {
+		return nil, fmt.Errorf("unexpected id type: %T", args[0])
+	}
+
+	sp, _, err := p.ynxKeeper.GetSponsorship(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	msgTypeURLs := sp.Policy.AllowedMsgTypeUrls
+	if msgTypeURLs == nil {
+		msgTypeURLs = []string{}
+	}
+
+	return method.Outputs.Pack(msgTypeURLs)
+}
+
+func (p Precompile) setSponsoredMsgTypes(ctx sdk.Context, contract *vm.Contract, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf("invalid args length: got %d, expected 2", len(args))
+	}
+
+	id, ok := args[0].(uint64)
+	if !ok {
+		return nil, fmt.Errorf("unexpected id type: %T", args[0])
+	}
+	msgTypeURLs, ok := args[1].([]string)
+	if !ok {
+		return nil, fmt.Errorf("unexpected msgTypeUrls type: %T", args[1])
+	}
+
+	sp, found, err := p.ynxKeeper.GetSponsorship(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("sponsorship %d does not exist", id)
+	}
+
+	policy := sp.Policy
+	policy.AllowedMsgTypeUrls = msgTypeURLs
+	if err := p.ynxKeeper.UpdateSponsorship(ctx, sdk.AccAddress(contract.Caller().Bytes()), id, policy); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// policyFromArgs decodes (allowedContracts, maxGasPerTx, dailyCap, expiresAt).
+func policyFromArgs(args []interface{}) (ynxtypes.SponsorshipPolicy, error) {
+	contracts, ok := args[0].([]common.Address)
+	if !ok {
+		return ynxtypes.SponsorshipPolicy{}, fmt.Errorf("unexpected allowedContracts type: %T", args[0])
+	}
+	maxGas, ok := args[1].(uint64)
+	if !ok {
+		return ynxtypes.SponsorshipPolicy{}, fmt.Errorf("unexpected maxGasPerTx type: %T", args[1])
+	}
+	dailyCap, err := asInt(args[2])
+	if err != nil {
+		return ynxtypes.SponsorshipPolicy{}, err
+	}
+	expiresAt, ok := args[3].(int64)
+	if !ok {
+		return ynxtypes.SponsorshipPolicy{}, fmt.Errorf("unexpected expiresAt type: %T", args[3])
+	}
+
+	allowed := make([]string, len(contracts))
+	for i, c := range contracts {
+		allowed[i] = c.Hex()
+	}
+
+	return ynxtypes.SponsorshipPolicy{
+		AllowedContracts: allowed,
+		MaxGasPerTx:      maxGas,
+		DailyCap:         dailyCap,
+		ExpiresAt:        expiresAt,
+	}, nil
+}
+
+func asInt(v interface{}) (sdkmath.Int, error) {
+	t, ok := v.(*big.Int)
+	if !ok || t == nil {
+		return sdkmath.Int{}, fmt.Errorf("unexpected uint256 type: %T", v)
+	}
+	if t.BitLen() > sdkmath.MaxBitLen || t.Sign() < 0 {
+		return sdkmath.Int{}, fmt.Errorf("uint256 out of range: %s", t.String())
+	}
+	return sdkmath.NewIntFromBigInt(t), nil
+}
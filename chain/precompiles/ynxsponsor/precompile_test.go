@@ -0,0 +1,145 @@
+package ynxsponsor_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v2"
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	ynx "github.com/JiahaoAlbus/YNX/chain"
+	ynxconfig "github.com/JiahaoAlbus/YNX/chain/config"
+	"github.com/JiahaoAlbus/YNX/chain/precompiles/ynxsponsor"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+func init() {
+	cfg := sdk.GetConfig()
+	ynxconfig.SetBech32Prefixes(cfg)
+	ynxconfig.SetBip44CoinType(cfg)
+	ynxconfig.RegisterDenoms()
+	cfg.Seal()
+}
+
+func TestPrecompileRegisteredInApp(t *testing.T) {
+	app := ynx.NewApp(
+		log.NewNopLogger(),
+		dbm.NewMemDB(),
+		nil,
+		true,
+		simtestutil.EmptyAppOptions{},
+	)
+
+	params := evmtypes.DefaultParams()
+	params.ActiveStaticPrecompiles = append(params.ActiveStaticPrecompiles, ynxsponsor.PrecompileAddress)
+
+	pc, ok, err := app.EVMKeeper.GetStaticPrecompileInstance(&params, common.HexToAddress(ynxsponsor.PrecompileAddress))
+	require.NoError(t, err)
+	require.True(t, ok)
+	_, is := pc.(*ynxsponsor.Precompile)
+	require.True(t, is)
+}
+
+func TestSponsorshipLifecycle(t *testing.T) {
+	app := ynx.NewApp(
+		log.NewNopLogger(),
+		dbm.NewMemDB(),
+		nil,
+		true,
+		simtestutil.EmptyAppOptions{},
+	)
+
+	ctx := app.BaseApp.NewUncachedContext(false, cmtproto.Header{
+		ChainID: "ynx_test-1",
+		Height:  1,
+		Time:    time.Unix(1, 0).UTC(),
+	})
+
+	sponsor := common.HexToAddress("0x6666666666666666666666666666666666666666")
+	dapp := common.HexToAddress("0x7777777777777777777777777777777777777777")
+	denom := evmtypes.GetEVMCoinDenom()
+
+	coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(5_000)))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, sdk.AccAddress(sponsor.Bytes()), coins))
+
+	pc := ynxsponsor.NewPrecompile(app.YNXKeeper, app.BankKeeper)
+	contract := vm.NewContract(sponsor, common.HexToAddress(ynxsponsor.PrecompileAddress), uint256.NewInt(0), 10_000_000, nil)
+
+	input, err := ynxsponsor.ABI.Pack(ynxsponsor.CreateSponsorshipMethod, []common.Address{dapp}, uint64(200_000), big.NewInt(1_000), int64(0), big.NewInt(3_000))
+	require.NoError(t, err)
+	contract.Input = input
+
+	out, err := pc.Execute(ctx, contract, false)
+	require.NoError(t, err)
+	decoded, err := ynxsponsor.ABI.Methods[ynxsponsor.CreateSponsorshipMethod].Outputs.Unpack(out)
+	require.NoError(t, err)
+	id := decoded[0].(uint64)
+	require.Equal(t, uint64(1), id)
+
+	input, err = ynxsponsor.ABI.Pack(ynxsponsor.GetSponsorshipMethod, id)
+	require.NoError(t, err)
+	contract.Input = input
+
+	out, err = pc.Execute(ctx, contract, true)
+	require.NoError(t, err)
+	decoded, err = ynxsponsor.ABI.Methods[ynxsponsor.GetSponsorshipMethod].Outputs.Unpack(out)
+	require.NoError(t, err)
+	require.Equal(t, sponsor, decoded[0])
+	require.Equal(t, []common.Address{dapp}, decoded[1])
+	require.Equal(t, uint64(200_000), decoded[2])
+	require.Equal(t, big.NewInt(3_000), decoded[5])
+
+	// The sponsor scopes the sponsorship to Cosmos bank sends; updating the policy keeps them.
+	msgSend := "/cosmos.bank.v1beta1.MsgSend"
+	input, err = ynxsponsor.ABI.Pack(ynxsponsor.SetSponsoredMsgTypesMethod, id, []string{msgSend})
+	require.NoError(t, err)
+	contract.Input = input
+	_, err = pc.Execute(ctx, contract, false)
+	require.NoError(t, err)
+
+	input, err = ynxsponsor.ABI.Pack(ynxsponsor.UpdateSponsorshipMethod, id, []common.Address{dapp}, uint64(100_000), big.NewInt(1_000), int64(0))
+	require.NoError(t, err)
+	contract.Input = input
+	_, err = pc.Execute(ctx, contract, false)
+	require.NoError(t, err)
+
+	input, err = ynxsponsor.ABI.Pack(ynxsponsor.GetSponsoredMsgTypesMethod, id)
+	require.NoError(t, err)
+	contract.Input = input
+	out, err = pc.Execute(ctx, contract, true)
+	require.NoError(t, err)
+	decoded, err = ynxsponsor.ABI.Methods[ynxsponsor.GetSponsoredMsgTypesMethod].Outputs.Unpack(out)
+	require.NoError(t, err)
+	require.Equal(t, []string{msgSend}, decoded[0])
+
+	// Another caller cannot close the sponsorship.
+	input, err = ynxsponsor.ABI.Pack(ynxsponsor.CloseSponsorshipMethod, id)
+	require.NoError(t, err)
+	other := vm.NewContract(dapp, common.HexToAddress(ynxsponsor.PrecompileAddress), uint256.NewInt(0), 10_000_000, nil)
+	other.Input = input
+	_, err = pc.Execute(ctx, other, false)
+	require.Error(t, err)
+
+	contract.Input = input
+	out, err = pc.Execute(ctx, contract, false)
+	require.NoError(t, err)
+	decoded, err = ynxsponsor.ABI.Methods[ynxsponsor.CloseSponsorshipMethod].Outputs.Unpack(out)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(3_000), decoded[0])
+	require.Equal(t, sdkmath.NewInt(5_000), app.BankKeeper.GetBalance(ctx, sdk.AccAddress(sponsor.Bytes()), denom).Amount)
+}
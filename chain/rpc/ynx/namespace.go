@@ -34,10 +34,8 @@ func createAPIs(
 
 	enabled := strings.EqualFold(os.Getenv("YNX_PRECONFIRM_ENABLED"), "true") || os.Getenv("YNX_PRECONFIRM_ENABLED") == "1"
 	if enabled {
-		if signers, threshold, err := LoadPreconfirmSignersFromEnv(); err == nil {
-			if err := api.SetPreconfirmSigners(signers, threshold); err != nil {
-				ctx.Logger.Error("failed to set preconfirm signers", "err", err)
-			}
+		if signers, err := LoadPreconfirmSignersFromEnv(); err == nil {
+			api.SetPreconfirmSigners(signers)
 		} else {
 			ctx.Logger.Error("failed to load preconfirm signers", "err", err)
 		}
@@ -1,6 +1,7 @@
 package ynx
 
 import (
+	"context"
 	"crypto/ecdsa"
 	"encoding/binary"
 	"encoding/hex"
@@ -23,6 +24,8 @@ import (
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	"cosmossdk.io/log"
+
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
 )
 
 const txConfirmDigestPrefix = "YNX_TXCONFIRM_V0"
@@ -40,6 +43,8 @@ type PreconfirmReceipt struct {
 	Signers     []common.Address `json:"signers,omitempty"`
 	Signatures  []hexutil.Bytes  `json:"signatures,omitempty"`
 	Threshold   uint32           `json:"threshold,omitempty"`
+	// SignerSetEpoch is the activation epoch of the on-chain signer set the signers belong to.
+	SignerSetEpoch hexutil.Uint64 `json:"signerSetEpoch"`
 }
 
 type PreconfirmSigner struct {
@@ -57,7 +62,7 @@ func LoadPreconfirmSignerFromEnv() (*PreconfirmSigner, error) {
 	return nil, fmt.Errorf("missing YNX_PRECONFIRM_PRIVKEY_HEX or YNX_PRECONFIRM_KEY_PATH")
 }
 
-func LoadPreconfirmSignersFromEnv() ([]*PreconfirmSigner, uint32, error) {
+func LoadPreconfirmSignersFromEnv() ([]*PreconfirmSigner, error) {
 	var signers []*PreconfirmSigner
 
 	if v := strings.TrimSpace(os.Getenv("YNX_PRECONFIRM_PRIVKEY_HEXES")); v != "" {
@@ -65,7 +70,7 @@ func LoadPreconfirmSignersFromEnv() ([]*PreconfirmSigner, uint32, error) {
 		for _, hexKey := range hexes {
 			signer, err := LoadPreconfirmSignerFromHex(hexKey)
 			if err != nil {
-				return nil, 0, err
+				return nil, err
 			}
 			signers = append(signers, signer)
 		}
@@ -74,35 +79,23 @@ func LoadPreconfirmSignersFromEnv() ([]*PreconfirmSigner, uint32, error) {
 		for _, p := range paths {
 			signer, err := LoadPreconfirmSignerFromFile(p)
 			if err != nil {
-				return nil, 0, err
+				return nil, err
 			}
 			signers = append(signers, signer)
 		}
 	} else {
 		signer, err := LoadPreconfirmSignerFromEnv()
 		if err != nil {
-			return nil, 0, err
+			return nil, err
 		}
 		signers = append(signers, signer)
 	}
 
 	if len(signers) == 0 {
-		return nil, 0, fmt.Errorf("no preconfirm signers configured")
+		return nil, fmt.Errorf("no preconfirm signers configured")
 	}
 
-	threshold := uint32(len(signers))
-	if v := strings.TrimSpace(os.Getenv("YNX_PRECONFIRM_THRESHOLD")); v != "" {
-		parsed, err := strconv.Atoi(v)
-		if err != nil || parsed <= 0 {
-			return nil, 0, fmt.Errorf("invalid YNX_PRECONFIRM_THRESHOLD: %q", v)
-		}
-		if parsed > len(signers) {
-			return nil, 0, fmt.Errorf("YNX_PRECONFIRM_THRESHOLD=%d exceeds signer count=%d", parsed, len(signers))
-		}
-		threshold = uint32(parsed)
-	}
-
-	return signers, threshold, nil
+	return signers, nil
 }
 
 func LoadPreconfirmSignerFromFile(path string) (*PreconfirmSigner, error) {
@@ -143,45 +136,50 @@ func (s *PreconfirmSigner) SignDigest(digest common.Hash) ([]byte, error) {
 	return crypto.Sign(digest.Bytes(), s.privKey)
 }
 
+// PreconfirmSignerRegistry returns the preconfirm signer set registered in x/ynx for the current
+// epoch, or nil if there is none.
+type PreconfirmSignerRegistry func(ctx context.Context) (*ynxtypes.PreconfirmSignerSet, error)
+
 type PublicAPI struct {
-	logger    log.Logger
-	backend   *backend.Backend
-	signers   []*PreconfirmSigner
-	threshold uint32
+	logger   log.Logger
+	backend  *backend.Backend
+	signers  []*PreconfirmSigner
+	registry PreconfirmSignerRegistry
 }
 
 func NewPublicAPI(logger log.Logger, backend *backend.Backend) *PublicAPI {
-	return &PublicAPI{
+	api := &PublicAPI{
 		logger:  logger.With(log.ModuleKey, "rpc.ynx"),
 		backend: backend,
 	}
+	if backend != nil {
+		api.registry = func(ctx context.Context) (*ynxtypes.PreconfirmSignerSet, error) {
+			res, err := ynxtypes.NewQueryClient(backend.ClientCtx).PreconfirmSigners(ctx, &ynxtypes.QueryPreconfirmSignersRequest{})
+			if err != nil {
+				return nil, err
+			}
+			return res.Active, nil
+		}
+	}
+	return api
 }
 
 func (api *PublicAPI) SetPreconfirmSigner(signer *PreconfirmSigner) {
 	if signer == nil {
 		api.signers = nil
-		api.threshold = 0
 		return
 	}
 	api.signers = []*PreconfirmSigner{signer}
-	api.threshold = 1
 }
 
-func (api *PublicAPI) SetPreconfirmSigners(signers []*PreconfirmSigner, threshold uint32) error {
+// SetPreconfirmSigners sets the keys receipts are signed with. Receipts carry the threshold of the
+// signer set registered on chain.
+func (api *PublicAPI) SetPreconfirmSigners(signers []*PreconfirmSigner) {
 	if len(signers) == 0 {
 		api.signers = nil
-		api.threshold = 0
-		return nil
-	}
-	if threshold == 0 {
-		threshold = uint32(len(signers))
-	}
-	if int(threshold) > len(signers) {
-		return fmt.Errorf("threshold=%d exceeds signer count=%d", threshold, len(signers))
+		return
 	}
 	api.signers = signers
-	api.threshold = threshold
-	return nil
 }
 
 func (api *PublicAPI) PreconfirmTx(txHash common.Hash) (*PreconfirmReceipt, error) {
@@ -192,6 +190,11 @@ func (api *PublicAPI) PreconfirmTx(txHash common.Hash) (*PreconfirmReceipt, erro
 		return nil, fmt.Errorf("backend is not available")
 	}
 
+	signerSet, registered, err := api.registeredSigners(api.backend.Ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	head, err := api.backend.BlockNumber()
 	if err != nil {
 		return nil, err
@@ -217,9 +220,9 @@ func (api *PublicAPI) PreconfirmTx(txHash common.Hash) (*PreconfirmReceipt, erro
 	evmChainIDHex := (*hexutil.Big)(new(big.Int).Set(api.backend.EvmChainID))
 	digest := txConfirmDigest(api.backend.ClientCtx.ChainID, api.backend.EvmChainID, txHash, status, targetBlock, issuedAt)
 
-	signers := make([]common.Address, 0, len(api.signers))
-	signatures := make([]hexutil.Bytes, 0, len(api.signers))
-	for _, signer := range api.signers {
+	signers := make([]common.Address, 0, len(registered))
+	signatures := make([]hexutil.Bytes, 0, len(registered))
+	for _, signer := range registered {
 		sig, err := signer.SignDigest(digest)
 		if err != nil {
 			return nil, err
@@ -240,10 +243,45 @@ func (api *PublicAPI) PreconfirmTx(txHash common.Hash) (*PreconfirmReceipt, erro
 		Signature:   signatures[0],
 		Signers:     signers,
 		Signatures:  signatures,
-		Threshold:   api.threshold,
+		Threshold:   signerSet.Threshold,
+
+		SignerSetEpoch: hexutil.Uint64(signerSet.ActivationEpoch),
 	}, nil
 }
 
+// registeredSigners returns the signer set registered on chain and the configured signers that
+// belong to it. Receipts are only signed with registered keys, so that clients can check them
+// against the on-chain set; the other keys are skipped. It fails if the registered keys cannot
+// meet the threshold of the set, since receipts with fewer signatures never verify.
+func (api *PublicAPI) registeredSigners(ctx context.Context) (ynxtypes.PreconfirmSignerSet, []*PreconfirmSigner, error) {
+	if api.registry == nil {
+		return ynxtypes.PreconfirmSignerSet{}, nil, fmt.Errorf("preconfirm signer registry is not available")
+	}
+	set, err := api.registry(ctx)
+	if err != nil {
+		return ynxtypes.PreconfirmSignerSet{}, nil, fmt.Errorf("failed to query preconfirm signers: %w", err)
+	}
+	if set == nil || len(set.Signers) == 0 {
+		return ynxtypes.PreconfirmSignerSet{}, nil, fmt.Errorf("no preconfirm signers are registered on chain")
+	}
+
+	registered := make([]*PreconfirmSigner, 0, len(api.signers))
+	for _, signer := range api.signers {
+		if !set.HasSigner(signer.Address()) {
+			api.logger.Debug("skipping unregistered preconfirm signer", "signer", signer.Address().Hex(), "epoch", set.ActivationEpoch)
+			continue
+		}
+		registered = append(registered, signer)
+	}
+	if len(registered) == 0 {
+		return ynxtypes.PreconfirmSignerSet{}, nil, fmt.Errorf("none of the configured preconfirm signers is registered in epoch %d", set.ActivationEpoch)
+	}
+	if len(registered) < int(set.Threshold) {
+		return ynxtypes.PreconfirmSignerSet{}, nil, fmt.Errorf("%d of the configured preconfirm signers are registered in epoch %d, below its threshold %d", len(registered), set.ActivationEpoch, set.Threshold)
+	}
+	return *set, registered, nil
+}
+
 func (api *PublicAPI) isPendingEthereumTx(txHash common.Hash) (bool, error) {
 	if api.backend == nil || api.backend.ClientCtx.Client == nil {
 		return false, fmt.Errorf("rpc client is not available")
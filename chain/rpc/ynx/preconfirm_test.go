@@ -1,11 +1,16 @@
 package ynx
 
 import (
+	"context"
 	"math/big"
 	"path/filepath"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/log"
+
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
 )
 
 func TestLoadPreconfirmSignerFromHex(t *testing.T) {
@@ -23,27 +28,23 @@ func TestLoadPreconfirmSignerFromHex(t *testing.T) {
 	}
 }
 
-func TestLoadPreconfirmSignersFromEnvThresholdValidation(t *testing.T) {
+func TestLoadPreconfirmSignersFromEnv(t *testing.T) {
 	t.Setenv("YNX_PRECONFIRM_PRIVKEY_HEXES", stringsJoin(
 		"4c0883a6910395b37d6231471b5dbb6204fe5129617082790f5b0f1b2f6b0f62",
 		"8f2a5594909a1f9d4b3e7c3dbf949015135c8db05d4953ea05559cc49aa3be53",
 	))
-	t.Setenv("YNX_PRECONFIRM_THRESHOLD", "2")
 
-	signers, threshold, err := LoadPreconfirmSignersFromEnv()
+	signers, err := LoadPreconfirmSignersFromEnv()
 	if err != nil {
 		t.Fatalf("expected env signer load to succeed, got error: %v", err)
 	}
 	if len(signers) != 2 {
 		t.Fatalf("expected 2 signers, got %d", len(signers))
 	}
-	if threshold != 2 {
-		t.Fatalf("expected threshold 2, got %d", threshold)
-	}
 
-	t.Setenv("YNX_PRECONFIRM_THRESHOLD", "3")
-	if _, _, err := LoadPreconfirmSignersFromEnv(); err == nil {
-		t.Fatal("expected threshold validation error")
+	t.Setenv("YNX_PRECONFIRM_PRIVKEY_HEXES", "not-hex")
+	if _, err := LoadPreconfirmSignersFromEnv(); err == nil {
+		t.Fatal("expected an invalid key to fail")
 	}
 }
 
@@ -92,6 +93,60 @@ func TestWritePreconfirmKeyFileNoOverwrite(t *testing.T) {
 	}
 }
 
+func TestRegisteredSignersOnlyUsesRegisteredKeys(t *testing.T) {
+	t.Parallel()
+
+	signerA, err := LoadPreconfirmSignerFromHex("4c0883a6910395b37d6231471b5dbb6204fe5129617082790f5b0f1b2f6b0f62")
+	if err != nil {
+		t.Fatal(err)
+	}
+	signerB, err := LoadPreconfirmSignerFromHex("8f2a5594909a1f9d4b3e7c3dbf949015135c8db05d4953ea05559cc49aa3be53")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var active *ynxtypes.PreconfirmSignerSet
+	api := NewPublicAPI(log.NewNopLogger(), nil)
+	api.registry = func(context.Context) (*ynxtypes.PreconfirmSignerSet, error) { return active, nil }
+	api.SetPreconfirmSigners([]*PreconfirmSigner{signerA, signerB})
+
+	if _, _, err := api.registeredSigners(context.Background()); err == nil {
+		t.Fatal("expected signing to be refused without a registered signer set")
+	}
+
+	active = &ynxtypes.PreconfirmSignerSet{ActivationEpoch: 4, Signers: []string{signerB.Address().Hex()}, Threshold: 1}
+	set, registered, err := api.registeredSigners(context.Background())
+	if err != nil {
+		t.Fatalf("expected registered signer, got error: %v", err)
+	}
+	if len(registered) != 1 || registered[0] != signerB {
+		t.Fatalf("expected only signer B, got %d signers", len(registered))
+	}
+	if set.ActivationEpoch != 4 || set.Threshold != 1 {
+		t.Fatalf("unexpected signer set: %+v", set)
+	}
+
+	// Receipts signed by fewer registered keys than the threshold would never verify.
+	active = &ynxtypes.PreconfirmSignerSet{
+		ActivationEpoch: 4,
+		Signers:         []string{signerB.Address().Hex(), common.HexToAddress("0x1111111111111111111111111111111111111111").Hex()},
+		Threshold:       2,
+	}
+	if _, _, err := api.registeredSigners(context.Background()); err == nil {
+		t.Fatal("expected signing to be refused below the registered threshold")
+	}
+	active.Signers[1] = signerA.Address().Hex()
+	if _, registered, err := api.registeredSigners(context.Background()); err != nil || len(registered) != 2 {
+		t.Fatalf("expected both keys to sign, got %d signers and error %v", len(registered), err)
+	}
+
+	// After a rotation away from both keys the node refuses to sign.
+	active = &ynxtypes.PreconfirmSignerSet{ActivationEpoch: 5, Signers: []string{common.HexToAddress("0x1111111111111111111111111111111111111111").Hex()}, Threshold: 1}
+	if _, _, err := api.registeredSigners(context.Background()); err == nil {
+		t.Fatal("expected unregistered keys to be refused")
+	}
+}
+
 func stringsJoin(values ...string) string {
 	return values[0] + "," + values[1]
 }
@@ -63,7 +63,6 @@ DEV_PROPOSAL_THRESHOLD="${YNX_DEV_PROPOSAL_THRESHOLD:-1000000000000000000}" # 1
 DEV_PROPOSAL_DEPOSIT="${YNX_DEV_PROPOSAL_DEPOSIT:-1000000000000000000}"     # 1 NYXT (1e18)
 DEV_QUORUM_PERCENT="${YNX_DEV_QUORUM_PERCENT:-1}"
 DEV_PRECONFIRM_SIGNER_COUNT="${YNX_DEV_PRECONFIRM_SIGNER_COUNT:-1}"
-DEV_PRECONFIRM_THRESHOLD="${YNX_DEV_PRECONFIRM_THRESHOLD:-0}"
 
 BIN="$ROOT_DIR/ynxd"
 
@@ -134,7 +133,6 @@ COMMUNITY_RECIPIENT_ADDR="${YNX_COMMUNITY_RECIPIENT:-$VAL_ADDR}"
 
 PRECONFIRM_KEY_PATH="$HOME_DIR/config/ynx_preconfirm.key"
 PRECONFIRM_KEY_PATHS_CSV=""
-PRECONFIRM_THRESHOLD_VALUE=""
 
 if [[ -z "${YNX_PRECONFIRM_PRIVKEY_HEXES:-}" && -z "${YNX_PRECONFIRM_KEY_PATHS:-}" ]]; then
   if [[ "$DEV_PRECONFIRM_SIGNER_COUNT" -gt 1 ]]; then
@@ -152,11 +150,6 @@ if [[ -z "${YNX_PRECONFIRM_PRIVKEY_HEXES:-}" && -z "${YNX_PRECONFIRM_KEY_PATHS:-
         PRECONFIRM_KEY_PATHS_CSV="$PRECONFIRM_KEY_PATHS_CSV,$KEY_PATH"
       fi
     done
-
-    PRECONFIRM_THRESHOLD_VALUE="$DEV_PRECONFIRM_THRESHOLD"
-    if [[ -z "$PRECONFIRM_THRESHOLD_VALUE" || "$PRECONFIRM_THRESHOLD_VALUE" -le 0 ]]; then
-      PRECONFIRM_THRESHOLD_VALUE="$DEV_PRECONFIRM_SIGNER_COUNT"
-    fi
   else
     if [[ ! -f "$PRECONFIRM_KEY_PATH" ]]; then
       echo "Generating preconfirm signer key..."
@@ -211,7 +204,6 @@ if [[ -n "${YNX_PRECONFIRM_PRIVKEY_HEXES:-}" || -n "${YNX_PRECONFIRM_KEY_PATHS:-
   :
 elif [[ -n "$PRECONFIRM_KEY_PATHS_CSV" ]]; then
   PRECONFIRM_ENV+=(YNX_PRECONFIRM_KEY_PATHS="$PRECONFIRM_KEY_PATHS_CSV")
-  PRECONFIRM_ENV+=(YNX_PRECONFIRM_THRESHOLD="$PRECONFIRM_THRESHOLD_VALUE")
 else
   PRECONFIRM_ENV+=(YNX_PRECONFIRM_KEY_PATH="$PRECONFIRM_KEY_PATH")
 fi
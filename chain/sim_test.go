@@ -0,0 +1,174 @@
+//go:build sims
+
+package ynx
+
+import (
+	"encoding/json"
+	"math/rand"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v2"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	"github.com/cosmos/cosmos-sdk/testutil/simsx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	simcli "github.com/cosmos/cosmos-sdk/x/simulation/client/cli"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+
+	ynxconfig "github.com/JiahaoAlbus/YNX/chain/config"
+)
+
+func init() {
+	simcli.GetSimulatorFlags()
+
+	// The randomized genesis funds accounts and bonds in sdk.DefaultBondDenom, which must be the
+	// EVM gas denom for fees to reach the x/ynx fee split.
+	sdk.DefaultBondDenom = ynxconfig.BaseDenom
+	ynxconfig.SetBech32Prefixes(sdk.GetConfig())
+}
+
+// TestFullAppSimulation runs randomized operations of all modules, including x/ynx, against the
+// YNX app.
+func TestFullAppSimulation(t *testing.T) {
+	simsx.Run(t, NewApp, setupStateFactory)
+}
+
+// TestAppImportExport exports the state of a simulated app, imports it into a fresh app and
+// compares both stores.
+func TestAppImportExport(t *testing.T) {
+	simsx.Run(t, NewApp, setupStateFactory, func(tb testing.TB, ti simsx.TestInstance[*App], _ []simtypes.Account) {
+		tb.Helper()
+		app := ti.App
+
+		tb.Log("exporting genesis...")
+		exported, err := app.ExportAppStateAndValidators(false, nil, nil)
+		require.NoError(tb, err)
+
+		tb.Log("importing genesis...")
+		newApp := simsx.NewSimulationAppInstance(tb, ti.Cfg, NewApp).App
+
+		var genesisState GenesisState
+		require.NoError(tb, json.Unmarshal(exported.AppState, &genesisState))
+		ctxB := newApp.NewContextLegacy(true, cmtproto.Header{Height: app.LastBlockHeight()})
+		_, err = newApp.ModuleManager.InitGenesis(ctxB, genesisState)
+		if err != nil && strings.Contains(err.Error(), "validator set is empty after InitGenesis") {
+			tb.Skip("skipping simulation as all validators have been unbonded")
+		}
+		require.NoError(tb, err)
+		require.NoError(tb, newApp.StoreConsensusParams(ctxB, exported.ConsensusParams))
+
+		tb.Log("comparing stores...")
+		skipPrefixes := map[string][][]byte{
+			stakingtypes.StoreKey: {
+				stakingtypes.UnbondingQueueKey, stakingtypes.RedelegationQueueKey, stakingtypes.ValidatorQueueKey,
+				stakingtypes.HistoricalInfoKey, stakingtypes.UnbondingIDKey, stakingtypes.UnbondingIndexKey,
+				stakingtypes.UnbondingTypeKey, stakingtypes.ValidatorUpdatesKey,
+			},
+			authzkeeper.StoreKey:   {authzkeeper.GrantQueuePrefix},
+			feegrant.StoreKey:      {feegrant.FeeAllowanceQueueKeyPrefix},
+			slashingtypes.StoreKey: {slashingtypes.ValidatorMissedBlockBitmapKeyPrefix},
+		}
+		assertEqualStores(tb, app, newApp, app.SimulationManager().StoreDecoders, skipPrefixes)
+	})
+}
+
+// TestAppStateDeterminism runs each seed several times and checks that every run ends with the
+// same app hash.
+func TestAppStateDeterminism(t *testing.T) {
+	const numTimesToRunPerSeed = 3
+
+	var seeds []int64
+	if s := simcli.NewConfigFromFlags().Seed; s != simcli.DefaultSeedValue {
+		for j := 0; j < numTimesToRunPerSeed; j++ {
+			seeds = append(seeds, s)
+		}
+	} else {
+		for i := 0; i < 3; i++ {
+			seed := rand.Int63()
+			for j := 0; j < numTimesToRunPerSeed; j++ {
+				seeds = append(seeds, seed)
+			}
+		}
+	}
+
+	var mx sync.Mutex
+	appHashResults := make(map[int64][][]byte)
+	captureAndCheckHash := func(tb testing.TB, ti simsx.TestInstance[*App], _ []simtypes.Account) {
+		tb.Helper()
+		seed, appHash := ti.Cfg.Seed, ti.App.LastCommitID().Hash
+
+		mx.Lock()
+		otherHashes := appHashResults[seed]
+		if len(otherHashes) < numTimesToRunPerSeed-1 {
+			appHashResults[seed] = append(otherHashes, appHash)
+		} else {
+			delete(appHashResults, seed)
+		}
+		mx.Unlock()
+
+		for _, h := range otherHashes {
+			require.Equal(tb, h, appHash, "non-determinism in seed %d", seed)
+		}
+	}
+	simsx.RunWithSeeds(t, NewApp, setupStateFactory, seeds, nil, captureAndCheckHash)
+}
+
+func setupStateFactory(app *App) simsx.SimStateFactory {
+	return simsx.SimStateFactory{
+		Codec:         app.AppCodec(),
+		AppStateFn:    simtestutil.AppStateFn(app.AppCodec(), app.SimulationManager(), simGenesis(app)),
+		BlockedAddr:   BlockedAddresses(),
+		AccountSource: app.AccountKeeper,
+		BalanceSource: app.BankKeeper,
+	}
+}
+
+// simGenesis is the default genesis without an EVM base fee, so that the random fees of simulated
+// Cosmos transactions pass the fee checks of the ante handler.
+func simGenesis(app *App) map[string]json.RawMessage {
+	genesis := app.DefaultGenesis()
+
+	var feemarketGenState feemarkettypes.GenesisState
+	app.AppCodec().MustUnmarshalJSON(genesis[feemarkettypes.ModuleName], &feemarketGenState)
+	feemarketGenState.Params.NoBaseFee = true
+	feemarketGenState.Params.MinGasPrice = sdkmath.LegacyZeroDec()
+	genesis[feemarkettypes.ModuleName] = app.AppCodec().MustMarshalJSON(&feemarketGenState)
+
+	return genesis
+}
+
+func assertEqualStores(tb testing.TB, app, newApp *App, storeDecoders simtypes.StoreDecoderRegistry, skipPrefixes map[string][][]byte) {
+	tb.Helper()
+	ctxA := app.NewContextLegacy(true, cmtproto.Header{Height: app.LastBlockHeight()})
+	ctxB := newApp.NewContextLegacy(true, cmtproto.Header{Height: app.LastBlockHeight()})
+
+	require.NotEmpty(tb, app.keys)
+	for keyName, keyA := range app.keys {
+		storeA := ctxA.KVStore(keyA)
+		storeB := ctxB.KVStore(newApp.GetKey(keyName))
+
+		failedKVAs, failedKVBs := simtestutil.DiffKVStores(storeA, storeB, skipPrefixes[keyName])
+		require.Equal(tb, len(failedKVAs), len(failedKVBs), "unequal sets of key-values to compare %s", keyName)
+
+		tb.Logf("compared %d different key/value pairs in %s", len(failedKVAs), keyName)
+		if !assert.Equal(tb, 0, len(failedKVAs), simtestutil.GetSimulationLog(keyName, storeDecoders, failedKVAs, failedKVBs)) {
+			for _, v := range failedKVAs {
+				tb.Logf("store mismatch: %q", v)
+			}
+			tb.FailNow()
+		}
+	}
+}
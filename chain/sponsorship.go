@@ -0,0 +1,202 @@
+package ynx
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	ynxkeeper "github.com/JiahaoAlbus/YNX/chain/x/ynx/keeper"
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
+)
+
+// sponsoredTxKey carries the sponsorship that paid the fee of a transaction from the ante handler
+// to the post handler of the same transaction.
+type sponsoredTxKey struct{}
+
+// sponsoredTx records how much a sponsorship paid towards the fee of a transaction.
+type sponsoredTx struct {
+	id      uint64
+	sponsor sdk.AccAddress
+	sender  sdk.AccAddress
+
+	// contract is the contract called by a sponsored EVM transaction, msgTypeURLs the message
+	// types of a sponsored Cosmos transaction.
+	contract    string
+	msgTypeURLs []string
+
+	// charged is the fee deducted by the ante handler, i.e. before the EVM refunds unused gas.
+	charged sdkmath.Int
+}
+
+// sponsorableEthereumTx returns the EVM message of tx if it can be sponsored: a single call to a
+// contract that transfers no value. Sponsorships only pay fees, never the value of a call.
+func sponsorableEthereumTx(tx sdk.Tx) (*evmtypes.MsgEthereumTx, common.Address, bool) {
+	msgs := tx.GetMsgs()
+	if len(msgs) != 1 {
+		return nil, common.Address{}, false
+	}
+	ethMsg, ok := msgs[0].(*evmtypes.MsgEthereumTx)
+	if !ok || ethMsg.Raw.Transaction == nil {
+		return nil, common.Address{}, false
+	}
+
+	ethTx := ethMsg.AsTransaction()
+	to := ethTx.To()
+	if to == nil || ethTx.Value().Sign() != 0 {
+		return nil, common.Address{}, false
+	}
+	return ethMsg, *to, true
+}
+
+// sponsorableCosmosTx returns the message type URLs, gas limit and fee of tx if it is a Cosmos
+// transaction that can be sponsored: one paying its fee in the EVM denom without a fee granter.
+// Fee grants take precedence over sponsorships.
+func sponsorableCosmosTx(tx sdk.Tx) ([]string, uint64, sdkmath.Int, bool) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || len(feeTx.FeeGranter()) != 0 {
+		return nil, 0, sdkmath.Int{}, false
+	}
+	fee := feeTx.GetFee()
+	if len(fee) != 1 || fee[0].Denom != evmtypes.GetEVMCoinDenom() {
+		return nil, 0, sdkmath.Int{}, false
+	}
+
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return nil, 0, sdkmath.Int{}, false
+	}
+	typeURLs := make([]string, len(msgs))
+	for i, msg := range msgs {
+		if _, ok := msg.(*evmtypes.MsgEthereumTx); ok {
+			return nil, 0, sdkmath.Int{}, false
+		}
+		typeURLs[i] = sdk.MsgTypeURL(msg)
+	}
+	return typeURLs, feeTx.GetGas(), fee[0].Amount, true
+}
+
+// sponsorTx runs ante on tx with its fee paid by a matching gas sponsorship, if there is one. EVM
+// calls are matched by the called contract, Cosmos transactions by the type URLs of their
+// messages.
+//
+// The sponsorship funds the sender, or the fee payer of a Cosmos transaction, with the maximum fee
+// of the transaction before ante runs, so that the balance checks and the fee deduction of the
+// ante handler apply unchanged. Whatever ante did not deduct is returned to the sponsorship right
+// away, and the gas an EVM transaction is refunded after execution is returned by the post
+// handler.
+func sponsorTx(
+	ctx sdk.Context,
+	tx sdk.Tx,
+	sim bool,
+	bankKeeper bankkeeper.Keeper,
+	ynxKeeper ynxkeeper.Keeper,
+	ante sdk.AnteHandler,
+) (sdk.Context, error) {
+	var (
+		sp        ynxtypes.Sponsorship
+		found     bool
+		err       error
+		sender    sdk.AccAddress
+		maxFee    sdkmath.Int
+		sponsored sponsoredTx
+	)
+	if ethMsg, contract, ok := sponsorableEthereumTx(tx); ok {
+		maxFee = sdkmath.NewIntFromBigInt(ethMsg.GetFee())
+		sp, found, err = ynxKeeper.MatchSponsorship(ctx, contract, ethMsg.GetGas(), maxFee)
+		sender = ethMsg.GetFrom()
+		sponsored.contract = contract.Hex()
+	} else if typeURLs, gas, fee, ok := sponsorableCosmosTx(tx); ok {
+		maxFee = fee
+		sp, found, err = ynxKeeper.MatchMsgSponsorship(ctx, typeURLs, gas, maxFee)
+		sender = tx.(sdk.FeeTx).FeePayer()
+		sponsored.msgTypeURLs = typeURLs
+	}
+	if err != nil {
+		return ctx, err
+	}
+	if !found || !maxFee.IsPositive() {
+		return ante(ctx, tx, sim)
+	}
+
+	sponsor, err := sdk.AccAddressFromBech32(sp.Sponsor)
+	if err != nil {
+		return ctx, err
+	}
+
+	// The sender is not authenticated until ante has verified the signature. If it fails, ante
+	// returns an error and the funding is discarded together with the rest of the ante state.
+	denom := evmtypes.GetEVMCoinDenom()
+	before := bankKeeper.GetBalance(ctx, sender, denom).Amount
+
+	if err := ynxKeeper.FundSponsoredTx(ctx, sp.Id, sender, maxFee); err != nil {
+		return ctx, err
+	}
+
+	newCtx, err := ante(ctx, tx, sim)
+	if err != nil {
+		return newCtx, err
+	}
+
+	surplus := bankKeeper.GetBalance(newCtx, sender, denom).Amount.Sub(before)
+	surplus = sdkmath.MinInt(sdkmath.MaxInt(surplus, sdkmath.ZeroInt()), maxFee)
+	if err := ynxKeeper.RefundSponsoredTx(newCtx, sp.Id, sponsor, sender, surplus); err != nil {
+		return newCtx, err
+	}
+
+	sponsored.id = sp.Id
+	sponsored.sponsor = sponsor
+	sponsored.sender = sender
+	sponsored.charged = maxFee.Sub(surplus)
+	return newCtx.WithValue(sponsoredTxKey{}, sponsored), nil
+}
+
+// SponsorshipPostDecorator returns the gas refunded to the sender of a sponsored EVM transaction
+// to its sponsorship and emits EventTxSponsored with the fee the sponsorship finally paid, for
+// sponsored Cosmos transactions the fee charged by the ante handler. It reads
+// the fee collector snapshot and must run before FeeSplitPostDecorator moves the fee out.
+type SponsorshipPostDecorator struct {
+	bankKeeper bankkeeper.Keeper
+	ynxKeeper  ynxkeeper.Keeper
+}
+
+func NewSponsorshipPostDecorator(bankKeeper bankkeeper.Keeper, ynxKeeper ynxkeeper.Keeper) SponsorshipPostDecorator {
+	return SponsorshipPostDecorator{
+		bankKeeper: bankKeeper,
+		ynxKeeper:  ynxKeeper,
+	}
+}
+
+func (d SponsorshipPostDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	sponsored, ok := ctx.Value(sponsoredTxKey{}).(sponsoredTx)
+	if !ok {
+		return next(ctx, tx, simulate, success)
+	}
+
+	// The fee is only final once the EVM has refunded the unused gas to the sender; return that
+	// refund to the sponsorship. The refund is part of the discarded message state of failed
+	// transactions, which pay the full up-front fee. Outside of DeliverTx there is no fee
+	// collector snapshot and the sponsorship keeps the up-front fee until the next block.
+	fee := sponsored.charged
+	if snapshot, ok := ctx.Value(feeCollectorSnapshotKey{}).(feeCollectorSnapshot); ok && success {
+		feeCollectorAddr := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+		after := d.bankKeeper.GetAllBalances(ctx, feeCollectorAddr)
+		paid := feeCollectorDelta(snapshot.before, after).AmountOf(evmtypes.GetEVMCoinDenom())
+		fee = sdkmath.MinInt(paid, sponsored.charged)
+
+		if err := d.ynxKeeper.RefundSponsoredTx(ctx, sponsored.id, sponsored.sponsor, sponsored.sender, sponsored.charged.Sub(fee)); err != nil {
+			return ctx, err
+		}
+	}
+
+	if err := d.ynxKeeper.EmitTxSponsored(ctx, sponsored.id, sponsored.sponsor, sponsored.sender, sponsored.contract, sponsored.msgTypeURLs, fee); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate, success)
+}
@@ -1,23 +1,236 @@
 package ynx
 
 import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+
 	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/JiahaoAlbus/YNX/chain/precompiles/circuitbreaker"
+	"github.com/JiahaoAlbus/YNX/chain/precompiles/nyxtvotes"
+	"github.com/JiahaoAlbus/YNX/chain/precompiles/stakevotes"
+	"github.com/JiahaoAlbus/YNX/chain/precompiles/ynxsponsor"
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
 )
 
+// Upgrade is a named software upgrade. The name must match the name of the x/upgrade plan that
+// schedules it.
+type Upgrade struct {
+	Name string
+
+	// StoreUpgrades lists the module stores the upgrade adds, renames and deletes. They are
+	// applied when the upgraded binary loads the stores at the upgrade height.
+	StoreUpgrades storetypes.StoreUpgrades
+
+	// Migrations lists the consensus versions the upgrade migrates modules to. The module
+	// migrations themselves are registered by the modules and always run up to the current
+	// consensus versions; the upgrade fails if running them leaves any of the listed modules
+	// below the listed version.
+	Migrations module.VersionMap
+
+	// PostUpgrade hooks run in order after the module migrations, e.g. to redeploy system
+	// contracts.
+	PostUpgrade []PostUpgradeHook
+}
+
+// PostUpgradeHook runs once the module migrations of an upgrade are done.
+type PostUpgradeHook func(ctx sdk.Context, app *App) error
+
+// Upgrades is the registry of named upgrades, oldest first.
+var Upgrades = []Upgrade{
+	{
+		// v1 migrates the x/ynx params of the v0 testnets, which predate the revenue ledger.
+		Name: "v1",
+		Migrations: module.VersionMap{
+			ynxtypes.ModuleName: 2,
+		},
+	},
+	{
+		// v2 records the code hash attestations of the system contracts deployed before
+		// attestations existed.
+		Name: "v2",
+		PostUpgrade: []PostUpgradeHook{
+			func(ctx sdk.Context, app *App) error { return app.YNXKeeper.AttestSystemContracts(ctx) },
+		},
+	},
+	{
+		// v3 moves the system contracts from the nine fixed fields to the registry keyed by name.
+		Name: "v3",
+		Migrations: module.VersionMap{
+			ynxtypes.ModuleName: 3,
+		},
+	},
+	{
+		// v4 serves NYXT through the native x/erc20 token pair instead of the standalone ERC20
+		// deployed at genesis, which becomes redeemable for native NYXT.
+		Name: "v4",
+		PostUpgrade: []PostUpgradeHook{
+			enableNativeNYXT,
+			func(ctx sdk.Context, app *App) error { return app.YNXKeeper.RetireLegacyNYXT(ctx) },
+		},
+	},
+	{
+		// v5 activates the stake votes precompile and seeds its checkpoints from the existing
+		// delegations, which x/ynx snapshots at the end of the upgrade block.
+		Name: "v5",
+		PostUpgrade: []PostUpgradeHook{
+			func(ctx sdk.Context, app *App) error {
+				return activateStaticPrecompile(ctx, app, stakevotes.PrecompileAddress)
+			},
+			func(ctx sdk.Context, app *App) error { return app.YNXKeeper.MarkAllStakeVotesDirty(ctx) },
+		},
+	},
+	{
+		// v6 activates the circuit breaker precompile and bounds circuit breakers by the default
+		// circuit_breaker_max_blocks. The circuit guardian stays unset until governance sets it.
+		Name: "v6",
+		PostUpgrade: []PostUpgradeHook{
+			func(ctx sdk.Context, app *App) error {
+				return activateStaticPrecompile(ctx, app, circuitbreaker.PrecompileAddress)
+			},
+			setDefaultCircuitBreakerMaxBlocks,
+		},
+	},
+	{
+		// v7 activates the sponsorship precompile, which chains launched before it never had.
+		Name: "v7",
+		PostUpgrade: []PostUpgradeHook{
+			func(ctx sdk.Context, app *App) error {
+				return activateStaticPrecompile(ctx, app, ynxsponsor.PrecompileAddress)
+			},
+		},
+	},
+	{
+		// v8 moves the deprecated inflation_treasury_bps of the x/ynx params, and of any scheduled
+		// params change, into an inflation recipient of kind treasury.
+		Name: "v8",
+		Migrations: module.VersionMap{
+			ynxtypes.ModuleName: 4,
+		},
+	},
+	{
+		// v9 records the standalone NYXT retired by v4 with its supply, which caps its redemptions.
+		Name: "v9",
+		PostUpgrade: []PostUpgradeHook{
+			func(ctx sdk.Context, app *App) error { return app.YNXKeeper.RetireLegacyNYXT(ctx) },
+		},
+	},
+	{
+		// v10 anchors the x/ynx reconciliation records at the bank total supply and the revenue
+		// ledger, which the supply and treasury invariants check from then on.
+		Name: "v10",
+		Migrations: module.VersionMap{
+			ynxtypes.ModuleName: 5,
+		},
+	},
+	{
+		// v11 makes the stored EVM call circuit breakers swap the code of their target, so that
+		// they also block the calls contracts make to it.
+		Name: "v11",
+		Migrations: module.VersionMap{
+			ynxtypes.ModuleName: 6,
+		},
+	},
+}
+
+// setDefaultCircuitBreakerMaxBlocks sets circuit_breaker_max_blocks, which predates v6, to its
+// default unless it is already set.
+func setDefaultCircuitBreakerMaxBlocks(ctx sdk.Context, app *App) error {
+	params, err := app.YNXKeeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.CircuitBreakerMaxBlocks != 0 {
+		return nil
+	}
+	params.CircuitBreakerMaxBlocks = ynxtypes.DefaultCircuitBreakerMaxBlocks
+	return app.YNXKeeper.Params.Set(ctx, params)
+}
+
+// enableNativeNYXT registers the native NYXT token pair and its WERC20 precompile, and activates
+// the NYXT votes precompile. Whatever is already in place is left as is.
+func enableNativeNYXT(ctx sdk.Context, app *App) error {
+	contract := common.HexToAddress(ynxtypes.NativeNYXTContract)
+	if !app.Erc20Keeper.IsERC20Registered(ctx, contract) {
+		if err := app.Erc20Keeper.SetToken(ctx, NativeNYXTTokenPair()); err != nil {
+			return err
+		}
+	}
+	if !app.Erc20Keeper.IsNativePrecompileAvailable(ctx, contract) {
+		if err := app.Erc20Keeper.EnableNativePrecompile(ctx, contract); err != nil {
+			return err
+		}
+	}
+	return activateStaticPrecompile(ctx, app, nyxtvotes.PrecompileAddress)
+}
+
+// activateStaticPrecompile adds the static precompile at address to the active ones of the EVM
+// params, unless it is already active.
+func activateStaticPrecompile(ctx sdk.Context, app *App, address string) error {
+	params := app.EVMKeeper.GetParams(ctx)
+	if slices.Contains(params.ActiveStaticPrecompiles, address) {
+		return nil
+	}
+	params.ActiveStaticPrecompiles = append(params.ActiveStaticPrecompiles, address)
+	return app.EVMKeeper.SetParams(ctx, params)
+}
+
+// GetUpgrade returns the registered upgrade called name.
+func GetUpgrade(name string) (Upgrade, bool) {
+	for _, u := range Upgrades {
+		if u.Name == name {
+			return u, true
+		}
+	}
+	return Upgrade{}, false
+}
+
 func (app *App) RegisterUpgradeHandlers() {
-	// Upgrade handlers (store migrations / module migrations) should be registered here.
-	// v0 keeps this empty on purpose.
+	for _, u := range Upgrades {
+		app.UpgradeKeeper.SetUpgradeHandler(u.Name, app.upgradeHandler(u))
+	}
+
 	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
 	if err != nil {
 		panic(err)
 	}
+	if upgradeInfo.Name == "" || app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		return
+	}
+
+	// Unknown upgrades are left to x/upgrade, which halts the node at the plan height.
+	u, ok := GetUpgrade(upgradeInfo.Name)
+	if !ok {
+		return
+	}
+	app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &u.StoreUpgrades))
+}
+
+func (app *App) upgradeHandler(u Upgrade) upgradetypes.UpgradeHandler {
+	return func(ctx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		toVM, err := app.ModuleManager.RunMigrations(ctx, app.Configurator(), fromVM)
+		if err != nil {
+			return nil, err
+		}
+		for name, version := range u.Migrations {
+			if toVM[name] < version {
+				return nil, fmt.Errorf("upgrade %s: module %s migrated to version %d, expected at least %d", u.Name, name, toVM[name], version)
+			}
+		}
 
-	if upgradeInfo.Name != "" && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
-		storeUpgrades := storetypes.StoreUpgrades{
-			Added: []string{},
+		sdkCtx := sdk.UnwrapSDKContext(ctx)
+		for i, hook := range u.PostUpgrade {
+			if err := hook(sdkCtx, app); err != nil {
+				return nil, fmt.Errorf("upgrade %s: post-upgrade hook %d: %w", u.Name, i, err)
+			}
 		}
-		// configure store loader that checks if version == upgradeHeight and applies store upgrades
-		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
+		return toVM, nil
 	}
 }
@@ -0,0 +1,350 @@
+package ynx
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/header"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+	"github.com/cosmos/evm/x/vm/statedb"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	ynxconfig "github.com/JiahaoAlbus/YNX/chain/config"
+	"github.com/JiahaoAlbus/YNX/chain/precompiles/circuitbreaker"
+	"github.com/JiahaoAlbus/YNX/chain/precompiles/nyxtvotes"
+	"github.com/JiahaoAlbus/YNX/chain/precompiles/stakevotes"
+	"github.com/JiahaoAlbus/YNX/chain/precompiles/ynxsponsor"
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
+)
+
+func TestUpgradesRegistry(t *testing.T) {
+	app, _ := newFeeSplitTestApp(t)
+	current := app.ModuleManager.GetVersionMap()
+
+	seen := make(map[string]struct{}, len(Upgrades))
+	for _, u := range Upgrades {
+		require.NotEmpty(t, u.Name)
+		_, dup := seen[u.Name]
+		require.False(t, dup, "duplicate upgrade %s", u.Name)
+		seen[u.Name] = struct{}{}
+
+		require.True(t, app.UpgradeKeeper.HasHandler(u.Name), "no handler for upgrade %s", u.Name)
+		for name, version := range u.Migrations {
+			require.Contains(t, current, name)
+			require.LessOrEqual(t, version, current[name], "upgrade %s migrates %s past its consensus version", u.Name, name)
+		}
+	}
+
+	_, ok := GetUpgrade("does-not-exist")
+	require.False(t, ok)
+}
+
+func TestUpgradeV1MigratesYNX(t *testing.T) {
+	app, ctx := newFeeSplitTestApp(t)
+	ctx = ctx.WithHeaderInfo(header.Info{ChainID: ctx.ChainID(), Height: ctx.BlockHeight(), Time: ctx.BlockTime()})
+
+	// State written by a v0 binary: x/ynx at version 1 with params that predate the epochs.
+	fromVM := app.ModuleManager.GetVersionMap()
+	fromVM[ynxtypes.ModuleName] = 1
+	require.NoError(t, app.UpgradeKeeper.SetModuleVersionMap(ctx, fromVM))
+
+	params, err := app.YNXKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.EpochLengthBlocks = 0
+	require.NoError(t, app.YNXKeeper.Params.Set(ctx, params))
+
+	require.NoError(t, app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: "v1", Height: ctx.BlockHeight()}))
+
+	// The later migrations run too.
+	toVM, err := app.UpgradeKeeper.GetModuleVersionMap(ctx)
+	require.NoError(t, err)
+	require.Equal(t, app.ModuleManager.GetVersionMap()[ynxtypes.ModuleName], toVM[ynxtypes.ModuleName])
+
+	params, err = app.YNXKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, ynxtypes.DefaultEpochLengthBlocks, params.EpochLengthBlocks)
+
+	done, err := app.UpgradeKeeper.GetDoneHeight(ctx, "v1")
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockHeight(), done)
+}
+
+func TestUpgradeV2AttestsSystemContracts(t *testing.T) {
+	app, ctx := newFeeSplitTestApp(t)
+	ctx = ctx.WithHeaderInfo(header.Info{ChainID: ctx.ChainID(), Height: ctx.BlockHeight(), Time: ctx.BlockTime()})
+
+	// System contracts recorded by a binary without attestations, in the v0 fields.
+	inbox := common.HexToAddress("0x6666666666666666666666666666666666666666")
+	code := []byte{0x60, 0x00}
+	codeHash := crypto.Keccak256(code)
+	app.EVMKeeper.SetCode(ctx, codeHash, code)
+	require.NoError(t, app.EVMKeeper.SetAccount(ctx, inbox, statedb.Account{Balance: new(uint256.Int), CodeHash: codeHash}))
+	require.NoError(t, app.YNXKeeper.SystemContracts.Set(ctx, ynxtypes.SystemContracts{DomainInbox: inbox.Hex()}))
+
+	fromVM := app.ModuleManager.GetVersionMap()
+	fromVM[ynxtypes.ModuleName] = 2
+	require.NoError(t, app.UpgradeKeeper.SetModuleVersionMap(ctx, fromVM))
+	require.NoError(t, app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: "v2", Height: ctx.BlockHeight()}))
+
+	contracts, err := app.YNXKeeper.SystemContracts.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []ynxtypes.SystemContractEntry{{Name: "domain_inbox", Address: inbox.Hex()}}, contracts.Contracts)
+	require.Equal(t, []ynxtypes.SystemContractAttestation{
+		{Name: "domain_inbox", CodeHash: common.BytesToHash(codeHash).Hex()},
+	}, contracts.Attestations)
+}
+
+func TestUpgradeV4EnablesNativeNYXT(t *testing.T) {
+	app, ctx := newFeeSplitTestApp(t)
+	ctx = ctx.WithHeaderInfo(header.Info{ChainID: ctx.ChainID(), Height: ctx.BlockHeight(), Time: ctx.BlockTime()})
+
+	// A chain that launched with the standalone NYXT ERC20 and without the votes precompile.
+	require.NoError(t, app.EVMKeeper.SetParams(ctx, evmtypes.DefaultParams()))
+	legacy := deployUpgradeTestNYXT(t, app, ctx, 1_000).Hex()
+
+	require.NoError(t, app.UpgradeKeeper.SetModuleVersionMap(ctx, app.ModuleManager.GetVersionMap()))
+	require.NoError(t, app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: "v4", Height: ctx.BlockHeight()}))
+
+	native := common.HexToAddress(ynxtypes.NativeNYXTContract)
+	require.True(t, app.Erc20Keeper.IsERC20Registered(ctx, native))
+	require.True(t, app.Erc20Keeper.IsNativePrecompileAvailable(ctx, native))
+	require.Contains(t, app.EVMKeeper.GetParams(ctx).ActiveStaticPrecompiles, nyxtvotes.PrecompileAddress)
+
+	contracts, err := app.YNXKeeper.SystemContracts.Get(ctx)
+	require.NoError(t, err)
+	require.Empty(t, contracts.Get("nyxt"))
+	require.Equal(t, legacy, contracts.Get(ynxtypes.LegacyNYXTContractName))
+	record, err := app.YNXKeeper.LegacyNYXT.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, legacy, record.Contract)
+	require.Equal(t, sdkmath.NewInt(1_000), record.Supply)
+
+	// Running the hooks again changes nothing.
+	u, ok := GetUpgrade("v4")
+	require.True(t, ok)
+	for _, hook := range u.PostUpgrade {
+		require.NoError(t, hook(ctx, app))
+	}
+	require.Len(t, app.Erc20Keeper.GetTokenPairs(ctx), 1)
+}
+
+// deployUpgradeTestNYXT deploys a standalone NYXT ERC20 minting supply to its deployer as the nyxt
+// system contract.
+func deployUpgradeTestNYXT(t *testing.T, app *App, ctx sdk.Context, supply int64) common.Address {
+	t.Helper()
+
+	require.NoError(t, app.FeeMarketKeeper.SetParams(ctx, feemarkettypes.DefaultParams()))
+	require.NoError(t, app.YNXKeeper.SystemContracts.Set(ctx, ynxtypes.SystemContracts{}))
+
+	// NYXT(address initialOwner, address initialRecipient, uint256 initialSupply)
+	holder := common.LeftPadBytes(common.HexToAddress("0x5555555555555555555555555555555555555555").Bytes(), 32)
+	args := append(append(append([]byte{}, holder...), holder...), common.LeftPadBytes(big.NewInt(supply).Bytes(), 32)...)
+	gov := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	token, err := app.YNXKeeper.DeploySystemContract(ctx, gov, "nyxt", "NYXT", nil, args, nil)
+	require.NoError(t, err)
+	return token
+}
+
+func TestUpgradeV5EnablesStakeVotes(t *testing.T) {
+	app, ctx := newFeeSplitTestApp(t)
+	ctx = ctx.WithHeaderInfo(header.Info{ChainID: ctx.ChainID(), Height: ctx.BlockHeight(), Time: ctx.BlockTime()})
+	require.NoError(t, app.EVMKeeper.SetParams(ctx, evmtypes.DefaultParams()))
+
+	// A delegation made before the upgrade, which no staking hook reported to x/ynx.
+	val := sdk.ValAddress(common.HexToAddress("0x7777777777777777777777777777777777777777").Bytes())
+	delegator := sdk.AccAddress(common.HexToAddress("0x8888888888888888888888888888888888888888").Bytes())
+	require.NoError(t, app.StakingKeeper.SetValidator(ctx, stakingtypes.Validator{
+		OperatorAddress: val.String(),
+		Status:          stakingtypes.Bonded,
+		Tokens:          sdkmath.NewInt(1_000),
+		DelegatorShares: sdkmath.LegacyNewDec(1_000),
+	}))
+	require.NoError(t, app.StakingKeeper.SetDelegation(ctx, stakingtypes.NewDelegation(delegator.String(), val.String(), sdkmath.LegacyNewDec(1_000))))
+
+	require.NoError(t, app.UpgradeKeeper.SetModuleVersionMap(ctx, app.ModuleManager.GetVersionMap()))
+	require.NoError(t, app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: "v5", Height: ctx.BlockHeight()}))
+	require.Contains(t, app.EVMKeeper.GetParams(ctx).ActiveStaticPrecompiles, stakevotes.PrecompileAddress)
+
+	// The end of the upgrade block snapshots the existing stake.
+	require.NoError(t, app.YNXKeeper.SnapshotStakeVotes(ctx))
+	votes, err := app.YNXKeeper.GetStakeVotes(ctx, sdk.AccAddress(val))
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(1_000), votes)
+}
+
+func TestUpgradeV6EnablesCircuitBreakers(t *testing.T) {
+	app, ctx := newFeeSplitTestApp(t)
+	ctx = ctx.WithHeaderInfo(header.Info{ChainID: ctx.ChainID(), Height: ctx.BlockHeight(), Time: ctx.BlockTime()})
+	require.NoError(t, app.EVMKeeper.SetParams(ctx, evmtypes.DefaultParams()))
+
+	// Params stored before v6 have no circuit_breaker_max_blocks.
+	params, err := app.YNXKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.CircuitBreakerMaxBlocks = 0
+	require.NoError(t, app.YNXKeeper.Params.Set(ctx, params))
+
+	require.NoError(t, app.UpgradeKeeper.SetModuleVersionMap(ctx, app.ModuleManager.GetVersionMap()))
+	require.NoError(t, app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: "v6", Height: ctx.BlockHeight()}))
+	require.Contains(t, app.EVMKeeper.GetParams(ctx).ActiveStaticPrecompiles, circuitbreaker.PrecompileAddress)
+
+	params, err = app.YNXKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, ynxtypes.DefaultCircuitBreakerMaxBlocks, params.CircuitBreakerMaxBlocks)
+	require.Empty(t, params.CircuitGuardianAddress)
+}
+
+func TestUpgradeV7EnablesSponsorships(t *testing.T) {
+	app, ctx := newFeeSplitTestApp(t)
+	ctx = ctx.WithHeaderInfo(header.Info{ChainID: ctx.ChainID(), Height: ctx.BlockHeight(), Time: ctx.BlockTime()})
+	require.NoError(t, app.EVMKeeper.SetParams(ctx, evmtypes.DefaultParams()))
+
+	require.NoError(t, app.UpgradeKeeper.SetModuleVersionMap(ctx, app.ModuleManager.GetVersionMap()))
+	require.NoError(t, app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: "v7", Height: ctx.BlockHeight()}))
+	params := app.EVMKeeper.GetParams(ctx)
+	require.Contains(t, params.ActiveStaticPrecompiles, ynxsponsor.PrecompileAddress)
+
+	_, ok, err := app.EVMKeeper.GetStaticPrecompileInstance(&params, common.HexToAddress(ynxsponsor.PrecompileAddress))
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+func TestUpgradeV8MigratesInflationTreasuryBps(t *testing.T) {
+	app, ctx := newFeeSplitTestApp(t)
+	ctx = ctx.WithHeaderInfo(header.Info{ChainID: ctx.ChainID(), Height: ctx.BlockHeight(), Time: ctx.BlockTime()})
+
+	// Params stored before v8 keep the treasury share in inflation_treasury_bps.
+	params, err := app.YNXKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.InflationRecipients = nil
+	params.InflationTreasuryBps = 2_500 //nolint:staticcheck // state written before v8
+	require.NoError(t, app.YNXKeeper.Params.Set(ctx, params))
+
+	fromVM := app.ModuleManager.GetVersionMap()
+	fromVM[ynxtypes.ModuleName] = 3
+	require.NoError(t, app.UpgradeKeeper.SetModuleVersionMap(ctx, fromVM))
+	require.NoError(t, app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: "v8", Height: ctx.BlockHeight()}))
+
+	params, err = app.YNXKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Zero(t, params.InflationTreasuryBps) //nolint:staticcheck // checks the migrated field
+	require.Equal(t, []ynxtypes.InflationRecipient{{
+		Name: ynxtypes.InflationTreasuryRecipientName,
+		Kind: ynxtypes.InflationRecipientKind_INFLATION_RECIPIENT_KIND_TREASURY,
+		Bps:  2_500,
+	}}, params.InflationRecipients)
+}
+
+func TestUpgradeV9RecordsLegacyNYXT(t *testing.T) {
+	app, ctx := newFeeSplitTestApp(t)
+	ctx = ctx.WithHeaderInfo(header.Info{ChainID: ctx.ChainID(), Height: ctx.BlockHeight(), Time: ctx.BlockTime()})
+	require.NoError(t, app.EVMKeeper.SetParams(ctx, evmtypes.DefaultParams()))
+
+	// A chain that ran v4 before the legacy NYXT record existed.
+	legacy := deployUpgradeTestNYXT(t, app, ctx, 1_000)
+	contracts, err := app.YNXKeeper.SystemContracts.Get(ctx)
+	require.NoError(t, err)
+	require.NoError(t, contracts.Rename("nyxt", ynxtypes.LegacyNYXTContractName))
+	require.NoError(t, app.YNXKeeper.SystemContracts.Set(ctx, contracts))
+
+	require.NoError(t, app.UpgradeKeeper.SetModuleVersionMap(ctx, app.ModuleManager.GetVersionMap()))
+	require.NoError(t, app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: "v9", Height: ctx.BlockHeight()}))
+
+	record, err := app.YNXKeeper.LegacyNYXT.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, legacy.Hex(), record.Contract)
+	require.Equal(t, sdkmath.NewInt(1_000), record.Supply)
+	require.True(t, record.Redeemed.IsZero())
+}
+
+func TestUpgradeV10AnchorsReconciliation(t *testing.T) {
+	app, ctx := newFeeSplitTestApp(t)
+	ctx = ctx.WithHeaderInfo(header.Info{ChainID: ctx.ChainID(), Height: ctx.BlockHeight(), Time: ctx.BlockTime()})
+
+	// A record written before v10 counts what x/ynx observed itself.
+	old := ynxtypes.NewReconciliationRecord(ynxconfig.BaseDenom)
+	old.TreasuryInflows = sdkmath.NewInt(250)
+	require.NoError(t, app.YNXKeeper.Reconciliation.Set(ctx, ynxconfig.BaseDenom, old))
+
+	fromVM := app.ModuleManager.GetVersionMap()
+	fromVM[ynxtypes.ModuleName] = 4
+	require.NoError(t, app.UpgradeKeeper.SetModuleVersionMap(ctx, fromVM))
+	require.NoError(t, app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: "v10", Height: ctx.BlockHeight()}))
+
+	r, err := app.YNXKeeper.Reconciliation.Get(ctx, ynxconfig.BaseDenom)
+	require.NoError(t, err)
+	require.Equal(t, app.BankKeeper.GetSupply(ctx, ynxconfig.BaseDenom).Amount, r.SupplyAnchor)
+	require.True(t, r.TreasuryInflows.IsZero())
+
+	for _, route := range []string{"supply-burns", "treasury-inflows"} {
+		results, err := app.YNXKeeper.RunInvariants(ctx, route)
+		require.NoError(t, err)
+		require.False(t, results[0].Broken, results[0].Message)
+	}
+}
+
+func TestUpgradeV11SwapsCircuitBreakerCode(t *testing.T) {
+	app, ctx := newFeeSplitTestApp(t)
+	ctx = ctx.WithHeaderInfo(header.Info{ChainID: ctx.ChainID(), Height: ctx.BlockHeight(), Time: ctx.BlockTime()})
+
+	bridge := common.HexToAddress("0x3333333333333333333333333333333333333333")
+	original := crypto.Keccak256([]byte{0x00})
+	app.EVMKeeper.SetCode(ctx, original, []byte{0x00})
+	app.EVMKeeper.SetCodeHash(ctx, bridge.Bytes(), original)
+
+	// A breaker tripped before v11 records no original code hash.
+	b := ynxtypes.CircuitBreaker{
+		Kind:          ynxtypes.CircuitBreakerKind_CIRCUIT_BREAKER_KIND_EVM_CALL,
+		Target:        bridge.Hex(),
+		TrippedBy:     sdk.AccAddress(bridge.Bytes()).String(),
+		TrippedHeight: ctx.BlockHeight(),
+		ExpiryHeight:  ctx.BlockHeight() + 10,
+	}
+	require.NoError(t, app.YNXKeeper.CircuitBreakers.Set(ctx, collections.Join3(int32(b.Kind), b.Target, ""), b))
+
+	fromVM := app.ModuleManager.GetVersionMap()
+	fromVM[ynxtypes.ModuleName] = 5
+	require.NoError(t, app.UpgradeKeeper.SetModuleVersionMap(ctx, fromVM))
+	require.NoError(t, app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: "v11", Height: ctx.BlockHeight()}))
+
+	breakers, err := app.YNXKeeper.GetCircuitBreakers(ctx)
+	require.NoError(t, err)
+	require.Len(t, breakers, 1)
+	require.Equal(t, common.BytesToHash(original).Hex(), breakers[0].OriginalCodeHash)
+	require.NotEqual(t, common.BytesToHash(original), app.EVMKeeper.GetCodeHash(ctx, bridge))
+}
+
+func TestUpgradeHandlerRunsPostUpgradeHooks(t *testing.T) {
+	app, ctx := newFeeSplitTestApp(t)
+
+	var calls []int
+	u := Upgrade{
+		Name: "test",
+		PostUpgrade: []PostUpgradeHook{
+			func(_ sdk.Context, _ *App) error { calls = append(calls, 1); return nil },
+			func(_ sdk.Context, _ *App) error { calls = append(calls, 2); return nil },
+		},
+	}
+	_, err := app.upgradeHandler(u)(ctx, upgradetypes.Plan{Name: u.Name}, app.ModuleManager.GetVersionMap())
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2}, calls)
+
+	next := app.ModuleManager.GetVersionMap()[ynxtypes.ModuleName] + 1
+	u.Migrations = map[string]uint64{ynxtypes.ModuleName: next}
+	_, err = app.upgradeHandler(u)(ctx, upgradetypes.Plan{Name: u.Name}, app.ModuleManager.GetVersionMap())
+	require.ErrorContains(t, err, fmt.Sprintf("expected at least %d", next))
+}
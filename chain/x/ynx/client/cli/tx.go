@@ -0,0 +1,170 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
+)
+
+const (
+	flagActivationHeight = "activation-height"
+	flagOut              = "out"
+
+	defaultDraftProposalFile = "draft_proposal.json"
+)
+
+// Proposal is the proposal file format read by `tx gov submit-proposal`.
+type Proposal struct {
+	Messages  []json.RawMessage `json:"messages,omitempty"`
+	Metadata  string            `json:"metadata"`
+	Deposit   string            `json:"deposit"`
+	Title     string            `json:"title"`
+	Summary   string            `json:"summary"`
+	Expedited bool              `json:"expedited"`
+}
+
+// NewTxCmd returns the custom x/ynx tx commands. AutoCLI adds the Msg service commands.
+func NewTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        ynxtypes.ModuleName,
+		Short:                      "Transactions commands for the ynx module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(NewDraftUpdateParamsCmd())
+	return cmd
+}
+
+// NewDraftUpdateParamsCmd returns the command that writes a MsgUpdateParams governance proposal.
+func NewDraftUpdateParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "draft-update-params [params-file]",
+		Short: "Write a governance proposal that updates the x/ynx params",
+		Long: `Write a proposal file for "tx gov submit-proposal" carrying a MsgUpdateParams signed by the
+governance module account.
+
+The new params are read from params-file, either a Params object or the output of
+"query ynx params -o json". Without params-file the current on-chain params are queried, so the
+draft can be edited by hand before it is submitted.`,
+		Example: `ynxd tx ynx draft-update-params params.json --title "Raise the burn share" --summary "..." --deposit 10000000anyxt`,
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var params ynxtypes.Params
+			if len(args) == 1 {
+				if params, err = readParamsFile(clientCtx.Codec, args[0]); err != nil {
+					return err
+				}
+			} else {
+				res, err := ynxtypes.NewQueryClient(clientCtx).Params(cmd.Context(), &ynxtypes.QueryParamsRequest{})
+				if err != nil {
+					return fmt.Errorf("query current params: %w", err)
+				}
+				params = res.Params
+			}
+
+			activationHeight, err := cmd.Flags().GetInt64(flagActivationHeight)
+			if err != nil {
+				return err
+			}
+			prop, err := govcli.ReadGovPropCmdFlags("", cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := &ynxtypes.MsgUpdateParams{
+				Authority:        authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params:           params,
+				ActivationHeight: activationHeight,
+			}
+			proposal, err := NewUpdateParamsProposal(clientCtx.Codec, msg, prop.Title, prop.Summary, prop.Metadata, prop.InitialDeposit)
+			if err != nil {
+				return err
+			}
+
+			out, err := cmd.Flags().GetString(flagOut)
+			if err != nil {
+				return err
+			}
+			bz, err := json.MarshalIndent(proposal, "", " ")
+			if err != nil {
+				return err
+			}
+			if err := os.WriteFile(out, bz, 0o600); err != nil {
+				return err
+			}
+
+			cmd.Printf("The draft proposal has been written to %s. Submit it with: ynxd tx gov submit-proposal %s\n", out, out)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagNode, "tcp://localhost:26657", "<host>:<port> to CometBFT RPC interface for this chain")
+	cmd.Flags().String(flags.FlagGRPC, "", "the gRPC endpoint to use for this chain")
+	cmd.Flags().Bool(flags.FlagGRPCInsecure, false, "allow gRPC over insecure channels, if not the server must use TLS")
+	govcli.AddGovPropFlagsToCmd(cmd)
+	cmd.Flags().Int64(flagActivationHeight, 0, "block height at which the params take effect (0 applies them when the proposal executes)")
+	cmd.Flags().String(flagOut, defaultDraftProposalFile, "proposal file to write")
+	return cmd
+}
+
+// NewUpdateParamsProposal validates msg and wraps it into a proposal file.
+func NewUpdateParamsProposal(cdc codec.Codec, msg *ynxtypes.MsgUpdateParams, title, summary, metadata string, deposit sdk.Coins) (Proposal, error) {
+	if err := msg.Params.Validate(); err != nil {
+		return Proposal{}, fmt.Errorf("invalid params: %w", err)
+	}
+	if msg.ActivationHeight < 0 {
+		return Proposal{}, fmt.Errorf("activation height must not be negative, got %d", msg.ActivationHeight)
+	}
+
+	bz, err := cdc.MarshalInterfaceJSON(msg)
+	if err != nil {
+		return Proposal{}, err
+	}
+	return Proposal{
+		Messages: []json.RawMessage{bz},
+		Metadata: metadata,
+		Deposit:  deposit.String(),
+		Title:    title,
+		Summary:  summary,
+	}, nil
+}
+
+// readParamsFile reads params from a Params JSON object or a QueryParamsResponse.
+func readParamsFile(cdc codec.Codec, path string) (ynxtypes.Params, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return ynxtypes.Params{}, err
+	}
+
+	var wrapper struct {
+		Params json.RawMessage `json:"params"`
+	}
+	if err := json.Unmarshal(bz, &wrapper); err == nil && len(wrapper.Params) > 0 {
+		bz = wrapper.Params
+	}
+
+	var params ynxtypes.Params
+	if err := cdc.UnmarshalJSON(bz, &params); err != nil {
+		return ynxtypes.Params{}, fmt.Errorf("parse params file %s: %w", path, err)
+	}
+	return params, nil
+}
@@ -0,0 +1,106 @@
+package cli
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
+)
+
+func newTestCodec() codec.Codec {
+	registry := codectypes.NewInterfaceRegistry()
+	ynxtypes.RegisterInterfaces(registry)
+	return codec.NewProtoCodec(registry)
+}
+
+func TestNewUpdateParamsProposal(t *testing.T) {
+	t.Parallel()
+
+	cdc := newTestCodec()
+	params := ynxtypes.DefaultParams()
+	params.FeeBurnBps = 5_000
+
+	msg := &ynxtypes.MsgUpdateParams{
+		Authority:        authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Params:           params,
+		ActivationHeight: 100,
+	}
+	deposit := sdk.NewCoins(sdk.NewCoin("anyxt", sdkmath.NewInt(10)))
+
+	proposal, err := NewUpdateParamsProposal(cdc, msg, "title", "summary", "ipfs://meta", deposit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if proposal.Title != "title" || proposal.Summary != "summary" || proposal.Metadata != "ipfs://meta" || proposal.Deposit != "10anyxt" {
+		t.Fatalf("unexpected proposal: %+v", proposal)
+	}
+	if len(proposal.Messages) != 1 {
+		t.Fatalf("expected 1 message, got %d", len(proposal.Messages))
+	}
+
+	var got sdk.Msg
+	if err := cdc.UnmarshalInterfaceJSON(proposal.Messages[0], &got); err != nil {
+		t.Fatal(err)
+	}
+	gotMsg, ok := got.(*ynxtypes.MsgUpdateParams)
+	if !ok {
+		t.Fatalf("expected MsgUpdateParams, got %T", got)
+	}
+	if gotMsg.Params.FeeBurnBps != 5_000 || gotMsg.ActivationHeight != 100 || gotMsg.Authority != msg.Authority {
+		t.Fatalf("unexpected message: %+v", gotMsg)
+	}
+}
+
+func TestNewUpdateParamsProposalRejectsInvalidParams(t *testing.T) {
+	t.Parallel()
+
+	params := ynxtypes.DefaultParams()
+	params.FeeBurnBps = ynxtypes.BPSDenominator + 1
+
+	if _, err := NewUpdateParamsProposal(newTestCodec(), &ynxtypes.MsgUpdateParams{Params: params}, "", "", "", nil); err == nil {
+		t.Fatal("expected invalid params to be rejected")
+	}
+}
+
+func TestReadParamsFile(t *testing.T) {
+	t.Parallel()
+
+	cdc := newTestCodec()
+	params := ynxtypes.DefaultParams()
+	params.EpochLengthBlocks = 42
+
+	bare, err := cdc.MarshalJSON(&params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wrapped, err := json.Marshal(map[string]json.RawMessage{"params": bare})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	for name, bz := range map[string][]byte{"bare.json": bare, "wrapped.json": wrapped} {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, bz, 0o600); err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := readParamsFile(cdc, path)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if got.EpochLengthBlocks != 42 {
+			t.Fatalf("%s: expected epoch_length_blocks 42, got %d", name, got.EpochLengthBlocks)
+		}
+	}
+}
@@ -9,7 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-func parseAnyAddress(_ sdk.Context, addr string) (sdk.AccAddress, error) {
+func parseAnyAddress(addr string) (sdk.AccAddress, error) {
 	addr = strings.TrimSpace(addr)
 	if addr == "" {
 		return nil, fmt.Errorf("empty address")
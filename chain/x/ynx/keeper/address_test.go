@@ -14,7 +14,7 @@ func TestParseAnyAddressSupportsBech32AndHex(t *testing.T) {
 	ynxconfig.SetBech32Prefixes(cfg)
 
 	bech32Addr := sdk.AccAddress(bytesRepeat(0x11, 20)).String()
-	parsedBech32, err := parseAnyAddress(sdk.Context{}, bech32Addr)
+	parsedBech32, err := parseAnyAddress(bech32Addr)
 	if err != nil {
 		t.Fatalf("expected bech32 address to parse, got error: %v", err)
 	}
@@ -22,7 +22,7 @@ func TestParseAnyAddressSupportsBech32AndHex(t *testing.T) {
 		t.Fatal("expected parsed bech32 address to match source")
 	}
 
-	parsedHex, err := parseAnyAddress(sdk.Context{}, "0x2222222222222222222222222222222222222222")
+	parsedHex, err := parseAnyAddress("0x2222222222222222222222222222222222222222")
 	if err != nil {
 		t.Fatalf("expected hex address to parse, got error: %v", err)
 	}
@@ -32,7 +32,7 @@ func TestParseAnyAddressSupportsBech32AndHex(t *testing.T) {
 }
 
 func TestParseAnyAddressRejectsUnsupportedFormats(t *testing.T) {
-	_, err := parseAnyAddress(sdk.Context{}, "not-an-address")
+	_, err := parseAnyAddress("not-an-address")
 	if err == nil {
 		t.Fatal("expected unsupported address format error")
 	}
@@ -0,0 +1,271 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
+)
+
+// circuitGovernanceContracts are the system contracts through which the timelock resets circuit
+// breakers. Like x/gov messages, calls to them cannot be blocked.
+var circuitGovernanceContracts = []string{"governor", "timelock"}
+
+// TripCircuitBreaker blocks target for durationBlocks blocks on behalf of the circuit guardian.
+//
+// A precompile breaker also removes the precompile from the active static precompiles of the EVM,
+// so that contracts cannot call it either; lifting the breaker restores it. An EVM call breaker
+// swaps the code of the target contract for a stub that reverts the blocked calls, whoever makes
+// them; lifting the last breaker on the contract restores its code.
+func (k Keeper) TripCircuitBreaker(
+	ctx context.Context,
+	guardian sdk.AccAddress,
+	kind ynxtypes.CircuitBreakerKind,
+	target, selector string,
+	durationBlocks uint64,
+	reason string,
+) (ynxtypes.CircuitBreaker, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return ynxtypes.CircuitBreaker{}, err
+	}
+	if params.CircuitGuardianAddress == "" {
+		return ynxtypes.CircuitBreaker{}, errorsmod.Wrap(errortypes.ErrUnauthorized, "no circuit guardian is configured")
+	}
+	if guardian.String() != params.CircuitGuardianAddress {
+		return ynxtypes.CircuitBreaker{}, errorsmod.Wrapf(errortypes.ErrUnauthorized, "%s is not the circuit guardian", guardian)
+	}
+	if durationBlocks == 0 || durationBlocks > params.CircuitBreakerMaxBlocks {
+		return ynxtypes.CircuitBreaker{}, errorsmod.Wrapf(
+			errortypes.ErrInvalidRequest,
+			"duration_blocks must be between 1 and %d, got %d", params.CircuitBreakerMaxBlocks, durationBlocks,
+		)
+	}
+	if len(reason) > ynxtypes.MaxCircuitBreakerReasonLength {
+		return ynxtypes.CircuitBreaker{}, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "reason longer than %d bytes", ynxtypes.MaxCircuitBreakerReasonLength)
+	}
+
+	target, selector, err = ynxtypes.NormalizeCircuitBreaker(kind, target, selector)
+	if err != nil {
+		return ynxtypes.CircuitBreaker{}, errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+	if err := k.checkCircuitBreakable(ctx, kind, target); err != nil {
+		return ynxtypes.CircuitBreaker{}, err
+	}
+
+	key := collections.Join3(int32(kind), target, selector)
+	existing, err := k.CircuitBreakers.Get(ctx, key)
+	switch {
+	case err == nil && existing.Active(sdkCtx.BlockHeight()):
+		return ynxtypes.CircuitBreaker{}, errorsmod.Wrapf(
+			errortypes.ErrInvalidRequest,
+			"circuit breaker on %s %s is already tripped until height %d", target, selector, existing.ExpiryHeight,
+		)
+	case err == nil:
+		if err := k.liftCircuitBreaker(sdkCtx, existing, ""); err != nil {
+			return ynxtypes.CircuitBreaker{}, err
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return ynxtypes.CircuitBreaker{}, err
+	}
+
+	breaker := ynxtypes.CircuitBreaker{
+		Kind:          kind,
+		Target:        target,
+		Selector:      selector,
+		TrippedBy:     guardian.String(),
+		TrippedHeight: sdkCtx.BlockHeight(),
+		ExpiryHeight:  sdkCtx.BlockHeight() + int64(durationBlocks),
+		Reason:        reason,
+	}
+	if kind == ynxtypes.CircuitBreakerKind_CIRCUIT_BREAKER_KIND_PRECOMPILE {
+		deactivated, err := k.setStaticPrecompileActive(sdkCtx, target, false)
+		if err != nil {
+			return ynxtypes.CircuitBreaker{}, err
+		}
+		if !deactivated {
+			return ynxtypes.CircuitBreaker{}, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "%s is not an active static precompile", target)
+		}
+		breaker.DeactivatedPrecompile = true
+	}
+	if kind == ynxtypes.CircuitBreakerKind_CIRCUIT_BREAKER_KIND_EVM_CALL {
+		breaker.OriginalCodeHash, err = k.circuitBreakerOriginalCodeHash(sdkCtx, target)
+		if err != nil {
+			return ynxtypes.CircuitBreaker{}, err
+		}
+	}
+
+	if err := k.CircuitBreakers.Set(ctx, key, breaker); err != nil {
+		return ynxtypes.CircuitBreaker{}, err
+	}
+	if err := k.swapCircuitBreakerCode(sdkCtx, target, breaker.OriginalCodeHash); err != nil {
+		return ynxtypes.CircuitBreaker{}, err
+	}
+
+	return breaker, sdkCtx.EventManager().EmitTypedEvent(&ynxtypes.EventCircuitBreakerTripped{CircuitBreaker: breaker})
+}
+
+// ResetCircuitBreaker lifts a tripped circuit breaker before it expires. resetBy is recorded in the
+// event; callers must check that it is the module authority or the timelock.
+func (k Keeper) ResetCircuitBreaker(ctx context.Context, resetBy string, kind ynxtypes.CircuitBreakerKind, target, selector string) error {
+	target, selector, err := ynxtypes.NormalizeCircuitBreaker(kind, target, selector)
+	if err != nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+
+	breaker, err := k.CircuitBreakers.Get(ctx, collections.Join3(int32(kind), target, selector))
+	if errors.Is(err, collections.ErrNotFound) {
+		return errorsmod.Wrapf(errortypes.ErrNotFound, "no circuit breaker on %s %s", target, selector)
+	}
+	if err != nil {
+		return err
+	}
+
+	return k.liftCircuitBreaker(sdk.UnwrapSDKContext(ctx), breaker, resetBy)
+}
+
+// ExpireCircuitBreakers lifts the circuit breakers that expire at the current height.
+func (k Keeper) ExpireCircuitBreakers(ctx sdk.Context) error {
+	var expired []ynxtypes.CircuitBreaker
+	err := k.CircuitBreakers.Walk(ctx, nil, func(_ collections.Triple[int32, string, string], b ynxtypes.CircuitBreaker) (bool, error) {
+		if !b.Active(ctx.BlockHeight()) {
+			expired = append(expired, b)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, b := range expired {
+		if err := k.liftCircuitBreaker(ctx, b, ""); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetCircuitBreakers returns the stored circuit breakers ordered by kind, target and selector.
+func (k Keeper) GetCircuitBreakers(ctx context.Context) ([]ynxtypes.CircuitBreaker, error) {
+	breakers := []ynxtypes.CircuitBreaker{}
+	err := k.CircuitBreakers.Walk(ctx, nil, func(_ collections.Triple[int32, string, string], b ynxtypes.CircuitBreaker) (bool, error) {
+		breakers = append(breakers, b)
+		return false, nil
+	})
+	return breakers, err
+}
+
+// MsgCircuitBreaker returns the active circuit breaker that blocks messages of typeURL, if any.
+func (k Keeper) MsgCircuitBreaker(ctx context.Context, typeURL string) (ynxtypes.CircuitBreaker, bool, error) {
+	return k.activeCircuitBreaker(ctx, ynxtypes.CircuitBreakerKind_CIRCUIT_BREAKER_KIND_MSG, typeURL, "")
+}
+
+// CallCircuitBreaker returns the active circuit breaker that blocks an EVM call of target with
+// input, if any: a breaker on the function selector of input, on every call to target, or on
+// target as a precompile.
+func (k Keeper) CallCircuitBreaker(ctx context.Context, target common.Address, input []byte) (ynxtypes.CircuitBreaker, bool, error) {
+	if len(input) >= 4 {
+		b, found, err := k.activeCircuitBreaker(ctx, ynxtypes.CircuitBreakerKind_CIRCUIT_BREAKER_KIND_EVM_CALL, target.Hex(), ynxtypes.FormatSelector(input[:4]))
+		if err != nil || found {
+			return b, found, err
+		}
+	}
+	b, found, err := k.activeCircuitBreaker(ctx, ynxtypes.CircuitBreakerKind_CIRCUIT_BREAKER_KIND_EVM_CALL, target.Hex(), "")
+	if err != nil || found {
+		return b, found, err
+	}
+	return k.activeCircuitBreaker(ctx, ynxtypes.CircuitBreakerKind_CIRCUIT_BREAKER_KIND_PRECOMPILE, target.Hex(), "")
+}
+
+func (k Keeper) activeCircuitBreaker(ctx context.Context, kind ynxtypes.CircuitBreakerKind, target, selector string) (ynxtypes.CircuitBreaker, bool, error) {
+	b, err := k.CircuitBreakers.Get(ctx, collections.Join3(int32(kind), target, selector))
+	if errors.Is(err, collections.ErrNotFound) {
+		return ynxtypes.CircuitBreaker{}, false, nil
+	}
+	if err != nil {
+		return ynxtypes.CircuitBreaker{}, false, err
+	}
+	return b, b.Active(sdk.UnwrapSDKContext(ctx).BlockHeight()), nil
+}
+
+// liftCircuitBreaker removes b, reactivates the precompile it deactivated and updates the code it
+// swapped.
+func (k Keeper) liftCircuitBreaker(ctx sdk.Context, b ynxtypes.CircuitBreaker, resetBy string) error {
+	if err := k.CircuitBreakers.Remove(ctx, collections.Join3(int32(b.Kind), b.Target, b.Selector)); err != nil {
+		return err
+	}
+	if b.DeactivatedPrecompile {
+		if _, err := k.setStaticPrecompileActive(ctx, b.Target, true); err != nil {
+			return err
+		}
+	}
+	if err := k.swapCircuitBreakerCode(ctx, b.Target, b.OriginalCodeHash); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&ynxtypes.EventCircuitBreakerLifted{
+		Kind:     b.Kind,
+		Target:   b.Target,
+		Selector: b.Selector,
+		ResetBy:  resetBy,
+	})
+}
+
+// checkCircuitBreakable rejects breakers on the paths governance resets breakers and replaces the
+// guardian through: x/gov messages, the circuit breaker itself and the governance system contracts.
+func (k Keeper) checkCircuitBreakable(ctx context.Context, kind ynxtypes.CircuitBreakerKind, target string) error {
+	if kind == ynxtypes.CircuitBreakerKind_CIRCUIT_BREAKER_KIND_MSG {
+		if strings.HasPrefix(target, "/cosmos.gov.") ||
+			target == sdk.MsgTypeURL(&ynxtypes.MsgTripCircuitBreaker{}) ||
+			target == sdk.MsgTypeURL(&ynxtypes.MsgResetCircuitBreaker{}) {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "%s cannot be blocked", target)
+		}
+		return nil
+	}
+
+	if target == common.HexToAddress(ynxtypes.CircuitBreakerPrecompileAddress).Hex() {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "the circuit breaker precompile cannot be blocked")
+	}
+	contracts, err := k.SystemContracts.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	for _, name := range circuitGovernanceContracts {
+		if addr := contracts.Get(name); addr != "" && common.HexToAddress(addr).Hex() == target {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "the %s system contract cannot be blocked", name)
+		}
+	}
+	return nil
+}
+
+// setStaticPrecompileActive adds or removes address from the active static precompiles of the EVM
+// params and reports whether that changed them.
+func (k Keeper) setStaticPrecompileActive(ctx sdk.Context, address string, active bool) (bool, error) {
+	params := k.evmKeeper.GetParams(ctx)
+	idx := slices.Index(params.ActiveStaticPrecompiles, address)
+	if active == (idx >= 0) {
+		return false, nil
+	}
+
+	if active {
+		params.ActiveStaticPrecompiles = append(params.ActiveStaticPrecompiles, address)
+	} else {
+		params.ActiveStaticPrecompiles = slices.Delete(slices.Clone(params.ActiveStaticPrecompiles), idx, idx+1)
+	}
+	return true, k.evmKeeper.SetParams(ctx, params)
+}
@@ -0,0 +1,207 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
+)
+
+// CircuitBreakerShadowAddress returns the address the original code of target runs from while an
+// EVM call circuit breaker on target is tripped. No key controls it.
+func CircuitBreakerShadowAddress(target common.Address) common.Address {
+	return common.BytesToAddress(crypto.Keccak256([]byte("ynx/circuit-breaker/shadow"), target.Bytes()))
+}
+
+// GetCode returns the EVM code deployed at addr.
+func (k Keeper) GetCode(ctx sdk.Context, addr common.Address) []byte {
+	return k.evmKeeper.GetCode(ctx, k.evmKeeper.GetCodeHash(ctx, addr))
+}
+
+// circuitBreakerOriginalCodeHash returns the code hash an EVM call breaker on target restores: the
+// one recorded by the other breakers on target, or else the current code hash of target, which
+// must be a contract.
+func (k Keeper) circuitBreakerOriginalCodeHash(ctx sdk.Context, target string) (string, error) {
+	var original string
+	err := k.CircuitBreakers.Walk(ctx, evmCallBreakersRange(target), func(_ collections.Triple[int32, string, string], b ynxtypes.CircuitBreaker) (bool, error) {
+		original = b.OriginalCodeHash
+		return original != "", nil
+	})
+	if err != nil || original != "" {
+		return original, err
+	}
+
+	addr := common.HexToAddress(target)
+	if !k.evmKeeper.IsContract(ctx, addr) {
+		return "", errorsmod.Wrapf(errortypes.ErrInvalidRequest, "%s is not a contract", target)
+	}
+	return k.evmKeeper.GetCodeHash(ctx, addr).Hex(), nil
+}
+
+// swapCircuitBreakerCode makes the code of target enforce the EVM call breakers stored on it, so
+// that they also block the calls contracts make to target.
+//
+// While breakers are stored, target runs a stub that reverts the blocked calls and delegates the
+// others to the original code, moved to the shadow address of target. Delegating keeps the storage,
+// balance, caller and value of target. Once no breaker is left, the original code is restored.
+// Breakers recorded without an original code hash never swapped code and are left alone.
+func (k Keeper) swapCircuitBreakerCode(ctx sdk.Context, target, originalCodeHash string) error {
+	if originalCodeHash == "" {
+		return nil
+	}
+
+	blockAll := false
+	var selectors [][]byte
+	stored := false
+	err := k.CircuitBreakers.Walk(ctx, evmCallBreakersRange(target), func(_ collections.Triple[int32, string, string], b ynxtypes.CircuitBreaker) (bool, error) {
+		stored = true
+		if b.Selector == "" {
+			blockAll = true
+		} else {
+			selectors = append(selectors, common.FromHex(b.Selector))
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	addr := common.HexToAddress(target)
+	shadow := CircuitBreakerShadowAddress(addr)
+	original := common.HexToHash(originalCodeHash)
+	previous := k.evmKeeper.GetCodeHash(ctx, addr)
+
+	if !stored {
+		k.evmKeeper.SetCodeHash(ctx, addr.Bytes(), original.Bytes())
+		k.evmKeeper.DeleteCodeHash(ctx, shadow)
+		if previous != original {
+			k.evmKeeper.DeleteCode(ctx, previous.Bytes())
+		}
+		return nil
+	}
+
+	if blockAll {
+		selectors = nil
+	}
+	code := circuitBreakerCode(shadow, selectors)
+	hash := crypto.Keccak256Hash(code)
+	k.evmKeeper.SetCode(ctx, hash.Bytes(), code)
+	k.evmKeeper.SetCodeHash(ctx, addr.Bytes(), hash.Bytes())
+	if previous != original && previous != hash {
+		k.evmKeeper.DeleteCode(ctx, previous.Bytes())
+	}
+
+	// The EVM only loads the code of addresses with an account.
+	if k.accountKeeper.GetAccount(ctx, shadow.Bytes()) == nil {
+		k.accountKeeper.SetAccount(ctx, k.accountKeeper.NewAccountWithAddress(ctx, shadow.Bytes()))
+	}
+	k.evmKeeper.SetCodeHash(ctx, shadow.Bytes(), original.Bytes())
+	return nil
+}
+
+// swapStoredCircuitBreakerCode records the original code hash of the stored EVM call breakers
+// recorded without one and swaps the code of their targets. Breakers on targets that are not
+// contracts are left as they are.
+func (k Keeper) swapStoredCircuitBreakerCode(ctx sdk.Context) error {
+	var breakers []ynxtypes.CircuitBreaker
+	kind := int32(ynxtypes.CircuitBreakerKind_CIRCUIT_BREAKER_KIND_EVM_CALL)
+	err := k.CircuitBreakers.Walk(ctx, collections.NewPrefixedTripleRange[int32, string, string](kind), func(_ collections.Triple[int32, string, string], b ynxtypes.CircuitBreaker) (bool, error) {
+		if b.OriginalCodeHash == "" {
+			breakers = append(breakers, b)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, b := range breakers {
+		if !k.evmKeeper.IsContract(ctx, common.HexToAddress(b.Target)) {
+			continue
+		}
+		b.OriginalCodeHash, err = k.circuitBreakerOriginalCodeHash(ctx, b.Target)
+		if err != nil {
+			return err
+		}
+		if err := k.CircuitBreakers.Set(ctx, collections.Join3(kind, b.Target, b.Selector), b); err != nil {
+			return err
+		}
+		if err := k.swapCircuitBreakerCode(ctx, b.Target, b.OriginalCodeHash); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// circuitBreakerCode returns the stub target runs while EVM call breakers on it are tripped. With
+// no selectors it reverts every call. Otherwise it reverts the calls starting with one of
+// selectors and delegates the others, including calls without a selector, to shadow, returning or
+// reverting with whatever shadow does.
+func circuitBreakerCode(shadow common.Address, selectors [][]byte) []byte {
+	revert := []byte{byte(vm.JUMPDEST), byte(vm.PUSH1), 0, byte(vm.DUP1), byte(vm.REVERT)}
+	if len(selectors) == 0 {
+		return revert[1:]
+	}
+	selectors = slices.SortedFunc(slices.Values(selectors), func(a, b []byte) int { return slices.Compare(a, b) })
+
+	const (
+		headerSize   = 14
+		selectorSize = 11
+	)
+	revertAt := headerSize + selectorSize*len(selectors) + 4
+	delegateAt := revertAt + len(revert)
+	push2 := func(offset int) []byte {
+		return binary.BigEndian.AppendUint16([]byte{byte(vm.PUSH2)}, uint16(offset)) //nolint:gosec // the stub is far below 64KiB
+	}
+
+	// Calls shorter than a selector are delegated; the others compare their selector.
+	code := []byte{byte(vm.CALLDATASIZE), byte(vm.PUSH1), 4, byte(vm.GT)}
+	code = append(code, push2(delegateAt)...)
+	code = append(code, byte(vm.JUMPI), byte(vm.PUSH1), 0, byte(vm.CALLDATALOAD), byte(vm.PUSH1), 0xe0, byte(vm.SHR))
+	for _, selector := range selectors {
+		code = append(code, byte(vm.DUP1), byte(vm.PUSH4))
+		code = append(code, selector...)
+		code = append(code, byte(vm.EQ))
+		code = append(code, push2(revertAt)...)
+		code = append(code, byte(vm.JUMPI))
+	}
+	code = append(code, push2(delegateAt)...)
+	code = append(code, byte(vm.JUMP))
+	code = append(code, revert...)
+
+	// delegatecall(gas(), shadow, 0, calldatasize(), 0, 0) on a copy of the calldata, then return
+	// or revert with its return data.
+	code = append(code,
+		byte(vm.JUMPDEST),
+		byte(vm.CALLDATASIZE), byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.CALLDATACOPY),
+		byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.CALLDATASIZE), byte(vm.PUSH1), 0, byte(vm.PUSH20),
+	)
+	code = append(code, shadow.Bytes()...)
+	code = append(code,
+		byte(vm.GAS), byte(vm.DELEGATECALL),
+		byte(vm.RETURNDATASIZE), byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.RETURNDATACOPY),
+	)
+	okAt := len(code) + 3 + 1 + 4
+	code = append(code, push2(okAt)...)
+	code = append(code,
+		byte(vm.JUMPI),
+		byte(vm.RETURNDATASIZE), byte(vm.PUSH1), 0, byte(vm.REVERT),
+		byte(vm.JUMPDEST), byte(vm.RETURNDATASIZE), byte(vm.PUSH1), 0, byte(vm.RETURN),
+	)
+	return code
+}
+
+// evmCallBreakersRange ranges over the EVM call circuit breakers on target.
+func evmCallBreakersRange(target string) collections.Ranger[collections.Triple[int32, string, string]] {
+	return collections.NewSuperPrefixedTripleRange[int32, string, string](int32(ynxtypes.CircuitBreakerKind_CIRCUIT_BREAKER_KIND_EVM_CALL), target)
+}
@@ -0,0 +1,181 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	ynx "github.com/JiahaoAlbus/YNX/chain"
+	ynxkeeper "github.com/JiahaoAlbus/YNX/chain/x/ynx/keeper"
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
+)
+
+// setCode installs code at addr as a contract, without running any constructor.
+func setCode(app *ynx.App, ctx sdk.Context, addr common.Address, code []byte) {
+	hash := crypto.Keccak256Hash(code)
+	if app.AccountKeeper.GetAccount(ctx, addr.Bytes()) == nil {
+		app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr.Bytes()))
+	}
+	app.EVMKeeper.SetCode(ctx, hash.Bytes(), code)
+	app.EVMKeeper.SetCodeHash(ctx, addr.Bytes(), hash.Bytes())
+}
+
+func TestCircuitBreakers(t *testing.T) {
+	app, ctx := newTestApp(t, 10)
+	k := app.YNXKeeper
+	require.NoError(t, app.EVMKeeper.SetParams(ctx, ynx.NewEVMGenesisState().Params))
+
+	guardian := sdk.AccAddress(make20(0xa1))
+	params := ynxtypes.DefaultParams()
+	params.CircuitGuardianAddress = guardian.String()
+	params.CircuitBreakerMaxBlocks = 100
+	require.NoError(t, k.Params.Set(ctx, params))
+
+	const (
+		msgKind        = ynxtypes.CircuitBreakerKind_CIRCUIT_BREAKER_KIND_MSG
+		callKind       = ynxtypes.CircuitBreakerKind_CIRCUIT_BREAKER_KIND_EVM_CALL
+		precompileKind = ynxtypes.CircuitBreakerKind_CIRCUIT_BREAKER_KIND_PRECOMPILE
+	)
+	msgSend := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	bridge := common.BytesToAddress(make20(0xb1))
+	precompile := common.HexToAddress(ynxtypes.StakeVotesPrecompileAddress)
+	setCode(app, ctx, bridge, []byte{0x00})
+
+	// Only the guardian trips, for at most circuit_breaker_max_blocks.
+	_, err := k.TripCircuitBreaker(ctx, sdk.AccAddress(make20(0xa2)), msgKind, msgSend, "", 10, "")
+	require.ErrorContains(t, err, "not the circuit guardian")
+	_, err = k.TripCircuitBreaker(ctx, guardian, msgKind, msgSend, "", 101, "")
+	require.ErrorContains(t, err, "duration_blocks")
+
+	// Governance paths cannot be blocked.
+	_, err = k.TripCircuitBreaker(ctx, guardian, msgKind, sdk.MsgTypeURL(&govv1.MsgVote{}), "", 10, "")
+	require.ErrorContains(t, err, "cannot be blocked")
+	_, err = k.TripCircuitBreaker(ctx, guardian, precompileKind, ynxtypes.CircuitBreakerPrecompileAddress, "", 10, "")
+	require.ErrorContains(t, err, "cannot be blocked")
+
+	b, err := k.TripCircuitBreaker(ctx, guardian, msgKind, msgSend, "", 10, "drain")
+	require.NoError(t, err)
+	require.Equal(t, int64(20), b.ExpiryHeight)
+	_, err = k.TripCircuitBreaker(ctx, guardian, msgKind, msgSend, "", 10, "")
+	require.ErrorContains(t, err, "already tripped")
+
+	_, err = k.TripCircuitBreaker(ctx, guardian, callKind, common.BytesToAddress(make20(0xb2)).Hex(), "", 10, "")
+	require.ErrorContains(t, err, "is not a contract")
+	_, err = k.TripCircuitBreaker(ctx, guardian, callKind, bridge.Hex(), "0xA9059CBB", 50, "")
+	require.NoError(t, err)
+	_, err = k.TripCircuitBreaker(ctx, guardian, precompileKind, precompile.Hex(), "", 5, "")
+	require.NoError(t, err)
+	require.NotContains(t, app.EVMKeeper.GetParams(ctx).ActiveStaticPrecompiles, precompile.Hex())
+
+	_, blocked, err := k.MsgCircuitBreaker(ctx, msgSend)
+	require.NoError(t, err)
+	require.True(t, blocked)
+	for _, tc := range []struct {
+		target  common.Address
+		input   []byte
+		blocked bool
+	}{
+		{bridge, common.FromHex("0xa9059cbb0000"), true},
+		{bridge, common.FromHex("0x23b872dd"), false},
+		{bridge, nil, false},
+		{precompile, nil, true},
+	} {
+		_, blocked, err := k.CallCircuitBreaker(ctx, tc.target, tc.input)
+		require.NoError(t, err)
+		require.Equal(t, tc.blocked, blocked, "%s %x", tc.target, tc.input)
+	}
+
+	// The precompile breaker expires first and reactivates the precompile.
+	ctx = ctx.WithBlockHeight(15)
+	require.NoError(t, k.ExpireCircuitBreakers(ctx))
+	require.Contains(t, app.EVMKeeper.GetParams(ctx).ActiveStaticPrecompiles, precompile.Hex())
+	_, blocked, err = k.CallCircuitBreaker(ctx, precompile, nil)
+	require.NoError(t, err)
+	require.False(t, blocked)
+
+	// Governance resets the message breaker before it expires.
+	require.NoError(t, k.ResetCircuitBreaker(ctx, k.GetAuthority(), msgKind, msgSend, ""))
+	_, blocked, err = k.MsgCircuitBreaker(ctx, msgSend)
+	require.NoError(t, err)
+	require.False(t, blocked)
+	require.ErrorContains(t, k.ResetCircuitBreaker(ctx, k.GetAuthority(), msgKind, msgSend, ""), "no circuit breaker")
+
+	breakers, err := k.GetCircuitBreakers(ctx)
+	require.NoError(t, err)
+	require.Len(t, breakers, 1)
+	require.Equal(t, "0xa9059cbb", breakers[0].Selector)
+	gs := ynxtypes.DefaultGenesis()
+	gs.CircuitBreakers = breakers
+	require.NoError(t, gs.Validate())
+}
+
+func TestCircuitBreakerSwapsCode(t *testing.T) {
+	app, ctx := newSystemContractsTestApp(t)
+	k := app.YNXKeeper
+
+	guardian := sdk.AccAddress(make20(0xa1))
+	params, err := k.Params.Get(ctx)
+	require.NoError(t, err)
+	params.CircuitGuardianAddress = guardian.String()
+	require.NoError(t, k.Params.Set(ctx, params))
+
+	// bridge stores the first word of its calldata; proxy forwards its calldata to bridge and
+	// reverts if that call fails.
+	bridge := common.BytesToAddress(make20(0xb1))
+	proxy := common.BytesToAddress(make20(0xb2))
+	caller := common.BytesToAddress(make20(0xb3))
+	bridgeCode := common.FromHex("0x60003560005500")
+	setCode(app, ctx, bridge, bridgeCode)
+	setCode(app, ctx, proxy, common.FromHex("0x366000600037600060003660006000"+"73"+bridge.Hex()[2:]+"5af1602d57600080fd5b00"))
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, caller.Bytes()))
+	original := app.EVMKeeper.GetCodeHash(ctx, bridge)
+	shadow := ynxkeeper.CircuitBreakerShadowAddress(bridge)
+
+	call := func(to common.Address, input string) error {
+		// A failed call consumes the whole limit of the gas meter, so each call gets its own.
+		callCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+		_, err := app.EVMKeeper.CallEVMWithData(callCtx, caller, &to, common.FromHex(input), true, nil)
+		return err
+	}
+	stored := func() common.Hash { return app.EVMKeeper.GetState(ctx, bridge, common.Hash{}) }
+
+	const callKind = ynxtypes.CircuitBreakerKind_CIRCUIT_BREAKER_KIND_EVM_CALL
+	b, err := k.TripCircuitBreaker(ctx, guardian, callKind, bridge.Hex(), "0xa9059cbb", 10, "")
+	require.NoError(t, err)
+	require.Equal(t, original.Hex(), b.OriginalCodeHash)
+	require.NotEqual(t, original, app.EVMKeeper.GetCodeHash(ctx, bridge))
+	require.Equal(t, bridgeCode, k.GetCode(ctx, shadow))
+
+	// The blocked selector reverts whoever calls it; the other calls run the original code in the
+	// storage of bridge.
+	for _, to := range []common.Address{bridge, proxy} {
+		require.Error(t, call(to, "0xa9059cbb01"), to)
+		require.NoError(t, call(to, "0x23b872dd02"), to)
+		require.Equal(t, common.BytesToHash(common.RightPadBytes(common.FromHex("0x23b872dd02"), 32)), stored())
+		require.NoError(t, call(to, ""), to)
+	}
+
+	// A breaker on every call reverts the other selectors too, until it is lifted.
+	_, err = k.TripCircuitBreaker(ctx, guardian, callKind, bridge.Hex(), "", 20, "")
+	require.NoError(t, err)
+	require.Error(t, call(proxy, "0x23b872dd02"))
+	require.NoError(t, k.ResetCircuitBreaker(ctx, k.GetAuthority(), callKind, bridge.Hex(), ""))
+	require.Error(t, call(proxy, "0xa9059cbb01"))
+	require.NoError(t, call(proxy, "0x23b872dd03"))
+
+	// Lifting the last breaker restores the original code.
+	ctx = ctx.WithBlockHeight(b.ExpiryHeight)
+	require.NoError(t, k.ExpireCircuitBreakers(ctx))
+	require.Equal(t, original, app.EVMKeeper.GetCodeHash(ctx, bridge))
+	require.Empty(t, k.GetCode(ctx, shadow))
+	require.NoError(t, call(proxy, "0xa9059cbb01"))
+	require.Equal(t, common.BytesToHash(common.RightPadBytes(common.FromHex("0xa9059cbb01"), 32)), stored())
+}
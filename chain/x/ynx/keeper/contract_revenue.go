@@ -0,0 +1,174 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
+)
+
+// RegisterContractRevenue registers contract for developer fee rebates paid to withdraw. The
+// deployer proves it created the contract by the account nonce of the deploying transaction:
+// contract must be the CREATE address derived from deployer and nonce.
+func (k Keeper) RegisterContractRevenue(ctx context.Context, deployer common.Address, contract common.Address, nonce uint64, withdraw sdk.AccAddress) error {
+	if created := crypto.CreateAddress(deployer, nonce); created != contract {
+		return errorsmod.Wrapf(errortypes.ErrUnauthorized, "contract %s was not created by %s at nonce %d", contract.Hex(), deployer.Hex(), nonce)
+	}
+	return k.setContractRevenue(ctx, deployer, contract, withdraw)
+}
+
+// RegisterSelfContractRevenue registers contract for developer fee rebates on its own behalf, e.g.
+// from its constructor through the IYNXProtocol precompile. The contract is recorded as its own
+// deployer.
+//
+// origin is the sender of the transaction making the call. An externally owned account calling
+// on its own behalf is the origin, so only contracts can register themselves.
+func (k Keeper) RegisterSelfContractRevenue(ctx context.Context, contract common.Address, origin common.Address, withdraw sdk.AccAddress) error {
+	if contract == origin {
+		return errorsmod.Wrapf(errortypes.ErrUnauthorized, "%s is not a contract", contract.Hex())
+	}
+	return k.setContractRevenue(ctx, contract, contract, withdraw)
+}
+
+func (k Keeper) setContractRevenue(ctx context.Context, deployer common.Address, contract common.Address, withdraw sdk.AccAddress) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// Contracts calling the precompile from their constructor have no code yet; their
+	// registration is proven by the call itself, which no transaction origin can make.
+	if deployer != contract && !k.evmKeeper.IsContract(sdkCtx, contract) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "no contract deployed at %s", contract.Hex())
+	}
+
+	has, err := k.ContractRevenues.Has(ctx, contract.Bytes())
+	if err != nil {
+		return err
+	}
+	if has {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "contract %s is already registered", contract.Hex())
+	}
+
+	if withdraw.Empty() {
+		withdraw = sdk.AccAddress(deployer.Bytes())
+	}
+	if err := k.validateWithdrawAddress(ctx, withdraw); err != nil {
+		return err
+	}
+	cr := ynxtypes.ContractRevenue{
+		ContractAddress: contract.Hex(),
+		DeployerAddress: sdk.AccAddress(deployer.Bytes()).String(),
+		WithdrawAddress: withdraw.String(),
+	}
+	if err := k.ContractRevenues.Set(ctx, contract.Bytes(), cr); err != nil {
+		return err
+	}
+
+	return sdkCtx.EventManager().EmitTypedEvent(&ynxtypes.EventContractRevenueRegistered{
+		ContractAddress: cr.ContractAddress,
+		DeployerAddress: cr.DeployerAddress,
+		WithdrawAddress: cr.WithdrawAddress,
+	})
+}
+
+// validateWithdrawAddress rejects withdraw addresses the developer fee rebate cannot be paid to:
+// addresses the bank module blocks from receiving funds, and module accounts.
+func (k Keeper) validateWithdrawAddress(ctx context.Context, withdraw sdk.AccAddress) error {
+	if k.bankKeeper.BlockedAddr(withdraw) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "withdraw address %s is not allowed to receive funds", withdraw)
+	}
+	if _, ok := k.accountKeeper.GetAccount(ctx, withdraw).(sdk.ModuleAccountI); ok {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "withdraw address %s is a module account", withdraw)
+	}
+	return nil
+}
+
+// UpdateContractRevenue changes the withdraw address of contract. It must be called by the
+// address that registered the contract.
+func (k Keeper) UpdateContractRevenue(ctx context.Context, deployer common.Address, contract common.Address, withdraw sdk.AccAddress) error {
+	cr, err := k.getOwnedContractRevenue(ctx, deployer, contract)
+	if err != nil {
+		return err
+	}
+
+	if withdraw.Empty() {
+		withdraw = sdk.AccAddress(deployer.Bytes())
+	}
+	if err := k.validateWithdrawAddress(ctx, withdraw); err != nil {
+		return err
+	}
+	cr.WithdrawAddress = withdraw.String()
+	if err := k.ContractRevenues.Set(ctx, contract.Bytes(), cr); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&ynxtypes.EventContractRevenueUpdated{
+		ContractAddress: cr.ContractAddress,
+		DeployerAddress: cr.DeployerAddress,
+		WithdrawAddress: cr.WithdrawAddress,
+	})
+}
+
+// CancelContractRevenue removes the registration of contract. It must be called by the address
+// that registered the contract.
+func (k Keeper) CancelContractRevenue(ctx context.Context, deployer common.Address, contract common.Address) error {
+	cr, err := k.getOwnedContractRevenue(ctx, deployer, contract)
+	if err != nil {
+		return err
+	}
+
+	if err := k.ContractRevenues.Remove(ctx, contract.Bytes()); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&ynxtypes.EventContractRevenueCancelled{
+		ContractAddress: cr.ContractAddress,
+		DeployerAddress: cr.DeployerAddress,
+	})
+}
+
+func (k Keeper) getOwnedContractRevenue(ctx context.Context, deployer common.Address, contract common.Address) (ynxtypes.ContractRevenue, error) {
+	cr, found, err := k.GetContractRevenue(ctx, contract)
+	if err != nil {
+		return ynxtypes.ContractRevenue{}, err
+	}
+	if !found {
+		return ynxtypes.ContractRevenue{}, errorsmod.Wrapf(errortypes.ErrNotFound, "contract %s is not registered", contract.Hex())
+	}
+	if cr.DeployerAddress != sdk.AccAddress(deployer.Bytes()).String() {
+		return ynxtypes.ContractRevenue{}, errorsmod.Wrapf(errortypes.ErrUnauthorized, "contract %s was registered by %s", contract.Hex(), cr.DeployerAddress)
+	}
+	return cr, nil
+}
+
+// GetContractRevenue returns the registration of contract, if any.
+func (k Keeper) GetContractRevenue(ctx context.Context, contract common.Address) (ynxtypes.ContractRevenue, bool, error) {
+	cr, err := k.ContractRevenues.Get(ctx, contract.Bytes())
+	if errors.Is(err, collections.ErrNotFound) {
+		return ynxtypes.ContractRevenue{}, false, nil
+	}
+	if err != nil {
+		return ynxtypes.ContractRevenue{}, false, err
+	}
+	return cr, true, nil
+}
+
+// GetContractRevenues returns all registered contracts ordered by contract address. A non-empty
+// deployer restricts the result to contracts registered by that bech32 address.
+func (k Keeper) GetContractRevenues(ctx context.Context, deployer string) ([]ynxtypes.ContractRevenue, error) {
+	out := []ynxtypes.ContractRevenue{}
+	err := k.ContractRevenues.Walk(ctx, nil, func(_ []byte, cr ynxtypes.ContractRevenue) (bool, error) {
+		if deployer == "" || cr.DeployerAddress == deployer {
+			out = append(out, cr)
+		}
+		return false, nil
+	})
+	return out, err
+}
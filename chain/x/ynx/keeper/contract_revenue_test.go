@@ -0,0 +1,176 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/evm/x/vm/statedb"
+
+	ynxconfig "github.com/JiahaoAlbus/YNX/chain/config"
+	ynxkeeper "github.com/JiahaoAlbus/YNX/chain/x/ynx/keeper"
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
+)
+
+func TestRegisterContractRevenue(t *testing.T) {
+	app, ctx := newTestApp(t, 1)
+
+	deployer := common.BytesToAddress(make20(0x33))
+	contract := crypto.CreateAddress(deployer, 3)
+	withdraw := sdk.AccAddress(make20(0x44))
+
+	msgServer := ynxkeeper.NewMsgServerImpl(app.YNXKeeper)
+	msg := &ynxtypes.MsgRegisterContractRevenue{
+		DeployerAddress: sdk.AccAddress(deployer.Bytes()).String(),
+		ContractAddress: contract.Hex(),
+		Nonce:           3,
+		WithdrawAddress: withdraw.String(),
+	}
+
+	// No code is deployed at the contract address yet.
+	_, err := msgServer.RegisterContractRevenue(ctx, msg)
+	require.Error(t, err)
+
+	code := []byte{0x60, 0x00}
+	codeHash := crypto.Keccak256(code)
+	app.EVMKeeper.SetCode(ctx, codeHash, code)
+	require.NoError(t, app.EVMKeeper.SetAccount(ctx, contract, statedb.Account{Balance: new(uint256.Int), CodeHash: codeHash}))
+
+	wrongNonce := *msg
+	wrongNonce.Nonce = 4
+	_, err = msgServer.RegisterContractRevenue(ctx, &wrongNonce)
+	require.Error(t, err)
+
+	_, err = msgServer.RegisterContractRevenue(ctx, msg)
+	require.NoError(t, err)
+	_, err = msgServer.RegisterContractRevenue(ctx, msg)
+	require.Error(t, err)
+
+	queryServer := ynxkeeper.NewQueryServerImpl(app.YNXKeeper)
+	res, err := queryServer.ContractRevenue(ctx, &ynxtypes.QueryContractRevenueRequest{ContractAddress: contract.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, withdraw.String(), res.ContractRevenue.WithdrawAddress)
+
+	// Only the deployer can change the registration.
+	_, err = msgServer.UpdateContractRevenue(ctx, &ynxtypes.MsgUpdateContractRevenue{
+		DeployerAddress: withdraw.String(),
+		ContractAddress: contract.Hex(),
+	})
+	require.Error(t, err)
+
+	_, err = msgServer.UpdateContractRevenue(ctx, &ynxtypes.MsgUpdateContractRevenue{
+		DeployerAddress: msg.DeployerAddress,
+		ContractAddress: contract.Hex(),
+	})
+	require.NoError(t, err)
+
+	all, err := queryServer.ContractRevenues(ctx, &ynxtypes.QueryContractRevenuesRequest{DeployerAddress: msg.DeployerAddress})
+	require.NoError(t, err)
+	require.Len(t, all.ContractRevenues, 1)
+	require.Equal(t, msg.DeployerAddress, all.ContractRevenues[0].WithdrawAddress)
+
+	_, err = msgServer.CancelContractRevenue(ctx, &ynxtypes.MsgCancelContractRevenue{
+		DeployerAddress: msg.DeployerAddress,
+		ContractAddress: contract.Hex(),
+	})
+	require.NoError(t, err)
+
+	_, err = queryServer.ContractRevenue(ctx, &ynxtypes.QueryContractRevenueRequest{ContractAddress: contract.Hex()})
+	require.Error(t, err)
+}
+
+func TestContractRevenueRejectsBlockedWithdrawAddress(t *testing.T) {
+	app, ctx := newTestApp(t, 1)
+
+	deployer := common.BytesToAddress(make20(0x33))
+	contract := crypto.CreateAddress(deployer, 0)
+	code := []byte{0x60, 0x00}
+	codeHash := crypto.Keccak256(code)
+	app.EVMKeeper.SetCode(ctx, codeHash, code)
+	require.NoError(t, app.EVMKeeper.SetAccount(ctx, contract, statedb.Account{Balance: new(uint256.Int), CodeHash: codeHash}))
+
+	// The x/ynx module account is blocked; the gov module account is a module account.
+	for _, withdraw := range []sdk.AccAddress{
+		authtypes.NewModuleAddress(ynxtypes.ModuleName),
+		authtypes.NewModuleAddress(govtypes.ModuleName),
+	} {
+		msgServer := ynxkeeper.NewMsgServerImpl(app.YNXKeeper)
+		_, err := msgServer.RegisterContractRevenue(ctx, &ynxtypes.MsgRegisterContractRevenue{
+			DeployerAddress: sdk.AccAddress(deployer.Bytes()).String(),
+			ContractAddress: contract.Hex(),
+			Nonce:           0,
+			WithdrawAddress: withdraw.String(),
+		})
+		require.ErrorContains(t, err, withdraw.String())
+
+		// Contracts registering themselves through the precompile are checked alike.
+		self := common.BytesToAddress(make20(0x55))
+		require.ErrorContains(t, app.YNXKeeper.RegisterSelfContractRevenue(ctx, self, deployer, withdraw), withdraw.String())
+	}
+
+	withdraw := sdk.AccAddress(make20(0x44))
+	require.NoError(t, app.YNXKeeper.RegisterContractRevenue(ctx, deployer, contract, 0, withdraw))
+	err := app.YNXKeeper.UpdateContractRevenue(ctx, deployer, contract, authtypes.NewModuleAddress(ynxtypes.ModuleName))
+	require.ErrorContains(t, err, "not allowed to receive funds")
+
+	cr, err := app.YNXKeeper.ContractRevenues.Get(ctx, contract.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, withdraw.String(), cr.WithdrawAddress)
+}
+
+func TestSplitContractTxFeeDeveloperRebate(t *testing.T) {
+	app, ctx := newTestApp(t, 1)
+
+	treasury := sdk.AccAddress(make20(0x22))
+	withdraw := sdk.AccAddress(make20(0x44))
+	registered := common.BytesToAddress(make20(0x55))
+	unregistered := common.BytesToAddress(make20(0x66))
+
+	params := ynxtypes.DefaultParams()
+	params.TreasuryAddress = treasury.String()
+	params.FeeDeveloperBps = 1_000
+	require.NoError(t, app.YNXKeeper.Params.Set(ctx, params))
+	origin := common.BytesToAddress(make20(0x77))
+	require.ErrorContains(t, app.YNXKeeper.RegisterSelfContractRevenue(ctx, origin, origin, withdraw), "is not a contract")
+	require.NoError(t, app.YNXKeeper.RegisterSelfContractRevenue(ctx, registered, origin, withdraw))
+
+	// Registered addresses earn no rebate until they hold code.
+	fee := sdk.NewCoins(sdk.NewCoin(ynxconfig.BaseDenom, sdkmath.NewInt(10_000)))
+	fundFeeCollector(t, app, ctx, fee)
+	require.NoError(t, app.YNXKeeper.SplitContractTxFee(ctx, fee, registered))
+	require.True(t, app.BankKeeper.GetBalance(ctx, withdraw, ynxconfig.BaseDenom).Amount.IsZero())
+
+	code := []byte{0x60, 0x00}
+	codeHash := crypto.Keccak256(code)
+	app.EVMKeeper.SetCode(ctx, codeHash, code)
+	require.NoError(t, app.EVMKeeper.SetAccount(ctx, registered, statedb.Account{Balance: new(uint256.Int), CodeHash: codeHash}))
+
+	fundFeeCollector(t, app, ctx, fee)
+	require.NoError(t, app.YNXKeeper.SplitContractTxFee(ctx, fee, registered))
+
+	require.Equal(t, sdkmath.NewInt(1_000), app.BankKeeper.GetBalance(ctx, withdraw, ynxconfig.BaseDenom).Amount)
+
+	rec, err := app.YNXKeeper.Revenue.Get(ctx, ynxconfig.BaseDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(1_000), rec.FeeDevelopers)
+	require.Equal(t, sdkmath.NewInt(9_000), rec.FeeValidators)
+
+	// Calls to unregistered contracts leave the developer share to validators.
+	fundFeeCollector(t, app, ctx, fee)
+	require.NoError(t, app.YNXKeeper.SplitContractTxFee(ctx, fee, unregistered))
+
+	require.Equal(t, sdkmath.NewInt(1_000), app.BankKeeper.GetBalance(ctx, withdraw, ynxconfig.BaseDenom).Amount)
+	rec, err = app.YNXKeeper.Revenue.Get(ctx, ynxconfig.BaseDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(1_000), rec.FeeDevelopers)
+	require.Equal(t, sdkmath.NewInt(14_000), rec.FeeValidators)
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"embed"
 	"encoding/json"
 	"fmt"
@@ -8,25 +9,57 @@ import (
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
 )
 
 //go:embed contracts/*.json
 var contractsFS embed.FS
 
 type hardhatArtifact struct {
-	ABI      json.RawMessage `json:"abi"`
-	Bytecode string          `json:"bytecode"`
+	ABI                 json.RawMessage                 `json:"abi"`
+	Bytecode            string                          `json:"bytecode"`
+	DeployedBytecode    string                          `json:"deployedBytecode"`
+	ImmutableReferences map[string][]immutableReference `json:"immutableReferences"`
+	BuildInfoID         string                          `json:"buildInfoId"`
 }
 
-func loadHardhatArtifact(contractName string) (abi.ABI, []byte, error) {
+// immutableReference is a range of the runtime code the constructor fills with an immutable value.
+type immutableReference struct {
+	Start  int `json:"start"`
+	Length int `json:"length"`
+}
+
+// defaultSystemContractArtifact returns the artifact the default manifest deploys for the system
+// contract name, including the airdrop distributor, or "" for contracts outside it. Both deploy
+// modes use the same artifacts.
+func defaultSystemContractArtifact(name string) string {
+	contracts := ynxtypes.DefaultSystemManifest(ynxtypes.SystemDeployMode_SYSTEM_DEPLOY_MODE_CREATE).Contracts
+	for _, c := range append(contracts, ynxtypes.AirdropManifestContract(false)) {
+		if c.Name == name {
+			return c.Artifact
+		}
+	}
+	return ""
+}
+
+func readHardhatArtifact(contractName string) (hardhatArtifact, error) {
 	path := fmt.Sprintf("contracts/%s.json", contractName)
 	bz, err := contractsFS.ReadFile(path)
 	if err != nil {
-		return abi.ABI{}, nil, err
+		return hardhatArtifact{}, err
 	}
 
 	var art hardhatArtifact
 	if err := json.Unmarshal(bz, &art); err != nil {
+		return hardhatArtifact{}, err
+	}
+	return art, nil
+}
+
+func loadHardhatArtifact(contractName string) (abi.ABI, []byte, error) {
+	art, err := readHardhatArtifact(contractName)
+	if err != nil {
 		return abi.ABI{}, nil, err
 	}
 
@@ -46,3 +79,50 @@ func loadHardhatArtifact(contractName string) (abi.ABI, []byte, error) {
 
 	return parsed, bytecode, nil
 }
+
+// runtimeArtifact is the runtime code of an embedded artifact.
+type runtimeArtifact struct {
+	code        []byte
+	immutables  []immutableReference
+	buildInfoID string
+}
+
+func loadRuntimeArtifact(contractName string) (runtimeArtifact, error) {
+	art, err := readHardhatArtifact(contractName)
+	if err != nil {
+		return runtimeArtifact{}, err
+	}
+
+	code, err := hexutil.Decode(art.DeployedBytecode)
+	if err != nil {
+		return runtimeArtifact{}, err
+	}
+	if len(code) == 0 {
+		return runtimeArtifact{}, fmt.Errorf("empty deployed bytecode for %s", contractName)
+	}
+
+	var immutables []immutableReference
+	for _, refs := range art.ImmutableReferences {
+		immutables = append(immutables, refs...)
+	}
+	return runtimeArtifact{code: code, immutables: immutables, buildInfoID: art.BuildInfoID}, nil
+}
+
+// matches reports whether code is the artifact's runtime code, whatever the immutable values its
+// constructor filled in.
+func (a runtimeArtifact) matches(code []byte) bool {
+	if len(code) != len(a.code) {
+		return false
+	}
+
+	live := bytes.Clone(code)
+	expected := bytes.Clone(a.code)
+	for _, ref := range a.immutables {
+		if ref.Start < 0 || ref.Length < 0 || ref.Start+ref.Length > len(live) {
+			return false
+		}
+		clear(live[ref.Start : ref.Start+ref.Length])
+		clear(expected[ref.Start : ref.Start+ref.Length])
+	}
+	return bytes.Equal(live, expected)
+}
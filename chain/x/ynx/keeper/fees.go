@@ -2,7 +2,11 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 
@@ -15,67 +19,295 @@ import (
 	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
 )
 
-func (k Keeper) SplitTxFee(ctx context.Context, fee sdk.Coin) error {
-	if fee.Amount.IsZero() {
-		return nil
+// SplitTxFee splits transaction fees held by the fee collector according to the fee split
+// params and the fee denom policy of each denom.
+func (k Keeper) SplitTxFee(ctx context.Context, fees sdk.Coins) error {
+	return k.splitTxFee(ctx, fees, nil)
+}
+
+// SplitContractTxFee splits the fees of an EVM transaction that called contract. If contract is
+// registered for developer fee rebates and holds code, fee_developer_bps of the fees goes to its
+// withdraw address.
+func (k Keeper) SplitContractTxFee(ctx context.Context, fees sdk.Coins, contract common.Address) error {
+	cr, found, err := k.GetContractRevenue(ctx, contract)
+	if err != nil {
+		return err
 	}
+	if !found || !k.evmKeeper.IsContract(sdk.UnwrapSDKContext(ctx), contract) {
+		return k.splitTxFee(ctx, fees, nil)
+	}
+	return k.splitTxFee(ctx, fees, &cr)
+}
 
-	params, err := k.Params.Get(ctx)
+// HoldTxFee records fees an EVM transaction was charged up front by the ante handler. The post
+// handler releases them when it splits the final fee of the transaction. Failed transactions
+// never reach that point with their state intact, so their fees stay held until
+// SplitHeldTxFees splits them at the end of the block.
+func (k Keeper) HoldTxFee(ctx context.Context, fees sdk.Coins) error {
+	for _, fee := range fees {
+		held, err := k.HeldTxFees.Get(ctx, fee.Denom)
+		if errors.Is(err, collections.ErrNotFound) {
+			held = sdkmath.ZeroInt()
+		} else if err != nil {
+			return err
+		}
+		if err := k.HeldTxFees.Set(ctx, fee.Denom, held.Add(fee.Amount)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReleaseTxFee releases fees held by HoldTxFee once they have been split.
+func (k Keeper) ReleaseTxFee(ctx context.Context, fees sdk.Coins) error {
+	for _, fee := range fees {
+		held, err := k.HeldTxFees.Get(ctx, fee.Denom)
+		if errors.Is(err, collections.ErrNotFound) {
+			held = sdkmath.ZeroInt()
+		} else if err != nil {
+			return err
+		}
+		if held.LT(fee.Amount) {
+			return errorsmod.Wrapf(errortypes.ErrLogic, "releasing %s of held %s%s", fee, held, fee.Denom)
+		}
+		if held.Equal(fee.Amount) {
+			if err := k.HeldTxFees.Remove(ctx, fee.Denom); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := k.HeldTxFees.Set(ctx, fee.Denom, held.Sub(fee.Amount)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SplitHeldTxFees splits the fees still held at the end of a block, i.e. the full up-front fees
+// of the failed EVM transactions of the block, which stay in the fee collector. They are split
+// like the fees of Cosmos transactions: a failed call pays no developer rebate.
+func (k Keeper) SplitHeldTxFees(ctx context.Context) error {
+	fees := sdk.NewCoins()
+	err := k.HeldTxFees.Walk(ctx, nil, func(denom string, amount sdkmath.Int) (bool, error) {
+		fees = fees.Add(sdk.NewCoin(denom, amount))
+		return false, nil
+	})
 	if err != nil {
 		return err
 	}
+	if err := k.HeldTxFees.Clear(ctx, nil); err != nil {
+		return err
+	}
 
-	burnBps := params.FeeBurnBps
-	treasuryBps := params.FeeTreasuryBps
-	founderBps := params.FeeFounderBps
+	return k.SplitTxFee(ctx, fees)
+}
 
-	if uint64(burnBps)+uint64(treasuryBps)+uint64(founderBps) > ynxtypes.BPSDenominator {
-		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "fee split bps exceeds %d", ynxtypes.BPSDenominator)
+func (k Keeper) splitTxFee(ctx context.Context, fees sdk.Coins, cr *ynxtypes.ContractRevenue) error {
+	if fees.IsZero() {
+		return nil
+	}
+
+	params, mintDenom, err := k.feeSplitParams(ctx, cr)
+	if err != nil {
+		return err
 	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, fee := range fees {
+		if err := k.splitFee(sdkCtx, params, params.FeeSplitModeFor(fee.Denom, mintDenom), fee, cr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PreviewTxFeeSplit returns how SplitTxFee would split fee at the current height, as the fee
+// fields of a revenue record, without moving any funds.
+func (k Keeper) PreviewTxFeeSplit(ctx context.Context, fee sdk.Coin) (ynxtypes.RevenueRecord, error) {
+	params, mintDenom, err := k.feeSplitParams(ctx, nil)
+	if err != nil {
+		return ynxtypes.RevenueRecord{}, err
+	}
+
+	burn, treasury, founder, developer, err := feeSplitShares(params, params.FeeSplitModeFor(fee.Denom, mintDenom), fee)
+	if err != nil {
+		return ynxtypes.RevenueRecord{}, err
+	}
+
+	split := ynxtypes.NewRevenueRecord(fee.Denom)
+	split.FeeBurned = burn
+	split.FeeTreasury = treasury
+	split.FeeFounder = founder
+	split.FeeDevelopers = developer
+	split.FeeValidators = fee.Amount.Sub(burn).Sub(treasury).Sub(founder).Sub(developer)
+	return split, nil
+}
+
+// feeSplitParams returns the params a fee split at the current height uses, with the developer
+// share only for fees of a registered contract, and the mint denom.
+func (k Keeper) feeSplitParams(ctx context.Context, cr *ynxtypes.ContractRevenue) (ynxtypes.Params, string, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return ynxtypes.Params{}, "", err
+	}
+
+	params.FeeFounderBps = params.EffectiveFeeFounderBps(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	if cr == nil {
+		params.FeeDeveloperBps = 0
+	}
+
+	if uint64(params.FeeBurnBps)+uint64(params.FeeTreasuryBps)+uint64(params.FeeFounderBps)+uint64(params.FeeDeveloperBps) > ynxtypes.BPSDenominator {
+		return ynxtypes.Params{}, "", errorsmod.Wrapf(errortypes.ErrInvalidRequest, "fee split bps exceeds %d", ynxtypes.BPSDenominator)
+	}
+
+	mintParams, err := k.mintKeeper.Params.Get(ctx)
+	if err != nil {
+		return ynxtypes.Params{}, "", err
+	}
+
+	return params, mintParams.MintDenom, nil
+}
+
+// feeShare is a protocol share of a single fee. The empty recipient burns the share.
+type feeShare struct {
+	recipient string
+	amount    sdkmath.Int
+
+	// treasury marks the treasury share, whose payouts are reconciled with the revenue ledger.
+	treasury bool
+}
+
+func (k Keeper) splitFee(ctx sdk.Context, params ynxtypes.Params, mode ynxtypes.FeeSplitMode, fee sdk.Coin, cr *ynxtypes.ContractRevenue) error {
+	if fee.Amount.IsZero() {
+		return nil
+	}
 
 	feeCollectorAddr := k.accountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
 	if feeCollectorAddr == nil {
 		return errorsmod.Wrap(errortypes.ErrLogic, "fee collector module account not set")
 	}
 
-	amount := fee.Amount
-	burn := amount.Mul(sdkmath.NewIntFromUint64(uint64(burnBps))).QuoRaw(ynxtypes.BPSDenominator)
-	treasury := amount.Mul(sdkmath.NewIntFromUint64(uint64(treasuryBps))).QuoRaw(ynxtypes.BPSDenominator)
-	founder := amount.Mul(sdkmath.NewIntFromUint64(uint64(founderBps))).QuoRaw(ynxtypes.BPSDenominator)
-
-	// Burn.
-	if !burn.IsZero() {
-		coins := sdk.NewCoins(sdk.NewCoin(fee.Denom, burn))
-		if err := k.bankKeeper.SendCoinsFromModuleToModule(sdkCtx, authtypes.FeeCollectorName, evmtypes.ModuleName, coins); err != nil {
-			return err
-		}
-		if err := k.bankKeeper.BurnCoins(sdkCtx, evmtypes.ModuleName, coins); err != nil {
+	burn, treasury, founder, developer, err := feeSplitShares(params, mode, fee)
+	if err != nil {
+		return err
+	}
+
+	contract, withdraw := "", ""
+	if cr != nil {
+		contract, withdraw = cr.ContractAddress, cr.WithdrawAddress
+	}
+
+	shares := []feeShare{
+		{recipient: "", amount: burn},
+		{recipient: params.TreasuryAddress, amount: treasury, treasury: true},
+		{recipient: params.FounderAddress, amount: founder},
+		{recipient: withdraw, amount: developer},
+	}
+	if params.FeeSettlementIntervalBlocks > 0 {
+		if err := k.accrueFeeShares(ctx, fee.Denom, shares); err != nil {
 			return err
 		}
+	} else if err := k.payFeeShares(ctx, fee.Denom, shares); err != nil {
+		return err
 	}
 
-	// Treasury.
-	if !treasury.IsZero() && params.TreasuryAddress != "" {
-		addr, err := sdk.AccAddressFromBech32(params.TreasuryAddress)
+	return k.recordFeeSplit(ctx, fee.Denom, fee.Amount, burn, treasury, founder, developer, contract)
+}
+
+// feeSplitShares computes the protocol shares of fee under params and mode. Whatever is left
+// goes to validators.
+func feeSplitShares(params ynxtypes.Params, mode ynxtypes.FeeSplitMode, fee sdk.Coin) (burn, treasury, founder, developer sdkmath.Int, err error) {
+	amount := fee.Amount
+	burn = amount.Mul(sdkmath.NewIntFromUint64(uint64(params.FeeBurnBps))).QuoRaw(ynxtypes.BPSDenominator)
+	treasury = amount.Mul(sdkmath.NewIntFromUint64(uint64(params.FeeTreasuryBps))).QuoRaw(ynxtypes.BPSDenominator)
+	founder = amount.Mul(sdkmath.NewIntFromUint64(uint64(params.FeeFounderBps))).QuoRaw(ynxtypes.BPSDenominator)
+	developer = amount.Mul(sdkmath.NewIntFromUint64(uint64(params.FeeDeveloperBps))).QuoRaw(ynxtypes.BPSDenominator)
+
+	switch mode {
+	case ynxtypes.FeeSplitMode_FEE_SPLIT_MODE_FULL:
+	case ynxtypes.FeeSplitMode_FEE_SPLIT_MODE_NO_BURN:
+		burn = sdkmath.ZeroInt()
+	case ynxtypes.FeeSplitMode_FEE_SPLIT_MODE_TREASURY_ONLY:
+		treasury = burn.Add(treasury).Add(founder)
+		burn = sdkmath.ZeroInt()
+		founder = sdkmath.ZeroInt()
+	case ynxtypes.FeeSplitMode_FEE_SPLIT_MODE_PASSTHROUGH:
+		burn = sdkmath.ZeroInt()
+		treasury = sdkmath.ZeroInt()
+		founder = sdkmath.ZeroInt()
+		developer = sdkmath.ZeroInt()
+	default:
+		err = errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid fee split mode for %s: %s", fee.Denom, mode)
+		return burn, treasury, founder, developer, err
+	}
+
+	// Shares without a recipient stay in the fee collector for validators.
+	if params.TreasuryAddress == "" {
+		treasury = sdkmath.ZeroInt()
+	}
+	if params.FounderAddress == "" {
+		founder = sdkmath.ZeroInt()
+	}
+
+	return burn, treasury, founder, developer, nil
+}
+
+// payFeeShares burns and pays out the protocol shares of a fee right away.
+func (k Keeper) payFeeShares(ctx sdk.Context, denom string, shares []feeShare) error {
+	for _, share := range shares {
+		if share.amount.IsZero() {
+			continue
+		}
+		coins := sdk.NewCoins(sdk.NewCoin(denom, share.amount))
+
+		// Burn.
+		if share.recipient == "" {
+			if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, evmtypes.ModuleName, coins); err != nil {
+				return err
+			}
+			if err := k.bankKeeper.BurnCoins(ctx, evmtypes.ModuleName, coins); err != nil {
+				return err
+			}
+			continue
+		}
+
+		addr, err := sdk.AccAddressFromBech32(share.recipient)
 		if err != nil {
 			return errorsmod.Wrap(errortypes.ErrInvalidAddress, err.Error())
 		}
-		coins := sdk.NewCoins(sdk.NewCoin(fee.Denom, treasury))
-		if err := k.bankKeeper.SendCoinsFromModuleToAccount(sdkCtx, authtypes.FeeCollectorName, addr, coins); err != nil {
+		if share.treasury {
+			if err := k.sendToTreasuryObserved(ctx, authtypes.FeeCollectorName, addr, coins, coins); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, addr, coins); err != nil {
 			return err
 		}
 	}
 
-	// Founder.
-	if !founder.IsZero() && params.FounderAddress != "" {
-		addr, err := sdk.AccAddressFromBech32(params.FounderAddress)
-		if err != nil {
-			return errorsmod.Wrap(errortypes.ErrInvalidAddress, err.Error())
-		}
-		coins := sdk.NewCoins(sdk.NewCoin(fee.Denom, founder))
-		if err := k.bankKeeper.SendCoinsFromModuleToAccount(sdkCtx, authtypes.FeeCollectorName, addr, coins); err != nil {
+	return nil
+}
+
+// accrueFeeShares moves the protocol shares of a fee to the x/ynx module account in a single
+// transfer and records them for the next settlement.
+func (k Keeper) accrueFeeShares(ctx sdk.Context, denom string, shares []feeShare) error {
+	total := sdkmath.ZeroInt()
+	for _, share := range shares {
+		total = total.Add(share.amount)
+	}
+	if total.IsZero() {
+		return nil
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(denom, total))
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, ynxtypes.ModuleName, coins); err != nil {
+		return err
+	}
+
+	for _, share := range shares {
+		if err := k.addAccruedFeeShare(ctx, share.recipient, denom, share.amount, share.treasury); err != nil {
 			return err
 		}
 	}
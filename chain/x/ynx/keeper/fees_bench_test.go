@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ynx "github.com/JiahaoAlbus/YNX/chain"
+	ynxconfig "github.com/JiahaoAlbus/YNX/chain/config"
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
+)
+
+func newFeeBenchApp(b *testing.B, interval uint64) (*ynx.App, sdk.Context) {
+	b.Helper()
+
+	app, ctx := newTestApp(b, 1)
+
+	params := ynxtypes.DefaultParams()
+	params.TreasuryAddress = sdk.AccAddress(make20(0x22)).String()
+	params.FounderAddress = sdk.AccAddress(make20(0x11)).String()
+	params.FeeFounderBps = 500
+	params.FeeSettlementIntervalBlocks = interval
+	require.NoError(b, app.YNXKeeper.Params.Set(ctx, params))
+
+	return app, ctx
+}
+
+// BenchmarkSplitTxFee compares the per-transaction cost of paying fee shares out right away with
+// accruing them for epoch settlement.
+func BenchmarkSplitTxFee(b *testing.B) {
+	fee := sdk.NewCoins(sdk.NewCoin(ynxconfig.BaseDenom, sdkmath.NewInt(10_000)))
+
+	for _, bc := range []struct {
+		name     string
+		interval uint64
+	}{
+		{name: "per-tx", interval: 0},
+		{name: "batched", interval: 100},
+	} {
+		b.Run(bc.name, func(b *testing.B) {
+			app, ctx := newFeeBenchApp(b, bc.interval)
+
+			var gas storetypes.Gas
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				fundFeeCollector(b, app, ctx, fee)
+				txCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+				b.StartTimer()
+
+				require.NoError(b, app.YNXKeeper.SplitTxFee(txCtx, fee))
+				gas += txCtx.GasMeter().GasConsumed()
+			}
+			b.ReportMetric(float64(gas)/float64(b.N), "gas/tx")
+		})
+	}
+}
+
+// BenchmarkFeeSettlementBlock measures a block of fee-paying transactions followed by the
+// BeginBlock settlement, which only does work in batched mode.
+func BenchmarkFeeSettlementBlock(b *testing.B) {
+	const txsPerBlock = 100
+
+	fee := sdk.NewCoins(sdk.NewCoin(ynxconfig.BaseDenom, sdkmath.NewInt(10_000)))
+	blockFees := sdk.NewCoins(sdk.NewCoin(ynxconfig.BaseDenom, sdkmath.NewInt(10_000*txsPerBlock)))
+
+	for _, bc := range []struct {
+		name     string
+		interval uint64
+	}{
+		{name: "per-tx", interval: 0},
+		{name: "batched", interval: 1},
+	} {
+		b.Run(fmt.Sprintf("%s/%d-txs", bc.name, txsPerBlock), func(b *testing.B) {
+			app, ctx := newFeeBenchApp(b, bc.interval)
+
+			var gas storetypes.Gas
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				fundFeeCollector(b, app, ctx, blockFees)
+				blockCtx := ctx.WithBlockHeight(int64(i + 1)).WithGasMeter(storetypes.NewInfiniteGasMeter())
+				b.StartTimer()
+
+				for j := 0; j < txsPerBlock; j++ {
+					require.NoError(b, app.YNXKeeper.SplitTxFee(blockCtx, fee))
+				}
+				require.NoError(b, app.YNXKeeper.SettleFeeShares(blockCtx))
+				gas += blockCtx.GasMeter().GasConsumed()
+			}
+			b.ReportMetric(float64(gas)/float64(b.N), "gas/block")
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	ynxconfig "github.com/JiahaoAlbus/YNX/chain/config"
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
+)
+
+func TestSplitTxFeeDenomPolicies(t *testing.T) {
+	app, ctx := newTestApp(t, 1)
+
+	treasury := sdk.AccAddress(make20(0x22))
+	founder := sdk.AccAddress(make20(0x11))
+
+	params := ynxtypes.DefaultParams()
+	params.TreasuryAddress = treasury.String()
+	params.FounderAddress = founder.String()
+	params.FeeFounderBps = 500
+	params.FeeDenomPolicies = []ynxtypes.FeeDenomPolicy{
+		{Denom: "ibc/noburn", Mode: ynxtypes.FeeSplitMode_FEE_SPLIT_MODE_NO_BURN},
+		{Denom: "ibc/treasury", Mode: ynxtypes.FeeSplitMode_FEE_SPLIT_MODE_TREASURY_ONLY},
+		{Denom: "ibc/pass", Mode: ynxtypes.FeeSplitMode_FEE_SPLIT_MODE_PASSTHROUGH},
+	}
+	require.NoError(t, app.YNXKeeper.Params.Set(ctx, params))
+
+	fees := sdk.NewCoins(
+		sdk.NewCoin(ynxconfig.BaseDenom, sdkmath.NewInt(10_000)),
+		sdk.NewCoin("ibc/noburn", sdkmath.NewInt(10_000)),
+		sdk.NewCoin("ibc/treasury", sdkmath.NewInt(10_000)),
+		sdk.NewCoin("ibc/pass", sdkmath.NewInt(10_000)),
+		sdk.NewCoin("ibc/unlisted", sdkmath.NewInt(10_000)),
+	)
+	fundFeeCollector(t, app, ctx, fees)
+	require.NoError(t, app.YNXKeeper.SplitTxFee(ctx, fees))
+
+	for _, tc := range []struct {
+		denom                                 string
+		burned, treasury, founder, validators int64
+	}{
+		{denom: ynxconfig.BaseDenom, burned: 4_000, treasury: 1_000, founder: 500, validators: 4_500},
+		{denom: "ibc/noburn", treasury: 1_000, founder: 500, validators: 8_500},
+		{denom: "ibc/treasury", treasury: 5_500, validators: 4_500},
+		{denom: "ibc/pass", validators: 10_000},
+		{denom: "ibc/unlisted", validators: 10_000},
+	} {
+		rec, err := app.YNXKeeper.Revenue.Get(ctx, tc.denom)
+		require.NoError(t, err, tc.denom)
+		require.Equal(t, sdkmath.NewInt(tc.burned), rec.FeeBurned, tc.denom)
+		require.Equal(t, sdkmath.NewInt(tc.treasury), rec.FeeTreasury, tc.denom)
+		require.Equal(t, sdkmath.NewInt(tc.founder), rec.FeeFounder, tc.denom)
+		require.Equal(t, sdkmath.NewInt(tc.validators), rec.FeeValidators, tc.denom)
+
+		require.Equal(t, sdkmath.NewInt(tc.treasury), app.BankKeeper.GetBalance(ctx, treasury, tc.denom).Amount, tc.denom)
+		require.Equal(t, sdkmath.NewInt(tc.founder), app.BankKeeper.GetBalance(ctx, founder, tc.denom).Amount, tc.denom)
+	}
+
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	require.Equal(t, sdkmath.NewInt(10_000), app.BankKeeper.GetBalance(ctx, feeCollector, "ibc/pass").Amount)
+}
+
+func TestSplitTxFeeFounderFeeDecay(t *testing.T) {
+	app, ctx := newTestApp(t, 150)
+
+	founder := sdk.AccAddress(make20(0x11))
+
+	params := ynxtypes.DefaultParams()
+	params.FounderAddress = founder.String()
+	params.FeeFounderBps = 0
+	params.FounderFeeDecay = &ynxtypes.FounderFeeDecay{
+		StartHeight: 100,
+		EndHeight:   200,
+		StartBps:    1_000,
+		EndBps:      0,
+		Mode:        ynxtypes.FounderFeeDecayMode_FOUNDER_FEE_DECAY_MODE_LINEAR,
+	}
+	require.NoError(t, app.YNXKeeper.Params.Set(ctx, params))
+
+	fees := sdk.NewCoins(sdk.NewCoin(ynxconfig.BaseDenom, sdkmath.NewInt(10_000)))
+	fundFeeCollector(t, app, ctx, fees)
+	require.NoError(t, app.YNXKeeper.SplitTxFee(ctx, fees))
+
+	// Halfway through the schedule the founder share is 500 bps.
+	require.Equal(t, sdkmath.NewInt(500), app.BankKeeper.GetBalance(ctx, founder, ynxconfig.BaseDenom).Amount)
+
+	ctx = ctx.WithBlockHeight(200)
+	fundFeeCollector(t, app, ctx, fees)
+	require.NoError(t, app.YNXKeeper.SplitTxFee(ctx, fees))
+	require.Equal(t, sdkmath.NewInt(500), app.BankKeeper.GetBalance(ctx, founder, ynxconfig.BaseDenom).Amount)
+}
@@ -1,8 +1,13 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/collections"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
@@ -13,43 +18,230 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *ynxtypes.GenesisState) {
 		panic(err)
 	}
 
-	if err := k.Params.Set(ctx, data.Params); err != nil {
+	// Genesis files exported before inflation_treasury_bps was deprecated still carry it.
+	params := data.Params
+	if err := params.MigrateInflationTreasuryBps(); err != nil {
+		panic(err)
+	}
+	if err := k.Params.Set(ctx, params); err != nil {
 		panic(err)
 	}
 	if err := k.SystemConfig.Set(ctx, data.System); err != nil {
 		panic(err)
 	}
-	if err := k.SystemContracts.Set(ctx, data.SystemContracts); err != nil {
+	// Genesis files exported before the registry existed carry the v0 entries.
+	contracts := data.SystemContracts
+	if err := contracts.MigrateLegacyEntries(); err != nil {
+		panic(err)
+	}
+	if err := k.SystemContracts.Set(ctx, contracts); err != nil {
+		panic(err)
+	}
+	if err := k.Epoch.Set(ctx, data.Epoch); err != nil {
+		panic(err)
+	}
+	for _, r := range data.Revenue {
+		if err := k.Revenue.Set(ctx, r.Denom, r); err != nil {
+			panic(err)
+		}
+	}
+	for _, er := range data.EpochRevenue {
+		for _, r := range er.Revenue {
+			if err := k.EpochRevenue.Set(ctx, collections.Join(er.Epoch, r.Denom), r); err != nil {
+				panic(err)
+			}
+		}
+	}
+	for _, pp := range data.PendingParams {
+		if err := pp.MigrateInflationTreasuryBps(); err != nil {
+			panic(err)
+		}
+		if err := k.PendingParams.Set(ctx, pp.ActivationHeight, pp); err != nil {
+			panic(err)
+		}
+	}
+	for _, share := range data.AccruedFeeShares {
+		if err := k.AccruedFeeShares.Set(ctx, collections.Join(share.Recipient, share.Denom), share.Amount); err != nil {
+			panic(err)
+		}
+	}
+	for _, share := range data.AccruedTreasuryShares {
+		if err := k.AccruedTreasuryShares.Set(ctx, collections.Join(share.Recipient, share.Denom), share.Amount); err != nil {
+			panic(err)
+		}
+	}
+	for _, cr := range data.ContractRevenues {
+		contract, err := ynxtypes.ParseContractAddress(cr.ContractAddress)
+		if err != nil {
+			panic(err)
+		}
+		cr.ContractAddress = contract.Hex()
+		if err := k.ContractRevenues.Set(ctx, contract.Bytes(), cr); err != nil {
+			panic(err)
+		}
+	}
+	for _, sp := range data.Sponsorships {
+		policy, err := normalizeSponsorshipPolicy(sp.Policy)
+		if err != nil {
+			panic(err)
+		}
+		sp.Policy = policy
+		if err := k.setSponsorship(ctx, sp); err != nil {
+			panic(err)
+		}
+	}
+	if err := k.SponsorshipSeq.Set(ctx, data.NextSponsorshipId); err != nil {
 		panic(err)
 	}
+	for _, r := range data.Reconciliation {
+		if err := k.Reconciliation.Set(ctx, r.Denom, r); err != nil {
+			panic(err)
+		}
+	}
+	for _, lock := range data.VoteLocks {
+		account := sdk.MustAccAddressFromBech32(lock.Account)
+		if err := k.VoteLocks.Set(ctx, account, lock); err != nil {
+			panic(err)
+		}
+	}
+	for _, c := range data.VoteCheckpoints {
+		account := sdk.MustAccAddressFromBech32(c.Account)
+		if err := k.VoteCheckpoints.Set(ctx, collections.Join([]byte(account), c.Height), c.Votes); err != nil {
+			panic(err)
+		}
+	}
+	for _, c := range data.VoteSupplyCheckpoints {
+		if err := k.VoteSupplyCheckpoints.Set(ctx, c.Height, c.Votes); err != nil {
+			panic(err)
+		}
+	}
+	for _, voter := range data.StakeVoters {
+		delegator := sdk.MustAccAddressFromBech32(voter.Delegator)
+		if err := k.StakeVoters.Set(ctx, delegator, voter); err != nil {
+			panic(err)
+		}
+	}
+	for _, c := range data.StakeVoteCheckpoints {
+		account := sdk.MustAccAddressFromBech32(c.Account)
+		if err := k.StakeVoteCheckpoints.Set(ctx, collections.Join([]byte(account), c.Height), c.Votes); err != nil {
+			panic(err)
+		}
+	}
+	for _, c := range data.StakeVoteSupplyCheckpoints {
+		if err := k.StakeVoteSupplyCheckpoints.Set(ctx, c.Height, c.Votes); err != nil {
+			panic(err)
+		}
+	}
+	for _, b := range data.CircuitBreakers {
+		if err := k.CircuitBreakers.Set(ctx, collections.Join3(int32(b.Kind), b.Target, b.Selector), b); err != nil {
+			panic(err)
+		}
+	}
+	for _, s := range data.PreconfirmSignerSets {
+		if err := k.PreconfirmSignerSets.Set(ctx, s.ActivationEpoch, s); err != nil {
+			panic(err)
+		}
+	}
+	if data.LegacyNyxt != nil {
+		if err := k.LegacyNYXT.Set(ctx, *data.LegacyNyxt); err != nil {
+			panic(err)
+		}
+	}
+	// New chains, and genesis files exported before the reconciliation records were anchored,
+	// start reconciling from the imported state.
+	mintParams, err := k.mintKeeper.Params.Get(ctx)
+	if err != nil {
+		panic(err)
+	}
+	anchored := false
+	for _, r := range data.Reconciliation {
+		anchored = anchored || (r.Denom == mintParams.MintDenom && !r.SupplyAnchor.IsNil())
+	}
+	if !anchored {
+		if err := k.anchorReconciliation(ctx); err != nil {
+			panic(err)
+		}
+	}
 
 	if !data.System.Enabled {
 		return
 	}
+	// A genesis exported from a running chain carries the deployed contracts in the imported EVM
+	// state. They are verified instead of being deployed again.
+	if len(contracts.Contracts) > 0 {
+		if err := k.importSystemContracts(ctx, contracts); err != nil {
+			panic(err)
+		}
+		return
+	}
 
 	cacheCtx, write := ctx.CacheContext()
-	contracts, err := k.deploySystemContracts(cacheCtx, data.System)
-	if err != nil {
+	if _, err := k.initSystemContracts(cacheCtx, data.System); err != nil {
 		panic(err)
 	}
+	write()
+}
+
+// initSystemContracts deploys the system contracts of cfg and records their addresses and code
+// hashes.
+func (k Keeper) initSystemContracts(ctx sdk.Context, cfg ynxtypes.SystemConfig) (*systemDeployResult, error) {
+	result, err := k.deploySystemContracts(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	contracts := result.plan.contracts
+	for _, c := range result.contracts {
+		if err := k.attestSystemContract(ctx, &contracts, c.Name, common.HexToAddress(c.Address), c.Artifact); err != nil {
+			return nil, err
+		}
+	}
 
-	if err := k.SystemContracts.Set(cacheCtx, contracts); err != nil {
-		panic(err)
+	if err := k.SystemContracts.Set(ctx, contracts); err != nil {
+		return nil, err
 	}
 
 	// If treasury_address is unset, default it to the deployed treasury contract.
-	params, err := k.Params.Get(cacheCtx)
+	params, err := k.Params.Get(ctx)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
-	if params.TreasuryAddress == "" && contracts.Treasury != "" {
-		params.TreasuryAddress = mustHexToBech32Acc(cacheCtx, contracts.Treasury)
-		if err := k.Params.Set(cacheCtx, params); err != nil {
-			panic(err)
+	if treasury := contracts.Get("treasury"); params.TreasuryAddress == "" && treasury != "" {
+		params.TreasuryAddress = mustHexToBech32Acc(treasury)
+		if err := k.Params.Set(ctx, params); err != nil {
+			return nil, err
 		}
 	}
 
-	write()
+	return result, nil
+}
+
+// importSystemContracts checks that every system_contracts entry has code in the imported EVM
+// state and that its code hash is the attested one. Entries without an attestation are attested.
+func (k Keeper) importSystemContracts(ctx sdk.Context, contracts ynxtypes.SystemContracts) error {
+	for _, e := range contracts.Contracts {
+		contract := common.HexToAddress(e.Address)
+		if !k.evmKeeper.IsContract(ctx, contract) {
+			return fmt.Errorf(
+				"system contract %s: no code at %s in the imported EVM state; clear system_contracts to deploy the system contracts again",
+				e.Name, e.Address,
+			)
+		}
+
+		a, ok := contracts.Attestation(e.Name)
+		if !ok {
+			if err := k.attestSystemContract(ctx, &contracts, e.Name, contract, ""); err != nil {
+				return err
+			}
+			continue
+		}
+		if codeHash := k.evmKeeper.GetCodeHash(ctx, contract); common.HexToHash(a.CodeHash) != codeHash {
+			return fmt.Errorf(
+				"system contract %s: code hash mismatch at %s: attested %s, imported EVM state has %s",
+				e.Name, e.Address, a.CodeHash, codeHash.Hex(),
+			)
+		}
+	}
+	return k.SystemContracts.Set(ctx, contracts)
 }
 
 func (k Keeper) ExportGenesis(ctx sdk.Context) *ynxtypes.GenesisState {
@@ -66,10 +258,123 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *ynxtypes.GenesisState {
 		panic(err)
 	}
 
+	epoch, err := k.CurrentEpoch(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	revenue := []ynxtypes.RevenueRecord{}
+	if err := k.Revenue.Walk(ctx, nil, func(_ string, r ynxtypes.RevenueRecord) (bool, error) {
+		revenue = append(revenue, r)
+		return false, nil
+	}); err != nil {
+		panic(err)
+	}
+
+	epochRevenue := []ynxtypes.EpochRevenue{}
+	if err := k.EpochRevenue.Walk(ctx, nil, func(key collections.Pair[uint64, string], r ynxtypes.RevenueRecord) (bool, error) {
+		if n := len(epochRevenue); n == 0 || epochRevenue[n-1].Epoch != key.K1() {
+			epochRevenue = append(epochRevenue, ynxtypes.EpochRevenue{Epoch: key.K1()})
+		}
+		last := &epochRevenue[len(epochRevenue)-1]
+		last.Revenue = append(last.Revenue, r)
+		return false, nil
+	}); err != nil {
+		panic(err)
+	}
+
+	pendingParams, err := k.GetPendingParams(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	accruedFeeShares, err := k.GetAccruedFeeShares(ctx)
+	if err != nil {
+		panic(err)
+	}
+	accruedTreasuryShares, err := k.GetAccruedTreasuryShares(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	contractRevenues, err := k.GetContractRevenues(ctx, "")
+	if err != nil {
+		panic(err)
+	}
+
+	sponsorships, err := k.GetSponsorships(ctx, "")
+	if err != nil {
+		panic(err)
+	}
+	nextSponsorshipID, err := k.SponsorshipSeq.Peek(ctx)
+	if err != nil {
+		panic(err)
+	}
+	if nextSponsorshipID == 0 {
+		nextSponsorshipID = 1
+	}
+
+	reconciliation, err := k.GetReconciliation(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	voteLocks, err := k.GetVoteLocks(ctx)
+	if err != nil {
+		panic(err)
+	}
+	voteCheckpoints, voteSupplyCheckpoints, err := k.GetVoteCheckpoints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	stakeVoters, err := k.GetStakeVoters(ctx)
+	if err != nil {
+		panic(err)
+	}
+	stakeVoteCheckpoints, stakeVoteSupplyCheckpoints, err := k.GetStakeVoteCheckpoints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	circuitBreakers, err := k.GetCircuitBreakers(ctx)
+	if err != nil {
+		panic(err)
+	}
+	preconfirmSignerSets, err := k.GetPreconfirmSignerSets(ctx)
+	if err != nil {
+		panic(err)
+	}
+	var legacyNYXT *ynxtypes.LegacyNYXT
+	if legacy, err := k.LegacyNYXT.Get(ctx); err == nil {
+		legacyNYXT = &legacy
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		panic(err)
+	}
+
 	return &ynxtypes.GenesisState{
-		Params:          params,
-		System:          system,
-		SystemContracts: contracts,
+		Params:                params,
+		System:                system,
+		SystemContracts:       contracts,
+		Epoch:                 epoch,
+		Revenue:               revenue,
+		EpochRevenue:          epochRevenue,
+		PendingParams:         pendingParams,
+		AccruedFeeShares:      accruedFeeShares,
+		AccruedTreasuryShares: accruedTreasuryShares,
+		ContractRevenues:      contractRevenues,
+		Sponsorships:          sponsorships,
+		NextSponsorshipId:     nextSponsorshipID,
+		Reconciliation:        reconciliation,
+		VoteLocks:             voteLocks,
+		VoteCheckpoints:       voteCheckpoints,
+		VoteSupplyCheckpoints: voteSupplyCheckpoints,
+
+		StakeVoters:                stakeVoters,
+		StakeVoteCheckpoints:       stakeVoteCheckpoints,
+		StakeVoteSupplyCheckpoints: stakeVoteSupplyCheckpoints,
+
+		CircuitBreakers:      circuitBreakers,
+		PreconfirmSignerSets: preconfirmSignerSets,
+		LegacyNyxt:           legacyNYXT,
 	}
 }
 
@@ -77,8 +382,8 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *ynxtypes.GenesisState {
 // configured prefix.
 //
 // Panics on invalid input.
-func mustHexToBech32Acc(ctx sdk.Context, hexAddr string) string {
-	acc, err := parseAnyAddress(ctx, hexAddr)
+func mustHexToBech32Acc(hexAddr string) string {
+	acc, err := parseAnyAddress(hexAddr)
 	if err != nil {
 		panic(fmt.Errorf("invalid address: %w", err))
 	}
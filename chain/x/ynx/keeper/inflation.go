@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"context"
+
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 
@@ -11,47 +13,89 @@ import (
 	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
 )
 
-func (k Keeper) SplitInflationToTreasury(ctx sdk.Context) error {
+// SplitInflation sends the inflation_recipients shares of the current block provision from the fee
+// collector to their recipients. The remainder is left for validators.
+func (k Keeper) SplitInflation(ctx sdk.Context) error {
 	params, err := k.Params.Get(ctx)
 	if err != nil {
 		return err
 	}
-	if params.InflationTreasuryBps == 0 {
-		return nil
+
+	minted, err := k.BlockProvision(ctx)
+	if err != nil {
+		return err
 	}
-	if params.TreasuryAddress == "" {
+	if minted.Amount.IsZero() {
 		return nil
 	}
-	if params.InflationTreasuryBps > ynxtypes.BPSDenominator {
-		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "inflation_treasury_bps out of range: %d", params.InflationTreasuryBps)
+	// x/mint minted the provision earlier in the block.
+	if err := k.addMinted(ctx, minted); err != nil {
+		return err
 	}
 
-	treasuryAddr, err := sdk.AccAddressFromBech32(params.TreasuryAddress)
-	if err != nil {
-		return errorsmod.Wrap(errortypes.ErrInvalidAddress, err.Error())
+	treasury := sdkmath.ZeroInt()
+	shares := make([]ynxtypes.InflationRecipientShare, 0, len(params.InflationRecipients))
+	for _, recipient := range params.InflationRecipients {
+		share := minted.Amount.Mul(sdkmath.NewIntFromUint64(uint64(recipient.Bps))).QuoRaw(ynxtypes.BPSDenominator)
+		if share.IsZero() {
+			continue
+		}
+		coins := sdk.NewCoins(sdk.NewCoin(minted.Denom, share))
+
+		// The treasury share is recorded as treasury inflation rather than as a recipient share.
+		if recipient.Kind == ynxtypes.InflationRecipientKind_INFLATION_RECIPIENT_KIND_TREASURY {
+			if params.TreasuryAddress == "" {
+				continue
+			}
+			treasuryAddr, err := sdk.AccAddressFromBech32(params.TreasuryAddress)
+			if err != nil {
+				return errorsmod.Wrap(errortypes.ErrInvalidAddress, err.Error())
+			}
+			if err := k.sendToTreasuryObserved(ctx, authtypes.FeeCollectorName, treasuryAddr, coins, coins); err != nil {
+				return err
+			}
+			treasury = treasury.Add(share)
+			continue
+		}
+
+		if err := k.sendInflationShare(ctx, recipient, coins); err != nil {
+			return err
+		}
+		shares = append(shares, ynxtypes.InflationRecipientShare{Name: recipient.Name, Amount: share})
 	}
 
+	return k.recordInflationSplit(ctx, minted.Denom, minted.Amount, treasury, shares)
+}
+
+// BlockProvision returns the inflation x/mint mints per block at the current annual provisions.
+func (k Keeper) BlockProvision(ctx context.Context) (sdk.Coin, error) {
 	minter, err := k.mintKeeper.Minter.Get(ctx)
 	if err != nil {
-		return err
+		return sdk.Coin{}, err
 	}
 	mintParams, err := k.mintKeeper.Params.Get(ctx)
 	if err != nil {
-		return err
+		return sdk.Coin{}, err
 	}
+	return minter.BlockProvision(mintParams), nil
+}
 
-	minted := minter.BlockProvision(mintParams)
-	if minted.Amount.IsZero() {
-		return nil
+func (k Keeper) sendInflationShare(ctx sdk.Context, recipient ynxtypes.InflationRecipient, coins sdk.Coins) error {
+	feeCollectorAddr := k.accountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	if feeCollectorAddr == nil {
+		return errorsmod.Wrap(errortypes.ErrLogic, "fee collector module account not set")
 	}
 
-	bps := sdkmath.NewIntFromUint64(uint64(params.InflationTreasuryBps))
-	amount := minted.Amount.Mul(bps).QuoRaw(ynxtypes.BPSDenominator)
-	if amount.IsZero() {
-		return nil
+	switch recipient.Kind {
+	case ynxtypes.InflationRecipientKind_INFLATION_RECIPIENT_KIND_ACCOUNT:
+		addr, err := sdk.AccAddressFromBech32(recipient.Address)
+		if err != nil {
+			return errorsmod.Wrap(errortypes.ErrInvalidAddress, err.Error())
+		}
+		return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, addr, coins)
+	case ynxtypes.InflationRecipientKind_INFLATION_RECIPIENT_KIND_COMMUNITY_POOL:
+		return k.distrKeeper.FundCommunityPool(ctx, coins, feeCollectorAddr)
+	default:
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid inflation recipient kind for %s: %s", recipient.Name, recipient.Kind)
 	}
-
-	coins := sdk.NewCoins(sdk.NewCoin(minted.Denom, amount))
-	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, treasuryAddr, coins)
 }
-
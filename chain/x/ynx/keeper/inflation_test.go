@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	ynxconfig "github.com/JiahaoAlbus/YNX/chain/config"
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
+)
+
+func TestSplitInflationRecipients(t *testing.T) {
+	app, ctx := newTestApp(t, 1)
+
+	mintParams, err := app.MintKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	mintParams.BlocksPerYear = 1
+	require.NoError(t, app.MintKeeper.Params.Set(ctx, mintParams))
+
+	minter := minttypes.DefaultInitialMinter()
+	minter.AnnualProvisions = sdkmath.LegacyNewDec(10_000)
+	require.NoError(t, app.MintKeeper.Minter.Set(ctx, minter))
+
+	treasury := sdk.AccAddress(make20(0x22))
+	ecosystem := sdk.AccAddress(make20(0x33))
+
+	params := ynxtypes.DefaultParams()
+	params.TreasuryAddress = treasury.String()
+	params.InflationRecipients = []ynxtypes.InflationRecipient{
+		{Name: "treasury", Kind: ynxtypes.InflationRecipientKind_INFLATION_RECIPIENT_KIND_TREASURY, Bps: 3_000},
+		{Name: "ecosystem_fund", Kind: ynxtypes.InflationRecipientKind_INFLATION_RECIPIENT_KIND_ACCOUNT, Address: ecosystem.String(), Bps: 1_500},
+		{Name: "community_pool", Kind: ynxtypes.InflationRecipientKind_INFLATION_RECIPIENT_KIND_COMMUNITY_POOL, Bps: 500},
+	}
+	require.NoError(t, app.YNXKeeper.Params.Set(ctx, params))
+
+	fundFeeCollector(t, app, ctx, sdk.NewCoins(sdk.NewCoin(ynxconfig.BaseDenom, sdkmath.NewInt(10_000))))
+	require.NoError(t, app.YNXKeeper.SplitInflation(ctx))
+
+	require.Equal(t, sdkmath.NewInt(3_000), app.BankKeeper.GetBalance(ctx, treasury, ynxconfig.BaseDenom).Amount)
+	require.Equal(t, sdkmath.NewInt(1_500), app.BankKeeper.GetBalance(ctx, ecosystem, ynxconfig.BaseDenom).Amount)
+
+	feePool, err := app.DistrKeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.LegacyNewDec(500), feePool.CommunityPool.AmountOf(ynxconfig.BaseDenom))
+
+	rec, err := app.YNXKeeper.Revenue.Get(ctx, ynxconfig.BaseDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(3_000), rec.InflationTreasury)
+	require.Equal(t, sdkmath.NewInt(2_000), rec.InflationRecipients)
+	require.Equal(t, sdkmath.NewInt(5_000), rec.InflationValidators)
+}
@@ -0,0 +1,440 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
+)
+
+// invariantRoute is a named x/ynx invariant.
+type invariantRoute struct {
+	route     string
+	invariant func(Keeper) sdk.Invariant
+}
+
+// invariantRoutes lists the x/ynx invariants in the order they are registered and reported.
+var invariantRoutes = []invariantRoute{
+	{"module-accounts", ModuleAccountsInvariant},
+	{"accrued-fee-shares", AccruedFeeSharesInvariant},
+	{"sponsorship-pool", SponsorshipPoolInvariant},
+	{"supply-burns", SupplyBurnsInvariant},
+	{"treasury-inflows", TreasuryInflowsInvariant},
+	{"revenue-ledger", RevenueLedgerInvariant},
+	{"fee-bps", FeeBpsInvariant},
+	{"vote-escrow", VoteEscrowInvariant},
+	{"stake-votes", StakeVotesInvariant},
+}
+
+// RegisterInvariants registers the x/ynx invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	for _, r := range invariantRoutes {
+		ir.RegisterRoute(ynxtypes.ModuleName, r.route, r.invariant(k))
+	}
+}
+
+// RunInvariants runs the x/ynx invariants, or only the one registered under route if it is set.
+func (k Keeper) RunInvariants(ctx sdk.Context, route string) ([]ynxtypes.InvariantResult, error) {
+	results := []ynxtypes.InvariantResult{}
+	for _, r := range invariantRoutes {
+		if route != "" && route != r.route {
+			continue
+		}
+		// Invariants only read state, but run them on a cache so that nothing leaks into ctx.
+		cacheCtx, _ := ctx.CacheContext()
+		msg, broken := r.invariant(k)(cacheCtx)
+		results = append(results, ynxtypes.InvariantResult{Route: r.route, Broken: broken, Message: msg})
+	}
+	if route != "" && len(results) == 0 {
+		return nil, errorsmod.Wrapf(errortypes.ErrNotFound, "unknown invariant route: %s", route)
+	}
+	return results, nil
+}
+
+// ModuleAccountsInvariant checks that the x/vm module account, through which fees are burned, holds
+// no coins: whatever is sent to it for burning must be burned in the same step.
+func ModuleAccountsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		evmAddr := k.accountKeeper.GetModuleAddress(evmtypes.ModuleName)
+		balance := k.bankKeeper.GetAllBalances(ctx, evmAddr)
+
+		broken := !balance.IsZero()
+		msg := fmt.Sprintf("\t%s module account balance: %s\n", evmtypes.ModuleName, balance)
+		return sdk.FormatInvariant(ynxtypes.ModuleName, "module-accounts", msg), broken
+	}
+}
+
+// AccruedFeeSharesInvariant checks that the x/ynx module account holds exactly the fee shares
+// awaiting settlement, so that no accrued fee is lost or left unaccounted.
+func AccruedFeeSharesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		shares, err := k.GetAccruedFeeShares(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(ynxtypes.ModuleName, "accrued-fee-shares", err.Error()), true
+		}
+
+		expected := sdk.NewCoins()
+		for _, share := range shares {
+			expected = expected.Add(sdk.NewCoin(share.Denom, share.Amount))
+		}
+
+		moduleAddr := k.accountKeeper.GetModuleAddress(ynxtypes.ModuleName)
+		balance := k.bankKeeper.GetAllBalances(ctx, moduleAddr)
+
+		broken := !balance.Equal(expected)
+		msg := fmt.Sprintf("\tsum of accrued fee shares: %s\n\tmodule account balance: %s\n", expected, balance)
+
+		// Treasury shares are a part of the accrued shares of the same recipient.
+		treasuryShares, err := k.GetAccruedTreasuryShares(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(ynxtypes.ModuleName, "accrued-fee-shares", err.Error()), true
+		}
+		for _, share := range treasuryShares {
+			accrued, err := k.AccruedFeeShares.Get(ctx, collections.Join(share.Recipient, share.Denom))
+			if err != nil && !errors.Is(err, collections.ErrNotFound) {
+				return sdk.FormatInvariant(ynxtypes.ModuleName, "accrued-fee-shares", err.Error()), true
+			}
+			if err != nil || share.Amount.GT(accrued) {
+				broken = true
+				msg += fmt.Sprintf("\t%s: accrued treasury share %s%s exceeds the accrued fee share\n", share.Recipient, share.Amount, share.Denom)
+			}
+		}
+		return sdk.FormatInvariant(ynxtypes.ModuleName, "accrued-fee-shares", msg), broken
+	}
+}
+
+// SponsorshipPoolInvariant checks that the sponsorship pool holds exactly the remaining budgets of
+// all gas sponsorships.
+func SponsorshipPoolInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		budgets, err := k.GetSponsorshipBudgets(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(ynxtypes.ModuleName, "sponsorship-pool", err.Error()), true
+		}
+
+		poolAddr := k.accountKeeper.GetModuleAddress(ynxtypes.SponsorshipPoolName)
+		balance := k.bankKeeper.GetBalance(ctx, poolAddr, evmtypes.GetEVMCoinDenom())
+
+		broken := !balance.Amount.Equal(budgets)
+		msg := fmt.Sprintf("\tsum of sponsorship budgets: %s\n\tsponsorship pool balance: %s\n", budgets, balance)
+		return sdk.FormatInvariant(ynxtypes.ModuleName, "sponsorship-pool", msg), broken
+	}
+}
+
+// VoteEscrowInvariant checks that the vote escrow holds exactly the native NYXT locked for voting,
+// and that the latest total supply vote checkpoint matches it.
+func VoteEscrowInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		locks, err := k.GetVoteLocks(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(ynxtypes.ModuleName, "vote-escrow", err.Error()), true
+		}
+		locked := sdkmath.ZeroInt()
+		for _, l := range locks {
+			locked = locked.Add(l.Locked)
+		}
+		total, err := k.GetTotalVotes(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(ynxtypes.ModuleName, "vote-escrow", err.Error()), true
+		}
+
+		escrowAddr := k.accountKeeper.GetModuleAddress(ynxtypes.VoteEscrowName)
+		balance := k.bankKeeper.GetBalance(ctx, escrowAddr, evmtypes.GetEVMCoinDenom())
+
+		broken := !balance.Amount.Equal(locked) || !total.Equal(locked)
+		msg := fmt.Sprintf("\tsum of vote locks: %s\n\ttotal supply vote checkpoint: %s\n\tvote escrow balance: %s\n", locked, total, balance)
+		return sdk.FormatInvariant(ynxtypes.ModuleName, "vote-escrow", msg), broken
+	}
+}
+
+// StakeVotesInvariant checks that the current stake vote checkpoint of every delegatee matches the
+// credits of the stake voters, and that the total checkpoint matches their sum.
+func StakeVotesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		voters, err := k.GetStakeVoters(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(ynxtypes.ModuleName, "stake-votes", err.Error()), true
+		}
+		credited := make(map[string]sdkmath.Int)
+		total := sdkmath.ZeroInt()
+		for _, v := range voters {
+			for _, c := range v.Credits {
+				if prev, ok := credited[c.Delegatee]; ok {
+					credited[c.Delegatee] = prev.Add(c.Votes)
+				} else {
+					credited[c.Delegatee] = c.Votes
+				}
+				total = total.Add(c.Votes)
+			}
+		}
+
+		// Delegatees whose votes dropped to zero still have a checkpoint to compare.
+		checkpoints, _, err := k.GetStakeVoteCheckpoints(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(ynxtypes.ModuleName, "stake-votes", err.Error()), true
+		}
+		for _, c := range checkpoints {
+			if _, ok := credited[c.Account]; !ok {
+				credited[c.Account] = sdkmath.ZeroInt()
+			}
+		}
+		delegatees := make([]string, 0, len(credited))
+		for delegatee := range credited {
+			delegatees = append(delegatees, delegatee)
+		}
+		sort.Strings(delegatees)
+
+		var (
+			broken bool
+			msg    string
+		)
+		for _, delegatee := range delegatees {
+			votes, err := k.GetStakeVotes(ctx, sdk.MustAccAddressFromBech32(delegatee))
+			if err != nil {
+				return sdk.FormatInvariant(ynxtypes.ModuleName, "stake-votes", err.Error()), true
+			}
+			if !votes.Equal(credited[delegatee]) {
+				broken = true
+				msg += fmt.Sprintf("\t%s: stake vote checkpoint %s, credits %s\n", delegatee, votes, credited[delegatee])
+			}
+		}
+		supply, err := k.GetTotalStakeVotes(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(ynxtypes.ModuleName, "stake-votes", err.Error()), true
+		}
+		if !supply.Equal(total) {
+			broken = true
+			msg += fmt.Sprintf("\ttotal stake vote checkpoint %s, sum of credits %s\n", supply, total)
+		}
+		return sdk.FormatInvariant(ynxtypes.ModuleName, "stake-votes", msg), broken
+	}
+}
+
+// SupplyBurnsInvariant checks that the bank total supply of the x/mint denom does not exceed its
+// supply when the reconciliation record was anchored, plus what x/mint and legacy NYXT
+// redemptions minted since, less the fees the revenue ledger reports as burned since. Burns
+// outside of x/ynx, e.g. slashing, only lower the supply; a ledger that reports more burns than
+// actually happened, or coins minted behind x/ynx's back, break it.
+func SupplyBurnsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		mintParams, err := k.mintKeeper.Params.Get(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(ynxtypes.ModuleName, "supply-burns", err.Error()), true
+		}
+		denom := mintParams.MintDenom
+
+		anchored, err := k.Reconciliation.Has(ctx, denom)
+		if err != nil {
+			return sdk.FormatInvariant(ynxtypes.ModuleName, "supply-burns", err.Error()), true
+		}
+		if !anchored {
+			msg := fmt.Sprintf("	%s: no anchored reconciliation record\n", denom)
+			return sdk.FormatInvariant(ynxtypes.ModuleName, "supply-burns", msg), true
+		}
+
+		ledger, observed, err := k.reconciliationByDenom(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(ynxtypes.ModuleName, "supply-burns", err.Error()), true
+		}
+		pending, err := k.pendingBurns(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(ynxtypes.ModuleName, "supply-burns", err.Error()), true
+		}
+
+		r := observed[denom]
+		burned := ledger[denom].FeeBurned.Sub(pending.AmountOf(denom)).Sub(r.FeeBurnedAnchor)
+		limit := r.SupplyAnchor.Add(r.Minted).Sub(burned)
+		supply := k.bankKeeper.GetSupply(ctx, denom).Amount
+
+		broken := supply.GT(limit)
+		msg := fmt.Sprintf("	%s: bank supply %s, anchored supply %s + minted %s - ledger burned %s\n", denom, supply, r.SupplyAnchor, r.Minted, burned)
+		return sdk.FormatInvariant(ynxtypes.ModuleName, "supply-burns", msg), broken
+	}
+}
+
+// TreasuryInflowsInvariant checks, for every denom, that the fee and inflation shares the revenue
+// ledger reports as sent to the treasury since the reconciliation record was anchored match the
+// increase of the treasury balance observed at each payout, plus the treasury shares awaiting
+// settlement.
+func TreasuryInflowsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		ledger, observed, err := k.reconciliationByDenom(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(ynxtypes.ModuleName, "treasury-inflows", err.Error()), true
+		}
+		pending, err := k.pendingTreasuryShares(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(ynxtypes.ModuleName, "treasury-inflows", err.Error()), true
+		}
+
+		var (
+			broken bool
+			msg    string
+		)
+		for _, denom := range unionDenoms(ledger, observed) {
+			r := observed[denom]
+			reported := ledger[denom].FeeTreasury.Add(ledger[denom].InflationTreasury).Sub(r.TreasuryAnchor)
+			if inflows := r.TreasuryInflows.Add(pending.AmountOf(denom)); !reported.Equal(inflows) {
+				broken = true
+				msg += fmt.Sprintf("\t%s: ledger fee_treasury + inflation_treasury since anchored %s, treasury inflows %s + pending %s\n", denom, reported, r.TreasuryInflows, pending.AmountOf(denom))
+			}
+		}
+		return sdk.FormatInvariant(ynxtypes.ModuleName, "treasury-inflows", msg), broken
+	}
+}
+
+// RevenueLedgerInvariant checks that the cumulative revenue ledger is the sum of the per-epoch
+// ledgers and that no ledger entry is negative.
+func RevenueLedgerInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		epochs := map[string]ynxtypes.RevenueRecord{}
+		var (
+			broken bool
+			msg    string
+		)
+		if err := k.EpochRevenue.Walk(ctx, nil, func(key collections.Pair[uint64, string], r ynxtypes.RevenueRecord) (bool, error) {
+			if err := r.Validate(); err != nil {
+				broken = true
+				msg += fmt.Sprintf("\tepoch %d: %s\n", key.K1(), err)
+			}
+			sum, ok := epochs[r.Denom]
+			if !ok {
+				sum = ynxtypes.NewRevenueRecord(r.Denom)
+			}
+			epochs[r.Denom] = sum.Add(r)
+			return false, nil
+		}); err != nil {
+			return sdk.FormatInvariant(ynxtypes.ModuleName, "revenue-ledger", err.Error()), true
+		}
+
+		total := map[string]ynxtypes.RevenueRecord{}
+		if err := k.Revenue.Walk(ctx, nil, func(denom string, r ynxtypes.RevenueRecord) (bool, error) {
+			if err := r.Validate(); err != nil {
+				broken = true
+				msg += fmt.Sprintf("\t%s\n", err)
+			}
+			total[denom] = ynxtypes.NewRevenueRecord(denom).Add(r)
+			return false, nil
+		}); err != nil {
+			return sdk.FormatInvariant(ynxtypes.ModuleName, "revenue-ledger", err.Error()), true
+		}
+
+		for _, denom := range unionDenoms(total, epochs) {
+			cumulative, ok := total[denom]
+			if !ok {
+				cumulative = ynxtypes.NewRevenueRecord(denom)
+			}
+			sum, ok := epochs[denom]
+			if !ok {
+				sum = ynxtypes.NewRevenueRecord(denom)
+			}
+			if !cumulative.Equal(sum) {
+				broken = true
+				msg += fmt.Sprintf("\t%s: cumulative %s, sum of epochs %s\n", denom, cumulative.String(), sum.String())
+			}
+		}
+		return sdk.FormatInvariant(ynxtypes.ModuleName, "revenue-ledger", msg), broken
+	}
+}
+
+// FeeBpsInvariant checks that the fee split bps of the current params, at the current height, and
+// of every scheduled params change sum to at most BPSDenominator.
+func FeeBpsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(ynxtypes.ModuleName, "fee-bps", err.Error()), true
+		}
+
+		var (
+			broken bool
+			msg    string
+		)
+		sum := uint64(params.FeeBurnBps) + uint64(params.FeeTreasuryBps) + uint64(params.EffectiveFeeFounderBps(ctx.BlockHeight())) + uint64(params.FeeDeveloperBps)
+		msg += fmt.Sprintf("\tfee split bps at height %d: %d\n", ctx.BlockHeight(), sum)
+		if sum > ynxtypes.BPSDenominator {
+			broken = true
+		}
+		if err := params.Validate(); err != nil {
+			broken = true
+			msg += fmt.Sprintf("\tparams: %s\n", err)
+		}
+
+		pending, err := k.GetPendingParams(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(ynxtypes.ModuleName, "fee-bps", err.Error()), true
+		}
+		for _, pp := range pending {
+			if err := pp.Apply(params).Validate(); err != nil {
+				broken = true
+				msg += fmt.Sprintf("\tpending params at height %d: %s\n", pp.ActivationHeight, err)
+			}
+		}
+		return sdk.FormatInvariant(ynxtypes.ModuleName, "fee-bps", msg), broken
+	}
+}
+
+// reconciliationByDenom returns the cumulative revenue ledger and the reconciliation records by
+// denom, with unset amounts as zero.
+func (k Keeper) reconciliationByDenom(ctx sdk.Context) (map[string]ynxtypes.RevenueRecord, map[string]ynxtypes.ReconciliationRecord, error) {
+	ledger := map[string]ynxtypes.RevenueRecord{}
+	if err := k.Revenue.Walk(ctx, nil, func(denom string, r ynxtypes.RevenueRecord) (bool, error) {
+		ledger[denom] = ynxtypes.NewRevenueRecord(denom).Add(r)
+		return false, nil
+	}); err != nil {
+		return nil, nil, err
+	}
+
+	observed := map[string]ynxtypes.ReconciliationRecord{}
+	records, err := k.GetReconciliation(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, r := range records {
+		observed[r.Denom] = r
+	}
+
+	for _, denom := range unionDenoms(ledger, observed) {
+		if _, ok := ledger[denom]; !ok {
+			ledger[denom] = ynxtypes.NewRevenueRecord(denom)
+		}
+		r, ok := observed[denom]
+		if !ok {
+			r = ynxtypes.NewReconciliationRecord(denom)
+		}
+		for _, amount := range []*sdkmath.Int{&r.TreasuryInflows, &r.SupplyAnchor, &r.Minted, &r.FeeBurnedAnchor, &r.TreasuryAnchor} {
+			if amount.IsNil() {
+				*amount = sdkmath.ZeroInt()
+			}
+		}
+		observed[denom] = r
+	}
+	return ledger, observed, nil
+}
+
+// unionDenoms returns the denoms keying either a or b in sorted order.
+func unionDenoms[A, B any](a map[string]A, b map[string]B) []string {
+	seen := make(map[string]struct{}, len(a)+len(b))
+	for denom := range a {
+		seen[denom] = struct{}{}
+	}
+	for denom := range b {
+		seen[denom] = struct{}{}
+	}
+	denoms := make([]string, 0, len(seen))
+	for denom := range seen {
+		denoms = append(denoms, denom)
+	}
+	sort.Strings(denoms)
+	return denoms
+}
@@ -0,0 +1,200 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	ynx "github.com/JiahaoAlbus/YNX/chain"
+	ynxconfig "github.com/JiahaoAlbus/YNX/chain/config"
+	ynxkeeper "github.com/JiahaoAlbus/YNX/chain/x/ynx/keeper"
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
+)
+
+func brokenInvariants(t *testing.T, app *ynx.App, ctx sdk.Context) []string {
+	t.Helper()
+
+	results, err := app.YNXKeeper.RunInvariants(ctx, "")
+	require.NoError(t, err)
+
+	broken := []string{}
+	for _, r := range results {
+		if r.Broken {
+			broken = append(broken, r.Route)
+		}
+	}
+	return broken
+}
+
+func TestInvariantsReconcileFeeSplits(t *testing.T) {
+	app, ctx := newTestApp(t, 1)
+
+	treasury := sdk.AccAddress(make20(0x22))
+	params := ynxtypes.DefaultParams()
+	params.TreasuryAddress = treasury.String()
+	params.FounderAddress = sdk.AccAddress(make20(0x11)).String()
+	params.FeeFounderBps = 500
+	require.NoError(t, app.YNXKeeper.Params.Set(ctx, params))
+
+	// The test mints the fees outside of x/mint, so the records are anchored after funding.
+	fee := sdk.NewCoins(sdk.NewCoin(ynxconfig.BaseDenom, sdkmath.NewInt(10_000)))
+	fundFeeCollector(t, app, ctx, fee.Add(fee...))
+	require.NoError(t, ynxkeeper.NewMigrator(app.YNXKeeper).Migrate4to5(ctx))
+
+	require.NoError(t, app.YNXKeeper.SplitTxFee(ctx, fee))
+	require.Empty(t, brokenInvariants(t, app, ctx))
+
+	// Batched: burns and treasury shares are pending until settlement.
+	params.FeeSettlementIntervalBlocks = 10
+	require.NoError(t, app.YNXKeeper.Params.Set(ctx, params))
+	require.NoError(t, app.YNXKeeper.SplitTxFee(ctx, fee))
+	require.Empty(t, brokenInvariants(t, app, ctx))
+
+	rec, err := app.YNXKeeper.Reconciliation.Get(ctx, ynxconfig.BaseDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(1_000), rec.TreasuryInflows)
+
+	require.NoError(t, app.YNXKeeper.SettleFeeShares(ctx.WithBlockHeight(10)))
+	require.Empty(t, brokenInvariants(t, app, ctx))
+
+	rec, err = app.YNXKeeper.Reconciliation.Get(ctx, ynxconfig.BaseDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(2_000), rec.TreasuryInflows)
+	require.Equal(t, sdkmath.NewInt(2_000), app.BankKeeper.GetBalance(ctx, treasury, ynxconfig.BaseDenom).Amount)
+	require.Equal(t, sdkmath.NewInt(20_000-8_000), app.BankKeeper.GetSupply(ctx, ynxconfig.BaseDenom).Amount)
+}
+
+func TestInvariantsReconcileInflation(t *testing.T) {
+	app, ctx := newTestApp(t, 1)
+
+	treasury := sdk.AccAddress(make20(0x22))
+	params := ynxtypes.DefaultParams()
+	params.TreasuryAddress = treasury.String()
+	require.NoError(t, app.YNXKeeper.Params.Set(ctx, params))
+	require.NoError(t, ynxkeeper.NewMigrator(app.YNXKeeper).Migrate4to5(ctx))
+
+	mintParams, err := app.MintKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	mintParams.BlocksPerYear = 1
+	require.NoError(t, app.MintKeeper.Params.Set(ctx, mintParams))
+	minter := minttypes.DefaultInitialMinter()
+	minter.AnnualProvisions = sdkmath.LegacyNewDec(10_000)
+	require.NoError(t, app.MintKeeper.Minter.Set(ctx, minter))
+
+	// Mint the block provision as x/mint does before x/ynx splits it.
+	minted, err := app.YNXKeeper.BlockProvision(ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(10_000), minted.Amount)
+	require.NoError(t, app.MintKeeper.MintCoins(ctx, sdk.NewCoins(minted)))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, authtypes.FeeCollectorName, sdk.NewCoins(minted)))
+	require.NoError(t, app.YNXKeeper.SplitInflation(ctx))
+	require.Empty(t, brokenInvariants(t, app, ctx))
+
+	rec, err := app.YNXKeeper.Reconciliation.Get(ctx, ynxconfig.BaseDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(10_000), rec.Minted)
+	require.Equal(t, sdkmath.NewInt(3_000), rec.TreasuryInflows)
+	require.Equal(t, sdkmath.NewInt(3_000), app.BankKeeper.GetBalance(ctx, treasury, ynxconfig.BaseDenom).Amount)
+}
+
+func TestInvariantsDetectDrift(t *testing.T) {
+	app, ctx := newTestApp(t, 1)
+
+	params := ynxtypes.DefaultParams()
+	params.TreasuryAddress = sdk.AccAddress(make20(0x22)).String()
+	require.NoError(t, app.YNXKeeper.Params.Set(ctx, params))
+
+	fee := sdk.NewCoins(sdk.NewCoin(ynxconfig.BaseDenom, sdkmath.NewInt(10_000)))
+	fundFeeCollector(t, app, ctx, fee)
+	require.NoError(t, ynxkeeper.NewMigrator(app.YNXKeeper).Migrate4to5(ctx))
+	require.NoError(t, app.YNXKeeper.SplitTxFee(ctx, fee))
+	require.Empty(t, brokenInvariants(t, app, ctx))
+
+	// A ledger burn the total supply does not reflect.
+	rec, err := app.YNXKeeper.Revenue.Get(ctx, ynxconfig.BaseDenom)
+	require.NoError(t, err)
+	rec.FeeBurned = rec.FeeBurned.AddRaw(1)
+	require.NoError(t, app.YNXKeeper.Revenue.Set(ctx, ynxconfig.BaseDenom, rec))
+	require.Equal(t, []string{"supply-burns", "revenue-ledger"}, brokenInvariants(t, app, ctx))
+	rec.FeeBurned = rec.FeeBurned.SubRaw(1)
+	require.NoError(t, app.YNXKeeper.Revenue.Set(ctx, ynxconfig.BaseDenom, rec))
+
+	// A ledger treasury payout the treasury never received.
+	rec.FeeTreasury = rec.FeeTreasury.AddRaw(1)
+	require.NoError(t, app.YNXKeeper.Revenue.Set(ctx, ynxconfig.BaseDenom, rec))
+	require.Equal(t, []string{"treasury-inflows", "revenue-ledger"}, brokenInvariants(t, app, ctx))
+	rec.FeeTreasury = rec.FeeTreasury.SubRaw(1)
+	require.NoError(t, app.YNXKeeper.Revenue.Set(ctx, ynxconfig.BaseDenom, rec))
+
+	// Coins minted outside of x/mint, left in the burn transit account.
+	stuck := sdk.NewCoins(sdk.NewCoin(ynxconfig.BaseDenom, sdkmath.NewInt(1)))
+	fundFeeCollector(t, app, ctx, stuck)
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, evmtypes.ModuleName, stuck))
+	require.Equal(t, []string{"module-accounts", "supply-burns"}, brokenInvariants(t, app, ctx))
+	require.NoError(t, app.BankKeeper.BurnCoins(ctx, evmtypes.ModuleName, stuck))
+
+	// Burns outside of x/ynx, here of the validators' fees, only lower the supply.
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, evmtypes.ModuleName, stuck))
+	require.NoError(t, app.BankKeeper.BurnCoins(ctx, evmtypes.ModuleName, stuck))
+	require.Empty(t, brokenInvariants(t, app, ctx))
+
+	// A queued params change whose fee split exceeds 10000 bps.
+	bad := params
+	bad.FeeBurnBps = 9_000
+	bad.FeeTreasuryBps = 2_000
+	require.NoError(t, app.YNXKeeper.PendingParams.Set(ctx, 100, ynxtypes.PendingParams{ActivationHeight: 100, Params: bad}))
+	require.Equal(t, []string{"fee-bps"}, brokenInvariants(t, app, ctx))
+
+	results, err := app.YNXKeeper.RunInvariants(ctx, "fee-bps")
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.True(t, results[0].Broken)
+
+	_, err = app.YNXKeeper.RunInvariants(ctx, "unknown")
+	require.Error(t, err)
+}
+
+func TestInitGenesisAnchorsReconciliation(t *testing.T) {
+	app, ctx := newTestApp(t, 1)
+	fundFeeCollector(t, app, ctx, sdk.NewCoins(sdk.NewCoin(ynxconfig.BaseDenom, sdkmath.NewInt(5_000))))
+
+	rec := ynxtypes.NewRevenueRecord(ynxconfig.BaseDenom)
+	rec.FeeBurned = sdkmath.NewInt(700)
+	rec.FeeTreasury = sdkmath.NewInt(200)
+	rec.InflationTreasury = sdkmath.NewInt(50)
+
+	gs := ynxtypes.DefaultGenesis()
+	gs.Revenue = []ynxtypes.RevenueRecord{rec}
+	gs.EpochRevenue = []ynxtypes.EpochRevenue{{Epoch: 0, Revenue: []ynxtypes.RevenueRecord{rec}}}
+	gs.AccruedFeeShares = []ynxtypes.AccruedFeeShare{{Denom: ynxconfig.BaseDenom, Amount: sdkmath.NewInt(100)}}
+	// A record exported before the anchors existed.
+	gs.Reconciliation = []ynxtypes.ReconciliationRecord{{
+		Denom:           ynxconfig.BaseDenom,
+		TreasuryInflows: sdkmath.NewInt(250),
+	}}
+	app.YNXKeeper.InitGenesis(ctx, gs)
+
+	anchored, err := app.YNXKeeper.Reconciliation.Get(ctx, ynxconfig.BaseDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(5_000), anchored.SupplyAnchor)
+	require.Equal(t, sdkmath.NewInt(600), anchored.FeeBurnedAnchor)
+	require.Equal(t, sdkmath.NewInt(250), anchored.TreasuryAnchor)
+	require.True(t, anchored.TreasuryInflows.IsZero())
+
+	_, broken := ynxkeeper.SupplyBurnsInvariant(app.YNXKeeper)(ctx)
+	require.False(t, broken)
+	_, broken = ynxkeeper.TreasuryInflowsInvariant(app.YNXKeeper)(ctx)
+	require.False(t, broken)
+
+	// An exported record is imported as is.
+	exported := app.YNXKeeper.ExportGenesis(ctx)
+	require.Equal(t, []ynxtypes.ReconciliationRecord{anchored}, exported.Reconciliation)
+}
@@ -5,12 +5,16 @@ import (
 
 	"cosmossdk.io/collections"
 	storetypes "cosmossdk.io/core/store"
+	sdkmath "cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	mintkeeper "github.com/cosmos/cosmos-sdk/x/mint/keeper"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 
 	feemarketkeeper "github.com/cosmos/evm/x/feemarket/keeper"
 	evmkeeper "github.com/cosmos/evm/x/vm/keeper"
@@ -26,6 +30,8 @@ type Keeper struct {
 	accountKeeper authkeeper.AccountKeeper
 	bankKeeper    bankkeeper.Keeper
 	mintKeeper    mintkeeper.Keeper
+	distrKeeper   distrkeeper.Keeper
+	stakingKeeper *stakingkeeper.Keeper
 
 	evmKeeper       *evmkeeper.Keeper
 	feeMarketKeeper feemarketkeeper.Keeper
@@ -34,6 +40,64 @@ type Keeper struct {
 	Params          collections.Item[ynxtypes.Params]
 	SystemConfig    collections.Item[ynxtypes.SystemConfig]
 	SystemContracts collections.Item[ynxtypes.SystemContracts]
+
+	// Revenue ledger.
+	Epoch        collections.Item[ynxtypes.EpochInfo]
+	Revenue      collections.Map[string, ynxtypes.RevenueRecord]
+	EpochRevenue collections.Map[collections.Pair[uint64, string], ynxtypes.RevenueRecord]
+
+	// Scheduled params changes keyed by activation height.
+	PendingParams collections.Map[int64, ynxtypes.PendingParams]
+
+	// Protocol fee shares awaiting settlement, keyed by (recipient, denom). The empty recipient
+	// holds the shares to burn.
+	AccruedFeeShares collections.Map[collections.Pair[string, string], sdkmath.Int]
+
+	// The part of the accrued fee shares paid to treasury_address, keyed like AccruedFeeShares. It
+	// is recorded as treasury inflows when the shares are settled.
+	AccruedTreasuryShares collections.Map[collections.Pair[string, string], sdkmath.Int]
+
+	// Contracts registered for developer fee rebates, keyed by contract address bytes.
+	ContractRevenues collections.Map[[]byte, ynxtypes.ContractRevenue]
+
+	// Gas sponsorships by id, the id sequence and indexes of sponsorships by allowed contract and
+	// by allowed message type URL.
+	Sponsorships           collections.Map[uint64, ynxtypes.Sponsorship]
+	SponsorshipSeq         collections.Sequence
+	SponsorshipsByContract collections.KeySet[collections.Pair[[]byte, uint64]]
+	SponsorshipsByMsgType  collections.KeySet[collections.Pair[string, uint64]]
+
+	// Minted supply and observed treasury inflows by denom since they were anchored, reconciled with
+	// the revenue ledger by the x/ynx invariants.
+	Reconciliation collections.Map[string, ynxtypes.ReconciliationRecord]
+
+	// Native NYXT locked for voting by account address bytes, and the ERC20Votes checkpoints of
+	// the delegated votes, keyed by (delegatee, height), and of the total locked supply.
+	VoteLocks             collections.Map[[]byte, ynxtypes.VoteLock]
+	VoteCheckpoints       collections.Map[collections.Pair[[]byte, int64], sdkmath.Int]
+	VoteSupplyCheckpoints collections.Map[int64, sdkmath.Int]
+
+	// Stake-weighted votes: the credits of each delegator by delegator address bytes, the
+	// checkpoints of the credited votes keyed by (delegatee, height) and of their total, and the
+	// delegators and validators marked by the staking hooks for the next snapshot.
+	StakeVoters                collections.Map[[]byte, ynxtypes.StakeVoter]
+	StakeVoteCheckpoints       collections.Map[collections.Pair[[]byte, int64], sdkmath.Int]
+	StakeVoteSupplyCheckpoints collections.Map[int64, sdkmath.Int]
+	StakeVotesDirty            collections.KeySet[[]byte]
+	StakeVotesDirtyValidators  collections.KeySet[[]byte]
+
+	// Tripped circuit breakers keyed by (kind, target, selector).
+	CircuitBreakers collections.Map[collections.Triple[int32, string, string], ynxtypes.CircuitBreaker]
+
+	// Preconfirm signer sets keyed by activation epoch.
+	PreconfirmSignerSets collections.Map[uint64, ynxtypes.PreconfirmSignerSet]
+
+	// Up-front fees charged to the EVM transactions of the current block that are not split yet,
+	// by denom.
+	HeldTxFees collections.Map[string, sdkmath.Int]
+
+	// The retired standalone NYXT ERC20 and the amount redeemed for native NYXT.
+	LegacyNYXT collections.Item[ynxtypes.LegacyNYXT]
 }
 
 func NewKeeper(
@@ -43,23 +107,103 @@ func NewKeeper(
 	accountKeeper authkeeper.AccountKeeper,
 	bankKeeper bankkeeper.Keeper,
 	mintKeeper mintkeeper.Keeper,
+	distrKeeper distrkeeper.Keeper,
+	stakingKeeper *stakingkeeper.Keeper,
 	evmKeeper *evmkeeper.Keeper,
 	feeMarketKeeper feemarketkeeper.Keeper,
 ) Keeper {
 	sb := collections.NewSchemaBuilder(storeService)
 
 	k := Keeper{
-		cdc:            cdc,
-		storeService:   storeService,
-		authority:      authority,
-		accountKeeper:  accountKeeper,
-		bankKeeper:     bankKeeper,
-		mintKeeper:     mintKeeper,
-		evmKeeper:      evmKeeper,
+		cdc:             cdc,
+		storeService:    storeService,
+		authority:       authority,
+		accountKeeper:   accountKeeper,
+		bankKeeper:      bankKeeper,
+		mintKeeper:      mintKeeper,
+		distrKeeper:     distrKeeper,
+		stakingKeeper:   stakingKeeper,
+		evmKeeper:       evmKeeper,
 		feeMarketKeeper: feeMarketKeeper,
 		Params:          collections.NewItem(sb, ynxtypes.ParamsKey, "params", codec.CollValue[ynxtypes.Params](cdc)),
 		SystemConfig:    collections.NewItem(sb, ynxtypes.SystemConfigKey, "system_config", codec.CollValue[ynxtypes.SystemConfig](cdc)),
 		SystemContracts: collections.NewItem(sb, ynxtypes.SystemContractsKey, "system_contracts", codec.CollValue[ynxtypes.SystemContracts](cdc)),
+		Epoch:           collections.NewItem(sb, ynxtypes.EpochKey, "epoch", codec.CollValue[ynxtypes.EpochInfo](cdc)),
+		Revenue:         collections.NewMap(sb, ynxtypes.RevenueKey, "revenue", collections.StringKey, codec.CollValue[ynxtypes.RevenueRecord](cdc)),
+		EpochRevenue: collections.NewMap(
+			sb,
+			ynxtypes.EpochRevenueKey,
+			"epoch_revenue",
+			collections.PairKeyCodec(collections.Uint64Key, collections.StringKey),
+			codec.CollValue[ynxtypes.RevenueRecord](cdc),
+		),
+		PendingParams: collections.NewMap(sb, ynxtypes.PendingParamsKey, "pending_params", collections.Int64Key, codec.CollValue[ynxtypes.PendingParams](cdc)),
+		AccruedFeeShares: collections.NewMap(
+			sb,
+			ynxtypes.AccruedFeeShareKey,
+			"accrued_fee_shares",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			sdk.IntValue,
+		),
+		AccruedTreasuryShares: collections.NewMap(
+			sb,
+			ynxtypes.AccruedTreasuryShareKey,
+			"accrued_treasury_shares",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			sdk.IntValue,
+		),
+		ContractRevenues: collections.NewMap(sb, ynxtypes.ContractRevenueKey, "contract_revenues", collections.BytesKey, codec.CollValue[ynxtypes.ContractRevenue](cdc)),
+		Sponsorships:     collections.NewMap(sb, ynxtypes.SponsorshipKey, "sponsorships", collections.Uint64Key, codec.CollValue[ynxtypes.Sponsorship](cdc)),
+		SponsorshipSeq:   collections.NewSequence(sb, ynxtypes.SponsorshipSeqKey, "sponsorship_seq"),
+		SponsorshipsByContract: collections.NewKeySet(
+			sb,
+			ynxtypes.SponsorshipByContractKey,
+			"sponsorships_by_contract",
+			collections.PairKeyCodec(collections.BytesKey, collections.Uint64Key),
+		),
+		SponsorshipsByMsgType: collections.NewKeySet(
+			sb,
+			ynxtypes.SponsorshipByMsgTypeKey,
+			"sponsorships_by_msg_type",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+		),
+		Reconciliation: collections.NewMap(sb, ynxtypes.ReconciliationKey, "reconciliation", collections.StringKey, codec.CollValue[ynxtypes.ReconciliationRecord](cdc)),
+		VoteLocks:      collections.NewMap(sb, ynxtypes.VoteLockKey, "vote_locks", collections.BytesKey, codec.CollValue[ynxtypes.VoteLock](cdc)),
+		VoteCheckpoints: collections.NewMap(
+			sb,
+			ynxtypes.VoteCheckpointKey,
+			"vote_checkpoints",
+			collections.PairKeyCodec(collections.BytesKey, collections.Int64Key),
+			sdk.IntValue,
+		),
+		VoteSupplyCheckpoints: collections.NewMap(sb, ynxtypes.VoteSupplyCheckpointKey, "vote_supply_checkpoints", collections.Int64Key, sdk.IntValue),
+		StakeVoters:           collections.NewMap(sb, ynxtypes.StakeVoterKey, "stake_voters", collections.BytesKey, codec.CollValue[ynxtypes.StakeVoter](cdc)),
+		StakeVoteCheckpoints: collections.NewMap(
+			sb,
+			ynxtypes.StakeVoteCheckpointKey,
+			"stake_vote_checkpoints",
+			collections.PairKeyCodec(collections.BytesKey, collections.Int64Key),
+			sdk.IntValue,
+		),
+		StakeVoteSupplyCheckpoints: collections.NewMap(sb, ynxtypes.StakeVoteSupplyCheckpointKey, "stake_vote_supply_checkpoints", collections.Int64Key, sdk.IntValue),
+		StakeVotesDirty:            collections.NewKeySet(sb, ynxtypes.StakeVotesDirtyKey, "stake_votes_dirty", collections.BytesKey),
+		StakeVotesDirtyValidators:  collections.NewKeySet(sb, ynxtypes.StakeVotesDirtyValidatorKey, "stake_votes_dirty_validators", collections.BytesKey),
+		CircuitBreakers: collections.NewMap(
+			sb,
+			ynxtypes.CircuitBreakerKey,
+			"circuit_breakers",
+			collections.TripleKeyCodec(collections.Int32Key, collections.StringKey, collections.StringKey),
+			codec.CollValue[ynxtypes.CircuitBreaker](cdc),
+		),
+		PreconfirmSignerSets: collections.NewMap(
+			sb,
+			ynxtypes.PreconfirmSignerSetKey,
+			"preconfirm_signer_sets",
+			collections.Uint64Key,
+			codec.CollValue[ynxtypes.PreconfirmSignerSet](cdc),
+		),
+		HeldTxFees: collections.NewMap(sb, ynxtypes.HeldTxFeeKey, "held_tx_fees", collections.StringKey, sdk.IntValue),
+		LegacyNYXT: collections.NewItem(sb, ynxtypes.LegacyNYXTKey, "legacy_nyxt", codec.CollValue[ynxtypes.LegacyNYXT](cdc)),
 	}
 
 	schema, err := sb.Build()
@@ -76,4 +220,3 @@ func (k Keeper) GetAuthority() string { return k.authority }
 func (k Keeper) GetParams(ctx context.Context) (ynxtypes.Params, error) {
 	return k.Params.Get(ctx)
 }
-
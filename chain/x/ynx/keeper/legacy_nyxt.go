@@ -0,0 +1,199 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
+)
+
+// erc20TransferTopic is the topic of the ERC20 Transfer(address,address,uint256) event.
+var erc20TransferTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+
+// LegacyNYXTRedemptionAddress returns the address standalone NYXT ERC20 tokens are sent to in
+// order to redeem them for native NYXT. It is the x/ynx module account, which no key controls, so
+// the tokens sent to it are out of circulation for good.
+func LegacyNYXTRedemptionAddress() common.Address {
+	return common.BytesToAddress(authtypes.NewModuleAddress(ynxtypes.ModuleName))
+}
+
+// EVMHooks returns the x/ynx hooks of the EVM module.
+func (k Keeper) EVMHooks() evmtypes.EvmHooks {
+	return evmHooks{k}
+}
+
+type evmHooks struct {
+	k Keeper
+}
+
+var _ evmtypes.EvmHooks = evmHooks{}
+
+// PostTxProcessing redeems the standalone NYXT ERC20 tokens the transaction sent to the
+// redemption address, see RedeemLegacyNYXT.
+func (h evmHooks) PostTxProcessing(ctx sdk.Context, _ common.Address, _ core.Message, receipt *ethtypes.Receipt) error {
+	return h.k.RedeemLegacyNYXT(ctx, receipt)
+}
+
+// RedeemLegacyNYXT mints native NYXT one to one for every Transfer of the retired standalone NYXT
+// ERC20 to LegacyNYXTRedemptionAddress in a successful transaction, and pays it to the sender of
+// the tokens. The redeemed tokens can never leave the redemption address, so the standalone and the
+// native NYXT in circulation add up to the same amount as before.
+//
+// Redemptions are capped at the supply recorded by RetireLegacyNYXT. A transaction that would
+// redeem more fails.
+func (k Keeper) RedeemLegacyNYXT(ctx sdk.Context, receipt *ethtypes.Receipt) error {
+	if receipt.Status != ethtypes.ReceiptStatusSuccessful || len(receipt.Logs) == 0 {
+		return nil
+	}
+
+	legacy, err := k.LegacyNYXT.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	token := common.HexToAddress(legacy.Contract)
+	redemption := common.BytesToHash(LegacyNYXTRedemptionAddress().Bytes())
+
+	redeemed := legacy.Redeemed
+	for _, log := range receipt.Logs {
+		if log.Address != token || len(log.Topics) != 3 || log.Topics[0] != erc20TransferTopic || log.Topics[2] != redemption {
+			continue
+		}
+		if len(log.Data) != common.HashLength {
+			continue
+		}
+		amount := sdkmath.NewIntFromBigInt(new(big.Int).SetBytes(log.Data))
+		if amount.IsZero() {
+			continue
+		}
+		if redeemed.Add(amount).GT(legacy.Supply) {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "redeeming %s legacy NYXT exceeds the unredeemed supply %s", amount, legacy.Supply.Sub(redeemed))
+		}
+		redeemed = redeemed.Add(amount)
+		account := sdk.AccAddress(common.BytesToAddress(log.Topics[1].Bytes()).Bytes())
+
+		coins := sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), amount))
+		if err := k.mintKeeper.MintCoins(ctx, coins); err != nil {
+			return errorsmod.Wrap(err, "mint redeemed legacy NYXT")
+		}
+		if err := k.addMinted(ctx, coins[0]); err != nil {
+			return err
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, account, coins); err != nil {
+			return errorsmod.Wrap(err, "pay redeemed legacy NYXT")
+		}
+		if err := ctx.EventManager().EmitTypedEvent(&ynxtypes.EventLegacyNYXTRedeemed{
+			Account:  account.String(),
+			Contract: token.Hex(),
+			Amount:   amount,
+			TxHash:   receipt.TxHash.Hex(),
+		}); err != nil {
+			return err
+		}
+	}
+
+	if redeemed.Equal(legacy.Redeemed) {
+		return nil
+	}
+	legacy.Redeemed = redeemed
+	return k.LegacyNYXT.Set(ctx, legacy)
+}
+
+// RetireLegacyNYXT moves the standalone NYXT ERC20 of the nyxt system contract entry to the
+// nyxt_legacy entry and records it with its total supply, which opens its redemption for native
+// NYXT. Chains without a nyxt entry are left alone.
+//
+// Chains that retired the token before the record existed have it in the nyxt_legacy entry only.
+// It is recorded as is, with the tokens already held by the redemption address counted as
+// redeemed.
+func (k Keeper) RetireLegacyNYXT(ctx sdk.Context) error {
+	if has, err := k.LegacyNYXT.Has(ctx); err != nil || has {
+		return err
+	}
+	contracts, err := k.SystemContracts.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	nyxt := contracts.Get("nyxt")
+	retired := nyxt == ""
+	if retired {
+		if nyxt = contracts.Get(ynxtypes.LegacyNYXTContractName); nyxt == "" {
+			return nil
+		}
+	}
+
+	supply, err := k.legacyNYXTView(ctx, nyxt, "totalSupply")
+	if err != nil {
+		return errorsmod.Wrap(err, "read legacy NYXT supply")
+	}
+	redeemed := sdkmath.ZeroInt()
+	if retired {
+		if redeemed, err = k.legacyNYXTView(ctx, nyxt, "balanceOf", LegacyNYXTRedemptionAddress()); err != nil {
+			return errorsmod.Wrap(err, "read redeemed legacy NYXT")
+		}
+	}
+	if err := k.LegacyNYXT.Set(ctx, ynxtypes.LegacyNYXT{
+		Contract: common.HexToAddress(nyxt).Hex(),
+		Supply:   supply,
+		Redeemed: sdkmath.MinInt(redeemed, supply),
+	}); err != nil {
+		return err
+	}
+	if retired {
+		return nil
+	}
+
+	if err := contracts.Rename("nyxt", ynxtypes.LegacyNYXTContractName); err != nil {
+		return err
+	}
+	if err := k.SystemContracts.Set(ctx, contracts); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&ynxtypes.EventSystemContractUpdated{
+		Name:       "nyxt",
+		OldAddress: nyxt,
+		Authority:  k.authority,
+	}); err != nil {
+		return err
+	}
+	return ctx.EventManager().EmitTypedEvent(&ynxtypes.EventSystemContractUpdated{
+		Name:       ynxtypes.LegacyNYXTContractName,
+		NewAddress: nyxt,
+		Authority:  k.authority,
+	})
+}
+
+// legacyNYXTView calls an amount-returning view method of the standalone NYXT ERC20.
+func (k Keeper) legacyNYXTView(ctx sdk.Context, contract, method string, args ...interface{}) (sdkmath.Int, error) {
+	out, err := k.systemView(ctx, SystemDeployerAddress(), "NYXT", contract, method, args...)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+	amount, ok := out.(*big.Int)
+	if !ok {
+		return sdkmath.Int{}, fmt.Errorf("%s returned %T", method, out)
+	}
+	return sdkmath.NewIntFromBigInt(amount), nil
+}
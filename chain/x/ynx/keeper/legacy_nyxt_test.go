@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	ynxkeeper "github.com/JiahaoAlbus/YNX/chain/x/ynx/keeper"
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
+)
+
+func legacyNYXTTransfer(token, from, to common.Address, amount int64) *ethtypes.Log {
+	return &ethtypes.Log{
+		Address: token,
+		Topics: []common.Hash{
+			crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)")),
+			common.BytesToHash(from.Bytes()),
+			common.BytesToHash(to.Bytes()),
+		},
+		Data: common.BigToHash(big.NewInt(amount)).Bytes(),
+	}
+}
+
+// deployLegacyNYXT deploys a standalone NYXT ERC20 minting supply to holder as the nyxt system
+// contract.
+func deployLegacyNYXT(t *testing.T, k ynxkeeper.Keeper, ctx sdk.Context, holder common.Address, supply int64) common.Address {
+	t.Helper()
+
+	gov := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	args, err := loadArtifactABI(t, "NYXT").Pack("", holder, holder, big.NewInt(supply))
+	require.NoError(t, err)
+	token, err := k.DeploySystemContract(ctx, gov, "nyxt", "NYXT", nil, args, nil)
+	require.NoError(t, err)
+	return token
+}
+
+func TestRedeemLegacyNYXT(t *testing.T) {
+	app, ctx := newSystemContractsTestApp(t)
+	k := app.YNXKeeper
+
+	denom := evmtypes.GetEVMCoinDenom()
+	holder := common.BytesToAddress(make20(0x82))
+	other := common.BytesToAddress(make20(0x83))
+	legacy := deployLegacyNYXT(t, k, ctx, holder, 1_000)
+
+	redemption := ynxkeeper.LegacyNYXTRedemptionAddress()
+	receipt := &ethtypes.Receipt{
+		Status: ethtypes.ReceiptStatusSuccessful,
+		Logs: []*ethtypes.Log{
+			legacyNYXTTransfer(legacy, holder, redemption, 700),
+			// Neither a transfer of another token nor one to another address is redeemed.
+			legacyNYXTTransfer(other, holder, redemption, 50),
+			legacyNYXTTransfer(legacy, holder, other, 50),
+		},
+	}
+
+	// The standalone NYXT is registered under nyxt until it is retired.
+	require.NoError(t, k.RedeemLegacyNYXT(ctx, receipt))
+	require.True(t, app.BankKeeper.GetBalance(ctx, sdk.AccAddress(holder.Bytes()), denom).IsZero())
+
+	require.NoError(t, k.RetireLegacyNYXT(ctx))
+	contracts, err := k.SystemContracts.Get(ctx)
+	require.NoError(t, err)
+	require.Empty(t, contracts.Get("nyxt"))
+	require.Equal(t, legacy.Hex(), contracts.Get(ynxtypes.LegacyNYXTContractName))
+	record, err := k.LegacyNYXT.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, legacy.Hex(), record.Contract)
+	require.Equal(t, sdkmath.NewInt(1_000), record.Supply)
+	require.True(t, record.Redeemed.IsZero())
+
+	// Failed transactions redeem nothing.
+	failed := *receipt
+	failed.Status = ethtypes.ReceiptStatusFailed
+	require.NoError(t, k.RedeemLegacyNYXT(ctx, &failed))
+	require.True(t, app.BankKeeper.GetBalance(ctx, sdk.AccAddress(holder.Bytes()), denom).IsZero())
+
+	supply := app.BankKeeper.GetSupply(ctx, denom).Amount
+	require.NoError(t, k.RedeemLegacyNYXT(ctx, receipt))
+	require.Equal(t, sdkmath.NewInt(700), app.BankKeeper.GetBalance(ctx, sdk.AccAddress(holder.Bytes()), denom).Amount)
+	require.Equal(t, supply.AddRaw(700), app.BankKeeper.GetSupply(ctx, denom).Amount)
+
+	// Redemptions stop at the supply recorded at retirement.
+	excess := &ethtypes.Receipt{
+		Status: ethtypes.ReceiptStatusSuccessful,
+		Logs:   []*ethtypes.Log{legacyNYXTTransfer(legacy, holder, redemption, 301)},
+	}
+	require.ErrorContains(t, k.RedeemLegacyNYXT(ctx, excess), "exceeds the unredeemed supply 300")
+	record, err = k.LegacyNYXT.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(700), record.Redeemed)
+}
+
+func TestRetireLegacyNYXTRecordsRetiredToken(t *testing.T) {
+	app, ctx := newSystemContractsTestApp(t)
+	k := app.YNXKeeper
+
+	// A chain that retired the token before the record existed: some tokens were already sent
+	// to the redemption address.
+	holder := common.BytesToAddress(make20(0x82))
+	legacy := deployLegacyNYXT(t, k, ctx, holder, 1_000)
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, holder.Bytes()))
+	_, err := app.EVMKeeper.CallEVM(ctx, loadArtifactABI(t, "NYXT"), holder, legacy, true, nil, "transfer", ynxkeeper.LegacyNYXTRedemptionAddress(), big.NewInt(250))
+	require.NoError(t, err)
+
+	contracts, err := k.SystemContracts.Get(ctx)
+	require.NoError(t, err)
+	require.NoError(t, contracts.Rename("nyxt", ynxtypes.LegacyNYXTContractName))
+	require.NoError(t, k.SystemContracts.Set(ctx, contracts))
+
+	require.NoError(t, k.RetireLegacyNYXT(ctx))
+	record, err := k.LegacyNYXT.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, legacy.Hex(), record.Contract)
+	require.Equal(t, sdkmath.NewInt(1_000), record.Supply)
+	require.Equal(t, sdkmath.NewInt(250), record.Redeemed)
+
+	// The entry is reserved once retired.
+	gov := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	require.ErrorContains(t, k.SetSystemContract(ctx, gov, ynxtypes.LegacyNYXTContractName, legacy), "reserved")
+	_, err = k.DeploySystemContract(ctx, gov, ynxtypes.LegacyNYXTContractName, "NYXT", nil, nil, nil)
+	require.ErrorContains(t, err, "reserved")
+}
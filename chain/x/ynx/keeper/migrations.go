@@ -0,0 +1,172 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
+)
+
+// Migrator performs the in-place store migrations of x/ynx.
+type Migrator struct {
+	keeper Keeper
+}
+
+func NewMigrator(k Keeper) Migrator {
+	return Migrator{keeper: k}
+}
+
+// Migrate1to2 migrates x/ynx from consensus version 1 to 2.
+//
+// Version 1 params only carry the founder, treasury and bps fields, so they are filled in with
+// the defaults of the fields added since, both in the active and the scheduled params, and their
+// inflation_treasury_bps is moved to inflation_recipients as in Migrate3to4. State
+// without an epoch starts one at the upgrade height; Migrate4to5 anchors the reconciliation
+// records.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	params, err = migrateParamsV2(params)
+	if err != nil {
+		return err
+	}
+	if err := params.Validate(); err != nil {
+		return fmt.Errorf("migrated params: %w", err)
+	}
+	if err := m.keeper.Params.Set(ctx, params); err != nil {
+		return err
+	}
+
+	var pending []ynxtypes.PendingParams
+	if err := m.keeper.PendingParams.Walk(ctx, nil, func(_ int64, pp ynxtypes.PendingParams) (bool, error) {
+		pending = append(pending, pp)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+	for _, pp := range pending {
+		if pp.Params, err = migrateParamsV2(pp.Params); err != nil {
+			return err
+		}
+		if err := pp.Validate(); err != nil {
+			return fmt.Errorf("migrated pending params: %w", err)
+		}
+		if err := m.keeper.PendingParams.Set(ctx, pp.ActivationHeight, pp); err != nil {
+			return err
+		}
+	}
+
+	if _, err := m.keeper.Epoch.Get(ctx); errors.Is(err, collections.ErrNotFound) {
+		if err := m.keeper.Epoch.Set(ctx, ynxtypes.EpochInfo{StartHeight: ctx.BlockHeight()}); err != nil {
+			return err
+		}
+	} else if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Migrate2to3 migrates x/ynx from consensus version 2 to 3.
+//
+// Version 2 keeps the system contracts in nine fixed fields; version 3 keeps them in a registry
+// ordered by name. The fields are moved to the registry together with their attestations.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	contracts, err := m.keeper.SystemContracts.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := contracts.MigrateLegacyEntries(); err != nil {
+		return err
+	}
+	if err := contracts.Validate(); err != nil {
+		return fmt.Errorf("migrated system contracts: %w", err)
+	}
+	return m.keeper.SystemContracts.Set(ctx, contracts)
+}
+
+// Migrate3to4 migrates x/ynx from consensus version 3 to 4.
+//
+// Version 3 params keep the treasury share of inflation in inflation_treasury_bps next to
+// inflation_recipients; version 4 keeps it in inflation_recipients only, as a recipient of kind
+// INFLATION_RECIPIENT_KIND_TREASURY. The active and the scheduled params are migrated.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if err := params.MigrateInflationTreasuryBps(); err != nil {
+		return err
+	}
+	if err := params.Validate(); err != nil {
+		return fmt.Errorf("migrated params: %w", err)
+	}
+	if err := m.keeper.Params.Set(ctx, params); err != nil {
+		return err
+	}
+
+	var pending []ynxtypes.PendingParams
+	if err := m.keeper.PendingParams.Walk(ctx, nil, func(_ int64, pp ynxtypes.PendingParams) (bool, error) {
+		pending = append(pending, pp)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+	for _, pp := range pending {
+		if err := pp.MigrateInflationTreasuryBps(); err != nil {
+			return err
+		}
+		if err := pp.Validate(); err != nil {
+			return fmt.Errorf("migrated pending params: %w", err)
+		}
+		if err := m.keeper.PendingParams.Set(ctx, pp.ActivationHeight, pp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// migrateParamsV2 fills in the fields version 1 params leave unset and moves their
+// inflation_treasury_bps to inflation_recipients.
+func migrateParamsV2(p ynxtypes.Params) (ynxtypes.Params, error) {
+	if p.EpochLengthBlocks == 0 {
+		p.EpochLengthBlocks = ynxtypes.DefaultEpochLengthBlocks
+	}
+	if p.FeeDenomPolicies == nil {
+		p.FeeDenomPolicies = []ynxtypes.FeeDenomPolicy{}
+	}
+	if p.InflationRecipients == nil {
+		p.InflationRecipients = []ynxtypes.InflationRecipient{}
+	}
+	if err := p.MigrateInflationTreasuryBps(); err != nil {
+		return ynxtypes.Params{}, err
+	}
+	return p, nil
+}
+
+// Migrate4to5 migrates x/ynx from consensus version 4 to 5.
+//
+// Version 4 reconciliation records hold the burns and treasury inflows x/ynx observed itself, with
+// escrowed treasury shares counted when they accrue. Version 5 reconciles the bank total supply
+// and the treasury inflows at payout against anchors, so the records are replaced with records
+// anchored at the upgrade height.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return m.keeper.anchorReconciliation(ctx)
+}
+
+// Migrate5to6 migrates x/ynx from consensus version 5 to 6.
+//
+// Version 5 EVM call circuit breakers only block the transactions calling their target. Version 6
+// breakers swap the code of their target for a stub that also reverts the calls contracts make, so
+// the code of the targets of the stored breakers is swapped.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	return m.keeper.swapStoredCircuitBreakerCode(ctx)
+}
@@ -0,0 +1,249 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ynxconfig "github.com/JiahaoAlbus/YNX/chain/config"
+	ynxkeeper "github.com/JiahaoAlbus/YNX/chain/x/ynx/keeper"
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
+)
+
+// v1Params returns params as written by consensus version 1, which only knows the founder,
+// treasury and bps fields.
+func v1Params() ynxtypes.Params {
+	return ynxtypes.Params{
+		TreasuryAddress:      sdk.AccAddress(make20(0x22)).String(),
+		FeeBurnBps:           4_000,
+		FeeTreasuryBps:       1_000,
+		InflationTreasuryBps: 3_000,
+	}
+}
+
+func TestMigrate1to2(t *testing.T) {
+	app, ctx := newTestApp(t, 100)
+
+	require.NoError(t, app.YNXKeeper.Params.Set(ctx, v1Params()))
+	require.NoError(t, app.YNXKeeper.PendingParams.Set(ctx, 200, ynxtypes.PendingParams{
+		ActivationHeight: 200,
+		Authority:        sdk.AccAddress(make20(0x33)).String(),
+		Params:           v1Params(),
+	}))
+
+	require.NoError(t, ynxkeeper.NewMigrator(app.YNXKeeper).Migrate1to2(ctx))
+
+	params, err := app.YNXKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, ynxtypes.DefaultEpochLengthBlocks, params.EpochLengthBlocks)
+	require.Equal(t, v1Params().TreasuryAddress, params.TreasuryAddress)
+	require.Equal(t, uint32(4_000), params.FeeBurnBps)
+	require.Equal(t, uint32(3_000), params.InflationTreasuryShareBps())
+	require.NoError(t, params.Validate())
+
+	pp, err := app.YNXKeeper.PendingParams.Get(ctx, 200)
+	require.NoError(t, err)
+	require.Equal(t, ynxtypes.DefaultEpochLengthBlocks, pp.Params.EpochLengthBlocks)
+
+	epoch, err := app.YNXKeeper.Epoch.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, ynxtypes.EpochInfo{StartHeight: 100}, epoch)
+}
+
+func TestMigrate1to2KeepsExistingState(t *testing.T) {
+	app, ctx := newTestApp(t, 100)
+
+	params := ynxtypes.DefaultParams()
+	params.EpochLengthBlocks = 50
+	require.NoError(t, app.YNXKeeper.Params.Set(ctx, params))
+	require.NoError(t, app.YNXKeeper.Epoch.Set(ctx, ynxtypes.EpochInfo{Number: 3, StartHeight: 90}))
+
+	require.NoError(t, ynxkeeper.NewMigrator(app.YNXKeeper).Migrate1to2(ctx))
+
+	got, err := app.YNXKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(50), got.EpochLengthBlocks)
+
+	epoch, err := app.YNXKeeper.Epoch.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, ynxtypes.EpochInfo{Number: 3, StartHeight: 90}, epoch)
+}
+
+func TestMigrate1to2RejectsInvalidParams(t *testing.T) {
+	app, ctx := newTestApp(t, 100)
+
+	params := v1Params()
+	params.FeeBurnBps = 9_500
+	require.NoError(t, app.YNXKeeper.Params.Set(ctx, params))
+
+	require.Error(t, ynxkeeper.NewMigrator(app.YNXKeeper).Migrate1to2(ctx))
+}
+
+func TestMigrate2to3(t *testing.T) {
+	app, ctx := newTestApp(t, 100)
+
+	codeHash := common.BytesToHash([]byte{0xaa}).Hex()
+	timelock := common.BytesToAddress(make20(0x73)).Hex()
+	treasury := common.BytesToAddress(make20(0x74)).Hex()
+	require.NoError(t, app.YNXKeeper.SystemContracts.Set(ctx, ynxtypes.SystemContracts{
+		Timelock: timelock,
+		Treasury: treasury,
+		Attestations: []ynxtypes.SystemContractAttestation{
+			{Name: "timelock", CodeHash: codeHash, Artifact: "YNXTimelock"},
+			{Name: "treasury", CodeHash: codeHash},
+		},
+	}))
+
+	require.NoError(t, ynxkeeper.NewMigrator(app.YNXKeeper).Migrate2to3(ctx))
+
+	contracts, err := app.YNXKeeper.SystemContracts.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, ynxtypes.SystemContracts{
+		Contracts: []ynxtypes.SystemContractEntry{
+			{Name: "timelock", Address: timelock},
+			{Name: "treasury", Address: treasury},
+		},
+		Attestations: []ynxtypes.SystemContractAttestation{
+			{Name: "timelock", CodeHash: codeHash, Artifact: "YNXTimelock"},
+			{Name: "treasury", CodeHash: codeHash},
+		},
+	}, contracts)
+
+	// Running it again is a no-op.
+	require.NoError(t, ynxkeeper.NewMigrator(app.YNXKeeper).Migrate2to3(ctx))
+	again, err := app.YNXKeeper.SystemContracts.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, contracts, again)
+}
+
+func TestMigrate3to4(t *testing.T) {
+	app, ctx := newTestApp(t, 100)
+
+	ecosystem := ynxtypes.InflationRecipient{Name: "treasury", Kind: ynxtypes.InflationRecipientKind_INFLATION_RECIPIENT_KIND_COMMUNITY_POOL, Bps: 500}
+	params := ynxtypes.DefaultParams()
+	params.InflationTreasuryBps = 3_000
+	params.InflationRecipients = []ynxtypes.InflationRecipient{ecosystem}
+	require.NoError(t, app.YNXKeeper.Params.Set(ctx, params))
+
+	scheduled := params
+	scheduled.InflationTreasuryBps = 2_000
+	require.NoError(t, app.YNXKeeper.PendingParams.Set(ctx, 200, ynxtypes.PendingParams{
+		ActivationHeight: 200,
+		Authority:        sdk.AccAddress(make20(0x33)).String(),
+		Params:           scheduled,
+		Fields:           []string{"inflation_treasury_bps"},
+	}))
+
+	require.NoError(t, ynxkeeper.NewMigrator(app.YNXKeeper).Migrate3to4(ctx))
+
+	// The name of the existing recipient is kept; the treasury share gets the next free one.
+	got, err := app.YNXKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Zero(t, got.InflationTreasuryBps)
+	require.Equal(t, []ynxtypes.InflationRecipient{
+		{Name: "treasury_2", Kind: ynxtypes.InflationRecipientKind_INFLATION_RECIPIENT_KIND_TREASURY, Bps: 3_000},
+		ecosystem,
+	}, got.InflationRecipients)
+
+	pp, err := app.YNXKeeper.PendingParams.Get(ctx, 200)
+	require.NoError(t, err)
+	require.Equal(t, []string{"inflation_recipients"}, pp.Fields)
+	require.Equal(t, uint32(2_000), pp.Params.InflationTreasuryShareBps())
+	require.Zero(t, pp.Params.InflationTreasuryBps)
+
+	// Running it again is a no-op.
+	require.NoError(t, ynxkeeper.NewMigrator(app.YNXKeeper).Migrate3to4(ctx))
+	again, err := app.YNXKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, got, again)
+}
+
+func TestMigrate4to5(t *testing.T) {
+	app, ctx := newTestApp(t, 100)
+	k := app.YNXKeeper
+
+	fundFeeCollector(t, app, ctx, sdk.NewCoins(sdk.NewCoin(ynxconfig.BaseDenom, sdkmath.NewInt(1_000))))
+
+	rec := ynxtypes.NewRevenueRecord(ynxconfig.BaseDenom)
+	rec.FeeBurned = sdkmath.NewInt(700)
+	rec.FeeTreasury = sdkmath.NewInt(200)
+	rec.InflationTreasury = sdkmath.NewInt(50)
+	require.NoError(t, k.Revenue.Set(ctx, ynxconfig.BaseDenom, rec))
+
+	treasury := sdk.AccAddress(make20(0x22)).String()
+	require.NoError(t, k.AccruedFeeShares.Set(ctx, collections.Join("", ynxconfig.BaseDenom), sdkmath.NewInt(100)))
+	require.NoError(t, k.AccruedFeeShares.Set(ctx, collections.Join(treasury, ynxconfig.BaseDenom), sdkmath.NewInt(30)))
+	require.NoError(t, k.AccruedTreasuryShares.Set(ctx, collections.Join(treasury, ynxconfig.BaseDenom), sdkmath.NewInt(30)))
+
+	// Version 4 records counted what x/ynx observed itself.
+	old := ynxtypes.NewReconciliationRecord(ynxconfig.BaseDenom)
+	old.TreasuryInflows = sdkmath.NewInt(250)
+	require.NoError(t, k.Reconciliation.Set(ctx, ynxconfig.BaseDenom, old))
+	require.NoError(t, k.Reconciliation.Set(ctx, "uother", ynxtypes.NewReconciliationRecord("uother")))
+
+	require.NoError(t, ynxkeeper.NewMigrator(k).Migrate4to5(ctx))
+
+	records, err := k.GetReconciliation(ctx)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	r := records[0]
+	require.Equal(t, ynxconfig.BaseDenom, r.Denom)
+	require.Equal(t, sdkmath.NewInt(1_000), r.SupplyAnchor)
+	require.Equal(t, sdkmath.NewInt(600), r.FeeBurnedAnchor)
+	require.Equal(t, sdkmath.NewInt(220), r.TreasuryAnchor)
+	require.True(t, r.Minted.IsZero())
+	require.True(t, r.TreasuryInflows.IsZero())
+
+	for _, route := range []string{"supply-burns", "treasury-inflows"} {
+		results, err := k.RunInvariants(ctx, route)
+		require.NoError(t, err)
+		require.False(t, results[0].Broken, results[0].Message)
+	}
+}
+
+func TestMigrate5to6(t *testing.T) {
+	app, ctx := newTestApp(t, 100)
+	k := app.YNXKeeper
+
+	bridge := common.BytesToAddress(make20(0xb1))
+	eoa := common.BytesToAddress(make20(0xb2))
+	setCode(app, ctx, bridge, []byte{0x00})
+	original := app.EVMKeeper.GetCodeHash(ctx, bridge)
+
+	// Version 5 breakers record no original code hash.
+	guardian := sdk.AccAddress(make20(0xa1)).String()
+	kind := ynxtypes.CircuitBreakerKind_CIRCUIT_BREAKER_KIND_EVM_CALL
+	for _, b := range []ynxtypes.CircuitBreaker{
+		{Kind: kind, Target: bridge.Hex(), TrippedBy: guardian, TrippedHeight: 90, ExpiryHeight: 110},
+		{Kind: kind, Target: bridge.Hex(), Selector: "0xa9059cbb", TrippedBy: guardian, TrippedHeight: 90, ExpiryHeight: 120},
+		{Kind: kind, Target: eoa.Hex(), TrippedBy: guardian, TrippedHeight: 90, ExpiryHeight: 110},
+	} {
+		require.NoError(t, k.CircuitBreakers.Set(ctx, collections.Join3(int32(b.Kind), b.Target, b.Selector), b))
+	}
+
+	require.NoError(t, ynxkeeper.NewMigrator(k).Migrate5to6(ctx))
+
+	breakers, err := k.GetCircuitBreakers(ctx)
+	require.NoError(t, err)
+	require.Len(t, breakers, 3)
+	for _, b := range breakers {
+		if b.Target == eoa.Hex() {
+			require.Empty(t, b.OriginalCodeHash)
+		} else {
+			require.Equal(t, original.Hex(), b.OriginalCodeHash)
+		}
+	}
+	require.NotEqual(t, original, app.EVMKeeper.GetCodeHash(ctx, bridge))
+	require.Equal(t, []byte{0x00}, k.GetCode(ctx, ynxkeeper.CircuitBreakerShadowAddress(bridge)))
+	require.Empty(t, k.GetCode(ctx, eoa))
+
+	// Lifting the breakers restores the code of bridge.
+	require.NoError(t, k.ExpireCircuitBreakers(ctx.WithBlockHeight(120)))
+	require.Equal(t, original, app.EVMKeeper.GetCodeHash(ctx, bridge))
+}
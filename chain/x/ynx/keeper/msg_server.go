@@ -3,6 +3,8 @@ package keeper
 import (
 	"context"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -31,6 +33,13 @@ func (s msgServer) UpdateParams(ctx context.Context, req *ynxtypes.MsgUpdatePara
 		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
 	}
 
+	if req.ActivationHeight != 0 {
+		if err := s.k.ScheduleParams(ctx, req.Authority, req.Params, req.ActivationHeight); err != nil {
+			return nil, err
+		}
+		return &ynxtypes.MsgUpdateParamsResponse{}, nil
+	}
+
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	if err := s.k.Params.Set(sdkCtx, req.Params); err != nil {
 		return nil, err
@@ -39,3 +48,245 @@ func (s msgServer) UpdateParams(ctx context.Context, req *ynxtypes.MsgUpdatePara
 	return &ynxtypes.MsgUpdateParamsResponse{}, nil
 }
 
+func (s msgServer) CancelPendingParams(ctx context.Context, req *ynxtypes.MsgCancelPendingParams) (*ynxtypes.MsgCancelPendingParamsResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "empty request")
+	}
+	if req.Authority != s.k.authority {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized, "invalid authority: %s", req.Authority)
+	}
+
+	if err := s.k.CancelPendingParams(ctx, req.Authority, req.ActivationHeight); err != nil {
+		return nil, err
+	}
+
+	return &ynxtypes.MsgCancelPendingParamsResponse{}, nil
+}
+
+func (s msgServer) RegisterContractRevenue(ctx context.Context, req *ynxtypes.MsgRegisterContractRevenue) (*ynxtypes.MsgRegisterContractRevenueResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "empty request")
+	}
+
+	deployer, contract, withdraw, err := parseContractRevenueMsg(req.DeployerAddress, req.ContractAddress, req.WithdrawAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.k.RegisterContractRevenue(ctx, deployer, contract, req.Nonce, withdraw); err != nil {
+		return nil, err
+	}
+
+	return &ynxtypes.MsgRegisterContractRevenueResponse{}, nil
+}
+
+func (s msgServer) UpdateContractRevenue(ctx context.Context, req *ynxtypes.MsgUpdateContractRevenue) (*ynxtypes.MsgUpdateContractRevenueResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "empty request")
+	}
+
+	deployer, contract, withdraw, err := parseContractRevenueMsg(req.DeployerAddress, req.ContractAddress, req.WithdrawAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.k.UpdateContractRevenue(ctx, deployer, contract, withdraw); err != nil {
+		return nil, err
+	}
+
+	return &ynxtypes.MsgUpdateContractRevenueResponse{}, nil
+}
+
+func (s msgServer) CancelContractRevenue(ctx context.Context, req *ynxtypes.MsgCancelContractRevenue) (*ynxtypes.MsgCancelContractRevenueResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "empty request")
+	}
+
+	deployer, contract, _, err := parseContractRevenueMsg(req.DeployerAddress, req.ContractAddress, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.k.CancelContractRevenue(ctx, deployer, contract); err != nil {
+		return nil, err
+	}
+
+	return &ynxtypes.MsgCancelContractRevenueResponse{}, nil
+}
+
+func (s msgServer) CreateSponsorship(ctx context.Context, req *ynxtypes.MsgCreateSponsorship) (*ynxtypes.MsgCreateSponsorshipResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "empty request")
+	}
+
+	sponsor, err := sdk.AccAddressFromBech32(req.Sponsor)
+	if err != nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidAddress, err.Error())
+	}
+
+	id, err := s.k.CreateSponsorship(ctx, sponsor, req.Policy, req.Budget)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ynxtypes.MsgCreateSponsorshipResponse{Id: id}, nil
+}
+
+func (s msgServer) UpdateSponsorship(ctx context.Context, req *ynxtypes.MsgUpdateSponsorship) (*ynxtypes.MsgUpdateSponsorshipResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "empty request")
+	}
+
+	sponsor, err := sdk.AccAddressFromBech32(req.Sponsor)
+	if err != nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidAddress, err.Error())
+	}
+
+	if err := s.k.UpdateSponsorship(ctx, sponsor, req.Id, req.Policy); err != nil {
+		return nil, err
+	}
+
+	return &ynxtypes.MsgUpdateSponsorshipResponse{}, nil
+}
+
+func (s msgServer) FundSponsorship(ctx context.Context, req *ynxtypes.MsgFundSponsorship) (*ynxtypes.MsgFundSponsorshipResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "empty request")
+	}
+
+	sponsor, err := sdk.AccAddressFromBech32(req.Sponsor)
+	if err != nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidAddress, err.Error())
+	}
+
+	if err := s.k.FundSponsorship(ctx, sponsor, req.Id, req.Amount); err != nil {
+		return nil, err
+	}
+
+	return &ynxtypes.MsgFundSponsorshipResponse{}, nil
+}
+
+func (s msgServer) CloseSponsorship(ctx context.Context, req *ynxtypes.MsgCloseSponsorship) (*ynxtypes.MsgCloseSponsorshipResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "empty request")
+	}
+
+	sponsor, err := sdk.AccAddressFromBech32(req.Sponsor)
+	if err != nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidAddress, err.Error())
+	}
+
+	if _, err := s.k.CloseSponsorship(ctx, sponsor, req.Id); err != nil {
+		return nil, err
+	}
+
+	return &ynxtypes.MsgCloseSponsorshipResponse{}, nil
+}
+
+func (s msgServer) DeploySystemContract(ctx context.Context, req *ynxtypes.MsgDeploySystemContract) (*ynxtypes.MsgDeploySystemContractResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "empty request")
+	}
+	if req.Authority != s.k.authority {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized, "invalid authority: %s", req.Authority)
+	}
+
+	created, err := s.k.DeploySystemContract(ctx, req.Authority, req.Name, req.Artifact, req.Bytecode, req.ConstructorArgs, req.MigrationCalls)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ynxtypes.MsgDeploySystemContractResponse{Address: created.Hex()}, nil
+}
+
+func (s msgServer) SetSystemContract(ctx context.Context, req *ynxtypes.MsgSetSystemContract) (*ynxtypes.MsgSetSystemContractResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "empty request")
+	}
+	if req.Authority != s.k.authority {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized, "invalid authority: %s", req.Authority)
+	}
+
+	contract, err := ynxtypes.ParseContractAddress(req.Address)
+	if err != nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidAddress, err.Error())
+	}
+
+	if err := s.k.SetSystemContract(ctx, req.Authority, req.Name, contract); err != nil {
+		return nil, err
+	}
+
+	return &ynxtypes.MsgSetSystemContractResponse{}, nil
+}
+
+func (s msgServer) TripCircuitBreaker(ctx context.Context, req *ynxtypes.MsgTripCircuitBreaker) (*ynxtypes.MsgTripCircuitBreakerResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "empty request")
+	}
+
+	guardian, err := sdk.AccAddressFromBech32(req.Guardian)
+	if err != nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidAddress, err.Error())
+	}
+
+	breaker, err := s.k.TripCircuitBreaker(ctx, guardian, req.Kind, req.Target, req.Selector, req.DurationBlocks, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ynxtypes.MsgTripCircuitBreakerResponse{ExpiryHeight: breaker.ExpiryHeight}, nil
+}
+
+func (s msgServer) ResetCircuitBreaker(ctx context.Context, req *ynxtypes.MsgResetCircuitBreaker) (*ynxtypes.MsgResetCircuitBreakerResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "empty request")
+	}
+	if req.Authority != s.k.authority {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized, "invalid authority: %s", req.Authority)
+	}
+
+	if err := s.k.ResetCircuitBreaker(ctx, req.Authority, req.Kind, req.Target, req.Selector); err != nil {
+		return nil, err
+	}
+
+	return &ynxtypes.MsgResetCircuitBreakerResponse{}, nil
+}
+
+func (s msgServer) SetPreconfirmSigners(ctx context.Context, req *ynxtypes.MsgSetPreconfirmSigners) (*ynxtypes.MsgSetPreconfirmSignersResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "empty request")
+	}
+	if req.Authority != s.k.authority {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized, "invalid authority: %s", req.Authority)
+	}
+
+	if err := s.k.SetPreconfirmSigners(ctx, req.Authority, req.SignerSet); err != nil {
+		return nil, err
+	}
+
+	return &ynxtypes.MsgSetPreconfirmSignersResponse{}, nil
+}
+
+// parseContractRevenueMsg decodes the addresses of a contract revenue message. withdraw is empty
+// when not set.
+func parseContractRevenueMsg(deployerAddr, contractAddr, withdrawAddr string) (common.Address, common.Address, sdk.AccAddress, error) {
+	deployer, err := sdk.AccAddressFromBech32(deployerAddr)
+	if err != nil {
+		return common.Address{}, common.Address{}, nil, errorsmod.Wrap(errortypes.ErrInvalidAddress, err.Error())
+	}
+
+	contract, err := ynxtypes.ParseContractAddress(contractAddr)
+	if err != nil {
+		return common.Address{}, common.Address{}, nil, errorsmod.Wrap(errortypes.ErrInvalidAddress, err.Error())
+	}
+
+	var withdraw sdk.AccAddress
+	if withdrawAddr != "" {
+		withdraw, err = sdk.AccAddressFromBech32(withdrawAddr)
+		if err != nil {
+			return common.Address{}, common.Address{}, nil, errorsmod.Wrap(errortypes.ErrInvalidAddress, err.Error())
+		}
+	}
+
+	return common.BytesToAddress(deployer), contract, withdraw, nil
+}
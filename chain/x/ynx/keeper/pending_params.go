@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
+)
+
+// ScheduleParams queues params to be applied at the BeginBlock of activationHeight. Only one
+// change may be scheduled per height; authority is recorded so that only it can cancel the change.
+//
+// Only the fields in which params differ from the current params are applied at activation, so
+// that the change does not revert other changes made before it activates.
+func (k Keeper) ScheduleParams(ctx context.Context, authority string, params ynxtypes.Params, activationHeight int64) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if activationHeight <= sdkCtx.BlockHeight() {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "activation height %d must be after the current height %d", activationHeight, sdkCtx.BlockHeight())
+	}
+
+	current, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	fields, err := ynxtypes.ChangedParamsFields(current, params)
+	if err != nil {
+		return err
+	}
+	if len(fields) == 0 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "params change does not change any field")
+	}
+
+	pending := ynxtypes.PendingParams{
+		ActivationHeight: activationHeight,
+		Authority:        authority,
+		Params:           params,
+		Fields:           fields,
+	}
+	if err := pending.Validate(); err != nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+
+	has, err := k.PendingParams.Has(ctx, activationHeight)
+	if err != nil {
+		return err
+	}
+	if has {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "params change already scheduled at height %d", activationHeight)
+	}
+
+	if err := k.PendingParams.Set(ctx, activationHeight, pending); err != nil {
+		return err
+	}
+
+	return sdkCtx.EventManager().EmitTypedEvent(&ynxtypes.EventParamsScheduled{
+		ActivationHeight: activationHeight,
+		Authority:        authority,
+	})
+}
+
+// CancelPendingParams removes the params change scheduled at activationHeight. It must be called
+// by the authority that scheduled the change.
+func (k Keeper) CancelPendingParams(ctx context.Context, authority string, activationHeight int64) error {
+	pending, err := k.PendingParams.Get(ctx, activationHeight)
+	if errors.Is(err, collections.ErrNotFound) {
+		return errorsmod.Wrapf(errortypes.ErrNotFound, "no params change scheduled at height %d", activationHeight)
+	}
+	if err != nil {
+		return err
+	}
+	if pending.Authority != authority {
+		return errorsmod.Wrapf(errortypes.ErrUnauthorized, "params change at height %d was scheduled by %s", activationHeight, pending.Authority)
+	}
+
+	if err := k.PendingParams.Remove(ctx, activationHeight); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&ynxtypes.EventParamsCancelled{
+		ActivationHeight: activationHeight,
+		Authority:        authority,
+	})
+}
+
+// ApplyPendingParams applies all params changes scheduled at or before the current height, in
+// activation height order, each on top of the params in effect at that point. A change that
+// yields invalid params, e.g. fee shares above 100% together with a change made after it was
+// scheduled, is dropped with EventParamsActivationFailed.
+func (k Keeper) ApplyPendingParams(ctx sdk.Context) error {
+	rng := new(collections.Range[int64]).EndInclusive(ctx.BlockHeight())
+
+	var due []ynxtypes.PendingParams
+	err := k.PendingParams.Walk(ctx, rng, func(_ int64, pending ynxtypes.PendingParams) (bool, error) {
+		due = append(due, pending)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, pending := range due {
+		if err := k.PendingParams.Remove(ctx, pending.ActivationHeight); err != nil {
+			return err
+		}
+
+		current, err := k.Params.Get(ctx)
+		if err != nil {
+			return err
+		}
+		params := pending.Apply(current)
+		if err := params.Validate(); err != nil {
+			if err := ctx.EventManager().EmitTypedEvent(&ynxtypes.EventParamsActivationFailed{
+				ActivationHeight: pending.ActivationHeight,
+				Authority:        pending.Authority,
+				Reason:           err.Error(),
+			}); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := k.Params.Set(ctx, params); err != nil {
+			return err
+		}
+		if err := ctx.EventManager().EmitTypedEvent(&ynxtypes.EventParamsActivated{
+			ActivationHeight: pending.ActivationHeight,
+			Authority:        pending.Authority,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetPendingParams returns all scheduled params changes ordered by activation height.
+func (k Keeper) GetPendingParams(ctx context.Context) ([]ynxtypes.PendingParams, error) {
+	pending := []ynxtypes.PendingParams{}
+	err := k.PendingParams.Walk(ctx, nil, func(_ int64, pp ynxtypes.PendingParams) (bool, error) {
+		pending = append(pending, pp)
+		return false, nil
+	})
+	return pending, err
+}
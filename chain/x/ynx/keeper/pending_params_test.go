@@ -0,0 +1,165 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ynxkeeper "github.com/JiahaoAlbus/YNX/chain/x/ynx/keeper"
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
+)
+
+func TestUpdateParamsScheduledActivation(t *testing.T) {
+	app, ctx := newTestApp(t, 10)
+	require.NoError(t, app.YNXKeeper.Params.Set(ctx, ynxtypes.DefaultParams()))
+
+	authority := app.YNXKeeper.GetAuthority()
+	msgServer := ynxkeeper.NewMsgServerImpl(app.YNXKeeper)
+
+	scheduled := ynxtypes.DefaultParams()
+	scheduled.FeeBurnBps = 1234
+
+	_, err := msgServer.UpdateParams(ctx, &ynxtypes.MsgUpdateParams{Authority: authority, Params: scheduled, ActivationHeight: 10})
+	require.Error(t, err)
+
+	_, err = msgServer.UpdateParams(ctx, &ynxtypes.MsgUpdateParams{Authority: authority, Params: scheduled, ActivationHeight: 12})
+	require.NoError(t, err)
+
+	_, err = msgServer.UpdateParams(ctx, &ynxtypes.MsgUpdateParams{Authority: authority, Params: scheduled, ActivationHeight: 12})
+	require.Error(t, err)
+
+	current, err := app.YNXKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, ynxtypes.DefaultParams().FeeBurnBps, current.FeeBurnBps)
+
+	queryServer := ynxkeeper.NewQueryServerImpl(app.YNXKeeper)
+	res, err := queryServer.PendingParams(ctx, &ynxtypes.QueryPendingParamsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.PendingParams, 1)
+	require.Equal(t, int64(12), res.PendingParams[0].ActivationHeight)
+	require.Equal(t, authority, res.PendingParams[0].Authority)
+
+	ctx = ctx.WithBlockHeight(11)
+	require.NoError(t, app.YNXKeeper.ApplyPendingParams(ctx))
+	current, err = app.YNXKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, ynxtypes.DefaultParams().FeeBurnBps, current.FeeBurnBps)
+
+	ctx = ctx.WithBlockHeight(12)
+	require.NoError(t, app.YNXKeeper.ApplyPendingParams(ctx))
+	current, err = app.YNXKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1234), current.FeeBurnBps)
+
+	pending, err := app.YNXKeeper.GetPendingParams(ctx)
+	require.NoError(t, err)
+	require.Empty(t, pending)
+}
+
+func TestCancelPendingParams(t *testing.T) {
+	app, ctx := newTestApp(t, 1)
+	require.NoError(t, app.YNXKeeper.Params.Set(ctx, ynxtypes.DefaultParams()))
+
+	authority := app.YNXKeeper.GetAuthority()
+	timelock := sdk.AccAddress(make20(0xaa)).String()
+
+	scheduled := ynxtypes.DefaultParams()
+	scheduled.FeeBurnBps = 1234
+	require.NoError(t, app.YNXKeeper.ScheduleParams(ctx, timelock, scheduled, 5))
+
+	msgServer := ynxkeeper.NewMsgServerImpl(app.YNXKeeper)
+
+	// x/gov cannot cancel a change scheduled by the timelock.
+	_, err := msgServer.CancelPendingParams(ctx, &ynxtypes.MsgCancelPendingParams{Authority: authority, ActivationHeight: 5})
+	require.Error(t, err)
+
+	_, err = msgServer.CancelPendingParams(ctx, &ynxtypes.MsgCancelPendingParams{Authority: timelock, ActivationHeight: 5})
+	require.Error(t, err)
+
+	require.NoError(t, app.YNXKeeper.CancelPendingParams(ctx, timelock, 5))
+	require.Error(t, app.YNXKeeper.CancelPendingParams(ctx, timelock, 5))
+
+	pending, err := app.YNXKeeper.GetPendingParams(ctx)
+	require.NoError(t, err)
+	require.Empty(t, pending)
+}
+
+func TestScheduledParamsKeepLaterChanges(t *testing.T) {
+	app, ctx := newTestApp(t, 10)
+	require.NoError(t, app.YNXKeeper.Params.Set(ctx, ynxtypes.DefaultParams()))
+
+	authority := app.YNXKeeper.GetAuthority()
+	msgServer := ynxkeeper.NewMsgServerImpl(app.YNXKeeper)
+
+	// A change identical to the current params changes nothing and is rejected.
+	_, err := msgServer.UpdateParams(ctx, &ynxtypes.MsgUpdateParams{Authority: authority, Params: ynxtypes.DefaultParams(), ActivationHeight: 20})
+	require.Error(t, err)
+
+	scheduled := ynxtypes.DefaultParams()
+	scheduled.FeeBurnBps = 1234
+	_, err = msgServer.UpdateParams(ctx, &ynxtypes.MsgUpdateParams{Authority: authority, Params: scheduled, ActivationHeight: 20})
+	require.NoError(t, err)
+
+	pending, err := app.YNXKeeper.GetPendingParams(ctx)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	require.Equal(t, []string{"fee_burn_bps"}, pending[0].Fields)
+
+	// Two changes made before the activation height: one to another field and one to the
+	// scheduled field itself.
+	updated := ynxtypes.DefaultParams()
+	updated.FeeTreasuryBps = 500
+	updated.FeeSettlementIntervalBlocks = 10
+	updated.FeeBurnBps = 1000
+	_, err = msgServer.UpdateParams(ctx, &ynxtypes.MsgUpdateParams{Authority: authority, Params: updated})
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(20)
+	require.NoError(t, app.YNXKeeper.ApplyPendingParams(ctx))
+
+	// Only the scheduled field is applied; the other changes are kept.
+	current, err := app.YNXKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1234), current.FeeBurnBps)
+	require.Equal(t, uint32(500), current.FeeTreasuryBps)
+	require.Equal(t, uint64(10), current.FeeSettlementIntervalBlocks)
+}
+
+func TestScheduledParamsDroppedWhenInvalidAtActivation(t *testing.T) {
+	app, ctx := newTestApp(t, 10)
+	require.NoError(t, app.YNXKeeper.Params.Set(ctx, ynxtypes.DefaultParams()))
+
+	authority := app.YNXKeeper.GetAuthority()
+	msgServer := ynxkeeper.NewMsgServerImpl(app.YNXKeeper)
+
+	scheduled := ynxtypes.DefaultParams()
+	scheduled.FeeBurnBps = 8_000
+	_, err := msgServer.UpdateParams(ctx, &ynxtypes.MsgUpdateParams{Authority: authority, Params: scheduled, ActivationHeight: 20})
+	require.NoError(t, err)
+
+	// Together with a treasury share raised in the meantime, the scheduled burn share exceeds
+	// 100% of the fee.
+	updated := ynxtypes.DefaultParams()
+	updated.FeeTreasuryBps = 3_000
+	_, err = msgServer.UpdateParams(ctx, &ynxtypes.MsgUpdateParams{Authority: authority, Params: updated})
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(20).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, app.YNXKeeper.ApplyPendingParams(ctx))
+
+	current, err := app.YNXKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, updated, current)
+
+	pending, err := app.YNXKeeper.GetPendingParams(ctx)
+	require.NoError(t, err)
+	require.Empty(t, pending)
+
+	var failed bool
+	for _, event := range ctx.EventManager().Events() {
+		failed = failed || event.Type == "ynx.ynx.v1.EventParamsActivationFailed"
+	}
+	require.True(t, failed)
+}
@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
+)
+
+// SetPreconfirmSigners registers set to become the active preconfirm signer set at the start of
+// its activation epoch, replacing a set already scheduled for that epoch. A set for the current
+// epoch is active immediately. authority is recorded in the event; callers must check that it is
+// the module authority or the timelock.
+//
+// Sets superseded by the active one are pruned.
+func (k Keeper) SetPreconfirmSigners(ctx context.Context, authority string, set ynxtypes.PreconfirmSignerSet) error {
+	set, err := set.Normalize()
+	if err != nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+
+	epoch, err := k.CurrentEpoch(ctx)
+	if err != nil {
+		return err
+	}
+	if set.ActivationEpoch < epoch.Number {
+		return errorsmod.Wrapf(
+			errortypes.ErrInvalidRequest,
+			"activation_epoch %d is before the current epoch %d", set.ActivationEpoch, epoch.Number,
+		)
+	}
+
+	if err := k.PreconfirmSignerSets.Set(ctx, set.ActivationEpoch, set); err != nil {
+		return err
+	}
+
+	active, found, err := k.activePreconfirmSignerSet(ctx, epoch.Number)
+	if err != nil {
+		return err
+	}
+	if found {
+		superseded := new(collections.Range[uint64]).EndExclusive(active.ActivationEpoch)
+		if err := k.PreconfirmSignerSets.Clear(ctx, superseded); err != nil {
+			return err
+		}
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&ynxtypes.EventPreconfirmSignersSet{
+		SignerSet: set,
+		Authority: authority,
+	})
+}
+
+// ActivePreconfirmSigners returns the preconfirm signer set active in the current epoch, if any.
+func (k Keeper) ActivePreconfirmSigners(ctx context.Context) (ynxtypes.PreconfirmSignerSet, bool, error) {
+	epoch, err := k.CurrentEpoch(ctx)
+	if err != nil {
+		return ynxtypes.PreconfirmSignerSet{}, false, err
+	}
+	return k.activePreconfirmSignerSet(ctx, epoch.Number)
+}
+
+// ScheduledPreconfirmSigners returns the preconfirm signer sets that become active after the
+// current epoch, ordered by activation epoch.
+func (k Keeper) ScheduledPreconfirmSigners(ctx context.Context) ([]ynxtypes.PreconfirmSignerSet, error) {
+	epoch, err := k.CurrentEpoch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sets := []ynxtypes.PreconfirmSignerSet{}
+	err = k.PreconfirmSignerSets.Walk(ctx, new(collections.Range[uint64]).StartExclusive(epoch.Number), func(_ uint64, s ynxtypes.PreconfirmSignerSet) (bool, error) {
+		sets = append(sets, s)
+		return false, nil
+	})
+	return sets, err
+}
+
+// IsPreconfirmSigner reports whether addr is a signer of the active preconfirm signer set.
+func (k Keeper) IsPreconfirmSigner(ctx context.Context, addr common.Address) (bool, error) {
+	set, found, err := k.ActivePreconfirmSigners(ctx)
+	if err != nil || !found {
+		return false, err
+	}
+	return set.HasSigner(addr), nil
+}
+
+// GetPreconfirmSignerSets returns the stored preconfirm signer sets ordered by activation epoch.
+func (k Keeper) GetPreconfirmSignerSets(ctx context.Context) ([]ynxtypes.PreconfirmSignerSet, error) {
+	sets := []ynxtypes.PreconfirmSignerSet{}
+	err := k.PreconfirmSignerSets.Walk(ctx, nil, func(_ uint64, s ynxtypes.PreconfirmSignerSet) (bool, error) {
+		sets = append(sets, s)
+		return false, nil
+	})
+	return sets, err
+}
+
+// activePreconfirmSignerSet returns the set with the latest activation epoch up to epoch.
+func (k Keeper) activePreconfirmSignerSet(ctx context.Context, epoch uint64) (ynxtypes.PreconfirmSignerSet, bool, error) {
+	var (
+		active ynxtypes.PreconfirmSignerSet
+		found  bool
+	)
+	err := k.PreconfirmSignerSets.Walk(ctx, new(collections.Range[uint64]).EndInclusive(epoch).Descending(), func(_ uint64, s ynxtypes.PreconfirmSignerSet) (bool, error) {
+		active, found = s, true
+		return true, nil
+	})
+	return active, found, err
+}
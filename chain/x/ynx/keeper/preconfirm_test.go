@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/JiahaoAlbus/YNX/chain/x/ynx/keeper"
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
+)
+
+func TestPreconfirmSignerRotation(t *testing.T) {
+	app, ctx := newTestApp(t, 10)
+	k := app.YNXKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+	queryServer := keeper.NewQueryServerImpl(k)
+	require.NoError(t, k.Epoch.Set(ctx, ynxtypes.EpochInfo{Number: 3, StartHeight: 10}))
+
+	signerA := common.BytesToAddress(make20(0xa1))
+	signerB := common.BytesToAddress(make20(0xb1))
+	signerC := common.BytesToAddress(make20(0xc1))
+
+	res, err := queryServer.PreconfirmSigners(ctx, &ynxtypes.QueryPreconfirmSignersRequest{})
+	require.NoError(t, err)
+	require.Nil(t, res.Active)
+
+	// Only the authority registers sets, and never for a past epoch.
+	_, err = msgServer.SetPreconfirmSigners(ctx, &ynxtypes.MsgSetPreconfirmSigners{
+		Authority: sdk.AccAddress(make20(0x01)).String(),
+		SignerSet: ynxtypes.PreconfirmSignerSet{ActivationEpoch: 3, Signers: []string{signerA.Hex()}, Threshold: 1},
+	})
+	require.ErrorContains(t, err, "invalid authority")
+	err = k.SetPreconfirmSigners(ctx, k.GetAuthority(), ynxtypes.PreconfirmSignerSet{ActivationEpoch: 2, Signers: []string{signerA.Hex()}, Threshold: 1})
+	require.ErrorContains(t, err, "before the current epoch")
+
+	// A set for the current epoch is active right away; addresses are stored checksummed.
+	_, err = msgServer.SetPreconfirmSigners(ctx, &ynxtypes.MsgSetPreconfirmSigners{
+		Authority: k.GetAuthority(),
+		SignerSet: ynxtypes.PreconfirmSignerSet{ActivationEpoch: 3, Signers: []string{signerA.Hex(), common.Bytes2Hex(signerB.Bytes())}, Threshold: 2},
+	})
+	require.NoError(t, err)
+	registered, err := k.IsPreconfirmSigner(ctx, signerB)
+	require.NoError(t, err)
+	require.True(t, registered)
+
+	// Rotate to C from epoch 5.
+	require.NoError(t, k.SetPreconfirmSigners(ctx, k.GetAuthority(), ynxtypes.PreconfirmSignerSet{ActivationEpoch: 5, Signers: []string{signerC.Hex()}, Threshold: 1}))
+	res, err = queryServer.PreconfirmSigners(ctx, &ynxtypes.QueryPreconfirmSignersRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), res.CurrentEpoch)
+	require.Equal(t, []string{signerA.Hex(), signerB.Hex()}, res.Active.Signers)
+	require.Len(t, res.Scheduled, 1)
+	require.Equal(t, uint64(5), res.Scheduled[0].ActivationEpoch)
+
+	require.NoError(t, k.Epoch.Set(ctx, ynxtypes.EpochInfo{Number: 5, StartHeight: 20}))
+	for _, tc := range []struct {
+		signer     common.Address
+		registered bool
+	}{
+		{signerA, false},
+		{signerB, false},
+		{signerC, true},
+	} {
+		registered, err := k.IsPreconfirmSigner(ctx, tc.signer)
+		require.NoError(t, err)
+		require.Equal(t, tc.registered, registered, tc.signer.Hex())
+	}
+
+	// Registering the next set prunes the superseded epoch 3 set.
+	require.NoError(t, k.SetPreconfirmSigners(ctx, k.GetAuthority(), ynxtypes.PreconfirmSignerSet{ActivationEpoch: 6}))
+	sets, err := k.GetPreconfirmSignerSets(ctx)
+	require.NoError(t, err)
+	require.Len(t, sets, 2)
+	require.Equal(t, uint64(5), sets[0].ActivationEpoch)
+
+	gs := ynxtypes.DefaultGenesis()
+	gs.PreconfirmSignerSets = sets
+	require.NoError(t, gs.Validate())
+	gs.PreconfirmSignerSets = append(gs.PreconfirmSignerSets, sets[0])
+	require.ErrorContains(t, gs.Validate(), "duplicate preconfirm signer set")
+}
@@ -3,7 +3,12 @@ package keeper
 import (
 	"context"
 
+	"github.com/ethereum/go-ethereum/common"
+
+	errorsmod "cosmossdk.io/errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 
 	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
 )
@@ -38,3 +43,190 @@ func (q queryServer) SystemContracts(ctx context.Context, _ *ynxtypes.QuerySyste
 	return &ynxtypes.QuerySystemContractsResponse{System: system, SystemContracts: contracts}, nil
 }
 
+func (q queryServer) VerifySystemContracts(ctx context.Context, _ *ynxtypes.QueryVerifySystemContractsRequest) (*ynxtypes.QueryVerifySystemContractsResponse, error) {
+	contracts, err := q.k.VerifySystemContracts(sdk.UnwrapSDKContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ynxtypes.QueryVerifySystemContractsResponse{Contracts: contracts, Verified: true}
+	for _, c := range contracts {
+		res.Verified = res.Verified && c.CodeHashMatches && c.ArtifactMatches
+	}
+	return res, nil
+}
+
+func (q queryServer) Revenue(ctx context.Context, req *ynxtypes.QueryRevenueRequest) (*ynxtypes.QueryRevenueResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	epoch, err := q.k.CurrentEpoch(sdkCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	revenue := []ynxtypes.RevenueRecord{}
+	if req.Denom != "" {
+		r, err := q.k.GetRevenue(sdkCtx, req.Denom)
+		if err != nil {
+			return nil, err
+		}
+		revenue = append(revenue, r)
+	} else if err := q.k.Revenue.Walk(sdkCtx, nil, func(_ string, r ynxtypes.RevenueRecord) (bool, error) {
+		revenue = append(revenue, r)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &ynxtypes.QueryRevenueResponse{Revenue: revenue, Epoch: epoch}, nil
+}
+
+func (q queryServer) RevenueByEpoch(ctx context.Context, req *ynxtypes.QueryRevenueByEpochRequest) (*ynxtypes.QueryRevenueByEpochResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	revenue, err := q.k.GetEpochRevenue(sdkCtx, req.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	return &ynxtypes.QueryRevenueByEpochResponse{Epoch: req.Epoch, Revenue: revenue}, nil
+}
+
+func (q queryServer) PendingParams(ctx context.Context, _ *ynxtypes.QueryPendingParamsRequest) (*ynxtypes.QueryPendingParamsResponse, error) {
+	pending, err := q.k.GetPendingParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &ynxtypes.QueryPendingParamsResponse{PendingParams: pending}, nil
+}
+
+func (q queryServer) ContractRevenue(ctx context.Context, req *ynxtypes.QueryContractRevenueRequest) (*ynxtypes.QueryContractRevenueResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "empty request")
+	}
+
+	contract, err := ynxtypes.ParseContractAddress(req.ContractAddress)
+	if err != nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidAddress, err.Error())
+	}
+
+	cr, found, err := q.k.GetContractRevenue(ctx, contract)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, errorsmod.Wrapf(errortypes.ErrNotFound, "contract %s is not registered", contract.Hex())
+	}
+	return &ynxtypes.QueryContractRevenueResponse{ContractRevenue: cr}, nil
+}
+
+func (q queryServer) ContractRevenues(ctx context.Context, req *ynxtypes.QueryContractRevenuesRequest) (*ynxtypes.QueryContractRevenuesResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "empty request")
+	}
+
+	crs, err := q.k.GetContractRevenues(ctx, req.DeployerAddress)
+	if err != nil {
+		return nil, err
+	}
+	return &ynxtypes.QueryContractRevenuesResponse{ContractRevenues: crs}, nil
+}
+
+func (q queryServer) Sponsorship(ctx context.Context, req *ynxtypes.QuerySponsorshipRequest) (*ynxtypes.QuerySponsorshipResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "empty request")
+	}
+
+	sp, found, err := q.k.GetSponsorship(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, errorsmod.Wrapf(errortypes.ErrNotFound, "sponsorship %d does not exist", req.Id)
+	}
+	return &ynxtypes.QuerySponsorshipResponse{Sponsorship: sp}, nil
+}
+
+func (q queryServer) Sponsorships(ctx context.Context, req *ynxtypes.QuerySponsorshipsRequest) (*ynxtypes.QuerySponsorshipsResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "empty request")
+	}
+
+	sps, err := q.k.GetSponsorships(ctx, req.Sponsor)
+	if err != nil {
+		return nil, err
+	}
+	return &ynxtypes.QuerySponsorshipsResponse{Sponsorships: sps}, nil
+}
+
+func (q queryServer) CircuitBreakers(ctx context.Context, _ *ynxtypes.QueryCircuitBreakersRequest) (*ynxtypes.QueryCircuitBreakersResponse, error) {
+	breakers, err := q.k.GetCircuitBreakers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	active := make([]ynxtypes.CircuitBreaker, 0, len(breakers))
+	for _, b := range breakers {
+		if b.Active(height) {
+			active = append(active, b)
+		}
+	}
+	return &ynxtypes.QueryCircuitBreakersResponse{CircuitBreakers: active}, nil
+}
+
+func (q queryServer) CircuitStatus(ctx context.Context, req *ynxtypes.QueryCircuitStatusRequest) (*ynxtypes.QueryCircuitStatusResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "empty request")
+	}
+
+	var (
+		breaker ynxtypes.CircuitBreaker
+		blocked bool
+		err     error
+	)
+	if req.MsgTypeUrl != "" {
+		breaker, blocked, err = q.k.MsgCircuitBreaker(ctx, req.MsgTypeUrl)
+	} else {
+		target, selector, nerr := ynxtypes.NormalizeCircuitBreaker(ynxtypes.CircuitBreakerKind_CIRCUIT_BREAKER_KIND_EVM_CALL, req.Target, req.Selector)
+		if nerr != nil {
+			return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, nerr.Error())
+		}
+		breaker, blocked, err = q.k.CallCircuitBreaker(ctx, common.HexToAddress(target), common.FromHex(selector))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ynxtypes.QueryCircuitStatusResponse{Blocked: blocked}
+	if blocked {
+		res.CircuitBreaker = &breaker
+	}
+	return res, nil
+}
+
+func (q queryServer) PreconfirmSigners(ctx context.Context, _ *ynxtypes.QueryPreconfirmSignersRequest) (*ynxtypes.QueryPreconfirmSignersResponse, error) {
+	epoch, err := q.k.CurrentEpoch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	active, found, err := q.k.ActivePreconfirmSigners(ctx)
+	if err != nil {
+		return nil, err
+	}
+	scheduled, err := q.k.ScheduledPreconfirmSigners(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ynxtypes.QueryPreconfirmSignersResponse{CurrentEpoch: epoch.Number, Scheduled: scheduled}
+	if found {
+		res.Active = &active
+	}
+	return res, nil
+}
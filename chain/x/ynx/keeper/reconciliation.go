@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
+)
+
+// sendToTreasuryObserved sends coins from moduleName to the treasury and records the increase of
+// the treasury balance it caused, up to treasuryCoins, as treasury inflows. The rest of coins is
+// paid to the same address as another share.
+func (k Keeper) sendToTreasuryObserved(ctx context.Context, moduleName string, treasury sdk.AccAddress, coins, treasuryCoins sdk.Coins) error {
+	before := k.bankKeeper.GetAllBalances(ctx, treasury)
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, moduleName, treasury, coins); err != nil {
+		return err
+	}
+
+	after := k.bankKeeper.GetAllBalances(ctx, treasury)
+	for _, coin := range treasuryCoins {
+		inflow := after.AmountOf(coin.Denom).Sub(before.AmountOf(coin.Denom))
+		if err := k.updateReconciliation(ctx, coin.Denom, func(r *ynxtypes.ReconciliationRecord) {
+			r.TreasuryInflows = r.TreasuryInflows.Add(sdkmath.MinInt(inflow, coin.Amount))
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addMinted records coins minted into the x/mint denom supply.
+func (k Keeper) addMinted(ctx context.Context, coin sdk.Coin) error {
+	if coin.IsZero() {
+		return nil
+	}
+	return k.updateReconciliation(ctx, coin.Denom, func(r *ynxtypes.ReconciliationRecord) {
+		r.Minted = r.Minted.Add(coin.Amount)
+	})
+}
+
+// updateReconciliation applies update to the reconciliation record of denom. A denom without a
+// record had no ledger entries when the records were anchored, so its anchors are zero.
+func (k Keeper) updateReconciliation(ctx context.Context, denom string, update func(*ynxtypes.ReconciliationRecord)) error {
+	r, err := k.Reconciliation.Get(ctx, denom)
+	if errors.Is(err, collections.ErrNotFound) {
+		r = ynxtypes.NewReconciliationRecord(denom)
+	} else if err != nil {
+		return err
+	}
+
+	update(&r)
+	return k.Reconciliation.Set(ctx, denom, r)
+}
+
+// GetReconciliation returns the reconciliation records ordered by denom.
+func (k Keeper) GetReconciliation(ctx context.Context) ([]ynxtypes.ReconciliationRecord, error) {
+	records := []ynxtypes.ReconciliationRecord{}
+	err := k.Reconciliation.Walk(ctx, nil, func(_ string, r ynxtypes.ReconciliationRecord) (bool, error) {
+		records = append(records, r)
+		return false, nil
+	})
+	return records, err
+}
+
+// anchorReconciliation replaces the reconciliation records with records anchored at the current
+// state: the bank total supply of the x/mint denom, and the ledger burns and treasury payouts less
+// the shares still awaiting settlement. The invariants only reconcile what happens afterwards.
+func (k Keeper) anchorReconciliation(ctx context.Context) error {
+	if err := k.Reconciliation.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	pendingBurns, err := k.pendingBurns(ctx)
+	if err != nil {
+		return err
+	}
+	pendingTreasury, err := k.pendingTreasuryShares(ctx)
+	if err != nil {
+		return err
+	}
+	mintParams, err := k.mintKeeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	mintRecord := ynxtypes.NewReconciliationRecord(mintParams.MintDenom)
+	records := []ynxtypes.ReconciliationRecord{}
+	if err := k.Revenue.Walk(ctx, nil, func(denom string, ledger ynxtypes.RevenueRecord) (bool, error) {
+		ledger = ynxtypes.NewRevenueRecord(denom).Add(ledger)
+		r := ynxtypes.NewReconciliationRecord(denom)
+		r.FeeBurnedAnchor = ledger.FeeBurned.Sub(pendingBurns.AmountOf(denom))
+		r.TreasuryAnchor = ledger.FeeTreasury.Add(ledger.InflationTreasury).Sub(pendingTreasury.AmountOf(denom))
+		if denom == mintParams.MintDenom {
+			mintRecord = r
+		} else {
+			records = append(records, r)
+		}
+		return false, nil
+	}); err != nil {
+		return err
+	}
+	mintRecord.SupplyAnchor = k.bankKeeper.GetSupply(ctx, mintParams.MintDenom).Amount
+	records = append(records, mintRecord)
+
+	for _, r := range records {
+		if err := k.Reconciliation.Set(ctx, r.Denom, r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pendingBurns returns the accrued fee shares awaiting settlement that will be burned.
+func (k Keeper) pendingBurns(ctx context.Context) (sdk.Coins, error) {
+	burns := sdk.NewCoins()
+	rng := collections.NewPrefixedPairRange[string, string]("")
+	err := k.AccruedFeeShares.Walk(ctx, rng, func(key collections.Pair[string, string], amount sdkmath.Int) (bool, error) {
+		burns = burns.Add(sdk.NewCoin(key.K2(), amount))
+		return false, nil
+	})
+	return burns, err
+}
+
+// pendingTreasuryShares returns the accrued treasury shares awaiting settlement.
+func (k Keeper) pendingTreasuryShares(ctx context.Context) (sdk.Coins, error) {
+	shares := sdk.NewCoins()
+	err := k.AccruedTreasuryShares.Walk(ctx, nil, func(key collections.Pair[string, string], amount sdkmath.Int) (bool, error) {
+		shares = shares.Add(sdk.NewCoin(key.K2(), amount))
+		return false, nil
+	})
+	return shares, err
+}
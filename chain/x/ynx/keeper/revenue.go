@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
+)
+
+// CurrentEpoch returns the current revenue accounting epoch.
+//
+// Chains that predate the revenue ledger have no epoch stored; for them epoch 0 is reported as
+// starting at the current height.
+func (k Keeper) CurrentEpoch(ctx context.Context) (ynxtypes.EpochInfo, error) {
+	epoch, err := k.Epoch.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return ynxtypes.EpochInfo{StartHeight: sdk.UnwrapSDKContext(ctx).BlockHeight()}, nil
+	}
+	return epoch, err
+}
+
+// AdvanceEpoch starts a new revenue epoch once epoch_length_blocks have elapsed since the
+// current epoch started.
+func (k Keeper) AdvanceEpoch(ctx sdk.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	epoch, err := k.Epoch.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return k.Epoch.Set(ctx, ynxtypes.EpochInfo{StartHeight: ctx.BlockHeight()})
+	}
+	if err != nil {
+		return err
+	}
+
+	elapsed := ctx.BlockHeight() - epoch.StartHeight
+	if params.EpochLengthBlocks == 0 || elapsed < 0 || uint64(elapsed) < params.EpochLengthBlocks {
+		return nil
+	}
+
+	return k.Epoch.Set(ctx, ynxtypes.EpochInfo{
+		Number:      epoch.Number + 1,
+		StartHeight: ctx.BlockHeight(),
+	})
+}
+
+// recordFeeSplit adds a transaction fee split to the revenue ledger and emits EventFeeSplit.
+func (k Keeper) recordFeeSplit(ctx sdk.Context, denom string, total, burned, treasury, founder, developer sdkmath.Int, contract string) error {
+	validators := total.Sub(burned).Sub(treasury).Sub(founder).Sub(developer)
+
+	delta := ynxtypes.NewRevenueRecord(denom)
+	delta.FeeBurned = burned
+	delta.FeeTreasury = treasury
+	delta.FeeFounder = founder
+	delta.FeeDevelopers = developer
+	delta.FeeValidators = validators
+
+	epoch, err := k.recordRevenue(ctx, delta)
+	if err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&ynxtypes.EventFeeSplit{
+		Denom:      denom,
+		Epoch:      epoch,
+		Total:      total,
+		Burned:     burned,
+		Treasury:   treasury,
+		Founder:    founder,
+		Validators: validators,
+		Developer:  developer,
+		Contract:   contract,
+	})
+}
+
+// recordInflationSplit adds an inflation split to the revenue ledger and emits EventInflationSplit.
+func (k Keeper) recordInflationSplit(ctx sdk.Context, denom string, minted, treasury sdkmath.Int, shares []ynxtypes.InflationRecipientShare) error {
+	recipients := sdkmath.ZeroInt()
+	for _, share := range shares {
+		recipients = recipients.Add(share.Amount)
+	}
+	validators := minted.Sub(treasury).Sub(recipients)
+
+	delta := ynxtypes.NewRevenueRecord(denom)
+	delta.InflationTreasury = treasury
+	delta.InflationValidators = validators
+	delta.InflationRecipients = recipients
+
+	epoch, err := k.recordRevenue(ctx, delta)
+	if err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&ynxtypes.EventInflationSplit{
+		Denom:      denom,
+		Epoch:      epoch,
+		Minted:     minted,
+		Treasury:   treasury,
+		Validators: validators,
+		Recipients: shares,
+	})
+}
+
+// recordRevenue adds delta to both the cumulative and the current epoch ledger and returns the
+// epoch it was recorded in.
+func (k Keeper) recordRevenue(ctx context.Context, delta ynxtypes.RevenueRecord) (uint64, error) {
+	epoch, err := k.CurrentEpoch(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	total, err := k.Revenue.Get(ctx, delta.Denom)
+	if errors.Is(err, collections.ErrNotFound) {
+		total = ynxtypes.NewRevenueRecord(delta.Denom)
+	} else if err != nil {
+		return 0, err
+	}
+	if err := k.Revenue.Set(ctx, delta.Denom, total.Add(delta)); err != nil {
+		return 0, err
+	}
+
+	key := collections.Join(epoch.Number, delta.Denom)
+	epochTotal, err := k.EpochRevenue.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		epochTotal = ynxtypes.NewRevenueRecord(delta.Denom)
+	} else if err != nil {
+		return 0, err
+	}
+	if err := k.EpochRevenue.Set(ctx, key, epochTotal.Add(delta)); err != nil {
+		return 0, err
+	}
+
+	return epoch.Number, nil
+}
+
+// GetRevenue returns the cumulative revenue record of denom, which is empty for denoms without
+// revenue.
+func (k Keeper) GetRevenue(ctx context.Context, denom string) (ynxtypes.RevenueRecord, error) {
+	r, err := k.Revenue.Get(ctx, denom)
+	if errors.Is(err, collections.ErrNotFound) {
+		return ynxtypes.NewRevenueRecord(denom), nil
+	}
+	return r, err
+}
+
+// GetEpochRevenue returns all revenue records of a single epoch.
+func (k Keeper) GetEpochRevenue(ctx context.Context, epoch uint64) ([]ynxtypes.RevenueRecord, error) {
+	records := []ynxtypes.RevenueRecord{}
+	err := k.EpochRevenue.Walk(ctx, collections.NewPrefixedPairRange[uint64, string](epoch), func(_ collections.Pair[uint64, string], r ynxtypes.RevenueRecord) (bool, error) {
+		records = append(records, r)
+		return false, nil
+	})
+	return records, err
+}
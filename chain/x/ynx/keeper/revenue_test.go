@@ -0,0 +1,176 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v2"
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	ynx "github.com/JiahaoAlbus/YNX/chain"
+	ynxconfig "github.com/JiahaoAlbus/YNX/chain/config"
+	ynxkeeper "github.com/JiahaoAlbus/YNX/chain/x/ynx/keeper"
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
+)
+
+func newTestApp(t testing.TB, height int64) (*ynx.App, sdk.Context) {
+	t.Helper()
+
+	ynxconfig.SetBech32Prefixes(sdk.GetConfig())
+
+	app := ynx.NewApp(
+		log.NewNopLogger(),
+		dbm.NewMemDB(),
+		nil,
+		true,
+		simtestutil.EmptyAppOptions{},
+	)
+
+	ctx := app.BaseApp.NewUncachedContext(false, cmtproto.Header{
+		ChainID: "ynx_test-1",
+		Height:  height,
+		Time:    time.Unix(1, 0).UTC(),
+	})
+
+	mintParams := minttypes.DefaultParams()
+	mintParams.MintDenom = ynxconfig.BaseDenom
+	require.NoError(t, app.MintKeeper.Params.Set(ctx, mintParams))
+
+	return app, ctx
+}
+
+func fundFeeCollector(t testing.TB, app *ynx.App, ctx sdk.Context, coins sdk.Coins) {
+	t.Helper()
+
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, authtypes.FeeCollectorName, coins))
+}
+
+func TestSplitTxFeeRecordsRevenue(t *testing.T) {
+	app, ctx := newTestApp(t, 1)
+
+	params := ynxtypes.DefaultParams()
+	params.TreasuryAddress = sdk.AccAddress(make20(0x22)).String()
+	params.FounderAddress = sdk.AccAddress(make20(0x11)).String()
+	params.FeeFounderBps = 500
+	require.NoError(t, app.YNXKeeper.Params.Set(ctx, params))
+	require.NoError(t, app.YNXKeeper.Epoch.Set(ctx, ynxtypes.EpochInfo{Number: 3, StartHeight: 1}))
+
+	fee := sdk.NewCoins(sdk.NewCoin(ynxconfig.BaseDenom, sdkmath.NewInt(10_000)))
+	fundFeeCollector(t, app, ctx, fee)
+	require.NoError(t, app.YNXKeeper.SplitTxFee(ctx, fee))
+	fundFeeCollector(t, app, ctx, fee)
+	require.NoError(t, app.YNXKeeper.SplitTxFee(ctx, fee))
+
+	total, err := app.YNXKeeper.Revenue.Get(ctx, ynxconfig.BaseDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(8_000), total.FeeBurned)
+	require.Equal(t, sdkmath.NewInt(2_000), total.FeeTreasury)
+	require.Equal(t, sdkmath.NewInt(1_000), total.FeeFounder)
+	require.Equal(t, sdkmath.NewInt(9_000), total.FeeValidators)
+
+	byEpoch, err := app.YNXKeeper.GetEpochRevenue(ctx, 3)
+	require.NoError(t, err)
+	require.Len(t, byEpoch, 1)
+	require.Equal(t, total, byEpoch[0])
+
+	var found bool
+	for _, ev := range ctx.EventManager().Events() {
+		if ev.Type == "ynx.ynx.v1.EventFeeSplit" {
+			found = true
+		}
+	}
+	require.True(t, found, "expected EventFeeSplit to be emitted")
+}
+
+func TestSplitTxFeeUnsetRecipientsGoToValidators(t *testing.T) {
+	app, ctx := newTestApp(t, 1)
+
+	require.NoError(t, app.YNXKeeper.Params.Set(ctx, ynxtypes.DefaultParams()))
+
+	fee := sdk.NewCoins(sdk.NewCoin(ynxconfig.BaseDenom, sdkmath.NewInt(1_000)))
+	fundFeeCollector(t, app, ctx, fee)
+	require.NoError(t, app.YNXKeeper.SplitTxFee(ctx, fee))
+
+	total, err := app.YNXKeeper.Revenue.Get(ctx, ynxconfig.BaseDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(400), total.FeeBurned)
+	require.True(t, total.FeeTreasury.IsZero())
+	require.True(t, total.FeeFounder.IsZero())
+	require.Equal(t, sdkmath.NewInt(600), total.FeeValidators)
+}
+
+func TestAdvanceEpoch(t *testing.T) {
+	app, ctx := newTestApp(t, 10)
+
+	params := ynxtypes.DefaultParams()
+	params.EpochLengthBlocks = 5
+	require.NoError(t, app.YNXKeeper.Params.Set(ctx, params))
+	require.NoError(t, app.YNXKeeper.Epoch.Set(ctx, ynxtypes.EpochInfo{Number: 0, StartHeight: 10}))
+
+	for _, tc := range []struct {
+		height int64
+		want   ynxtypes.EpochInfo
+	}{
+		{height: 14, want: ynxtypes.EpochInfo{Number: 0, StartHeight: 10}},
+		{height: 15, want: ynxtypes.EpochInfo{Number: 1, StartHeight: 15}},
+		{height: 19, want: ynxtypes.EpochInfo{Number: 1, StartHeight: 15}},
+		{height: 20, want: ynxtypes.EpochInfo{Number: 2, StartHeight: 20}},
+	} {
+		ctx = ctx.WithBlockHeight(tc.height)
+		require.NoError(t, app.YNXKeeper.AdvanceEpoch(ctx))
+
+		got, err := app.YNXKeeper.CurrentEpoch(ctx)
+		require.NoError(t, err)
+		require.Equal(t, tc.want, got, "height %d", tc.height)
+	}
+}
+
+func TestRevenueQueries(t *testing.T) {
+	app, ctx := newTestApp(t, 1)
+
+	require.NoError(t, app.YNXKeeper.Params.Set(ctx, ynxtypes.DefaultParams()))
+	require.NoError(t, app.YNXKeeper.Epoch.Set(ctx, ynxtypes.EpochInfo{Number: 2, StartHeight: 1}))
+
+	fee := sdk.NewCoins(sdk.NewCoin(ynxconfig.BaseDenom, sdkmath.NewInt(1_000)))
+	fundFeeCollector(t, app, ctx, fee)
+	require.NoError(t, app.YNXKeeper.SplitTxFee(ctx, fee))
+
+	q := ynxkeeper.NewQueryServerImpl(app.YNXKeeper)
+
+	res, err := q.Revenue(ctx, &ynxtypes.QueryRevenueRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Revenue, 1)
+	require.Equal(t, uint64(2), res.Epoch.Number)
+
+	res, err = q.Revenue(ctx, &ynxtypes.QueryRevenueRequest{Denom: "uother"})
+	require.NoError(t, err)
+	require.Len(t, res.Revenue, 1)
+	require.True(t, res.Revenue[0].FeeBurned.IsZero())
+
+	byEpoch, err := q.RevenueByEpoch(ctx, &ynxtypes.QueryRevenueByEpochRequest{Epoch: 2})
+	require.NoError(t, err)
+	require.Len(t, byEpoch.Revenue, 1)
+
+	byEpoch, err = q.RevenueByEpoch(ctx, &ynxtypes.QueryRevenueByEpochRequest{Epoch: 1})
+	require.NoError(t, err)
+	require.Empty(t, byEpoch.Revenue)
+}
+
+func make20(b byte) []byte {
+	out := make([]byte, 20)
+	for i := range out {
+		out[i] = b
+	}
+	return out
+}
@@ -0,0 +1,171 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
+)
+
+// addAccruedFeeShare adds amount to the share of recipient awaiting settlement. The empty
+// recipient accrues shares to burn. Treasury shares are also added to the accrued treasury shares.
+func (k Keeper) addAccruedFeeShare(ctx context.Context, recipient, denom string, amount sdkmath.Int, treasury bool) error {
+	if amount.IsZero() {
+		return nil
+	}
+
+	key := collections.Join(recipient, denom)
+	if err := addToMap(ctx, k.AccruedFeeShares, key, amount); err != nil {
+		return err
+	}
+	if !treasury {
+		return nil
+	}
+	return addToMap(ctx, k.AccruedTreasuryShares, key, amount)
+}
+
+// addToMap adds amount to the value of key in m.
+func addToMap[K any](ctx context.Context, m collections.Map[K, sdkmath.Int], key K, amount sdkmath.Int) error {
+	current, err := m.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		current = sdkmath.ZeroInt()
+	} else if err != nil {
+		return err
+	}
+
+	return m.Set(ctx, key, current.Add(amount))
+}
+
+// SettleFeeShares burns and pays out the accrued protocol fee shares at every height divisible by
+// fee_settlement_interval_blocks, with a single burn and one send per recipient. With the interval
+// set to zero, shares accrued before the switch to per-transaction payouts are settled right away.
+//
+// Each recipient is settled on its own. A recipient that cannot be paid, e.g. a blocked address,
+// keeps its shares accrued for the next settlement and an EventFeePayoutFailed is emitted instead
+// of failing the block.
+func (k Keeper) SettleFeeShares(ctx sdk.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if interval := params.FeeSettlementIntervalBlocks; interval > 0 && ctx.BlockHeight()%int64(interval) != 0 {
+		return nil
+	}
+
+	shares, err := k.GetAccruedFeeShares(ctx)
+	if err != nil {
+		return err
+	}
+	if len(shares) == 0 {
+		return nil
+	}
+
+	// Shares are ordered by recipient, so a recipient's denoms are adjacent and the shares to burn
+	// come first.
+	var pending []ynxtypes.FeePayout
+	for _, share := range shares {
+		coin := sdk.NewCoin(share.Denom, share.Amount)
+		if len(pending) > 0 && pending[len(pending)-1].Recipient == share.Recipient {
+			pending[len(pending)-1].Amount = sdk.Coins(pending[len(pending)-1].Amount).Add(coin)
+			continue
+		}
+		pending = append(pending, ynxtypes.FeePayout{Recipient: share.Recipient, Amount: sdk.NewCoins(coin)})
+	}
+
+	burned := sdk.NewCoins()
+	var payouts []ynxtypes.FeePayout
+	for _, payout := range pending {
+		if err := k.settleFeePayout(ctx, payout); err != nil {
+			if err := ctx.EventManager().EmitTypedEvent(&ynxtypes.EventFeePayoutFailed{
+				Recipient: payout.Recipient,
+				Amount:    payout.Amount,
+				Reason:    err.Error(),
+			}); err != nil {
+				return err
+			}
+			continue
+		}
+		if payout.Recipient == "" {
+			burned = payout.Amount
+		} else {
+			payouts = append(payouts, payout)
+		}
+	}
+	if burned.IsZero() && len(payouts) == 0 {
+		return nil
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&ynxtypes.EventFeeSharesSettled{
+		Burned:  burned,
+		Payouts: payouts,
+	})
+}
+
+// settleFeePayout burns or pays out the accrued shares of a single recipient and removes them, or
+// changes nothing if that fails. The treasury shares among them are recorded as treasury inflows.
+func (k Keeper) settleFeePayout(ctx sdk.Context, payout ynxtypes.FeePayout) error {
+	cacheCtx, write := ctx.CacheContext()
+
+	if payout.Recipient == "" {
+		if err := k.bankKeeper.BurnCoins(cacheCtx, ynxtypes.ModuleName, payout.Amount); err != nil {
+			return err
+		}
+	} else {
+		addr, err := sdk.AccAddressFromBech32(payout.Recipient)
+		if err != nil {
+			return errorsmod.Wrap(errortypes.ErrInvalidAddress, err.Error())
+		}
+		treasury := sdk.NewCoins()
+		rng := collections.NewPrefixedPairRange[string, string](payout.Recipient)
+		if err := k.AccruedTreasuryShares.Walk(cacheCtx, rng, func(key collections.Pair[string, string], amount sdkmath.Int) (bool, error) {
+			treasury = treasury.Add(sdk.NewCoin(key.K2(), amount))
+			return false, nil
+		}); err != nil {
+			return err
+		}
+		if err := k.sendToTreasuryObserved(cacheCtx, ynxtypes.ModuleName, addr, payout.Amount, treasury); err != nil {
+			return err
+		}
+		if err := k.AccruedTreasuryShares.Clear(cacheCtx, rng); err != nil {
+			return err
+		}
+	}
+
+	if err := k.AccruedFeeShares.Clear(cacheCtx, collections.NewPrefixedPairRange[string, string](payout.Recipient)); err != nil {
+		return err
+	}
+
+	write()
+	return nil
+}
+
+// GetAccruedFeeShares returns all fee shares awaiting settlement ordered by recipient and denom.
+func (k Keeper) GetAccruedFeeShares(ctx context.Context) ([]ynxtypes.AccruedFeeShare, error) {
+	return getAccruedShares(ctx, k.AccruedFeeShares)
+}
+
+// GetAccruedTreasuryShares returns the treasury shares among the fee shares awaiting settlement
+// ordered by recipient and denom.
+func (k Keeper) GetAccruedTreasuryShares(ctx context.Context) ([]ynxtypes.AccruedFeeShare, error) {
+	return getAccruedShares(ctx, k.AccruedTreasuryShares)
+}
+
+func getAccruedShares(ctx context.Context, m collections.Map[collections.Pair[string, string], sdkmath.Int]) ([]ynxtypes.AccruedFeeShare, error) {
+	shares := []ynxtypes.AccruedFeeShare{}
+	err := m.Walk(ctx, nil, func(key collections.Pair[string, string], amount sdkmath.Int) (bool, error) {
+		shares = append(shares, ynxtypes.AccruedFeeShare{
+			Recipient: key.K1(),
+			Denom:     key.K2(),
+			Amount:    amount,
+		})
+		return false, nil
+	})
+	return shares, err
+}
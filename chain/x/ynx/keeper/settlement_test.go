@@ -0,0 +1,144 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	ynx "github.com/JiahaoAlbus/YNX/chain"
+	ynxconfig "github.com/JiahaoAlbus/YNX/chain/config"
+	ynxkeeper "github.com/JiahaoAlbus/YNX/chain/x/ynx/keeper"
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
+)
+
+func requireAccruedFeeSharesInvariant(t *testing.T, app *ynx.App, ctx sdk.Context) {
+	t.Helper()
+
+	msg, broken := ynxkeeper.AccruedFeeSharesInvariant(app.YNXKeeper)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestSplitTxFeeBatchedSettlement(t *testing.T) {
+	app, ctx := newTestApp(t, 1)
+
+	treasury := sdk.AccAddress(make20(0x22))
+	founder := sdk.AccAddress(make20(0x11))
+
+	params := ynxtypes.DefaultParams()
+	params.TreasuryAddress = treasury.String()
+	params.FounderAddress = founder.String()
+	params.FeeFounderBps = 500
+	params.FeeSettlementIntervalBlocks = 10
+	require.NoError(t, app.YNXKeeper.Params.Set(ctx, params))
+
+	supplyBefore := app.BankKeeper.GetSupply(ctx, ynxconfig.BaseDenom).Amount
+
+	fee := sdk.NewCoins(sdk.NewCoin(ynxconfig.BaseDenom, sdkmath.NewInt(10_000)))
+	for i := 0; i < 3; i++ {
+		fundFeeCollector(t, app, ctx, fee)
+		require.NoError(t, app.YNXKeeper.SplitTxFee(ctx, fee))
+		requireAccruedFeeSharesInvariant(t, app, ctx)
+	}
+
+	// Nothing is paid out or burned before the settlement height.
+	require.True(t, app.BankKeeper.GetBalance(ctx, treasury, ynxconfig.BaseDenom).IsZero())
+	require.True(t, app.BankKeeper.GetBalance(ctx, founder, ynxconfig.BaseDenom).IsZero())
+	require.Equal(t, supplyBefore.AddRaw(30_000), app.BankKeeper.GetSupply(ctx, ynxconfig.BaseDenom).Amount)
+
+	ynxModule := authtypes.NewModuleAddress(ynxtypes.ModuleName)
+	require.Equal(t, sdkmath.NewInt(16_500), app.BankKeeper.GetBalance(ctx, ynxModule, ynxconfig.BaseDenom).Amount)
+
+	// The revenue ledger is updated per transaction.
+	rec, err := app.YNXKeeper.Revenue.Get(ctx, ynxconfig.BaseDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(12_000), rec.FeeBurned)
+
+	ctx = ctx.WithBlockHeight(9)
+	require.NoError(t, app.YNXKeeper.SettleFeeShares(ctx))
+	require.True(t, app.BankKeeper.GetBalance(ctx, treasury, ynxconfig.BaseDenom).IsZero())
+
+	ctx = ctx.WithBlockHeight(10)
+	require.NoError(t, app.YNXKeeper.SettleFeeShares(ctx))
+	requireAccruedFeeSharesInvariant(t, app, ctx)
+
+	require.Equal(t, sdkmath.NewInt(3_000), app.BankKeeper.GetBalance(ctx, treasury, ynxconfig.BaseDenom).Amount)
+	require.Equal(t, sdkmath.NewInt(1_500), app.BankKeeper.GetBalance(ctx, founder, ynxconfig.BaseDenom).Amount)
+	require.True(t, app.BankKeeper.GetBalance(ctx, ynxModule, ynxconfig.BaseDenom).IsZero())
+	require.Equal(t, supplyBefore.AddRaw(30_000-12_000), app.BankKeeper.GetSupply(ctx, ynxconfig.BaseDenom).Amount)
+
+	shares, err := app.YNXKeeper.GetAccruedFeeShares(ctx)
+	require.NoError(t, err)
+	require.Empty(t, shares)
+}
+
+func TestSettleFeeSharesAfterSwitchingToPerTx(t *testing.T) {
+	app, ctx := newTestApp(t, 3)
+
+	treasury := sdk.AccAddress(make20(0x22))
+	share := sdk.NewCoins(sdk.NewCoin(ynxconfig.BaseDenom, sdkmath.NewInt(100)))
+	fundFeeCollector(t, app, ctx, share)
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, ynxtypes.ModuleName, share))
+	require.NoError(t, app.YNXKeeper.AccruedFeeShares.Set(ctx, collections.Join(treasury.String(), ynxconfig.BaseDenom), sdkmath.NewInt(100)))
+
+	params := ynxtypes.DefaultParams()
+	params.TreasuryAddress = treasury.String()
+	require.NoError(t, app.YNXKeeper.Params.Set(ctx, params))
+
+	require.NoError(t, app.YNXKeeper.SettleFeeShares(ctx))
+	require.Equal(t, sdkmath.NewInt(100), app.BankKeeper.GetBalance(ctx, treasury, ynxconfig.BaseDenom).Amount)
+	requireAccruedFeeSharesInvariant(t, app, ctx)
+}
+
+func TestSettleFeeSharesKeepsFailedPayoutAccrued(t *testing.T) {
+	app, ctx := newTestApp(t, 1)
+
+	treasury := sdk.AccAddress(make20(0x22))
+	// Module accounts of x/ynx are blocked from receiving funds.
+	blocked := authtypes.NewModuleAddress(ynxtypes.SponsorshipPoolName)
+
+	accrued := sdk.NewCoins(sdk.NewCoin(ynxconfig.BaseDenom, sdkmath.NewInt(170)))
+	fundFeeCollector(t, app, ctx, accrued)
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, ynxtypes.ModuleName, accrued))
+	require.NoError(t, app.YNXKeeper.AccruedFeeShares.Set(ctx, collections.Join("", ynxconfig.BaseDenom), sdkmath.NewInt(20)))
+	require.NoError(t, app.YNXKeeper.AccruedFeeShares.Set(ctx, collections.Join(treasury.String(), ynxconfig.BaseDenom), sdkmath.NewInt(100)))
+	require.NoError(t, app.YNXKeeper.AccruedFeeShares.Set(ctx, collections.Join(blocked.String(), ynxconfig.BaseDenom), sdkmath.NewInt(50)))
+
+	supplyBefore := app.BankKeeper.GetSupply(ctx, ynxconfig.BaseDenom).Amount
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, app.YNXKeeper.SettleFeeShares(ctx))
+	requireAccruedFeeSharesInvariant(t, app, ctx)
+
+	// The other recipients are settled.
+	require.Equal(t, sdkmath.NewInt(100), app.BankKeeper.GetBalance(ctx, treasury, ynxconfig.BaseDenom).Amount)
+	require.Equal(t, supplyBefore.SubRaw(20), app.BankKeeper.GetSupply(ctx, ynxconfig.BaseDenom).Amount)
+
+	// The failed payout stays accrued for the next settlement.
+	shares, err := app.YNXKeeper.GetAccruedFeeShares(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []ynxtypes.AccruedFeeShare{{Recipient: blocked.String(), Denom: ynxconfig.BaseDenom, Amount: sdkmath.NewInt(50)}}, shares)
+	ynxModule := authtypes.NewModuleAddress(ynxtypes.ModuleName)
+	require.Equal(t, sdkmath.NewInt(50), app.BankKeeper.GetBalance(ctx, ynxModule, ynxconfig.BaseDenom).Amount)
+
+	var failed, settled bool
+	for _, event := range ctx.EventManager().Events() {
+		failed = failed || event.Type == "ynx.ynx.v1.EventFeePayoutFailed"
+		settled = settled || event.Type == "ynx.ynx.v1.EventFeeSharesSettled"
+	}
+	require.True(t, failed)
+	require.True(t, settled)
+}
+
+func TestAccruedFeeSharesInvariantDetectsMissingFunds(t *testing.T) {
+	app, ctx := newTestApp(t, 1)
+
+	require.NoError(t, app.YNXKeeper.AccruedFeeShares.Set(ctx, collections.Join("", ynxconfig.BaseDenom), sdkmath.NewInt(1)))
+
+	_, broken := ynxkeeper.AccruedFeeSharesInvariant(app.YNXKeeper)(ctx)
+	require.True(t, broken)
+}
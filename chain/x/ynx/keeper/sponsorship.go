@@ -0,0 +1,417 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
+)
+
+// CreateSponsorship creates a gas sponsorship controlled by sponsor and moves budget of the EVM
+// denom from sponsor to the sponsorship pool. It returns the id of the new sponsorship.
+func (k Keeper) CreateSponsorship(ctx context.Context, sponsor sdk.AccAddress, policy ynxtypes.SponsorshipPolicy, budget sdkmath.Int) (uint64, error) {
+	policy, err := normalizeSponsorshipPolicy(policy)
+	if err != nil {
+		return 0, err
+	}
+	if budget.IsNil() || budget.IsNegative() {
+		return 0, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "sponsorship budget must not be negative: %s", budget)
+	}
+
+	id, err := k.SponsorshipSeq.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+	// Id 0 is never assigned, so that contracts can use it for "no sponsorship".
+	if id == 0 {
+		if id, err = k.SponsorshipSeq.Next(ctx); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := k.sendToSponsorshipPool(ctx, sponsor, budget); err != nil {
+		return 0, err
+	}
+
+	sp := ynxtypes.Sponsorship{
+		Id:         id,
+		Sponsor:    sponsor.String(),
+		Policy:     policy,
+		Budget:     budget,
+		SpentToday: sdkmath.ZeroInt(),
+	}
+	if err := k.setSponsorship(ctx, sp); err != nil {
+		return 0, err
+	}
+
+	return id, sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&ynxtypes.EventSponsorshipCreated{
+		Id:      sp.Id,
+		Sponsor: sp.Sponsor,
+		Budget:  sp.Budget,
+	})
+}
+
+// UpdateSponsorship replaces the policy of sponsorship id. It must be called by its sponsor.
+func (k Keeper) UpdateSponsorship(ctx context.Context, sponsor sdk.AccAddress, id uint64, policy ynxtypes.SponsorshipPolicy) error {
+	policy, err := normalizeSponsorshipPolicy(policy)
+	if err != nil {
+		return err
+	}
+
+	sp, err := k.getOwnedSponsorship(ctx, sponsor, id)
+	if err != nil {
+		return err
+	}
+
+	if err := k.unindexSponsorship(ctx, sp); err != nil {
+		return err
+	}
+	sp.Policy = policy
+	if err := k.setSponsorship(ctx, sp); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&ynxtypes.EventSponsorshipUpdated{
+		Id:      sp.Id,
+		Sponsor: sp.Sponsor,
+	})
+}
+
+// FundSponsorship moves amount of the EVM denom from sponsor to the budget of sponsorship id. It
+// must be called by its sponsor.
+func (k Keeper) FundSponsorship(ctx context.Context, sponsor sdk.AccAddress, id uint64, amount sdkmath.Int) error {
+	if amount.IsNil() || !amount.IsPositive() {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "sponsorship funding must be positive: %s", amount)
+	}
+
+	sp, err := k.getOwnedSponsorship(ctx, sponsor, id)
+	if err != nil {
+		return err
+	}
+
+	if err := k.sendToSponsorshipPool(ctx, sponsor, amount); err != nil {
+		return err
+	}
+	sp.Budget = sp.Budget.Add(amount)
+	if err := k.Sponsorships.Set(ctx, sp.Id, sp); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&ynxtypes.EventSponsorshipFunded{
+		Id:      sp.Id,
+		Sponsor: sp.Sponsor,
+		Amount:  amount,
+	})
+}
+
+// CloseSponsorship removes sponsorship id and returns its remaining budget to the sponsor. It must
+// be called by its sponsor. It returns the refunded amount.
+func (k Keeper) CloseSponsorship(ctx context.Context, sponsor sdk.AccAddress, id uint64) (sdkmath.Int, error) {
+	sp, err := k.getOwnedSponsorship(ctx, sponsor, id)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	if err := k.unindexSponsorship(ctx, sp); err != nil {
+		return sdkmath.Int{}, err
+	}
+	if err := k.Sponsorships.Remove(ctx, sp.Id); err != nil {
+		return sdkmath.Int{}, err
+	}
+	if err := k.sendFromSponsorshipPool(ctx, sponsor, sp.Budget); err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	return sp.Budget, sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&ynxtypes.EventSponsorshipClosed{
+		Id:      sp.Id,
+		Sponsor: sp.Sponsor,
+		Refund:  sp.Budget,
+	})
+}
+
+// MatchSponsorship returns the sponsorship with the lowest id that pays for a call to contract
+// with the given gas limit and up-front fee at the current block time, if any.
+func (k Keeper) MatchSponsorship(ctx context.Context, contract common.Address, gas uint64, fee sdkmath.Int) (ynxtypes.Sponsorship, bool, error) {
+	iter, err := k.SponsorshipsByContract.Iterate(ctx, collections.NewPrefixedPairRange[[]byte, uint64](contract.Bytes()))
+	if err != nil {
+		return ynxtypes.Sponsorship{}, false, err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return ynxtypes.Sponsorship{}, false, err
+		}
+		sp, err := k.Sponsorships.Get(ctx, key.K2())
+		if err != nil {
+			return ynxtypes.Sponsorship{}, false, err
+		}
+		if sponsorshipPays(ctx, sp, gas, fee) {
+			return sp, true, nil
+		}
+	}
+
+	return ynxtypes.Sponsorship{}, false, nil
+}
+
+// MatchMsgSponsorship returns the sponsorship with the lowest id that pays for a Cosmos
+// transaction with messages of typeURLs, the given gas limit and fee at the current block time,
+// if any. The sponsorship must allow every one of typeURLs.
+func (k Keeper) MatchMsgSponsorship(ctx context.Context, typeURLs []string, gas uint64, fee sdkmath.Int) (ynxtypes.Sponsorship, bool, error) {
+	if len(typeURLs) == 0 {
+		return ynxtypes.Sponsorship{}, false, nil
+	}
+
+	iter, err := k.SponsorshipsByMsgType.Iterate(ctx, collections.NewPrefixedPairRange[string, uint64](typeURLs[0]))
+	if err != nil {
+		return ynxtypes.Sponsorship{}, false, err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return ynxtypes.Sponsorship{}, false, err
+		}
+		sp, err := k.Sponsorships.Get(ctx, key.K2())
+		if err != nil {
+			return ynxtypes.Sponsorship{}, false, err
+		}
+		if sp.Policy.AllowsMsgTypes(typeURLs) && sponsorshipPays(ctx, sp, gas, fee) {
+			return sp, true, nil
+		}
+	}
+
+	return ynxtypes.Sponsorship{}, false, nil
+}
+
+// FundSponsoredTx pays amount from the budget of sponsorship id to sender, so that the ante
+// handler can deduct the transaction fee from it, and counts it against the daily cap.
+func (k Keeper) FundSponsoredTx(ctx context.Context, id uint64, sender sdk.AccAddress, amount sdkmath.Int) error {
+	sp, err := k.Sponsorships.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if sp.Budget.LT(amount) {
+		return errorsmod.Wrapf(errortypes.ErrInsufficientFunds, "sponsorship %d budget %s is below %s", id, sp.Budget, amount)
+	}
+
+	if day := sponsorshipDay(sdk.UnwrapSDKContext(ctx)); sp.Day != day {
+		sp.Day = day
+		sp.SpentToday = sdkmath.ZeroInt()
+	}
+	sp.Budget = sp.Budget.Sub(amount)
+	sp.SpentToday = sp.SpentToday.Add(amount)
+	if err := k.Sponsorships.Set(ctx, sp.Id, sp); err != nil {
+		return err
+	}
+
+	return k.sendFromSponsorshipPool(ctx, sender, amount)
+}
+
+// RefundSponsoredTx returns amount that sender was funded with by sponsorship id but did not spend
+// on fees. If the transaction closed the sponsorship in the meantime, the amount goes back to the
+// sponsor instead.
+func (k Keeper) RefundSponsoredTx(ctx context.Context, id uint64, sponsor, sender sdk.AccAddress, amount sdkmath.Int) error {
+	if amount.IsZero() {
+		return nil
+	}
+
+	sp, err := k.Sponsorships.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return k.bankKeeper.SendCoins(ctx, sender, sponsor, sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), amount)))
+	}
+	if err != nil {
+		return err
+	}
+
+	sp.Budget = sp.Budget.Add(amount)
+	if sp.Day == sponsorshipDay(sdk.UnwrapSDKContext(ctx)) {
+		sp.SpentToday = sdkmath.MaxInt(sp.SpentToday.Sub(amount), sdkmath.ZeroInt())
+	}
+	if err := k.Sponsorships.Set(ctx, sp.Id, sp); err != nil {
+		return err
+	}
+
+	return k.sendToSponsorshipPool(ctx, sender, amount)
+}
+
+// EmitTxSponsored emits the event attributing the fee of a transaction to the sponsorship that
+// paid it. contract is empty for Cosmos transactions and msgTypeURLs for EVM transactions.
+func (k Keeper) EmitTxSponsored(ctx sdk.Context, id uint64, sponsor, sender sdk.AccAddress, contract string, msgTypeURLs []string, fee sdkmath.Int) error {
+	return ctx.EventManager().EmitTypedEvent(&ynxtypes.EventTxSponsored{
+		SponsorshipId: id,
+		Sponsor:       sponsor.String(),
+		Sender:        sender.String(),
+		Contract:      contract,
+		Fee:           fee,
+		MsgTypeUrls:   msgTypeURLs,
+	})
+}
+
+// GetSponsorship returns sponsorship id, if any.
+func (k Keeper) GetSponsorship(ctx context.Context, id uint64) (ynxtypes.Sponsorship, bool, error) {
+	sp, err := k.Sponsorships.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return ynxtypes.Sponsorship{}, false, nil
+	}
+	if err != nil {
+		return ynxtypes.Sponsorship{}, false, err
+	}
+	return sp, true, nil
+}
+
+// GetSponsorships returns all sponsorships ordered by id. A non-empty sponsor restricts the result
+// to the sponsorships of that bech32 address.
+func (k Keeper) GetSponsorships(ctx context.Context, sponsor string) ([]ynxtypes.Sponsorship, error) {
+	out := []ynxtypes.Sponsorship{}
+	err := k.Sponsorships.Walk(ctx, nil, func(_ uint64, sp ynxtypes.Sponsorship) (bool, error) {
+		if sponsor == "" || sp.Sponsor == sponsor {
+			out = append(out, sp)
+		}
+		return false, nil
+	})
+	return out, err
+}
+
+// GetSponsorshipBudgets returns the sum of all sponsorship budgets.
+func (k Keeper) GetSponsorshipBudgets(ctx context.Context) (sdkmath.Int, error) {
+	total := sdkmath.ZeroInt()
+	err := k.Sponsorships.Walk(ctx, nil, func(_ uint64, sp ynxtypes.Sponsorship) (bool, error) {
+		total = total.Add(sp.Budget)
+		return false, nil
+	})
+	return total, err
+}
+
+func (k Keeper) getOwnedSponsorship(ctx context.Context, sponsor sdk.AccAddress, id uint64) (ynxtypes.Sponsorship, error) {
+	sp, found, err := k.GetSponsorship(ctx, id)
+	if err != nil {
+		return ynxtypes.Sponsorship{}, err
+	}
+	if !found {
+		return ynxtypes.Sponsorship{}, errorsmod.Wrapf(errortypes.ErrNotFound, "sponsorship %d does not exist", id)
+	}
+	if sp.Sponsor != sponsor.String() {
+		return ynxtypes.Sponsorship{}, errorsmod.Wrapf(errortypes.ErrUnauthorized, "sponsorship %d belongs to %s", id, sp.Sponsor)
+	}
+	return sp, nil
+}
+
+// setSponsorship stores sp and indexes it under each of its allowed contracts and message types.
+func (k Keeper) setSponsorship(ctx context.Context, sp ynxtypes.Sponsorship) error {
+	if err := k.Sponsorships.Set(ctx, sp.Id, sp); err != nil {
+		return err
+	}
+	for _, addr := range sp.Policy.AllowedContracts {
+		if err := k.SponsorshipsByContract.Set(ctx, collections.Join(common.HexToAddress(addr).Bytes(), sp.Id)); err != nil {
+			return err
+		}
+	}
+	for _, typeURL := range sp.Policy.AllowedMsgTypeUrls {
+		if err := k.SponsorshipsByMsgType.Set(ctx, collections.Join(typeURL, sp.Id)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k Keeper) unindexSponsorship(ctx context.Context, sp ynxtypes.Sponsorship) error {
+	for _, addr := range sp.Policy.AllowedContracts {
+		if err := k.SponsorshipsByContract.Remove(ctx, collections.Join(common.HexToAddress(addr).Bytes(), sp.Id)); err != nil {
+			return err
+		}
+	}
+	for _, typeURL := range sp.Policy.AllowedMsgTypeUrls {
+		if err := k.SponsorshipsByMsgType.Remove(ctx, collections.Join(typeURL, sp.Id)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k Keeper) sendToSponsorshipPool(ctx context.Context, from sdk.AccAddress, amount sdkmath.Int) error {
+	if amount.IsZero() {
+		return nil
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), amount))
+	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, from, ynxtypes.SponsorshipPoolName, coins)
+}
+
+func (k Keeper) sendFromSponsorshipPool(ctx context.Context, to sdk.AccAddress, amount sdkmath.Int) error {
+	if amount.IsZero() {
+		return nil
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), amount))
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, ynxtypes.SponsorshipPoolName, to, coins)
+}
+
+// normalizeSponsorshipPolicy validates policy and stores its contracts in checksummed hex form.
+// It rejects the message types a Cosmos transaction sponsorship cannot be scoped to: authz MsgExec,
+// whose nested messages would not be checked, and MsgEthereumTx, which is sponsored by contract.
+func normalizeSponsorshipPolicy(policy ynxtypes.SponsorshipPolicy) (ynxtypes.SponsorshipPolicy, error) {
+	if policy.DailyCap.IsNil() {
+		policy.DailyCap = sdkmath.ZeroInt()
+	}
+	if err := policy.Validate(); err != nil {
+		return ynxtypes.SponsorshipPolicy{}, errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+
+	contracts := make([]string, len(policy.AllowedContracts))
+	for i, addr := range policy.AllowedContracts {
+		contract, err := ynxtypes.ParseContractAddress(addr)
+		if err != nil {
+			return ynxtypes.SponsorshipPolicy{}, errorsmod.Wrap(errortypes.ErrInvalidAddress, err.Error())
+		}
+		contracts[i] = contract.Hex()
+	}
+	policy.AllowedContracts = contracts
+
+	for _, typeURL := range policy.AllowedMsgTypeUrls {
+		if typeURL == sdk.MsgTypeURL(&authz.MsgExec{}) || typeURL == sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}) {
+			return ynxtypes.SponsorshipPolicy{}, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "%s cannot be sponsored by message type", typeURL)
+		}
+	}
+	return policy, nil
+}
+
+// sponsorshipPays reports whether sp pays for a transaction with the given gas limit and fee at
+// the current block time.
+func sponsorshipPays(ctx context.Context, sp ynxtypes.Sponsorship, gas uint64, fee sdkmath.Int) bool {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if !sp.Policy.Active(sdkCtx.BlockTime().Unix()) {
+		return false
+	}
+	if sp.Policy.MaxGasPerTx != 0 && gas > sp.Policy.MaxGasPerTx {
+		return false
+	}
+	if sp.Budget.LT(fee) {
+		return false
+	}
+	if remaining := sp.DailyRemaining(sponsorshipDay(sdkCtx)); remaining != nil && remaining.LT(fee) {
+		return false
+	}
+	return true
+}
+
+// sponsorshipDay returns the UTC day of the block time that sponsorship daily caps apply to.
+func sponsorshipDay(ctx sdk.Context) uint64 {
+	now := ctx.BlockTime().Unix()
+	if now < 0 {
+		return 0
+	}
+	return uint64(now) / ynxtypes.SecondsPerDay
+}
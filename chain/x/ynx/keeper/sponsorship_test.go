@@ -0,0 +1,231 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	ynxkeeper "github.com/JiahaoAlbus/YNX/chain/x/ynx/keeper"
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
+)
+
+func TestSponsorshipLifecycle(t *testing.T) {
+	app, ctx := newTestApp(t, 1)
+	ctx = ctx.WithBlockTime(time.Unix(10*ynxtypes.SecondsPerDay+5, 0).UTC())
+
+	denom := evmtypes.GetEVMCoinDenom()
+	sponsor := sdk.AccAddress(make20(0x61))
+	sender := sdk.AccAddress(make20(0x62))
+	dapp := common.BytesToAddress(make20(0x63))
+	other := common.BytesToAddress(make20(0x64))
+
+	coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(10_000)))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, sponsor, coins))
+
+	msgServer := ynxkeeper.NewMsgServerImpl(app.YNXKeeper)
+	res, err := msgServer.CreateSponsorship(ctx, &ynxtypes.MsgCreateSponsorship{
+		Sponsor: sponsor.String(),
+		Policy: ynxtypes.SponsorshipPolicy{
+			AllowedContracts: []string{dapp.Hex()},
+			MaxGasPerTx:      100_000,
+			DailyCap:         sdkmath.NewInt(1_500),
+		},
+		Budget: sdkmath.NewInt(4_000),
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.Id)
+	require.Equal(t, sdkmath.NewInt(6_000), app.BankKeeper.GetBalance(ctx, sponsor, denom).Amount)
+
+	_, found, err := app.YNXKeeper.MatchSponsorship(ctx, other, 50_000, sdkmath.NewInt(1_000))
+	require.NoError(t, err)
+	require.False(t, found, "contract not allowed")
+	_, found, err = app.YNXKeeper.MatchSponsorship(ctx, dapp, 200_000, sdkmath.NewInt(1_000))
+	require.NoError(t, err)
+	require.False(t, found, "gas limit above max_gas_per_tx")
+	_, found, err = app.YNXKeeper.MatchSponsorship(ctx, dapp, 50_000, sdkmath.NewInt(2_000))
+	require.NoError(t, err)
+	require.False(t, found, "fee above daily cap")
+
+	sp, found, err := app.YNXKeeper.MatchSponsorship(ctx, dapp, 50_000, sdkmath.NewInt(1_000))
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, res.Id, sp.Id)
+
+	// The sender is funded with the up-front fee and returns the unspent part.
+	require.NoError(t, app.YNXKeeper.FundSponsoredTx(ctx, sp.Id, sender, sdkmath.NewInt(1_000)))
+	require.NoError(t, app.YNXKeeper.RefundSponsoredTx(ctx, sp.Id, sponsor, sender, sdkmath.NewInt(400)))
+	require.Equal(t, sdkmath.NewInt(600), app.BankKeeper.GetBalance(ctx, sender, denom).Amount)
+
+	sp, found, err = app.YNXKeeper.GetSponsorship(ctx, sp.Id)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, sdkmath.NewInt(3_400), sp.Budget)
+	require.Equal(t, sdkmath.NewInt(600), sp.SpentToday)
+	require.Equal(t, uint64(10), sp.Day)
+
+	// 900 more would exceed the daily cap of 1500 today, but not tomorrow.
+	_, found, err = app.YNXKeeper.MatchSponsorship(ctx, dapp, 50_000, sdkmath.NewInt(1_000))
+	require.NoError(t, err)
+	require.False(t, found)
+	_, found, err = app.YNXKeeper.MatchSponsorship(ctx.WithBlockTime(ctx.BlockTime().Add(24*time.Hour)), dapp, 50_000, sdkmath.NewInt(1_000))
+	require.NoError(t, err)
+	require.True(t, found)
+
+	_, broken := ynxkeeper.SponsorshipPoolInvariant(app.YNXKeeper)(ctx)
+	require.False(t, broken)
+
+	// Only the sponsor can change or close the sponsorship.
+	_, err = msgServer.FundSponsorship(ctx, &ynxtypes.MsgFundSponsorship{Sponsor: sender.String(), Id: sp.Id, Amount: sdkmath.NewInt(1)})
+	require.Error(t, err)
+	_, err = msgServer.UpdateSponsorship(ctx, &ynxtypes.MsgUpdateSponsorship{
+		Sponsor: sponsor.String(),
+		Id:      sp.Id,
+		Policy: ynxtypes.SponsorshipPolicy{
+			AllowedContracts: []string{other.Hex()},
+			DailyCap:         sdkmath.ZeroInt(),
+		},
+	})
+	require.NoError(t, err)
+
+	_, found, err = app.YNXKeeper.MatchSponsorship(ctx, dapp, 50_000, sdkmath.NewInt(1_000))
+	require.NoError(t, err)
+	require.False(t, found)
+	_, found, err = app.YNXKeeper.MatchSponsorship(ctx, other, 500_000, sdkmath.NewInt(1_000))
+	require.NoError(t, err)
+	require.True(t, found)
+
+	_, err = msgServer.CloseSponsorship(ctx, &ynxtypes.MsgCloseSponsorship{Sponsor: sender.String(), Id: sp.Id})
+	require.Error(t, err)
+	_, err = msgServer.CloseSponsorship(ctx, &ynxtypes.MsgCloseSponsorship{Sponsor: sponsor.String(), Id: sp.Id})
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(9_400), app.BankKeeper.GetBalance(ctx, sponsor, denom).Amount)
+
+	_, found, err = app.YNXKeeper.MatchSponsorship(ctx, other, 50_000, sdkmath.NewInt(1_000))
+	require.NoError(t, err)
+	require.False(t, found)
+
+	_, broken = ynxkeeper.SponsorshipPoolInvariant(app.YNXKeeper)(ctx)
+	require.False(t, broken)
+}
+
+func TestSponsorshipExpiry(t *testing.T) {
+	app, ctx := newTestApp(t, 1)
+	ctx = ctx.WithBlockTime(time.Unix(1_000, 0).UTC())
+
+	denom := evmtypes.GetEVMCoinDenom()
+	sponsor := sdk.AccAddress(make20(0x71))
+	dapp := common.BytesToAddress(make20(0x72))
+
+	coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(1_000)))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, sponsor, coins))
+
+	id, err := app.YNXKeeper.CreateSponsorship(ctx, sponsor, ynxtypes.SponsorshipPolicy{
+		AllowedContracts: []string{dapp.Hex()},
+		DailyCap:         sdkmath.ZeroInt(),
+		ExpiresAt:        2_000,
+	}, sdkmath.NewInt(1_000))
+	require.NoError(t, err)
+
+	_, found, err := app.YNXKeeper.MatchSponsorship(ctx, dapp, 1_000_000, sdkmath.NewInt(1_000))
+	require.NoError(t, err)
+	require.True(t, found)
+	_, found, err = app.YNXKeeper.MatchSponsorship(ctx, dapp, 1_000_000, sdkmath.NewInt(1_001))
+	require.NoError(t, err)
+	require.False(t, found, "fee above budget")
+
+	_, found, err = app.YNXKeeper.MatchSponsorship(ctx.WithBlockTime(time.Unix(2_000, 0).UTC()), dapp, 1_000_000, sdkmath.NewInt(1_000))
+	require.NoError(t, err)
+	require.False(t, found, "expired")
+
+	sps, err := app.YNXKeeper.GetSponsorships(ctx, sponsor.String())
+	require.NoError(t, err)
+	require.Len(t, sps, 1)
+	require.Equal(t, id, sps[0].Id)
+	next, err := app.YNXKeeper.SponsorshipSeq.Peek(ctx)
+	require.NoError(t, err)
+	require.Equal(t, id+1, next)
+}
+
+func TestMatchMsgSponsorship(t *testing.T) {
+	app, ctx := newTestApp(t, 1)
+	ctx = ctx.WithBlockTime(time.Unix(1_000, 0).UTC())
+
+	denom := evmtypes.GetEVMCoinDenom()
+	sponsor := sdk.AccAddress(make20(0x71))
+	msgSend := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	msgDelegate := sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})
+
+	coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(2_000)))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, sponsor, coins))
+
+	// authz MsgExec would let any message through and MsgEthereumTx is sponsored by contract.
+	for _, typeURL := range []string{sdk.MsgTypeURL(&authz.MsgExec{}), sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{})} {
+		_, err := app.YNXKeeper.CreateSponsorship(ctx, sponsor, ynxtypes.SponsorshipPolicy{
+			AllowedMsgTypeUrls: []string{typeURL},
+			DailyCap:           sdkmath.ZeroInt(),
+		}, sdkmath.NewInt(1_000))
+		require.ErrorContains(t, err, "cannot be sponsored")
+	}
+
+	sendOnly, err := app.YNXKeeper.CreateSponsorship(ctx, sponsor, ynxtypes.SponsorshipPolicy{
+		AllowedMsgTypeUrls: []string{msgSend},
+		MaxGasPerTx:        100_000,
+		DailyCap:           sdkmath.ZeroInt(),
+	}, sdkmath.NewInt(1_000))
+	require.NoError(t, err)
+	both, err := app.YNXKeeper.CreateSponsorship(ctx, sponsor, ynxtypes.SponsorshipPolicy{
+		AllowedMsgTypeUrls: []string{msgDelegate, msgSend},
+		DailyCap:           sdkmath.ZeroInt(),
+	}, sdkmath.NewInt(1_000))
+	require.NoError(t, err)
+
+	// The sponsorship with the lowest id that allows every message and fits the limits pays.
+	sp, found, err := app.YNXKeeper.MatchMsgSponsorship(ctx, []string{msgSend}, 100_000, sdkmath.NewInt(1_000))
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, sendOnly, sp.Id)
+
+	sp, found, err = app.YNXKeeper.MatchMsgSponsorship(ctx, []string{msgSend}, 200_000, sdkmath.NewInt(1_000))
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, both, sp.Id, "gas limit above the first policy")
+
+	sp, found, err = app.YNXKeeper.MatchMsgSponsorship(ctx, []string{msgSend, msgDelegate}, 100_000, sdkmath.NewInt(1_000))
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, both, sp.Id, "the first policy does not allow every message")
+
+	_, found, err = app.YNXKeeper.MatchMsgSponsorship(ctx, []string{msgSend, sdk.MsgTypeURL(&banktypes.MsgMultiSend{})}, 100_000, sdkmath.NewInt(1_000))
+	require.NoError(t, err)
+	require.False(t, found)
+
+	// Updating a policy reindexes it; closing it removes it.
+	require.NoError(t, app.YNXKeeper.UpdateSponsorship(ctx, sponsor, both, ynxtypes.SponsorshipPolicy{
+		AllowedMsgTypeUrls: []string{msgDelegate},
+		DailyCap:           sdkmath.ZeroInt(),
+	}))
+	_, found, err = app.YNXKeeper.MatchMsgSponsorship(ctx, []string{msgSend}, 200_000, sdkmath.NewInt(1_000))
+	require.NoError(t, err)
+	require.False(t, found)
+
+	_, err = app.YNXKeeper.CloseSponsorship(ctx, sponsor, both)
+	require.NoError(t, err)
+	_, found, err = app.YNXKeeper.MatchMsgSponsorship(ctx, []string{msgDelegate}, 100_000, sdkmath.NewInt(1_000))
+	require.NoError(t, err)
+	require.False(t, found)
+}
@@ -0,0 +1,347 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
+)
+
+// Stake-weighted votes are checkpointed from x/staking: the staking hooks only mark the delegators
+// and validators whose bonded stake may have changed, and SnapshotStakeVotes recomputes their
+// credits at the end of the block, after x/staking has applied the validator set updates.
+
+// DelegateStakeVotes delegates the stake-weighted votes of delegator to delegatee. An empty
+// delegatee credits the votes of each delegation back to its validator's operator account.
+func (k Keeper) DelegateStakeVotes(ctx context.Context, delegator, delegatee sdk.AccAddress) error {
+	voter, err := k.GetStakeVoter(ctx, delegator)
+	if err != nil {
+		return err
+	}
+	old := voter.Delegatee
+	voter.Delegatee = ""
+	if !delegatee.Empty() {
+		voter.Delegatee = delegatee.String()
+	}
+	if voter.Delegatee == old {
+		return nil
+	}
+	if err := k.snapshotStakeVoter(ctx, delegator, voter); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&ynxtypes.EventStakeVotesDelegated{
+		Delegator:    delegator.String(),
+		OldDelegatee: old,
+		NewDelegatee: voter.Delegatee,
+	})
+}
+
+// GetStakeVoter returns the stake voter of delegator. Delegators without bonded stake nor a
+// delegatee have an empty voter.
+func (k Keeper) GetStakeVoter(ctx context.Context, delegator sdk.AccAddress) (ynxtypes.StakeVoter, error) {
+	voter, err := k.StakeVoters.Get(ctx, delegator)
+	if errors.Is(err, collections.ErrNotFound) {
+		return ynxtypes.StakeVoter{Delegator: delegator.String(), Credits: []ynxtypes.StakeVoteCredit{}}, nil
+	}
+	return voter, err
+}
+
+// GetStakeVotes returns the stake-weighted votes currently credited to account.
+func (k Keeper) GetStakeVotes(ctx context.Context, account sdk.AccAddress) (sdkmath.Int, error) {
+	return k.GetPastStakeVotes(ctx, account, sdk.UnwrapSDKContext(ctx).BlockHeight())
+}
+
+// GetPastStakeVotes returns the stake-weighted votes credited to account at the end of block height.
+func (k Keeper) GetPastStakeVotes(ctx context.Context, account sdk.AccAddress, height int64) (sdkmath.Int, error) {
+	rng := collections.NewPrefixedPairRange[[]byte, int64](account).EndInclusive(height).Descending()
+	return latestVoteCheckpoint(ctx, k.StakeVoteCheckpoints, rng)
+}
+
+// GetTotalStakeVotes returns the bonded stake currently counted as votes.
+func (k Keeper) GetTotalStakeVotes(ctx context.Context) (sdkmath.Int, error) {
+	return k.GetPastTotalStakeVotes(ctx, sdk.UnwrapSDKContext(ctx).BlockHeight())
+}
+
+// GetPastTotalStakeVotes returns the bonded stake counted as votes at the end of block height.
+func (k Keeper) GetPastTotalStakeVotes(ctx context.Context, height int64) (sdkmath.Int, error) {
+	rng := new(collections.Range[int64]).EndInclusive(height).Descending()
+	return latestVoteCheckpoint(ctx, k.StakeVoteSupplyCheckpoints, rng)
+}
+
+// SnapshotStakeVotes recomputes the credits of the delegators marked by the staking hooks, and of
+// all delegators of the marked validators, and checkpoints the changed votes at the current height.
+func (k Keeper) SnapshotStakeVotes(ctx context.Context) error {
+	var validators [][]byte
+	err := k.StakeVotesDirtyValidators.Walk(ctx, nil, func(valAddr []byte) (bool, error) {
+		validators = append(validators, valAddr)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, valAddr := range validators {
+		delegations, err := k.stakingKeeper.GetValidatorDelegations(ctx, valAddr)
+		if err != nil {
+			return err
+		}
+		for _, delegation := range delegations {
+			delegator, err := sdk.AccAddressFromBech32(delegation.DelegatorAddress)
+			if err != nil {
+				return err
+			}
+			if err := k.StakeVotesDirty.Set(ctx, delegator); err != nil {
+				return err
+			}
+		}
+		if err := k.StakeVotesDirtyValidators.Remove(ctx, valAddr); err != nil {
+			return err
+		}
+	}
+
+	var delegators [][]byte
+	err = k.StakeVotesDirty.Walk(ctx, nil, func(delegator []byte) (bool, error) {
+		delegators = append(delegators, delegator)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, delegator := range delegators {
+		voter, err := k.GetStakeVoter(ctx, delegator)
+		if err != nil {
+			return err
+		}
+		if err := k.snapshotStakeVoter(ctx, delegator, voter); err != nil {
+			return err
+		}
+		if err := k.StakeVotesDirty.Remove(ctx, delegator); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarkAllStakeVotesDirty marks every delegator for the next SnapshotStakeVotes, which seeds the
+// stake-weighted votes of a chain that already has delegations.
+func (k Keeper) MarkAllStakeVotesDirty(ctx context.Context) error {
+	var markErr error
+	err := k.stakingKeeper.IterateAllDelegations(ctx, func(delegation stakingtypes.Delegation) bool {
+		delegator, err := sdk.AccAddressFromBech32(delegation.DelegatorAddress)
+		if err == nil {
+			err = k.StakeVotesDirty.Set(ctx, delegator)
+		}
+		markErr = err
+		return err != nil
+	})
+	if err != nil {
+		return err
+	}
+	return markErr
+}
+
+// snapshotStakeVoter recomputes the credits of delegator from its delegations to bonded validators,
+// checkpoints the difference with its previous credits and stores voter with the new credits.
+func (k Keeper) snapshotStakeVoter(ctx context.Context, delegator sdk.AccAddress, voter ynxtypes.StakeVoter) error {
+	delta := make(map[string]sdkmath.Int)
+	for _, credit := range voter.Credits {
+		delta[credit.Delegatee] = credit.Votes.Neg()
+	}
+
+	credits := make(map[string]sdkmath.Int)
+	var iterErr error
+	err := k.stakingKeeper.IterateDelegatorDelegations(ctx, delegator, func(delegation stakingtypes.Delegation) bool {
+		iterErr = func() error {
+			valAddr, err := sdk.ValAddressFromBech32(delegation.ValidatorAddress)
+			if err != nil {
+				return err
+			}
+			validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+			if err != nil {
+				return err
+			}
+			if !validator.IsBonded() {
+				return nil
+			}
+			tokens := validator.TokensFromShares(delegation.Shares).TruncateInt()
+			if !tokens.IsPositive() {
+				return nil
+			}
+			delegatee := voter.Delegatee
+			if delegatee == "" {
+				delegatee = sdk.AccAddress(valAddr).String()
+			}
+			if prev, ok := credits[delegatee]; ok {
+				tokens = tokens.Add(prev)
+			}
+			credits[delegatee] = tokens
+			return nil
+		}()
+		return iterErr != nil
+	})
+	if err != nil {
+		return err
+	}
+	if iterErr != nil {
+		return iterErr
+	}
+
+	voter.Credits = make([]ynxtypes.StakeVoteCredit, 0, len(credits))
+	for delegatee, votes := range credits {
+		voter.Credits = append(voter.Credits, ynxtypes.StakeVoteCredit{Delegatee: delegatee, Votes: votes})
+		if prev, ok := delta[delegatee]; ok {
+			votes = votes.Add(prev)
+		}
+		delta[delegatee] = votes
+	}
+	sort.Slice(voter.Credits, func(i, j int) bool { return voter.Credits[i].Delegatee < voter.Credits[j].Delegatee })
+
+	delegatees := make([]string, 0, len(delta))
+	for delegatee := range delta {
+		delegatees = append(delegatees, delegatee)
+	}
+	sort.Strings(delegatees)
+
+	total := sdkmath.ZeroInt()
+	for _, delegatee := range delegatees {
+		if delta[delegatee].IsZero() {
+			continue
+		}
+		account, err := sdk.AccAddressFromBech32(delegatee)
+		if err != nil {
+			return err
+		}
+		if err := k.addStakeVotes(ctx, account, delta[delegatee]); err != nil {
+			return err
+		}
+		total = total.Add(delta[delegatee])
+	}
+	if !total.IsZero() {
+		if err := k.addTotalStakeVotes(ctx, total); err != nil {
+			return err
+		}
+	}
+
+	if len(voter.Credits) == 0 && voter.Delegatee == "" {
+		return k.StakeVoters.Remove(ctx, delegator)
+	}
+	return k.StakeVoters.Set(ctx, delegator, voter)
+}
+
+// addStakeVotes checkpoints the stake-weighted votes of delegatee changed by delta at the current
+// height.
+func (k Keeper) addStakeVotes(ctx context.Context, delegatee sdk.AccAddress, delta sdkmath.Int) error {
+	votes, err := k.GetStakeVotes(ctx, delegatee)
+	if err != nil {
+		return err
+	}
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	return k.StakeVoteCheckpoints.Set(ctx, collections.Join([]byte(delegatee), height), votes.Add(delta))
+}
+
+// addTotalStakeVotes checkpoints the total stake-weighted votes changed by delta at the current
+// height.
+func (k Keeper) addTotalStakeVotes(ctx context.Context, delta sdkmath.Int) error {
+	total, err := k.GetTotalStakeVotes(ctx)
+	if err != nil {
+		return err
+	}
+	return k.StakeVoteSupplyCheckpoints.Set(ctx, sdk.UnwrapSDKContext(ctx).BlockHeight(), total.Add(delta))
+}
+
+// GetStakeVoters returns all stake voters ordered by delegator address.
+func (k Keeper) GetStakeVoters(ctx context.Context) ([]ynxtypes.StakeVoter, error) {
+	out := []ynxtypes.StakeVoter{}
+	err := k.StakeVoters.Walk(ctx, nil, func(_ []byte, voter ynxtypes.StakeVoter) (bool, error) {
+		out = append(out, voter)
+		return false, nil
+	})
+	return out, err
+}
+
+// GetStakeVoteCheckpoints returns the stake vote checkpoints ordered by account address and
+// height, and the total checkpoints ordered by height.
+func (k Keeper) GetStakeVoteCheckpoints(ctx context.Context) (checkpoints, supply []ynxtypes.VoteCheckpoint, err error) {
+	checkpoints = []ynxtypes.VoteCheckpoint{}
+	err = k.StakeVoteCheckpoints.Walk(ctx, nil, func(key collections.Pair[[]byte, int64], votes sdkmath.Int) (bool, error) {
+		checkpoints = append(checkpoints, ynxtypes.VoteCheckpoint{
+			Account: sdk.AccAddress(key.K1()).String(),
+			Height:  key.K2(),
+			Votes:   votes,
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	supply = []ynxtypes.VoteCheckpoint{}
+	err = k.StakeVoteSupplyCheckpoints.Walk(ctx, nil, func(height int64, votes sdkmath.Int) (bool, error) {
+		supply = append(supply, ynxtypes.VoteCheckpoint{Height: height, Votes: votes})
+		return false, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return checkpoints, supply, nil
+}
+
+// StakingHooks returns the x/staking hooks that mark the stake-weighted votes to snapshot. They
+// hold the keeper by pointer because the staking hooks are set before the keeper is built.
+func (k *Keeper) StakingHooks() stakingtypes.StakingHooks {
+	return stakingHooks{k: k}
+}
+
+type stakingHooks struct {
+	k *Keeper
+}
+
+var _ stakingtypes.StakingHooks = stakingHooks{}
+
+func (h stakingHooks) markDelegator(ctx context.Context, delAddr sdk.AccAddress) error {
+	return h.k.StakeVotesDirty.Set(ctx, delAddr)
+}
+
+func (h stakingHooks) markValidator(ctx context.Context, valAddr sdk.ValAddress) error {
+	return h.k.StakeVotesDirtyValidators.Set(ctx, valAddr)
+}
+
+func (h stakingHooks) AfterDelegationModified(ctx context.Context, delAddr sdk.AccAddress, _ sdk.ValAddress) error {
+	return h.markDelegator(ctx, delAddr)
+}
+
+func (h stakingHooks) BeforeDelegationRemoved(ctx context.Context, delAddr sdk.AccAddress, _ sdk.ValAddress) error {
+	return h.markDelegator(ctx, delAddr)
+}
+
+func (h stakingHooks) AfterValidatorBonded(ctx context.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	return h.markValidator(ctx, valAddr)
+}
+
+func (h stakingHooks) AfterValidatorBeginUnbonding(ctx context.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	return h.markValidator(ctx, valAddr)
+}
+
+func (h stakingHooks) BeforeValidatorSlashed(ctx context.Context, valAddr sdk.ValAddress, _ sdkmath.LegacyDec) error {
+	return h.markValidator(ctx, valAddr)
+}
+
+func (stakingHooks) AfterValidatorCreated(context.Context, sdk.ValAddress) error   { return nil }
+func (stakingHooks) BeforeValidatorModified(context.Context, sdk.ValAddress) error { return nil }
+func (stakingHooks) AfterValidatorRemoved(context.Context, sdk.ConsAddress, sdk.ValAddress) error {
+	return nil
+}
+func (stakingHooks) BeforeDelegationCreated(context.Context, sdk.AccAddress, sdk.ValAddress) error {
+	return nil
+}
+func (stakingHooks) BeforeDelegationSharesModified(context.Context, sdk.AccAddress, sdk.ValAddress) error {
+	return nil
+}
+func (stakingHooks) AfterUnbondingInitiated(context.Context, uint64) error { return nil }
@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	ynxkeeper "github.com/JiahaoAlbus/YNX/chain/x/ynx/keeper"
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
+)
+
+func TestStakeVoteSnapshots(t *testing.T) {
+	app, ctx := newTestApp(t, 10)
+	k := app.YNXKeeper
+	hooks := app.YNXKeeper.StakingHooks()
+
+	val1 := sdk.ValAddress(make20(0x91))
+	val2 := sdk.ValAddress(make20(0x92))
+	alice := sdk.AccAddress(make20(0x93))
+	bob := sdk.AccAddress(make20(0x94))
+
+	setValidator := func(val sdk.ValAddress, status stakingtypes.BondStatus, tokens, shares int64) {
+		require.NoError(t, app.StakingKeeper.SetValidator(ctx, stakingtypes.Validator{
+			OperatorAddress: val.String(),
+			Status:          status,
+			Tokens:          sdkmath.NewInt(tokens),
+			DelegatorShares: sdkmath.LegacyNewDec(shares),
+		}))
+	}
+	setValidator(val1, stakingtypes.Bonded, 1_000, 1_000)
+	setValidator(val2, stakingtypes.Unbonded, 300, 300)
+	for _, d := range []struct {
+		val    sdk.ValAddress
+		shares int64
+	}{{val1, 600}, {val2, 300}} {
+		require.NoError(t, app.StakingKeeper.SetDelegation(ctx, stakingtypes.NewDelegation(alice.String(), d.val.String(), sdkmath.LegacyNewDec(d.shares))))
+		require.NoError(t, hooks.AfterDelegationModified(ctx, alice, d.val))
+	}
+
+	// Only the stake bonded to val1 counts, for the validator's operator account.
+	require.NoError(t, k.SnapshotStakeVotes(ctx))
+	votes, err := k.GetStakeVotes(ctx, sdk.AccAddress(val1))
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(600), votes)
+
+	// Delegating moves the votes right away.
+	ctx = ctx.WithBlockHeight(20)
+	require.NoError(t, k.DelegateStakeVotes(ctx, alice, bob))
+
+	// A slash halves val1's exchange rate, then val2 bonds.
+	ctx = ctx.WithBlockHeight(30)
+	setValidator(val1, stakingtypes.Bonded, 500, 1_000)
+	require.NoError(t, hooks.BeforeValidatorSlashed(ctx, val1, sdkmath.LegacyNewDecWithPrec(5, 1)))
+	require.NoError(t, k.SnapshotStakeVotes(ctx))
+	ctx = ctx.WithBlockHeight(40)
+	setValidator(val2, stakingtypes.Bonded, 300, 300)
+	require.NoError(t, hooks.AfterValidatorBonded(ctx, nil, val2))
+	require.NoError(t, k.SnapshotStakeVotes(ctx))
+
+	// Resetting the delegatee gives the votes back to the validators.
+	ctx = ctx.WithBlockHeight(50)
+	require.NoError(t, k.DelegateStakeVotes(ctx, alice, nil))
+
+	for _, tc := range []struct {
+		height          int64
+		val1, val2, bob int64
+		total           int64
+	}{
+		{height: 9},
+		{height: 10, val1: 600, total: 600},
+		{height: 20, bob: 600, total: 600},
+		{height: 30, bob: 300, total: 300},
+		{height: 40, bob: 600, total: 600},
+		{height: 50, val1: 300, val2: 300, total: 600},
+	} {
+		for _, acc := range []struct {
+			addr  sdk.AccAddress
+			votes int64
+		}{{sdk.AccAddress(val1), tc.val1}, {sdk.AccAddress(val2), tc.val2}, {bob, tc.bob}} {
+			votes, err := k.GetPastStakeVotes(ctx, acc.addr, tc.height)
+			require.NoError(t, err)
+			require.Equal(t, sdkmath.NewInt(acc.votes), votes, "%s at %d", acc.addr, tc.height)
+		}
+		total, err := k.GetPastTotalStakeVotes(ctx, tc.height)
+		require.NoError(t, err)
+		require.Equal(t, sdkmath.NewInt(tc.total), total, "total at %d", tc.height)
+	}
+
+	_, broken := ynxkeeper.StakeVotesInvariant(k)(ctx)
+	require.False(t, broken)
+
+	// The exported voters and checkpoints form a valid genesis state.
+	voters, err := k.GetStakeVoters(ctx)
+	require.NoError(t, err)
+	require.Len(t, voters, 1)
+	require.Empty(t, voters[0].Delegatee)
+	checkpoints, supply, err := k.GetStakeVoteCheckpoints(ctx)
+	require.NoError(t, err)
+	gs := ynxtypes.DefaultGenesis()
+	gs.StakeVoters, gs.StakeVoteCheckpoints, gs.StakeVoteSupplyCheckpoints = voters, checkpoints, supply
+	require.NoError(t, gs.Validate())
+}
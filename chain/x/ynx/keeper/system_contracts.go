@@ -0,0 +1,272 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
+)
+
+// SystemDeployerAddress returns the address that deploys system contracts after genesis and makes
+// their migration calls. It holds no funds and no key controls it.
+func SystemDeployerAddress() common.Address {
+	return common.BytesToAddress(authtypes.NewModuleAddress(ynxtypes.SystemDeployerName))
+}
+
+// DeploySystemContract deploys a system contract from the system deployer, runs the migration calls
+// and points the system_contracts entry name at the new contract. The creation code is the embedded
+// artifact, or bytecode when artifact is empty, followed by constructorArgs. Calls with an empty to
+// address go to the new contract.
+//
+// Nothing is written unless every step succeeds.
+func (k Keeper) DeploySystemContract(
+	ctx context.Context,
+	authority, name, artifact string,
+	bytecode, constructorArgs []byte,
+	calls []ynxtypes.SystemContractCall,
+) (common.Address, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if err := validateGovernedSystemContractName(name); err != nil {
+		return common.Address{}, err
+	}
+	initCode, err := systemContractInitCode(artifact, bytecode, constructorArgs)
+	if err != nil {
+		return common.Address{}, errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+
+	cacheCtx, write := sdkCtx.CacheContext()
+
+	from := SystemDeployerAddress()
+	deployerAcc := sdk.AccAddress(from.Bytes())
+	acc := k.accountKeeper.GetAccount(cacheCtx, deployerAcc)
+	if acc == nil {
+		acc = k.accountKeeper.NewAccountWithAddress(cacheCtx, deployerAcc)
+		k.accountKeeper.SetAccount(cacheCtx, acc)
+	}
+
+	d, err := newEVMGenesisDeployer(k, cacheCtx, from, acc.GetSequence())
+	if err != nil {
+		return common.Address{}, err
+	}
+	created, _, err := d.create(initCode)
+	if err != nil {
+		return common.Address{}, errorsmod.Wrapf(err, "deploy %s", name)
+	}
+
+	for i, call := range calls {
+		to := created
+		if call.To != "" {
+			if to, err = ynxtypes.ParseContractAddress(call.To); err != nil {
+				return common.Address{}, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "migration call %d: %s", i, err)
+			}
+		}
+		if _, err := d.call(to, call.Data); err != nil {
+			return common.Address{}, errorsmod.Wrapf(err, "migration call %d to %s", i, to.Hex())
+		}
+	}
+
+	if err := k.setSystemContract(cacheCtx, authority, name, created, artifact); err != nil {
+		return common.Address{}, err
+	}
+
+	write()
+	return created, nil
+}
+
+// SetSystemContract points the system_contracts entry name at an already deployed contract.
+func (k Keeper) SetSystemContract(ctx context.Context, authority, name string, contract common.Address) error {
+	if err := validateGovernedSystemContractName(name); err != nil {
+		return err
+	}
+	return k.setSystemContract(sdk.UnwrapSDKContext(ctx), authority, name, contract, "")
+}
+
+// validateGovernedSystemContractName checks a system contract entry name set by governance. The
+// nyxt_legacy entry is reserved for RetireLegacyNYXT.
+func validateGovernedSystemContractName(name string) error {
+	if err := ynxtypes.ValidateSystemContractName(name); err != nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+	if name == ynxtypes.LegacyNYXTContractName {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "system contract entry %s is reserved for the retired standalone NYXT", name)
+	}
+	return nil
+}
+
+func (k Keeper) setSystemContract(ctx sdk.Context, authority, name string, contract common.Address, artifact string) error {
+	if !k.evmKeeper.IsContract(ctx, contract) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "no contract code at %s", contract.Hex())
+	}
+
+	contracts, err := k.SystemContracts.Get(ctx)
+	if err != nil {
+		return err
+	}
+	old := contracts.Get(name)
+	prev, _ := contracts.Attestation(name)
+	if err := contracts.Set(name, contract.Hex()); err != nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+	if err := k.attestSystemContract(ctx, &contracts, name, contract, artifact, prev.Artifact); err != nil {
+		return err
+	}
+	if err := k.SystemContracts.Set(ctx, contracts); err != nil {
+		return err
+	}
+
+	// treasury_address defaults to the treasury contract at genesis; keep it following the entry.
+	if name == "treasury" && common.IsHexAddress(old) {
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return err
+		}
+		if params.TreasuryAddress == sdk.AccAddress(common.HexToAddress(old).Bytes()).String() {
+			params.TreasuryAddress = sdk.AccAddress(contract.Bytes()).String()
+			if err := k.Params.Set(ctx, params); err != nil {
+				return err
+			}
+		}
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&ynxtypes.EventSystemContractUpdated{
+		Name:       name,
+		OldAddress: old,
+		NewAddress: contract.Hex(),
+		Authority:  authority,
+		Artifact:   artifact,
+	})
+}
+
+// attestSystemContract records the live code hash of the system contract entry name. The artifact
+// is recorded when the contract was deployed from it. Otherwise the first of the known artifacts
+// whose runtime code matches the live code is recorded, followed by the artifact the default
+// manifest deploys for the entry.
+func (k Keeper) attestSystemContract(ctx sdk.Context, contracts *ynxtypes.SystemContracts, name string, contract common.Address, artifact string, known ...string) error {
+	codeHash := k.evmKeeper.GetCodeHash(ctx, contract)
+	attestation := ynxtypes.SystemContractAttestation{Name: name, CodeHash: codeHash.Hex()}
+
+	if artifact != "" {
+		runtime, err := loadRuntimeArtifact(artifact)
+		if err != nil {
+			return errorsmod.Wrapf(err, "load artifact %q", artifact)
+		}
+		attestation.Artifact = artifact
+		attestation.ArtifactVersion = runtime.buildInfoID
+		return contracts.Attest(attestation)
+	}
+
+	code := k.evmKeeper.GetCode(ctx, codeHash)
+	for _, candidate := range append(known, defaultSystemContractArtifact(name)) {
+		// Artifacts that are not embedded in this binary cannot match.
+		if runtime, err := loadRuntimeArtifact(candidate); err == nil && runtime.matches(code) {
+			attestation.Artifact = candidate
+			attestation.ArtifactVersion = runtime.buildInfoID
+			break
+		}
+	}
+	return contracts.Attest(attestation)
+}
+
+// AttestSystemContracts attests every set system_contracts entry that has no attestation yet, such
+// as the entries of a chain that predates attestations.
+func (k Keeper) AttestSystemContracts(ctx sdk.Context) error {
+	contracts, err := k.SystemContracts.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range contracts.Contracts {
+		if _, ok := contracts.Attestation(e.Name); ok {
+			continue
+		}
+		if err := k.attestSystemContract(ctx, &contracts, e.Name, common.HexToAddress(e.Address), ""); err != nil {
+			return err
+		}
+	}
+	return k.SystemContracts.Set(ctx, contracts)
+}
+
+// VerifySystemContracts compares the live code of every set system_contracts entry with its
+// attestation and with the runtime code of its artifact.
+func (k Keeper) VerifySystemContracts(ctx sdk.Context) ([]ynxtypes.SystemContractVerification, error) {
+	contracts, err := k.SystemContracts.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []ynxtypes.SystemContractVerification
+	for _, e := range contracts.Contracts {
+		codeHash := k.evmKeeper.GetCodeHash(ctx, common.HexToAddress(e.Address))
+		v := ynxtypes.SystemContractVerification{
+			Name:     e.Name,
+			Address:  e.Address,
+			CodeHash: codeHash.Hex(),
+			Artifact: defaultSystemContractArtifact(e.Name),
+		}
+		if a, ok := contracts.Attestation(e.Name); ok {
+			v.RecordedCodeHash = a.CodeHash
+			v.CodeHashMatches = strings.EqualFold(a.CodeHash, v.CodeHash)
+			if a.Artifact != "" {
+				v.Artifact = a.Artifact
+			}
+		}
+		// An artifact that is not embedded in this binary cannot match.
+		if runtime, err := loadRuntimeArtifact(v.Artifact); err == nil {
+			v.ArtifactVersion = runtime.buildInfoID
+			v.ArtifactMatches = runtime.matches(k.evmKeeper.GetCode(ctx, codeHash))
+		}
+		out = append(out, v)
+	}
+	return out, nil
+}
+
+// SystemContractOf returns the system_contracts entry that points at contract and the contract's
+// live code hash. official is true only when that code hash is the attested one.
+func (k Keeper) SystemContractOf(ctx sdk.Context, contract common.Address) (name string, codeHash common.Hash, official bool, err error) {
+	contracts, err := k.SystemContracts.Get(ctx)
+	if err != nil {
+		return "", common.Hash{}, false, err
+	}
+
+	for _, e := range contracts.Contracts {
+		if common.HexToAddress(e.Address) != contract {
+			continue
+		}
+
+		codeHash = k.evmKeeper.GetCodeHash(ctx, contract)
+		a, ok := contracts.Attestation(e.Name)
+		return e.Name, codeHash, ok && common.HexToHash(a.CodeHash) == codeHash, nil
+	}
+	return "", common.Hash{}, false, nil
+}
+
+// systemContractInitCode returns the creation code of a system contract deployment.
+func systemContractInitCode(artifact string, bytecode, constructorArgs []byte) ([]byte, error) {
+	switch {
+	case artifact != "" && len(bytecode) > 0:
+		return nil, fmt.Errorf("artifact and bytecode are mutually exclusive")
+	case artifact != "":
+		_, artifactBytecode, err := loadHardhatArtifact(artifact)
+		if err != nil {
+			return nil, fmt.Errorf("load artifact %q: %w", artifact, err)
+		}
+		bytecode = artifactBytecode
+	case len(bytecode) == 0:
+		return nil, fmt.Errorf("either artifact or bytecode must be set")
+	}
+
+	initCode := make([]byte, 0, len(bytecode)+len(constructorArgs))
+	initCode = append(initCode, bytecode...)
+	initCode = append(initCode, constructorArgs...)
+	return initCode, nil
+}
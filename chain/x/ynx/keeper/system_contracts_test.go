@@ -0,0 +1,185 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/v2/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+
+	ynx "github.com/JiahaoAlbus/YNX/chain"
+	ynxkeeper "github.com/JiahaoAlbus/YNX/chain/x/ynx/keeper"
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
+)
+
+// newSystemContractsTestApp returns a test app with the EVM, fee market and x/ynx state that
+// system contract deployments read.
+func newSystemContractsTestApp(t *testing.T) (*ynx.App, sdk.Context) {
+	t.Helper()
+
+	app, ctx := newTestApp(t, 1)
+	require.NoError(t, app.EVMKeeper.SetParams(ctx, ynx.NewEVMGenesisState().Params))
+	require.NoError(t, app.FeeMarketKeeper.SetParams(ctx, feemarkettypes.DefaultParams()))
+	require.NoError(t, app.YNXKeeper.Params.Set(ctx, ynxtypes.DefaultParams()))
+	require.NoError(t, app.YNXKeeper.SystemContracts.Set(ctx, ynxtypes.SystemContracts{}))
+	return app, ctx
+}
+
+func loadArtifactABI(t *testing.T, name string) abi.ABI {
+	t.Helper()
+
+	bz, err := os.ReadFile("contracts/" + name + ".json")
+	require.NoError(t, err)
+	var artifact struct {
+		ABI json.RawMessage `json:"abi"`
+	}
+	require.NoError(t, json.Unmarshal(bz, &artifact))
+	parsed, err := abi.JSON(strings.NewReader(string(artifact.ABI)))
+	require.NoError(t, err)
+	return parsed
+}
+
+func TestDeploySystemContract(t *testing.T) {
+	app, ctx := newSystemContractsTestApp(t)
+	gov := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	orgABI := loadArtifactABI(t, "YNXOrgRegistry")
+	admin := common.BytesToAddress(make20(0x71))
+
+	createOrg, err := orgABI.Pack("createOrg", admin, "ipfs://org")
+	require.NoError(t, err)
+	msg := &ynxtypes.MsgDeploySystemContract{
+		Authority:      gov,
+		Name:           "org_registry",
+		Artifact:       "YNXOrgRegistry",
+		MigrationCalls: []ynxtypes.SystemContractCall{{Data: createOrg}},
+	}
+
+	msgServer := ynxkeeper.NewMsgServerImpl(app.YNXKeeper)
+	_, err = msgServer.DeploySystemContract(ctx, &ynxtypes.MsgDeploySystemContract{
+		Authority: sdk.AccAddress(make20(0x72)).String(),
+		Name:      msg.Name,
+		Artifact:  msg.Artifact,
+	})
+	require.Error(t, err)
+
+	first, err := msgServer.DeploySystemContract(ctx, msg)
+	require.NoError(t, err)
+	second, err := msgServer.DeploySystemContract(ctx, msg)
+	require.NoError(t, err)
+	require.NotEqual(t, first.Address, second.Address)
+
+	contracts, err := app.YNXKeeper.SystemContracts.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, second.Address, contracts.Get("org_registry"))
+
+	// The migration call ran against the new contract.
+	res, err := app.EVMKeeper.CallEVM(ctx, orgABI, ynxkeeper.SystemDeployerAddress(), common.HexToAddress(second.Address), false, nil, "orgCount")
+	require.NoError(t, err)
+	count, err := orgABI.Unpack("orgCount", res.Ret)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(1), count[0])
+
+	var updates []ynxtypes.EventSystemContractUpdated
+	for _, ev := range ctx.EventManager().Events() {
+		if ev.Type != "ynx.ynx.v1.EventSystemContractUpdated" {
+			continue
+		}
+		parsed, err := sdk.ParseTypedEvent(abci.Event(ev))
+		require.NoError(t, err)
+		updates = append(updates, *parsed.(*ynxtypes.EventSystemContractUpdated))
+	}
+	require.Equal(t, []ynxtypes.EventSystemContractUpdated{
+		{Name: "org_registry", NewAddress: first.Address, Authority: gov, Artifact: "YNXOrgRegistry"},
+		{Name: "org_registry", OldAddress: first.Address, NewAddress: second.Address, Authority: gov, Artifact: "YNXOrgRegistry"},
+	}, updates)
+}
+
+func TestDeploySystemContractIsAtomic(t *testing.T) {
+	app, ctx := newSystemContractsTestApp(t)
+	gov := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	orgABI := loadArtifactABI(t, "YNXOrgRegistry")
+
+	// Org 1 does not exist yet, so the migration call reverts.
+	setURI, err := orgABI.Pack("setOrgMetadataURI", big.NewInt(1), "ipfs://org")
+	require.NoError(t, err)
+
+	_, err = app.YNXKeeper.DeploySystemContract(ctx, gov, "org_registry", "YNXOrgRegistry", nil, nil, []ynxtypes.SystemContractCall{{Data: setURI}})
+	require.ErrorContains(t, err, "migration call 0")
+
+	contracts, err := app.YNXKeeper.SystemContracts.Get(ctx)
+	require.NoError(t, err)
+	require.Empty(t, contracts.Get("org_registry"))
+	require.Nil(t, app.AccountKeeper.GetAccount(ctx, sdk.AccAddress(ynxkeeper.SystemDeployerAddress().Bytes())))
+
+	for _, tc := range []struct {
+		name, entry, artifact string
+		bytecode              []byte
+	}{
+		{"invalid entry name", "Org_Registry", "YNXOrgRegistry", nil},
+		{"unknown artifact", "org_registry", "YNXBridge", nil},
+		{"artifact and bytecode", "org_registry", "YNXOrgRegistry", []byte{0x60}},
+		{"no creation code", "org_registry", "", nil},
+	} {
+		_, err := app.YNXKeeper.DeploySystemContract(ctx, gov, tc.entry, tc.artifact, tc.bytecode, nil, nil)
+		require.Error(t, err, tc.name)
+	}
+}
+
+func TestSetSystemContractMovesTreasuryParam(t *testing.T) {
+	app, ctx := newSystemContractsTestApp(t)
+	gov := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	treasuryABI := loadArtifactABI(t, "YNXTreasury")
+	timelock := common.BytesToAddress(make20(0x73))
+
+	args, err := treasuryABI.Pack("", timelock)
+	require.NoError(t, err)
+	oldTreasury, err := app.YNXKeeper.DeploySystemContract(ctx, gov, "treasury", "YNXTreasury", nil, args, nil)
+	require.NoError(t, err)
+
+	params, err := app.YNXKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.TreasuryAddress = sdk.AccAddress(oldTreasury.Bytes()).String()
+	require.NoError(t, app.YNXKeeper.Params.Set(ctx, params))
+
+	newTreasury, err := app.YNXKeeper.DeploySystemContract(ctx, gov, "treasury", "YNXTreasury", nil, args, nil)
+	require.NoError(t, err)
+	params, err = app.YNXKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdk.AccAddress(newTreasury.Bytes()).String(), params.TreasuryAddress)
+
+	msgServer := ynxkeeper.NewMsgServerImpl(app.YNXKeeper)
+	_, err = msgServer.SetSystemContract(ctx, &ynxtypes.MsgSetSystemContract{Authority: gov, Name: "treasury", Address: common.BytesToAddress(make20(0x74)).Hex()})
+	require.ErrorContains(t, err, "no contract code")
+	_, err = msgServer.SetSystemContract(ctx, &ynxtypes.MsgSetSystemContract{Authority: gov, Name: "Vault", Address: oldTreasury.Hex()})
+	require.ErrorContains(t, err, "invalid system contract name")
+
+	_, err = msgServer.SetSystemContract(ctx, &ynxtypes.MsgSetSystemContract{Authority: gov, Name: "treasury", Address: oldTreasury.Hex()})
+	require.NoError(t, err)
+	contracts, err := app.YNXKeeper.SystemContracts.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, oldTreasury.Hex(), contracts.Get("treasury"))
+	params, err = app.YNXKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdk.AccAddress(oldTreasury.Bytes()).String(), params.TreasuryAddress)
+
+	// A treasury_address that was pointed elsewhere is left alone.
+	params.TreasuryAddress = sdk.AccAddress(make20(0x75)).String()
+	require.NoError(t, app.YNXKeeper.Params.Set(ctx, params))
+	_, err = msgServer.SetSystemContract(ctx, &ynxtypes.MsgSetSystemContract{Authority: gov, Name: "treasury", Address: newTreasury.Hex()})
+	require.NoError(t, err)
+	params, err = app.YNXKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdk.AccAddress(make20(0x75)).String(), params.TreasuryAddress)
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
@@ -10,12 +11,13 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
-	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/cosmos/evm/x/vm/statedb"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
 )
@@ -23,11 +25,12 @@ import (
 const genesisDeployGasLimit = uint64(30_000_000)
 
 type evmGenesisDeployer struct {
-	k      Keeper
-	ctx    sdk.Context
-	from   common.Address
-	nonce  uint64
-	evmCfg *statedb.EVMConfig
+	k       Keeper
+	ctx     sdk.Context
+	from    common.Address
+	nonce   uint64
+	gasUsed uint64
+	evmCfg  *statedb.EVMConfig
 }
 
 func newEVMGenesisDeployer(k Keeper, ctx sdk.Context, from common.Address, nonce uint64) (*evmGenesisDeployer, error) {
@@ -59,6 +62,7 @@ func (d *evmGenesisDeployer) apply(msg core.Message) ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
+	d.gasUsed += res.GasUsed
 	if res.VmError != "" {
 		return nil, errorsmod.Wrapf(errortypes.ErrLogic, "evm vm_error: %s", res.VmError)
 	}
@@ -123,243 +127,358 @@ func (d *evmGenesisDeployer) bumpNonce() error {
 	return nil
 }
 
-func (k Keeper) deploySystemContracts(ctx sdk.Context, cfg ynxtypes.SystemConfig) (ynxtypes.SystemContracts, error) {
-	deployerAcc, err := parseAnyAddress(ctx, cfg.DeployerAddress)
+// Create2FactoryAddress is the CREATE2 factory that deploys the system contracts in CREATE2 mode.
+// It is the keyless deployment proxy shipped in the EVM preinstalls: the calldata is a 32 byte salt
+// followed by the creation code, and it returns the address of the created contract.
+var Create2FactoryAddress = common.HexToAddress("0x4e59b44847b379578588920ca78fbf26c0b4956c")
+
+// SystemContractSalt returns the CREATE2 salt of the system contract name at a manifest version.
+// Version 0 is version 1.
+func SystemContractSalt(name string, version uint32) common.Hash {
+	if version == 0 {
+		version = 1
+	}
+	return crypto.Keccak256Hash([]byte(fmt.Sprintf("ynx/system/%s/v%d", name, version)))
+}
+
+// PredictSystemContracts returns the addresses InitGenesis deploys the system contracts at.
+// startNonce is the deployer account sequence when x/ynx InitGenesis runs and is only used in
+// CREATE mode; genesisTime is the genesis block time.
+func PredictSystemContracts(cfg ynxtypes.SystemConfig, startNonce uint64, genesisTime time.Time) (ynxtypes.SystemContracts, error) {
+	plan, err := newSystemDeployPlan(cfg, startNonce, genesisTime)
 	if err != nil {
 		return ynxtypes.SystemContracts{}, err
 	}
-	from := common.BytesToAddress(deployerAcc.Bytes())
+	return plan.contracts, nil
+}
 
-	// Ensure deployer account exists.
-	if acc := k.accountKeeper.GetAccount(ctx, deployerAcc); acc == nil {
-		acc = k.accountKeeper.NewAccountWithAddress(ctx, deployerAcc)
-		k.accountKeeper.SetAccount(ctx, acc)
-	}
+// systemDeployStep is one message sent by the deployer. Steps with a name create the system
+// contract of that entry at address from artifact; the others are plain calls.
+type systemDeployStep struct {
+	name     string
+	artifact string
+	address  common.Address
+	// to is nil for a CREATE deployment.
+	to   *common.Address
+	data []byte
+}
 
-	startNonce := k.accountKeeper.GetAccount(ctx, deployerAcc).GetSequence()
+// systemDeployPlan is the ordered list of messages that deploy and wire up the system contracts of
+// a manifest, together with the addresses they produce. Every step uses one deployer nonce.
+type systemDeployPlan struct {
+	from            common.Address
+	startNonce      uint64
+	mode            ynxtypes.SystemDeployMode
+	steps           []systemDeployStep
+	contracts       ynxtypes.SystemContracts
+	teamBeneficiary common.Address
+	community       common.Address
+	// nativeAllocations are the native NYXT sends from the deployer once the steps are done.
+	nativeAllocations []nativeAllocation
+}
+
+// nativeAllocation sends amount of the EVM denom from the deployer to to.
+type nativeAllocation struct {
+	to     common.Address
+	amount *big.Int
+}
+
+// create appends the deployment of the system contract name at a manifest version and returns its
+// address.
+func (p *systemDeployPlan) create(name, artifact string, version uint32, initCode []byte) (common.Address, error) {
+	step := systemDeployStep{name: name, artifact: artifact, data: initCode}
+	switch p.mode {
+	case ynxtypes.SystemDeployMode_SYSTEM_DEPLOY_MODE_CREATE:
+		step.address = crypto.CreateAddress(p.from, p.startNonce+uint64(len(p.steps)))
+	case ynxtypes.SystemDeployMode_SYSTEM_DEPLOY_MODE_CREATE2:
+		salt := SystemContractSalt(name, version)
+		factory := Create2FactoryAddress
+		step.to = &factory
+		step.address = crypto.CreateAddress2(factory, salt, crypto.Keccak256(initCode))
+		step.data = append(salt.Bytes(), initCode...)
+	default:
+		return common.Address{}, fmt.Errorf("unknown system deploy mode %s", p.mode)
+	}
+
+	if err := p.contracts.Set(name, step.address.Hex()); err != nil {
+		return common.Address{}, err
+	}
+	p.steps = append(p.steps, step)
+	return step.address, nil
+}
+
+// call appends a call from the deployer.
+func (p *systemDeployPlan) call(to common.Address, data []byte) {
+	p.steps = append(p.steps, systemDeployStep{to: &to, data: data})
+}
+
+// newSystemDeployPlan builds the deployment of the manifest of cfg. The manifest contracts are
+// deployed in order, then the manifest calls are made.
+func newSystemDeployPlan(cfg ynxtypes.SystemConfig, startNonce uint64, genesisTime time.Time) (*systemDeployPlan, error) {
+	deployerAcc, err := parseAnyAddress(cfg.DeployerAddress)
+	if err != nil {
+		return nil, err
+	}
+	from := common.BytesToAddress(deployerAcc.Bytes())
 
 	var community common.Address
 	if cfg.CommunityRecipientAddress != "" {
-		communityAcc, err := parseAnyAddress(ctx, cfg.CommunityRecipientAddress)
+		communityAcc, err := parseAnyAddress(cfg.CommunityRecipientAddress)
 		if err != nil {
-			return ynxtypes.SystemContracts{}, err
+			return nil, err
 		}
 		community = common.BytesToAddress(communityAcc.Bytes())
 	} else {
 		community = from
 	}
 	if community == (common.Address{}) {
-		return ynxtypes.SystemContracts{}, fmt.Errorf("invalid community_recipient_address: zero address")
+		return nil, fmt.Errorf("invalid community_recipient_address: zero address")
 	}
 
-	teamAcc, err := parseAnyAddress(ctx, cfg.TeamBeneficiaryAddress)
+	teamAcc, err := parseAnyAddress(cfg.TeamBeneficiaryAddress)
 	if err != nil {
-		return ynxtypes.SystemContracts{}, err
+		return nil, err
 	}
 	teamBeneficiary := common.BytesToAddress(teamAcc.Bytes())
 
 	supply, ok := new(big.Int).SetString(cfg.GenesisSupply, 10)
 	if !ok {
-		return ynxtypes.SystemContracts{}, fmt.Errorf("invalid genesis_supply")
+		return nil, fmt.Errorf("invalid genesis_supply")
 	}
-	proposalThreshold, ok := new(big.Int).SetString(cfg.ProposalThreshold, 10)
-	if !ok {
-		return ynxtypes.SystemContracts{}, fmt.Errorf("invalid proposal_threshold")
-	}
-	proposalDeposit, ok := new(big.Int).SetString(cfg.ProposalDeposit, 10)
-	if !ok {
-		return ynxtypes.SystemContracts{}, fmt.Errorf("invalid proposal_deposit")
+	teamAllocation, treasuryAllocation, communityAllocation, err := calcAllocations(supply, cfg.TeamPercent, cfg.TreasuryPercent, cfg.CommunityPercent)
+	if err != nil {
+		return nil, err
 	}
 
-	// Predicted addresses for dependency injection into constructors.
-	timelockPredicted := crypto.CreateAddress(from, startNonce+1)
-	governorPredicted := crypto.CreateAddress(from, startNonce+3)
+	// The airdrop is funded out of the community allocation.
+	airdropAmount := new(big.Int)
+	var sweepRecipient common.Address
+	if cfg.Airdrop.Enabled() {
+		if _, ok := airdropAmount.SetString(cfg.Airdrop.TotalAmount, 10); !ok {
+			return nil, fmt.Errorf("invalid airdrop total_amount")
+		}
+		if airdropAmount.Cmp(communityAllocation) > 0 {
+			return nil, fmt.Errorf("airdrop total_amount %s exceeds the community allocation %s", airdropAmount, communityAllocation)
+		}
+		communityAllocation = new(big.Int).Sub(communityAllocation, airdropAmount)
 
-	d, err := newEVMGenesisDeployer(k, ctx, from, startNonce)
-	if err != nil {
-		return ynxtypes.SystemContracts{}, err
+		sweepAcc, err := parseAnyAddress(cfg.Airdrop.SweepRecipientAddress)
+		if err != nil {
+			return nil, fmt.Errorf("invalid airdrop sweep_recipient_address: %w", err)
+		}
+		sweepRecipient = common.BytesToAddress(sweepAcc.Bytes())
+		if sweepRecipient == (common.Address{}) {
+			return nil, fmt.Errorf("invalid airdrop sweep_recipient_address: zero address")
+		}
 	}
 
-	nyxtABI, nyxtBytecode, err := loadHardhatArtifact("NYXT")
-	if err != nil {
-		return ynxtypes.SystemContracts{}, err
-	}
-	timelockABI, timelockBytecode, err := loadHardhatArtifact("YNXTimelock")
-	if err != nil {
-		return ynxtypes.SystemContracts{}, err
-	}
-	treasuryABI, treasuryBytecode, err := loadHardhatArtifact("YNXTreasury")
-	if err != nil {
-		return ynxtypes.SystemContracts{}, err
-	}
-	governorABI, governorBytecode, err := loadHardhatArtifact("YNXGovernor")
-	if err != nil {
-		return ynxtypes.SystemContracts{}, err
-	}
-	orgABI, orgBytecode, err := loadHardhatArtifact("YNXOrgRegistry")
-	if err != nil {
-		return ynxtypes.SystemContracts{}, err
-	}
-	subjectABI, subjectBytecode, err := loadHardhatArtifact("YNXSubjectRegistry")
-	if err != nil {
-		return ynxtypes.SystemContracts{}, err
-	}
-	arbitrationABI, arbitrationBytecode, err := loadHardhatArtifact("YNXArbitration")
-	if err != nil {
-		return ynxtypes.SystemContracts{}, err
-	}
-	domainInboxABI, domainInboxBytecode, err := loadHardhatArtifact("YNXDomainInbox")
-	if err != nil {
-		return ynxtypes.SystemContracts{}, err
-	}
-	vestingABI, vestingBytecode, err := loadHardhatArtifact("NYXTTeamVesting")
-	if err != nil {
-		return ynxtypes.SystemContracts{}, err
+	referenceTime := cfg.VestingReferenceTime
+	if referenceTime == 0 && genesisTime.Unix() > 0 {
+		referenceTime = uint64(genesisTime.Unix())
 	}
 
-	nyxtInit, err := abiPackInitCode(nyxtABI, nyxtBytecode, timelockPredicted, from, supply)
-	if err != nil {
-		return ynxtypes.SystemContracts{}, err
-	}
-	nyxtAddr, _, err := d.create(nyxtInit)
-	if err != nil {
-		return ynxtypes.SystemContracts{}, err
+	manifest := cfg.DeployManifest()
+	if err := manifest.Validate(); err != nil {
+		return nil, err
 	}
 
-	timelockInit, err := abiPackInitCode(
-		timelockABI,
-		timelockBytecode,
-		new(big.Int).SetUint64(cfg.TimelockDelaySeconds),
-		[]common.Address{governorPredicted},
-		[]common.Address{common.Address{}},
-		timelockPredicted,
-	)
-	if err != nil {
-		return ynxtypes.SystemContracts{}, err
-	}
-	timelockAddr, _, err := d.create(timelockInit)
-	if err != nil {
-		return ynxtypes.SystemContracts{}, err
+	p := &systemDeployPlan{
+		from:            from,
+		startNonce:      startNonce,
+		mode:            cfg.DeployMode,
+		teamBeneficiary: teamBeneficiary,
+		community:       community,
+	}
+	r := &manifestResolver{
+		addresses: make(map[string]common.Address, len(manifest.Contracts)),
+		config: map[string]string{
+			"deployer":                 from.Hex(),
+			"team_beneficiary":         teamBeneficiary.Hex(),
+			"community_recipient":      community.Hex(),
+			"genesis_supply":           supply.String(),
+			"team_allocation":          teamAllocation.String(),
+			"treasury_allocation":      treasuryAllocation.String(),
+			"community_allocation":     communityAllocation.String(),
+			"nyxt_erc20":               common.HexToAddress(ynxtypes.NativeNYXTContract).Hex(),
+			"nyxt_votes":               common.HexToAddress(ynxtypes.NYXTVotesPrecompileAddress).Hex(),
+			"stake_votes":              common.HexToAddress(ynxtypes.StakeVotesPrecompileAddress).Hex(),
+			"airdrop_merkle_root":      cfg.Airdrop.MerkleRoot,
+			"airdrop_amount":           airdropAmount.String(),
+			"airdrop_claim_deadline":   fmt.Sprint(cfg.Airdrop.ClaimDeadline),
+			"airdrop_sweep_recipient":  sweepRecipient.Hex(),
+			"voting_delay_blocks":      fmt.Sprint(cfg.VotingDelayBlocks),
+			"voting_period_blocks":     fmt.Sprint(cfg.VotingPeriodBlocks),
+			"proposal_threshold":       cfg.ProposalThreshold,
+			"proposal_deposit":         cfg.ProposalDeposit,
+			"quorum_percent":           fmt.Sprint(cfg.QuorumPercent),
+			"timelock_delay_seconds":   fmt.Sprint(cfg.TimelockDelaySeconds),
+			"vesting_start":            fmt.Sprint(referenceTime + cfg.VestingCliffSeconds),
+			"vesting_duration_seconds": fmt.Sprint(cfg.VestingDurationSeconds),
+		},
 	}
 
-	treasuryInit, err := abiPackInitCode(treasuryABI, treasuryBytecode, timelockAddr)
-	if err != nil {
-		return ynxtypes.SystemContracts{}, err
-	}
-	treasuryAddr, _, err := d.create(treasuryInit)
-	if err != nil {
-		return ynxtypes.SystemContracts{}, err
+	// CREATE addresses only depend on the nonce, so constructors can reference any manifest
+	// contract. CREATE2 addresses depend on the creation code, so they can only reference the
+	// contracts deployed before them.
+	if cfg.DeployMode == ynxtypes.SystemDeployMode_SYSTEM_DEPLOY_MODE_CREATE {
+		for i, c := range manifest.Contracts {
+			r.addresses[c.Name] = crypto.CreateAddress(from, startNonce+uint64(i))
+		}
 	}
 
-	governorInit, err := abiPackInitCode(
-		governorABI,
-		governorBytecode,
-		nyxtAddr,
-		nyxtAddr,
-		timelockAddr,
-		treasuryAddr,
-		new(big.Int).SetUint64(cfg.VotingDelayBlocks),
-		uint32(cfg.VotingPeriodBlocks),
-		proposalThreshold,
-		proposalDeposit,
-		new(big.Int).SetUint64(cfg.QuorumPercent),
-	)
-	if err != nil {
-		return ynxtypes.SystemContracts{}, err
-	}
-	governorAddr, _, err := d.create(governorInit)
-	if err != nil {
-		return ynxtypes.SystemContracts{}, err
-	}
+	abis := make(map[string]abi.ABI, len(manifest.Contracts))
+	for _, c := range manifest.Contracts {
+		contractABI, bytecode, err := loadHardhatArtifact(c.Artifact)
+		if err != nil {
+			return nil, fmt.Errorf("system contract %s: load artifact %q: %w", c.Name, c.Artifact, err)
+		}
+		abis[c.Name] = contractABI
 
-	orgInit, err := abiPackInitCode(orgABI, orgBytecode)
-	if err != nil {
-		return ynxtypes.SystemContracts{}, err
-	}
-	orgAddr, _, err := d.create(orgInit)
-	if err != nil {
-		return ynxtypes.SystemContracts{}, err
+		args, err := r.resolveArgs(contractABI.Constructor.Inputs, c.ConstructorArgs)
+		if err != nil {
+			return nil, fmt.Errorf("system contract %s: constructor: %w", c.Name, err)
+		}
+		initCode, err := abiPackInitCode(contractABI, bytecode, args...)
+		if err != nil {
+			return nil, fmt.Errorf("system contract %s: constructor: %w", c.Name, err)
+		}
+		addr, err := p.create(c.Name, c.Artifact, c.Version, initCode)
+		if err != nil {
+			return nil, err
+		}
+		r.addresses[c.Name] = addr
 	}
 
-	subjectInit, err := abiPackInitCode(subjectABI, subjectBytecode, orgAddr)
-	if err != nil {
-		return ynxtypes.SystemContracts{}, err
-	}
-	subjectAddr, _, err := d.create(subjectInit)
-	if err != nil {
-		return ynxtypes.SystemContracts{}, err
+	for i, call := range manifest.Calls {
+		contractABI := abis[call.Contract]
+		method, ok := contractABI.Methods[call.Method]
+		if !ok {
+			return nil, fmt.Errorf("system manifest call %d: %s has no method %q", i, call.Contract, call.Method)
+		}
+		args, err := r.resolveArgs(method.Inputs, call.Args)
+		if err != nil {
+			return nil, fmt.Errorf("system manifest call %d (%s.%s): %w", i, call.Contract, call.Method, err)
+		}
+		data, err := contractABI.Pack(call.Method, args...)
+		if err != nil {
+			return nil, fmt.Errorf("system manifest call %d (%s.%s): %w", i, call.Contract, call.Method, err)
+		}
+		p.call(r.addresses[call.Contract], data)
+	}
+
+	// Native NYXT has no token contract to transfer the allocations with, so the deployer sends
+	// them from its native balance to the treasury, the team vesting and the airdrop contracts of
+	// the manifest and to the community recipient.
+	if cfg.NativeNyxt {
+		for _, a := range []struct {
+			name   string
+			amount *big.Int
+		}{
+			{"treasury", treasuryAllocation},
+			{"team_vesting", teamAllocation},
+			{ynxtypes.SystemAirdropContractName, airdropAmount},
+		} {
+			if a.amount.Sign() == 0 {
+				continue
+			}
+			to, ok := r.addresses[a.name]
+			if !ok {
+				return nil, fmt.Errorf("native NYXT allocations need a %s contract in the system manifest", a.name)
+			}
+			p.nativeAllocations = append(p.nativeAllocations, nativeAllocation{to: to, amount: a.amount})
+		}
+		if communityAllocation.Sign() > 0 {
+			p.nativeAllocations = append(p.nativeAllocations, nativeAllocation{to: community, amount: communityAllocation})
+		}
 	}
 
-	arbitrationInit, err := abiPackInitCode(arbitrationABI, arbitrationBytecode, orgAddr)
-	if err != nil {
-		return ynxtypes.SystemContracts{}, err
-	}
-	arbitrationAddr, _, err := d.create(arbitrationInit)
-	if err != nil {
-		return ynxtypes.SystemContracts{}, err
-	}
+	return p, nil
+}
 
-	domainInboxInit, err := abiPackInitCode(domainInboxABI, domainInboxBytecode)
-	if err != nil {
-		return ynxtypes.SystemContracts{}, err
-	}
-	domainInboxAddr, _, err := d.create(domainInboxInit)
+// SystemContractReport describes one system contract created by a genesis deployment.
+type SystemContractReport struct {
+	Name     string `json:"name"`
+	Artifact string `json:"artifact"`
+	Address  string `json:"address"`
+	GasUsed  uint64 `json:"gas_used"`
+	CodeHash string `json:"code_hash"`
+}
+
+// systemDeployResult is the outcome of deploySystemContracts.
+type systemDeployResult struct {
+	plan      *systemDeployPlan
+	contracts []SystemContractReport
+	gasUsed   uint64
+}
+
+func (k Keeper) deploySystemContracts(ctx sdk.Context, cfg ynxtypes.SystemConfig) (*systemDeployResult, error) {
+	deployerAcc, err := parseAnyAddress(cfg.DeployerAddress)
 	if err != nil {
-		return ynxtypes.SystemContracts{}, err
+		return nil, err
 	}
 
-	startUnix := ctx.BlockTime().Unix()
-	if startUnix < 0 {
-		startUnix = 0
+	// Ensure deployer account exists.
+	if acc := k.accountKeeper.GetAccount(ctx, deployerAcc); acc == nil {
+		acc = k.accountKeeper.NewAccountWithAddress(ctx, deployerAcc)
+		k.accountKeeper.SetAccount(ctx, acc)
 	}
-	startTimestamp := uint64(startUnix) + cfg.VestingCliffSeconds
 
-	vestingInit, err := abiPackInitCode(
-		vestingABI,
-		vestingBytecode,
-		teamBeneficiary,
-		startTimestamp,
-		cfg.VestingDurationSeconds,
-	)
-	if err != nil {
-		return ynxtypes.SystemContracts{}, err
+	if cfg.DeployMode == ynxtypes.SystemDeployMode_SYSTEM_DEPLOY_MODE_CREATE2 && !k.evmKeeper.IsContract(ctx, Create2FactoryAddress) {
+		return nil, fmt.Errorf("no CREATE2 factory at %s: add it to the EVM preinstalls", Create2FactoryAddress.Hex())
 	}
-	teamVestingAddr, _, err := d.create(vestingInit)
+
+	startNonce := k.accountKeeper.GetAccount(ctx, deployerAcc).GetSequence()
+	plan, err := newSystemDeployPlan(cfg, startNonce, ctx.BlockTime())
 	if err != nil {
-		return ynxtypes.SystemContracts{}, err
+		return nil, err
 	}
 
-	teamAllocation, treasuryAllocation, communityAllocation, err := calcAllocations(supply, cfg.TeamPercent, cfg.TreasuryPercent, cfg.CommunityPercent)
+	d, err := newEVMGenesisDeployer(k, ctx, plan.from, startNonce)
 	if err != nil {
-		return ynxtypes.SystemContracts{}, err
+		return nil, err
 	}
+	result := &systemDeployResult{plan: plan}
+	for _, step := range plan.steps {
+		gasBefore := d.gasUsed
+		var created common.Address
+		if step.to == nil {
+			created, _, err = d.create(step.data)
+		} else {
+			var ret []byte
+			ret, err = d.call(*step.to, step.data)
+			created = common.BytesToAddress(ret)
+		}
+		if err != nil {
+			if step.name != "" {
+				return nil, errorsmod.Wrapf(err, "deploy %s", step.name)
+			}
+			return nil, errorsmod.Wrapf(err, "call %s", step.to.Hex())
+		}
 
-	if _, err := d.call(nyxtAddr, mustAbiPack(nyxtABI, "transfer", treasuryAddr, treasuryAllocation)); err != nil {
-		return ynxtypes.SystemContracts{}, err
-	}
-	if _, err := d.call(nyxtAddr, mustAbiPack(nyxtABI, "transfer", teamVestingAddr, teamAllocation)); err != nil {
-		return ynxtypes.SystemContracts{}, err
-	}
-	if community != from {
-		if _, err := d.call(nyxtAddr, mustAbiPack(nyxtABI, "transfer", community, communityAllocation)); err != nil {
-			return ynxtypes.SystemContracts{}, err
+		if step.name == "" {
+			continue
+		}
+		if created != step.address || !k.evmKeeper.IsContract(ctx, created) {
+			return nil, fmt.Errorf("deploy %s: expected contract at %s, got %s", step.name, step.address.Hex(), created.Hex())
+		}
+		result.contracts = append(result.contracts, SystemContractReport{
+			Name:     step.name,
+			Artifact: step.artifact,
+			Address:  created.Hex(),
+			GasUsed:  d.gasUsed - gasBefore,
+			CodeHash: k.evmKeeper.GetCodeHash(ctx, created).Hex(),
+		})
+	}
+	result.gasUsed = d.gasUsed
+
+	for _, a := range plan.nativeAllocations {
+		coins := sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), sdkmath.NewIntFromBigInt(a.amount)))
+		if err := k.bankKeeper.SendCoins(ctx, deployerAcc, a.to.Bytes(), coins); err != nil {
+			return nil, errorsmod.Wrapf(err, "send native NYXT allocation to %s", a.to.Hex())
 		}
 	}
 
-	// Ensure module account exists (sanity) and reserve the system contract addresses.
-	_ = authtypes.NewModuleAddress(ynxtypes.ModuleName)
-
-	return ynxtypes.SystemContracts{
-		Nyxt:            nyxtAddr.Hex(),
-		Timelock:        timelockAddr.Hex(),
-		Treasury:        treasuryAddr.Hex(),
-		Governor:        governorAddr.Hex(),
-		TeamVesting:     teamVestingAddr.Hex(),
-		OrgRegistry:     orgAddr.Hex(),
-		SubjectRegistry: subjectAddr.Hex(),
-		Arbitration:     arbitrationAddr.Hex(),
-		DomainInbox:     domainInboxAddr.Hex(),
-	}, nil
+	return result, nil
 }
 
 func abiPackInitCode(contractABI abi.ABI, bytecode []byte, args ...interface{}) ([]byte, error) {
@@ -373,14 +492,6 @@ func abiPackInitCode(contractABI abi.ABI, bytecode []byte, args ...interface{})
 	return initCode, nil
 }
 
-func mustAbiPack(contractABI abi.ABI, method string, args ...interface{}) []byte {
-	bz, err := contractABI.Pack(method, args...)
-	if err != nil {
-		panic(err)
-	}
-	return bz
-}
-
 func calcAllocations(
 	supply *big.Int,
 	teamPercent, treasuryPercent, communityPercent uint32,
@@ -0,0 +1,324 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	ynx "github.com/JiahaoAlbus/YNX/chain"
+	ynxkeeper "github.com/JiahaoAlbus/YNX/chain/x/ynx/keeper"
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
+)
+
+func testSystemConfig(mode ynxtypes.SystemDeployMode) ynxtypes.SystemConfig {
+	cfg := ynxtypes.DefaultSystemConfig()
+	cfg.Enabled = true
+	cfg.DeployerAddress = common.BytesToAddress(make20(0x81)).Hex()
+	cfg.TeamBeneficiaryAddress = common.BytesToAddress(make20(0x82)).Hex()
+	cfg.CommunityRecipientAddress = common.BytesToAddress(make20(0x83)).Hex()
+	cfg.DeployMode = mode
+	cfg.VestingReferenceTime = 1_700_000_000
+	return cfg
+}
+
+// initSystemGenesis runs x/ynx InitGenesis with cfg after bumping the deployer sequence to nonce.
+func initSystemGenesis(t *testing.T, cfg ynxtypes.SystemConfig, chainID string, nonce uint64) (*ynx.App, sdk.Context) {
+	t.Helper()
+
+	app, ctx := newSystemContractsTestApp(t)
+	ctx = ctx.WithChainID(chainID)
+	require.NoError(t, app.EVMKeeper.AddPreinstalls(ctx, evmtypes.DefaultPreinstalls))
+
+	deployer := app.AccountKeeper.NewAccountWithAddress(ctx, sdk.AccAddress(make20(0x81)))
+	require.NoError(t, deployer.SetSequence(nonce))
+	app.AccountKeeper.SetAccount(ctx, deployer)
+
+	gs := ynxtypes.DefaultGenesis()
+	gs.System = cfg
+	app.YNXKeeper.InitGenesis(ctx, gs)
+	return app, ctx
+}
+
+func TestCreate2FactoryIsPreinstalled(t *testing.T) {
+	for _, preinstall := range evmtypes.DefaultPreinstalls {
+		if common.HexToAddress(preinstall.Address) == ynxkeeper.Create2FactoryAddress {
+			return
+		}
+	}
+	t.Fatalf("%s is not in the default EVM preinstalls", ynxkeeper.Create2FactoryAddress.Hex())
+}
+
+func TestSystemContractsCreate2AddressesAreChainIndependent(t *testing.T) {
+	cfg := testSystemConfig(ynxtypes.SystemDeployMode_SYSTEM_DEPLOY_MODE_CREATE2)
+	predicted, err := ynxkeeper.PredictSystemContracts(cfg, 0, time.Unix(1, 0))
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		chainID string
+		nonce   uint64
+	}{
+		{"ynx_9001-1", 0},
+		{"ynx_9002-1", 7},
+	} {
+		app, ctx := initSystemGenesis(t, cfg, tc.chainID, tc.nonce)
+
+		contracts, err := app.YNXKeeper.SystemContracts.Get(ctx)
+		require.NoError(t, err)
+		require.Len(t, contracts.Attestations, len(ynxtypes.LegacySystemContractNames))
+		addresses := contracts
+		addresses.Attestations = nil
+		require.Equal(t, predicted, addresses, tc.chainID)
+		for _, name := range ynxtypes.LegacySystemContractNames {
+			require.True(t, app.EVMKeeper.IsContract(ctx, common.HexToAddress(contracts.Get(name))), name)
+		}
+
+		// The governor took over the timelock from the deployer.
+		timelockABI := loadArtifactABI(t, "YNXTimelock")
+		timelock := common.HexToAddress(contracts.Get("timelock"))
+		for _, check := range []struct {
+			role    common.Hash
+			account string
+			want    bool
+		}{
+			{crypto.Keccak256Hash([]byte("PROPOSER_ROLE")), contracts.Get("governor"), true},
+			{crypto.Keccak256Hash([]byte("CANCELLER_ROLE")), contracts.Get("governor"), true},
+			{common.Hash{}, cfg.DeployerAddress, false},
+		} {
+			res, err := app.EVMKeeper.CallEVM(ctx, timelockABI, ynxkeeper.SystemDeployerAddress(), timelock, false, nil, "hasRole", check.role, common.HexToAddress(check.account))
+			require.NoError(t, err)
+			out, err := timelockABI.Unpack("hasRole", res.Ret)
+			require.NoError(t, err)
+			require.Equal(t, check.want, out[0], check.account)
+		}
+	}
+}
+
+func TestSystemContractsCreateMatchesPrediction(t *testing.T) {
+	cfg := testSystemConfig(ynxtypes.SystemDeployMode_SYSTEM_DEPLOY_MODE_CREATE)
+	predicted, err := ynxkeeper.PredictSystemContracts(cfg, 3, time.Unix(1, 0))
+	require.NoError(t, err)
+	require.Equal(t, crypto.CreateAddress(common.BytesToAddress(make20(0x81)), 3).Hex(), predicted.Get("nyxt"))
+
+	app, ctx := initSystemGenesis(t, cfg, "ynx_9001-1", 3)
+	contracts, err := app.YNXKeeper.SystemContracts.Get(ctx)
+	require.NoError(t, err)
+	contracts.Attestations = nil
+	require.Equal(t, predicted, contracts)
+
+	// The deployer nonce moves CREATE addresses.
+	other, err := ynxkeeper.PredictSystemContracts(cfg, 4, time.Unix(1, 0))
+	require.NoError(t, err)
+	require.NotEqual(t, predicted.Get("nyxt"), other.Get("nyxt"))
+}
+
+func TestSystemManifestDeploysCustomContracts(t *testing.T) {
+	cfg := testSystemConfig(ynxtypes.SystemDeployMode_SYSTEM_DEPLOY_MODE_CREATE2)
+	cfg.Manifest = ynxtypes.SystemManifest{
+		Contracts: []ynxtypes.SystemManifestContract{
+			{Name: "orgs", Artifact: "YNXOrgRegistry"},
+			{Name: "subjects", Artifact: "YNXSubjectRegistry", ConstructorArgs: []ynxtypes.SystemManifestArg{{Contract: "orgs"}}},
+		},
+		Calls: []ynxtypes.SystemManifestCall{
+			{Contract: "orgs", Method: "createOrg", Args: []ynxtypes.SystemManifestArg{{Config: "team_beneficiary"}, {Value: "ipfs://org"}}},
+		},
+	}
+	predicted, err := ynxkeeper.PredictSystemContracts(cfg, 0, time.Unix(1, 0))
+	require.NoError(t, err)
+	require.Equal(t, []string{"orgs", "subjects"}, predicted.Names())
+
+	app, ctx := initSystemGenesis(t, cfg, "ynx_9001-1", 0)
+	contracts, err := app.YNXKeeper.SystemContracts.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, predicted.Contracts, contracts.Contracts)
+	a, ok := contracts.Attestation("subjects")
+	require.True(t, ok)
+	require.Equal(t, "YNXSubjectRegistry", a.Artifact)
+
+	view := func(artifact, contract, method string) interface{} {
+		contractABI := loadArtifactABI(t, artifact)
+		res, err := app.EVMKeeper.CallEVM(ctx, contractABI, ynxkeeper.SystemDeployerAddress(), common.HexToAddress(contracts.Get(contract)), false, nil, method)
+		require.NoError(t, err)
+		out, err := contractABI.Unpack(method, res.Ret)
+		require.NoError(t, err)
+		return out[0]
+	}
+	require.Equal(t, common.HexToAddress(contracts.Get("orgs")), view("YNXSubjectRegistry", "subjects", "orgRegistry"))
+	require.Equal(t, big.NewInt(1), view("YNXOrgRegistry", "orgs", "orgCount"))
+
+	// Bumping the version moves the contract.
+	cfg.Manifest.Contracts[0].Version = 2
+	moved, err := ynxkeeper.PredictSystemContracts(cfg, 0, time.Unix(1, 0))
+	require.NoError(t, err)
+	require.NotEqual(t, predicted.Get("orgs"), moved.Get("orgs"))
+
+	// Unknown methods and mistyped arguments fail the plan.
+	cfg.Manifest.Calls[0].Method = "createOrganization"
+	_, err = ynxkeeper.PredictSystemContracts(cfg, 0, time.Unix(1, 0))
+	require.ErrorContains(t, err, "no method")
+	cfg.Manifest.Calls[0].Method = "createOrg"
+	cfg.Manifest.Calls[0].Args[0] = ynxtypes.SystemManifestArg{Value: "42"}
+	_, err = ynxkeeper.PredictSystemContracts(cfg, 0, time.Unix(1, 0))
+	require.ErrorContains(t, err, "invalid address")
+}
+
+func TestVerifySystemContracts(t *testing.T) {
+	cfg := testSystemConfig(ynxtypes.SystemDeployMode_SYSTEM_DEPLOY_MODE_CREATE2)
+	app, ctx := initSystemGenesis(t, cfg, "ynx_9001-1", 0)
+	queryServer := ynxkeeper.NewQueryServerImpl(app.YNXKeeper)
+
+	res, err := queryServer.VerifySystemContracts(ctx, &ynxtypes.QueryVerifySystemContractsRequest{})
+	require.NoError(t, err)
+	require.True(t, res.Verified, "%+v", res.Contracts)
+	require.Len(t, res.Contracts, len(ynxtypes.LegacySystemContractNames))
+
+	contracts, err := app.YNXKeeper.SystemContracts.Get(ctx)
+	require.NoError(t, err)
+	for _, c := range res.Contracts {
+		a, ok := contracts.Attestation(c.Name)
+		require.True(t, ok, c.Name)
+		require.Equal(t, a.CodeHash, c.RecordedCodeHash, c.Name)
+		require.Equal(t, a.Artifact, c.Artifact, c.Name)
+		require.NotEmpty(t, c.ArtifactVersion, c.Name)
+	}
+
+	// Swapping the code under the inbox breaks both its attestation and the artifact match.
+	inbox := common.HexToAddress(contracts.Get("domain_inbox"))
+	code := []byte{0x60, 0x00}
+	codeHash := crypto.Keccak256(code)
+	app.EVMKeeper.SetCode(ctx, codeHash, code)
+	acc := app.EVMKeeper.GetAccount(ctx, inbox)
+	acc.CodeHash = codeHash
+	require.NoError(t, app.EVMKeeper.SetAccount(ctx, inbox, *acc))
+
+	res, err = queryServer.VerifySystemContracts(ctx, &ynxtypes.QueryVerifySystemContractsRequest{})
+	require.NoError(t, err)
+	require.False(t, res.Verified)
+	for _, c := range res.Contracts {
+		tampered := c.Name == "domain_inbox"
+		require.Equal(t, !tampered, c.CodeHashMatches, c.Name)
+		require.Equal(t, !tampered, c.ArtifactMatches, c.Name)
+	}
+
+	// Pointing the entry at other code attests the new code, which no artifact matches.
+	require.NoError(t, app.YNXKeeper.SetSystemContract(ctx, "gov", "domain_inbox", inbox))
+	contracts, err = app.YNXKeeper.SystemContracts.Get(ctx)
+	require.NoError(t, err)
+	a, ok := contracts.Attestation("domain_inbox")
+	require.True(t, ok)
+	require.Equal(t, ynxtypes.SystemContractAttestation{Name: "domain_inbox", CodeHash: common.BytesToHash(codeHash).Hex()}, a)
+}
+
+func TestSystemContractSalt(t *testing.T) {
+	salts := make(map[common.Hash]string)
+	for _, name := range ynxtypes.LegacySystemContractNames {
+		for _, version := range []uint32{1, 2} {
+			salt := ynxkeeper.SystemContractSalt(name, version)
+			require.NotContains(t, salts, salt, name)
+			salts[salt] = name
+		}
+	}
+	// Version 0 is the first version.
+	require.Equal(t, ynxkeeper.SystemContractSalt("nyxt", 1), ynxkeeper.SystemContractSalt("nyxt", 0))
+}
+
+func TestSimulateGenesisSystem(t *testing.T) {
+	cfg := testSystemConfig(ynxtypes.SystemDeployMode_SYSTEM_DEPLOY_MODE_CREATE2)
+	gs := ynxtypes.DefaultGenesis()
+	gs.System = cfg
+
+	app, ctx := newSystemContractsTestApp(t)
+	_, err := app.YNXKeeper.SimulateGenesisSystem(ctx, gs)
+	require.ErrorContains(t, err, "EVM preinstalls")
+
+	require.NoError(t, app.EVMKeeper.AddPreinstalls(ctx, evmtypes.DefaultPreinstalls))
+	report, err := app.YNXKeeper.SimulateGenesisSystem(ctx, gs)
+	require.NoError(t, err)
+	require.True(t, report.OK(), "%+v", report.Wiring)
+
+	predicted, err := ynxkeeper.PredictSystemContracts(cfg, 0, ctx.BlockTime())
+	require.NoError(t, err)
+	require.Len(t, report.Contracts, len(ynxtypes.LegacySystemContractNames))
+	var gasUsed uint64
+	for _, c := range report.Contracts {
+		require.Equal(t, predicted.Get(c.Name), c.Address)
+		require.NotZero(t, c.GasUsed)
+		gasUsed += c.GasUsed
+	}
+	require.Greater(t, report.GasUsed, gasUsed, "the wiring calls use gas too")
+	require.Equal(t, sdk.AccAddress(common.HexToAddress(predicted.Get("treasury")).Bytes()).String(), report.TreasuryAddress)
+
+	supply, ok := new(big.Int).SetString(cfg.GenesisSupply, 10)
+	require.True(t, ok)
+	amounts := make(map[string]string)
+	for _, a := range report.Allocations {
+		amounts[a.Recipient] = a.Amount
+	}
+	percentOf := func(percent uint32) string {
+		return new(big.Int).Quo(new(big.Int).Mul(supply, big.NewInt(int64(percent))), big.NewInt(100)).String()
+	}
+	require.Equal(t, map[string]string{
+		"treasury":     percentOf(cfg.TreasuryPercent),
+		"team_vesting": percentOf(cfg.TeamPercent),
+		"community":    percentOf(cfg.CommunityPercent),
+	}, amounts)
+
+	// The simulation leaves the state alone.
+	contracts, err := app.YNXKeeper.SystemContracts.Get(ctx)
+	require.NoError(t, err)
+	require.Empty(t, contracts.Contracts)
+	require.False(t, app.EVMKeeper.IsContract(ctx, common.HexToAddress(predicted.Get("nyxt"))))
+}
+
+func TestInitGenesisKeepsDeployedSystemContracts(t *testing.T) {
+	cfg := testSystemConfig(ynxtypes.SystemDeployMode_SYSTEM_DEPLOY_MODE_CREATE2)
+	app, ctx := initSystemGenesis(t, cfg, "ynx_9001-1", 0)
+	exported := app.YNXKeeper.ExportGenesis(ctx)
+	deployer := sdk.AccAddress(make20(0x81))
+	sequence, err := app.AccountKeeper.GetSequence(ctx, deployer)
+	require.NoError(t, err)
+
+	_, err = app.YNXKeeper.SimulateGenesisSystem(ctx, exported)
+	require.ErrorContains(t, err, "already set")
+
+	// Entries without an attestation are attested from the live code.
+	gs := *exported
+	gs.SystemContracts.Attestations = nil
+	app.YNXKeeper.InitGenesis(ctx, &gs)
+	contracts, err := app.YNXKeeper.SystemContracts.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, exported.SystemContracts, contracts)
+
+	got, err := app.AccountKeeper.GetSequence(ctx, deployer)
+	require.NoError(t, err)
+	require.Equal(t, sequence, got)
+
+	gs = *exported
+	gs.SystemContracts.Contracts = append([]ynxtypes.SystemContractEntry(nil), exported.SystemContracts.Contracts...)
+	require.NoError(t, gs.SystemContracts.Set("nyxt", common.BytesToAddress(make20(0x99)).Hex()))
+	require.Panics(t, func() { app.YNXKeeper.InitGenesis(ctx, &gs) })
+}
+
+func TestSystemAirdropIsFundedFromCommunityAllocation(t *testing.T) {
+	cfg := testSystemConfig(ynxtypes.SystemDeployMode_SYSTEM_DEPLOY_MODE_CREATE2)
+	supply, ok := new(big.Int).SetString(cfg.GenesisSupply, 10)
+	require.True(t, ok)
+	community := new(big.Int).Quo(new(big.Int).Mul(supply, big.NewInt(int64(cfg.CommunityPercent))), big.NewInt(100))
+
+	cfg.Airdrop = ynxtypes.SystemAirdrop{
+		MerkleRoot:            common.BytesToHash([]byte{0x01}).Hex(),
+		TotalAmount:           new(big.Int).Add(community, big.NewInt(1)).String(),
+		ClaimDeadline:         1_800_000_000,
+		SweepRecipientAddress: common.BytesToAddress(make20(0x84)).Hex(),
+	}
+	require.NoError(t, cfg.Validate())
+	_, err := ynxkeeper.PredictSystemContracts(cfg, 0, time.Unix(1_700_000_000, 0))
+	require.ErrorContains(t, err, "exceeds the community allocation")
+}
@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
+)
+
+// manifestResolver turns system manifest arguments into ABI values.
+type manifestResolver struct {
+	// addresses holds the addresses of the manifest contracts that can be referenced.
+	addresses map[string]common.Address
+	// config holds the system config values by SystemManifestConfigKeys key.
+	config map[string]string
+}
+
+// resolveArgs resolves args against the ABI inputs they are passed to.
+func (r *manifestResolver) resolveArgs(inputs abi.Arguments, args []ynxtypes.SystemManifestArg) ([]interface{}, error) {
+	if len(args) != len(inputs) {
+		return nil, fmt.Errorf("got %d args, expected %d", len(args), len(inputs))
+	}
+	values := make([]interface{}, len(args))
+	for i, arg := range args {
+		v, err := r.resolve(inputs[i].Type, arg)
+		if err != nil {
+			return nil, fmt.Errorf("arg %d (%s): %w", i, inputs[i].Name, err)
+		}
+		values[i] = v
+	}
+	return values, nil
+}
+
+func (r *manifestResolver) resolve(typ abi.Type, arg ynxtypes.SystemManifestArg) (interface{}, error) {
+	if typ.T == abi.SliceTy || typ.T == abi.ArrayTy {
+		if arg.Contract != "" || arg.Config != "" || arg.Value != "" || arg.Keccak256 != "" {
+			return nil, fmt.Errorf("%s argument takes elements", typ)
+		}
+		if typ.T == abi.ArrayTy && len(arg.Elements) != typ.Size {
+			return nil, fmt.Errorf("%s argument takes %d elements, got %d", typ, typ.Size, len(arg.Elements))
+		}
+
+		var out reflect.Value
+		if typ.T == abi.SliceTy {
+			out = reflect.MakeSlice(typ.GetType(), len(arg.Elements), len(arg.Elements))
+		} else {
+			out = reflect.New(typ.GetType()).Elem()
+		}
+		for i, e := range arg.Elements {
+			v, err := r.resolve(*typ.Elem, e)
+			if err != nil {
+				return nil, fmt.Errorf("element %d: %w", i, err)
+			}
+			out.Index(i).Set(reflect.ValueOf(v))
+		}
+		return out.Interface(), nil
+	}
+	if len(arg.Elements) > 0 {
+		return nil, fmt.Errorf("%s argument takes no elements", typ)
+	}
+
+	var s string
+	switch {
+	case arg.Contract != "":
+		addr, ok := r.addresses[arg.Contract]
+		if !ok {
+			return nil, fmt.Errorf("contract %s is not deployed yet: in CREATE2 mode only contracts deployed earlier can be referenced", arg.Contract)
+		}
+		s = addr.Hex()
+	case arg.Config != "":
+		v, ok := r.config[arg.Config]
+		if !ok {
+			return nil, fmt.Errorf("unknown config value %q", arg.Config)
+		}
+		s = v
+	case arg.Keccak256 != "":
+		s = crypto.Keccak256Hash([]byte(arg.Keccak256)).Hex()
+	default:
+		s = arg.Value
+	}
+	return parseManifestValue(typ, s)
+}
+
+// parseManifestValue parses s as a value of the scalar ABI type typ.
+func parseManifestValue(typ abi.Type, s string) (interface{}, error) {
+	switch typ.T {
+	case abi.AddressTy:
+		if !common.IsHexAddress(s) {
+			return nil, fmt.Errorf("invalid address %q", s)
+		}
+		return common.HexToAddress(s), nil
+	case abi.BoolTy:
+		return strconv.ParseBool(s)
+	case abi.StringTy:
+		return s, nil
+	case abi.BytesTy:
+		return hexutil.Decode(s)
+	case abi.FixedBytesTy:
+		bz, err := hexutil.Decode(s)
+		if err != nil {
+			return nil, err
+		}
+		if len(bz) != typ.Size {
+			return nil, fmt.Errorf("%s takes %d bytes, got %d", typ, typ.Size, len(bz))
+		}
+		out := reflect.New(typ.GetType()).Elem()
+		reflect.Copy(out, reflect.ValueOf(bz))
+		return out.Interface(), nil
+	case abi.IntTy, abi.UintTy:
+		n, ok := new(big.Int).SetString(s, 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid integer %q", s)
+		}
+		if typ.T == abi.UintTy && (n.Sign() < 0 || n.BitLen() > typ.Size) || typ.T == abi.IntTy && n.BitLen() >= typ.Size {
+			return nil, fmt.Errorf("%s does not fit %s", s, typ)
+		}
+		goType := typ.GetType()
+		switch goType.Kind() {
+		case reflect.Ptr:
+			return n, nil
+		case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return reflect.ValueOf(n.Uint64()).Convert(goType).Interface(), nil
+		default:
+			return reflect.ValueOf(n.Int64()).Convert(goType).Interface(), nil
+		}
+	default:
+		return nil, fmt.Errorf("unsupported argument type %s", typ)
+	}
+}
@@ -0,0 +1,225 @@
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
+)
+
+var (
+	timelockDefaultAdminRole = common.Hash{}
+	timelockProposerRole     = crypto.Keccak256Hash([]byte("PROPOSER_ROLE"))
+	timelockCancellerRole    = crypto.Keccak256Hash([]byte("CANCELLER_ROLE"))
+	timelockExecutorRole     = crypto.Keccak256Hash([]byte("EXECUTOR_ROLE"))
+)
+
+// SystemDeployReport describes a genesis system contract deployment. It is what
+// `ynxd genesis ynx simulate` prints.
+type SystemDeployReport struct {
+	DeployMode      string                   `json:"deploy_mode"`
+	Deployer        string                   `json:"deployer"`
+	Contracts       []SystemContractReport   `json:"contracts"`
+	GasUsed         uint64                   `json:"gas_used"`
+	Allocations     []SystemAllocationReport `json:"allocations"`
+	Wiring          []SystemWiringCheck      `json:"wiring"`
+	TreasuryAddress string                   `json:"treasury_address"`
+}
+
+// SystemAllocationReport is the NYXT balance of a genesis allocation recipient once the
+// deployment is done.
+type SystemAllocationReport struct {
+	Recipient string `json:"recipient"`
+	Address   string `json:"address"`
+	Amount    string `json:"amount"`
+}
+
+// SystemWiringCheck compares a value read from the deployed contracts with the value the system
+// config implies.
+type SystemWiringCheck struct {
+	Check    string `json:"check"`
+	Expected string `json:"expected"`
+	Actual   string `json:"actual"`
+	OK       bool   `json:"ok"`
+}
+
+// OK reports whether every wiring check passed.
+func (r SystemDeployReport) OK() bool {
+	for _, c := range r.Wiring {
+		if !c.OK {
+			return false
+		}
+	}
+	return true
+}
+
+// SimulateGenesisSystem runs the system contract deployment of data the way InitGenesis does and
+// reports the outcome. A genesis that already records deployed system contracts has nothing to
+// deploy and is rejected. ctx must hold the auth, bank, EVM and fee market genesis state. Nothing is
+// written to ctx.
+func (k Keeper) SimulateGenesisSystem(ctx sdk.Context, data *ynxtypes.GenesisState) (*SystemDeployReport, error) {
+	if err := data.Validate(); err != nil {
+		return nil, err
+	}
+	if !data.System.Enabled {
+		return nil, fmt.Errorf("system contract deployment is disabled")
+	}
+	existing := data.SystemContracts
+	if err := existing.MigrateLegacyEntries(); err != nil {
+		return nil, err
+	}
+	if len(existing.Contracts) > 0 {
+		return nil, fmt.Errorf("system_contracts is already set; InitGenesis verifies the deployed contracts instead of deploying them")
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	if err := k.Params.Set(cacheCtx, data.Params); err != nil {
+		return nil, err
+	}
+	result, err := k.initSystemContracts(cacheCtx, data.System)
+	if err != nil {
+		return nil, err
+	}
+	params, err := k.Params.Get(cacheCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &SystemDeployReport{
+		DeployMode:      data.System.DeployMode.String(),
+		Deployer:        result.plan.from.Hex(),
+		Contracts:       result.contracts,
+		GasUsed:         result.gasUsed,
+		TreasuryAddress: params.TreasuryAddress,
+	}
+
+	plan := result.plan
+	contracts := plan.contracts
+	artifacts := make(map[string]string, len(result.contracts))
+	for _, c := range result.contracts {
+		artifacts[c.Name] = c.Artifact
+	}
+
+	// The allocation and wiring checks below describe the default manifest. A check is skipped when
+	// the manifest does not deploy the contracts it reads from the artifact it expects.
+	deployed := func(names ...string) bool {
+		for _, name := range names {
+			if artifacts[name] != defaultSystemContractArtifact(name) {
+				return false
+			}
+		}
+		return true
+	}
+	addr := func(name string) common.Address { return common.HexToAddress(contracts.Get(name)) }
+
+	// Native NYXT is read from the bank balances, the standalone ERC20 from the nyxt contract.
+	native := data.System.NativeNyxt
+	nyxtVotes, nyxtERC20, nyxtRequires := addr("nyxt"), addr("nyxt"), []string{"nyxt"}
+	if native {
+		nyxtVotes = common.HexToAddress(ynxtypes.NYXTVotesPrecompileAddress)
+		nyxtERC20 = common.HexToAddress(ynxtypes.NativeNYXTContract)
+		nyxtRequires = nil
+	}
+	// The governor counts NYXT votes, or the bonded stake with stake_weighted_votes.
+	governorVotes, governorVotesRequires := nyxtVotes, nyxtRequires
+	if data.System.StakeWeightedVotes {
+		governorVotes, governorVotesRequires = common.HexToAddress(ynxtypes.StakeVotesPrecompileAddress), nil
+	}
+	balanceOf := func(account common.Address) (interface{}, error) {
+		if native {
+			return k.bankKeeper.GetBalance(cacheCtx, account.Bytes(), evmtypes.GetEVMCoinDenom()).Amount, nil
+		}
+		return k.systemView(cacheCtx, plan.from, "NYXT", contracts.Get("nyxt"), "balanceOf", account)
+	}
+
+	if deployed(nyxtRequires...) {
+		for _, r := range []struct {
+			recipient string
+			address   common.Address
+			requires  []string
+		}{
+			{"treasury", addr("treasury"), []string{"treasury"}},
+			{"team_vesting", addr("team_vesting"), []string{"team_vesting"}},
+			{"community", plan.community, nil},
+			{"airdrop", addr("airdrop"), []string{"airdrop"}},
+		} {
+			if !deployed(r.requires...) {
+				continue
+			}
+			balance, err := balanceOf(r.address)
+			if err != nil {
+				return nil, err
+			}
+			report.Allocations = append(report.Allocations, SystemAllocationReport{
+				Recipient: r.recipient,
+				Address:   r.address.Hex(),
+				Amount:    fmt.Sprint(balance),
+			})
+		}
+	}
+
+	for _, c := range []struct {
+		check, contract, method string
+		args                    []interface{}
+		expected                interface{}
+		requires                []string
+	}{
+		{"governor.token", "governor", "token", nil, governorVotes, governorVotesRequires},
+		{"governor.timelock", "governor", "timelock", nil, addr("timelock"), []string{"timelock"}},
+		{"governor.treasury", "governor", "treasury", nil, addr("treasury"), []string{"treasury"}},
+		{"nyxt.owner", "nyxt", "owner", nil, addr("timelock"), []string{"timelock"}},
+		{"treasury.timelock", "treasury", "timelock", nil, addr("timelock"), []string{"timelock"}},
+		{"timelock.min_delay", "timelock", "getMinDelay", nil, new(big.Int).SetUint64(data.System.TimelockDelaySeconds), nil},
+		{"timelock.proposer(governor)", "timelock", "hasRole", []interface{}{timelockProposerRole, addr("governor")}, true, []string{"governor"}},
+		{"timelock.canceller(governor)", "timelock", "hasRole", []interface{}{timelockCancellerRole, addr("governor")}, true, []string{"governor"}},
+		{"timelock.executor(anyone)", "timelock", "hasRole", []interface{}{timelockExecutorRole, common.Address{}}, true, nil},
+		{"timelock.admin(deployer)", "timelock", "hasRole", []interface{}{timelockDefaultAdminRole, plan.from}, false, nil},
+		{"team_vesting.owner", "team_vesting", "owner", nil, plan.teamBeneficiary, nil},
+		{"airdrop.token", "airdrop", "token", nil, nyxtERC20, nyxtRequires},
+		{"airdrop.claim_deadline", "airdrop", "claimDeadline", nil, new(big.Int).SetUint64(data.System.Airdrop.ClaimDeadline), nil},
+	} {
+		if !deployed(append([]string{c.contract}, c.requires...)...) {
+			continue
+		}
+		actual, err := k.systemView(cacheCtx, plan.from, artifacts[c.contract], contracts.Get(c.contract), c.method, c.args...)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", c.check, err)
+		}
+		expected := fmt.Sprint(c.expected)
+		report.Wiring = append(report.Wiring, SystemWiringCheck{
+			Check:    c.check,
+			Expected: expected,
+			Actual:   fmt.Sprint(actual),
+			OK:       fmt.Sprint(actual) == expected,
+		})
+	}
+
+	return report, nil
+}
+
+// systemView calls a view method of a system contract and returns its first result.
+func (k Keeper) systemView(ctx sdk.Context, from common.Address, artifact, contract, method string, args ...interface{}) (interface{}, error) {
+	contractABI, _, err := loadHardhatArtifact(artifact)
+	if err != nil {
+		return nil, err
+	}
+	res, err := k.evmKeeper.CallEVM(ctx, contractABI, from, common.HexToAddress(contract), false, nil, method, args...)
+	if err != nil {
+		return nil, err
+	}
+	out, err := contractABI.Unpack(method, res.Ret)
+	if err != nil {
+		return nil, err
+	}
+	if len(out) == 0 {
+		return nil, fmt.Errorf("%s returned nothing", method)
+	}
+	return out[0], nil
+}
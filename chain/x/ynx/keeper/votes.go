@@ -0,0 +1,250 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
+)
+
+// LockVotes moves amount of the EVM denom from account to the vote escrow and adds it to the votes
+// of the account's delegatee. As in ERC20Votes, the votes only count once they are delegated,
+// possibly to the account itself.
+func (k Keeper) LockVotes(ctx context.Context, account sdk.AccAddress, amount sdkmath.Int) error {
+	if amount.IsNil() || !amount.IsPositive() {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "vote lock amount must be positive: %s", amount)
+	}
+
+	lock, err := k.GetVoteLock(ctx, account)
+	if err != nil {
+		return err
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), amount))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, account, ynxtypes.VoteEscrowName, coins); err != nil {
+		return err
+	}
+
+	lock.Locked = lock.Locked.Add(amount)
+	if err := k.setVoteLock(ctx, account, lock); err != nil {
+		return err
+	}
+	if err := k.moveVotes(ctx, nil, delegateeOf(lock), amount); err != nil {
+		return err
+	}
+	if err := k.addTotalVotes(ctx, amount); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&ynxtypes.EventVotesLocked{
+		Account: account.String(),
+		Amount:  amount,
+	})
+}
+
+// UnlockVotes returns amount of the native NYXT account has locked and removes it from the votes
+// of the account's delegatee.
+func (k Keeper) UnlockVotes(ctx context.Context, account sdk.AccAddress, amount sdkmath.Int) error {
+	if amount.IsNil() || !amount.IsPositive() {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "vote unlock amount must be positive: %s", amount)
+	}
+
+	lock, err := k.GetVoteLock(ctx, account)
+	if err != nil {
+		return err
+	}
+	if lock.Locked.LT(amount) {
+		return errorsmod.Wrapf(errortypes.ErrInsufficientFunds, "%s has %s locked for voting, below %s", account, lock.Locked, amount)
+	}
+
+	lock.Locked = lock.Locked.Sub(amount)
+	if err := k.setVoteLock(ctx, account, lock); err != nil {
+		return err
+	}
+	if err := k.moveVotes(ctx, delegateeOf(lock), nil, amount); err != nil {
+		return err
+	}
+	if err := k.addTotalVotes(ctx, amount.Neg()); err != nil {
+		return err
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), amount))
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, ynxtypes.VoteEscrowName, account, coins); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&ynxtypes.EventVotesUnlocked{
+		Account: account.String(),
+		Amount:  amount,
+	})
+}
+
+// DelegateVotes delegates the votes of the native NYXT account has locked, now and in the future,
+// to delegatee.
+func (k Keeper) DelegateVotes(ctx context.Context, account, delegatee sdk.AccAddress) error {
+	if delegatee.Empty() {
+		return errorsmod.Wrap(errortypes.ErrInvalidAddress, "delegatee must not be empty")
+	}
+
+	lock, err := k.GetVoteLock(ctx, account)
+	if err != nil {
+		return err
+	}
+	old := lock.Delegatee
+	if err := k.moveVotes(ctx, delegateeOf(lock), delegatee, lock.Locked); err != nil {
+		return err
+	}
+	lock.Delegatee = delegatee.String()
+	if err := k.setVoteLock(ctx, account, lock); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&ynxtypes.EventVotesDelegated{
+		Account:      account.String(),
+		OldDelegatee: old,
+		NewDelegatee: delegatee.String(),
+	})
+}
+
+// GetVoteLock returns the vote lock of account. Accounts that never locked nor delegated have an
+// empty lock.
+func (k Keeper) GetVoteLock(ctx context.Context, account sdk.AccAddress) (ynxtypes.VoteLock, error) {
+	lock, err := k.VoteLocks.Get(ctx, account)
+	if errors.Is(err, collections.ErrNotFound) {
+		return ynxtypes.VoteLock{Account: account.String(), Locked: sdkmath.ZeroInt()}, nil
+	}
+	return lock, err
+}
+
+// GetVotes returns the votes currently delegated to account.
+func (k Keeper) GetVotes(ctx context.Context, account sdk.AccAddress) (sdkmath.Int, error) {
+	return k.GetPastVotes(ctx, account, sdk.UnwrapSDKContext(ctx).BlockHeight())
+}
+
+// GetPastVotes returns the votes delegated to account at the end of block height.
+func (k Keeper) GetPastVotes(ctx context.Context, account sdk.AccAddress, height int64) (sdkmath.Int, error) {
+	rng := collections.NewPrefixedPairRange[[]byte, int64](account).EndInclusive(height).Descending()
+	return latestVoteCheckpoint(ctx, k.VoteCheckpoints, rng)
+}
+
+// GetTotalVotes returns the total native NYXT locked for voting.
+func (k Keeper) GetTotalVotes(ctx context.Context) (sdkmath.Int, error) {
+	return k.GetPastTotalVotes(ctx, sdk.UnwrapSDKContext(ctx).BlockHeight())
+}
+
+// GetPastTotalVotes returns the total native NYXT locked for voting at the end of block height.
+func (k Keeper) GetPastTotalVotes(ctx context.Context, height int64) (sdkmath.Int, error) {
+	rng := new(collections.Range[int64]).EndInclusive(height).Descending()
+	return latestVoteCheckpoint(ctx, k.VoteSupplyCheckpoints, rng)
+}
+
+// latestVoteCheckpoint returns the value of the first checkpoint of rng, or zero when there is none.
+func latestVoteCheckpoint[K any](ctx context.Context, m collections.Map[K, sdkmath.Int], rng collections.Ranger[K]) (sdkmath.Int, error) {
+	iter, err := m.Iterate(ctx, rng)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return sdkmath.ZeroInt(), nil
+	}
+	return iter.Value()
+}
+
+// moveVotes moves amount of votes from one delegatee to another. A nil delegatee stands for
+// undelegated votes, which are not checkpointed.
+func (k Keeper) moveVotes(ctx context.Context, from, to sdk.AccAddress, amount sdkmath.Int) error {
+	if amount.IsZero() || from.Equals(to) {
+		return nil
+	}
+	if from != nil {
+		if err := k.addVotes(ctx, from, amount.Neg()); err != nil {
+			return err
+		}
+	}
+	if to != nil {
+		if err := k.addVotes(ctx, to, amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addVotes checkpoints the votes of delegatee changed by delta at the current height.
+func (k Keeper) addVotes(ctx context.Context, delegatee sdk.AccAddress, delta sdkmath.Int) error {
+	votes, err := k.GetVotes(ctx, delegatee)
+	if err != nil {
+		return err
+	}
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	return k.VoteCheckpoints.Set(ctx, collections.Join([]byte(delegatee), height), votes.Add(delta))
+}
+
+// addTotalVotes checkpoints the total locked supply changed by delta at the current height.
+func (k Keeper) addTotalVotes(ctx context.Context, delta sdkmath.Int) error {
+	total, err := k.GetTotalVotes(ctx)
+	if err != nil {
+		return err
+	}
+	return k.VoteSupplyCheckpoints.Set(ctx, sdk.UnwrapSDKContext(ctx).BlockHeight(), total.Add(delta))
+}
+
+// setVoteLock stores lock, or removes it once nothing is locked and the votes are not delegated.
+func (k Keeper) setVoteLock(ctx context.Context, account sdk.AccAddress, lock ynxtypes.VoteLock) error {
+	if lock.Locked.IsZero() && lock.Delegatee == "" {
+		return k.VoteLocks.Remove(ctx, account)
+	}
+	return k.VoteLocks.Set(ctx, account, lock)
+}
+
+// GetVoteLocks returns all vote locks ordered by account address.
+func (k Keeper) GetVoteLocks(ctx context.Context) ([]ynxtypes.VoteLock, error) {
+	out := []ynxtypes.VoteLock{}
+	err := k.VoteLocks.Walk(ctx, nil, func(_ []byte, lock ynxtypes.VoteLock) (bool, error) {
+		out = append(out, lock)
+		return false, nil
+	})
+	return out, err
+}
+
+// GetVoteCheckpoints returns the vote checkpoints ordered by account address and height, and the
+// total supply checkpoints ordered by height.
+func (k Keeper) GetVoteCheckpoints(ctx context.Context) (checkpoints, supply []ynxtypes.VoteCheckpoint, err error) {
+	checkpoints = []ynxtypes.VoteCheckpoint{}
+	err = k.VoteCheckpoints.Walk(ctx, nil, func(key collections.Pair[[]byte, int64], votes sdkmath.Int) (bool, error) {
+		checkpoints = append(checkpoints, ynxtypes.VoteCheckpoint{
+			Account: sdk.AccAddress(key.K1()).String(),
+			Height:  key.K2(),
+			Votes:   votes,
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	supply = []ynxtypes.VoteCheckpoint{}
+	err = k.VoteSupplyCheckpoints.Walk(ctx, nil, func(height int64, votes sdkmath.Int) (bool, error) {
+		supply = append(supply, ynxtypes.VoteCheckpoint{Height: height, Votes: votes})
+		return false, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return checkpoints, supply, nil
+}
+
+func delegateeOf(lock ynxtypes.VoteLock) sdk.AccAddress {
+	if lock.Delegatee == "" {
+		return nil
+	}
+	return sdk.MustAccAddressFromBech32(lock.Delegatee)
+}
@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	ynxkeeper "github.com/JiahaoAlbus/YNX/chain/x/ynx/keeper"
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
+)
+
+func TestVoteCheckpoints(t *testing.T) {
+	app, ctx := newTestApp(t, 10)
+	k := app.YNXKeeper
+
+	denom := evmtypes.GetEVMCoinDenom()
+	alice := sdk.AccAddress(make20(0x71))
+	bob := sdk.AccAddress(make20(0x72))
+
+	coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(1_000)))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, alice, coins))
+
+	// Locked NYXT only counts once it is delegated.
+	require.NoError(t, k.LockVotes(ctx, alice, sdkmath.NewInt(600)))
+	require.Equal(t, sdkmath.NewInt(400), app.BankKeeper.GetBalance(ctx, alice, denom).Amount)
+	votes, err := k.GetVotes(ctx, alice)
+	require.NoError(t, err)
+	require.True(t, votes.IsZero())
+	total, err := k.GetTotalVotes(ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(600), total)
+
+	require.NoError(t, k.DelegateVotes(ctx, alice, alice))
+	votes, err = k.GetVotes(ctx, alice)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(600), votes)
+
+	// Later blocks move the votes to bob and unlock part of them.
+	ctx = ctx.WithBlockHeight(20)
+	require.NoError(t, k.DelegateVotes(ctx, alice, bob))
+	ctx = ctx.WithBlockHeight(30)
+	require.NoError(t, k.UnlockVotes(ctx, alice, sdkmath.NewInt(100)))
+	require.Error(t, k.UnlockVotes(ctx, alice, sdkmath.NewInt(1_000)))
+
+	for _, tc := range []struct {
+		height     int64
+		alice, bob int64
+		total      int64
+	}{
+		{height: 9, alice: 0, bob: 0, total: 0},
+		{height: 10, alice: 600, bob: 0, total: 600},
+		{height: 19, alice: 600, bob: 0, total: 600},
+		{height: 20, alice: 0, bob: 600, total: 600},
+		{height: 30, alice: 0, bob: 500, total: 500},
+	} {
+		votes, err := k.GetPastVotes(ctx, alice, tc.height)
+		require.NoError(t, err)
+		require.Equal(t, sdkmath.NewInt(tc.alice), votes, "alice at %d", tc.height)
+		votes, err = k.GetPastVotes(ctx, bob, tc.height)
+		require.NoError(t, err)
+		require.Equal(t, sdkmath.NewInt(tc.bob), votes, "bob at %d", tc.height)
+		total, err := k.GetPastTotalVotes(ctx, tc.height)
+		require.NoError(t, err)
+		require.Equal(t, sdkmath.NewInt(tc.total), total, "total at %d", tc.height)
+	}
+
+	_, broken := ynxkeeper.VoteEscrowInvariant(k)(ctx)
+	require.False(t, broken)
+
+	// The exported locks and checkpoints form a valid genesis state.
+	locks, err := k.GetVoteLocks(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []ynxtypes.VoteLock{{Account: alice.String(), Locked: sdkmath.NewInt(500), Delegatee: bob.String()}}, locks)
+	checkpoints, supply, err := k.GetVoteCheckpoints(ctx)
+	require.NoError(t, err)
+	gs := ynxtypes.DefaultGenesis()
+	gs.VoteLocks, gs.VoteCheckpoints, gs.VoteSupplyCheckpoints = locks, checkpoints, supply
+	require.NoError(t, gs.Validate())
+}
@@ -0,0 +1,179 @@
+package module
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: "ynx.ynx.v1.Query",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the active x/ynx params",
+				},
+				{
+					RpcMethod: "SystemContracts",
+					Use:       "system-contracts",
+					Short:     "Query the system contract config and the deployed addresses",
+				},
+				{
+					RpcMethod: "VerifySystemContracts",
+					Use:       "verify-system-contracts",
+					Short:     "Compare the live code of the system contracts with their attestations and the embedded artifacts",
+				},
+				{
+					RpcMethod: "Revenue",
+					Use:       "revenue",
+					Short:     "Query the cumulative protocol revenue ledger",
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"denom": {Usage: "restrict the ledger to a single denom"},
+					},
+				},
+				{
+					RpcMethod:      "RevenueByEpoch",
+					Use:            "revenue-by-epoch [epoch]",
+					Short:          "Query the protocol revenue recorded during an epoch",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "epoch"}},
+				},
+				{
+					RpcMethod: "PendingParams",
+					Use:       "pending-params",
+					Short:     "Query the scheduled params changes",
+				},
+				{
+					RpcMethod:      "ContractRevenue",
+					Use:            "contract-revenue [contract-address]",
+					Short:          "Query the developer fee rebate registration of a contract",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract_address"}},
+				},
+				{
+					RpcMethod: "ContractRevenues",
+					Use:       "contract-revenues",
+					Short:     "Query the developer fee rebate registrations",
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"deployer_address": {Name: "deployer", Usage: "restrict the registrations to a single deployer"},
+					},
+				},
+				{
+					RpcMethod:      "Sponsorship",
+					Use:            "sponsorship [id]",
+					Short:          "Query a gas sponsorship",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod: "Sponsorships",
+					Use:       "sponsorships",
+					Short:     "Query the gas sponsorships",
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"sponsor": {Usage: "restrict the sponsorships to a single sponsor"},
+					},
+				},
+				{
+					RpcMethod: "CircuitBreakers",
+					Use:       "circuit-breakers",
+					Short:     "Query the tripped circuit breakers",
+				},
+				{
+					RpcMethod: "CircuitStatus",
+					Use:       "circuit-status",
+					Short:     "Query whether a message type or an EVM call is blocked by a circuit breaker",
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"msg_type_url": {Name: "msg-type-url", Usage: "the message type url to check"},
+						"target":       {Usage: "the 0x address of the called contract or precompile"},
+						"selector":     {Usage: "the 0x function selector of the call"},
+					},
+				},
+				{
+					RpcMethod: "PreconfirmSigners",
+					Use:       "preconfirm-signers",
+					Short:     "Query the active preconfirm signer set and the sets scheduled to replace it",
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service:              "ynx.ynx.v1.Msg",
+			EnhanceCustomCommand: true,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					// Submitted through governance, see draft-update-params.
+					RpcMethod: "UpdateParams",
+					Skip:      true,
+				},
+				{
+					RpcMethod: "CancelPendingParams",
+					Skip:      true,
+				},
+				{
+					RpcMethod: "DeploySystemContract",
+					Skip:      true,
+				},
+				{
+					RpcMethod: "SetSystemContract",
+					Skip:      true,
+				},
+				{
+					RpcMethod:      "TripCircuitBreaker",
+					Use:            "trip-circuit-breaker [kind] [target] [duration-blocks]",
+					Short:          "Block a message type, an EVM call target or a static precompile as the circuit guardian",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "kind"}, {ProtoField: "target"}, {ProtoField: "duration_blocks"}},
+				},
+				{
+					// Submitted through governance.
+					RpcMethod: "ResetCircuitBreaker",
+					Skip:      true,
+				},
+				{
+					// Submitted through governance.
+					RpcMethod: "SetPreconfirmSigners",
+					Skip:      true,
+				},
+				{
+					RpcMethod:      "RegisterContractRevenue",
+					Use:            "register-contract-revenue [contract-address] [nonce] [withdraw-address]",
+					Short:          "Register a deployed contract for developer fee rebates",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract_address"}, {ProtoField: "nonce"}, {ProtoField: "withdraw_address"}},
+				},
+				{
+					RpcMethod:      "UpdateContractRevenue",
+					Use:            "update-contract-revenue [contract-address] [withdraw-address]",
+					Short:          "Change the withdraw address of a registered contract",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract_address"}, {ProtoField: "withdraw_address"}},
+				},
+				{
+					RpcMethod:      "CancelContractRevenue",
+					Use:            "cancel-contract-revenue [contract-address]",
+					Short:          "Cancel the developer fee rebate registration of a contract",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract_address"}},
+				},
+				{
+					RpcMethod:      "CreateSponsorship",
+					Use:            "create-sponsorship [budget]",
+					Short:          "Create a gas sponsorship funded with budget, with the policy given by --policy",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "budget"}},
+				},
+				{
+					RpcMethod:      "UpdateSponsorship",
+					Use:            "update-sponsorship [id]",
+					Short:          "Replace the policy of a gas sponsorship",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "FundSponsorship",
+					Use:            "fund-sponsorship [id] [amount]",
+					Short:          "Add budget to a gas sponsorship",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "CloseSponsorship",
+					Use:            "close-sponsorship [id]",
+					Short:          "Close a gas sponsorship and refund the remaining budget",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+			},
+		},
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"cosmossdk.io/client/v2/autocli"
 	"cosmossdk.io/core/appmodule"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -12,22 +13,36 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
 
+	"github.com/JiahaoAlbus/YNX/chain/x/ynx/client/cli"
 	ynxkeeper "github.com/JiahaoAlbus/YNX/chain/x/ynx/keeper"
+	ynxsimulation "github.com/JiahaoAlbus/YNX/chain/x/ynx/simulation"
 	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
 )
 
-const ConsensusVersion = 1
+const ConsensusVersion = 6
 
 var (
 	_ module.AppModuleBasic = AppModule{}
 	_ module.HasGenesis     = AppModule{}
 	_ module.HasServices    = AppModule{}
+	_ module.HasInvariants  = AppModule{}
+
+	_ module.AppModuleSimulation = AppModule{}
+	_ module.HasProposalMsgs     = AppModule{}
+
+	_ autocli.HasAutoCLIConfig   = AppModule{}
+	_ autocli.HasCustomTxCommand = AppModule{}
 
 	_ appmodule.AppModule       = AppModule{}
 	_ appmodule.HasBeginBlocker = AppModule{}
+	_ appmodule.HasEndBlocker   = AppModule{}
 )
 
 type AppModuleBasic struct {
@@ -44,17 +59,32 @@ func (AppModuleBasic) RegisterInterfaces(r cdctypes.InterfaceRegistry) {
 	ynxtypes.RegisterInterfaces(r)
 }
 
-func (AppModuleBasic) RegisterGRPCGatewayRoutes(_ client.Context, _ *gwruntime.ServeMux) {}
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *gwruntime.ServeMux) {
+	if err := ynxtypes.RegisterQueryHandlerClient(context.Background(), mux, ynxtypes.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
+
+// GetTxCmd returns the custom x/ynx tx commands. AutoCLI adds the Msg service commands to it.
+func (AppModuleBasic) GetTxCmd() *cobra.Command {
+	return cli.NewTxCmd()
+}
 
 type AppModule struct {
 	AppModuleBasic
 	keeper ynxkeeper.Keeper
+
+	// Used by simulations only.
+	accountKeeper authkeeper.AccountKeeper
+	bankKeeper    bankkeeper.Keeper
 }
 
-func NewAppModule(cdc codec.Codec, k ynxkeeper.Keeper) AppModule {
+func NewAppModule(cdc codec.Codec, k ynxkeeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper) AppModule {
 	return AppModule{
 		AppModuleBasic: AppModuleBasic{cdc: cdc},
 		keeper:         k,
+		accountKeeper:  ak,
+		bankKeeper:     bk,
 	}
 }
 
@@ -65,6 +95,27 @@ func (am AppModule) IsAppModule() {}
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	ynxtypes.RegisterMsgServer(cfg.MsgServer(), ynxkeeper.NewMsgServerImpl(am.keeper))
 	ynxtypes.RegisterQueryServer(cfg.QueryServer(), ynxkeeper.NewQueryServerImpl(am.keeper))
+
+	m := ynxkeeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(ynxtypes.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", ynxtypes.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(ynxtypes.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 2 to 3: %v", ynxtypes.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(ynxtypes.ModuleName, 3, m.Migrate3to4); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 3 to 4: %v", ynxtypes.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(ynxtypes.ModuleName, 4, m.Migrate4to5); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 4 to 5: %v", ynxtypes.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(ynxtypes.ModuleName, 5, m.Migrate5to6); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 5 to 6: %v", ynxtypes.ModuleName, err))
+	}
+}
+
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	ynxkeeper.RegisterInvariants(ir, am.keeper)
 }
 
 func (am AppModule) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
@@ -94,6 +145,44 @@ func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
 func (am AppModule) BeginBlock(ctx context.Context) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	return am.keeper.SplitInflationToTreasury(sdkCtx)
+	if err := am.keeper.ApplyPendingParams(sdkCtx); err != nil {
+		return err
+	}
+	if err := am.keeper.ExpireCircuitBreakers(sdkCtx); err != nil {
+		return err
+	}
+	if err := am.keeper.AdvanceEpoch(sdkCtx); err != nil {
+		return err
+	}
+	if err := am.keeper.SettleFeeShares(sdkCtx); err != nil {
+		return err
+	}
+	return am.keeper.SplitInflation(sdkCtx)
+}
+
+func (am AppModule) EndBlock(ctx context.Context) error {
+	if err := am.keeper.SplitHeldTxFees(ctx); err != nil {
+		return err
+	}
+	return am.keeper.SnapshotStakeVotes(ctx)
 }
 
+// GenerateGenesisState creates a randomized GenState of x/ynx.
+func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	ynxsimulation.RandomizedGenState(simState)
+}
+
+// ProposalMsgs returns msgs used for governance proposals for simulations.
+func (AppModule) ProposalMsgs(_ module.SimulationState) []simtypes.WeightedProposalMsg {
+	return ynxsimulation.ProposalMsgs()
+}
+
+// RegisterStoreDecoder registers a decoder for the x/ynx collections.
+func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
+	sdr[ynxtypes.StoreKey] = simtypes.NewStoreDecoderFuncFromCollectionsSchema(am.keeper.Schema)
+}
+
+// WeightedOperations returns the x/ynx operations with their respective weights.
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	return ynxsimulation.WeightedOperations(simState.AppParams, simState.TxConfig, am.accountKeeper, am.bankKeeper, am.keeper)
+}
@@ -0,0 +1,146 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
+)
+
+// Simulation parameter constants
+const (
+	FeeBurnBps                  = "fee_burn_bps"
+	FeeTreasuryBps              = "fee_treasury_bps"
+	FeeFounderBps               = "fee_founder_bps"
+	FeeDeveloperBps             = "fee_developer_bps"
+	InflationTreasuryBps        = "inflation_treasury_bps"
+	EpochLengthBlocks           = "epoch_length_blocks"
+	FeeSettlementIntervalBlocks = "fee_settlement_interval_blocks"
+)
+
+// GenFeeSplitBps returns random burn, treasury, founder and developer fee bps that sum to at most
+// BPSDenominator.
+func GenFeeSplitBps(r *rand.Rand) (burn, treasury, founder, developer uint32) {
+	left := ynxtypes.BPSDenominator
+	pick := func() uint32 {
+		v := r.Intn(left + 1)
+		left -= v
+		return uint32(v)
+	}
+	return pick(), pick(), pick(), pick()
+}
+
+// GenInflationTreasuryBps randomized bps of the treasury inflation recipient
+func GenInflationTreasuryBps(r *rand.Rand) uint32 {
+	return uint32(r.Intn(ynxtypes.BPSDenominator/2 + 1))
+}
+
+// GenEpochLengthBlocks randomized EpochLengthBlocks. Epochs are short so that simulations cross
+// several of them.
+func GenEpochLengthBlocks(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 1, 100))
+}
+
+// GenFeeSettlementIntervalBlocks randomized FeeSettlementIntervalBlocks. Half of the simulations pay
+// fee shares per transaction.
+func GenFeeSettlementIntervalBlocks(r *rand.Rand) uint64 {
+	if r.Intn(2) == 0 {
+		return 0
+	}
+	return uint64(simtypes.RandIntBetween(r, 1, 20))
+}
+
+// RandomParams returns random x/ynx params. The treasury, the founder and an inflation recipient
+// are drawn from accs, or left unset.
+func RandomParams(r *rand.Rand, accs []simtypes.Account) ynxtypes.Params {
+	params := ynxtypes.DefaultParams()
+	params.FeeBurnBps, params.FeeTreasuryBps, params.FeeFounderBps, params.FeeDeveloperBps = GenFeeSplitBps(r)
+	params.EpochLengthBlocks = GenEpochLengthBlocks(r)
+	params.FeeSettlementIntervalBlocks = GenFeeSettlementIntervalBlocks(r)
+	randomizeRecipients(r, accs, &params, GenInflationTreasuryBps(r))
+	return params
+}
+
+func randomizeRecipients(r *rand.Rand, accs []simtypes.Account, params *ynxtypes.Params, inflationTreasuryBps uint32) {
+	params.InflationRecipients = []ynxtypes.InflationRecipient{}
+	params.SetInflationTreasuryShareBps(inflationTreasuryBps)
+	if len(accs) == 0 {
+		return
+	}
+	if r.Intn(4) != 0 {
+		acc, _ := simtypes.RandomAcc(r, accs)
+		params.TreasuryAddress = acc.Address.String()
+	}
+	if r.Intn(2) == 0 {
+		acc, _ := simtypes.RandomAcc(r, accs)
+		params.FounderAddress = acc.Address.String()
+	}
+
+	if left := ynxtypes.BPSDenominator - int(inflationRecipientsBps(params.InflationRecipients)); r.Intn(2) == 0 && left > 0 {
+		acc, _ := simtypes.RandomAcc(r, accs)
+		params.InflationRecipients = append(params.InflationRecipients, ynxtypes.InflationRecipient{
+			Name:    "sim_recipient",
+			Kind:    ynxtypes.InflationRecipientKind_INFLATION_RECIPIENT_KIND_ACCOUNT,
+			Address: acc.Address.String(),
+			Bps:     uint32(r.Intn(left + 1)),
+		})
+	}
+	if left := ynxtypes.BPSDenominator - int(inflationRecipientsBps(params.InflationRecipients)); r.Intn(2) == 0 && left > 0 {
+		params.InflationRecipients = append(params.InflationRecipients, ynxtypes.InflationRecipient{
+			Name: "community_pool",
+			Kind: ynxtypes.InflationRecipientKind_INFLATION_RECIPIENT_KIND_COMMUNITY_POOL,
+			Bps:  uint32(r.Intn(left + 1)),
+		})
+	}
+}
+
+func inflationRecipientsBps(recipients []ynxtypes.InflationRecipient) uint32 {
+	var bps uint32
+	for _, recipient := range recipients {
+		bps += recipient.Bps
+	}
+	return bps
+}
+
+// RandomizedGenState generates a random GenesisState for x/ynx. System contracts are not deployed.
+func RandomizedGenState(simState *module.SimulationState) {
+	var burn, treasury, founder, developer uint32
+	simState.AppParams.GetOrGenerate(FeeBurnBps, &burn, simState.Rand, func(r *rand.Rand) {
+		burn = uint32(r.Intn(ynxtypes.BPSDenominator + 1))
+	})
+	simState.AppParams.GetOrGenerate(FeeTreasuryBps, &treasury, simState.Rand, func(r *rand.Rand) {
+		treasury = uint32(r.Intn(ynxtypes.BPSDenominator - int(burn) + 1))
+	})
+	simState.AppParams.GetOrGenerate(FeeFounderBps, &founder, simState.Rand, func(r *rand.Rand) {
+		founder = uint32(r.Intn(ynxtypes.BPSDenominator - int(burn) - int(treasury) + 1))
+	})
+	simState.AppParams.GetOrGenerate(FeeDeveloperBps, &developer, simState.Rand, func(r *rand.Rand) {
+		developer = uint32(r.Intn(ynxtypes.BPSDenominator - int(burn) - int(treasury) - int(founder) + 1))
+	})
+
+	var inflationTreasury uint32
+	simState.AppParams.GetOrGenerate(InflationTreasuryBps, &inflationTreasury, simState.Rand, func(r *rand.Rand) { inflationTreasury = GenInflationTreasuryBps(r) })
+
+	var epochLength uint64
+	simState.AppParams.GetOrGenerate(EpochLengthBlocks, &epochLength, simState.Rand, func(r *rand.Rand) { epochLength = GenEpochLengthBlocks(r) })
+
+	var settlementInterval uint64
+	simState.AppParams.GetOrGenerate(FeeSettlementIntervalBlocks, &settlementInterval, simState.Rand, func(r *rand.Rand) {
+		settlementInterval = GenFeeSettlementIntervalBlocks(r)
+	})
+
+	params := ynxtypes.DefaultParams()
+	params.FeeBurnBps = burn
+	params.FeeTreasuryBps = treasury
+	params.FeeFounderBps = founder
+	params.FeeDeveloperBps = developer
+	params.EpochLengthBlocks = epochLength
+	params.FeeSettlementIntervalBlocks = settlementInterval
+	randomizeRecipients(simState.Rand, simState.Accounts, &params, inflationTreasury)
+
+	ynxGenesis := ynxtypes.DefaultGenesis()
+	ynxGenesis.Params = params
+	simState.GenState[ynxtypes.ModuleName] = simState.Cdc.MustMarshalJSON(ynxGenesis)
+}
@@ -0,0 +1,51 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	ynxsimulation "github.com/JiahaoAlbus/YNX/chain/x/ynx/simulation"
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
+)
+
+func TestRandomizedGenStateValidates(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	for seed := int64(0); seed < 50; seed++ {
+		r := rand.New(rand.NewSource(seed))
+		simState := module.SimulationState{
+			AppParams:    make(simtypes.AppParams),
+			Cdc:          cdc,
+			Rand:         r,
+			NumBonded:    3,
+			Accounts:     simtypes.RandomAccounts(r, 3),
+			InitialStake: sdkmath.NewInt(1000),
+			GenState:     make(map[string]json.RawMessage),
+		}
+		ynxsimulation.RandomizedGenState(&simState)
+
+		var gs ynxtypes.GenesisState
+		cdc.MustUnmarshalJSON(simState.GenState[ynxtypes.ModuleName], &gs)
+		require.NoError(t, gs.Validate(), "seed %d", seed)
+		require.False(t, gs.System.Enabled)
+	}
+}
+
+func TestRandomParamsValidate(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	accs := simtypes.RandomAccounts(r, 5)
+
+	for i := 0; i < 100; i++ {
+		require.NoError(t, ynxsimulation.RandomParams(r, accs).Validate())
+	}
+}
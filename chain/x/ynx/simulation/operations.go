@@ -0,0 +1,224 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	ynxkeeper "github.com/JiahaoAlbus/YNX/chain/x/ynx/keeper"
+	ynxtypes "github.com/JiahaoAlbus/YNX/chain/x/ynx/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgCreateSponsorship = "op_weight_msg_create_sponsorship"
+	OpWeightMsgFundSponsorship   = "op_weight_msg_fund_sponsorship"
+	OpWeightMsgCloseSponsorship  = "op_weight_msg_close_sponsorship"
+
+	DefaultWeightMsgCreateSponsorship int = 20
+	DefaultWeightMsgFundSponsorship   int = 20
+	DefaultWeightMsgCloseSponsorship  int = 10
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights.
+// Fee splits need no operation of their own: the fees of every simulated transaction go through
+// them.
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	txConfig client.TxConfig,
+	ak authkeeper.AccountKeeper,
+	bk bankkeeper.Keeper,
+	k ynxkeeper.Keeper,
+) simulation.WeightedOperations {
+	var weightMsgCreateSponsorship int
+	appParams.GetOrGenerate(OpWeightMsgCreateSponsorship, &weightMsgCreateSponsorship, nil, func(_ *rand.Rand) {
+		weightMsgCreateSponsorship = DefaultWeightMsgCreateSponsorship
+	})
+
+	var weightMsgFundSponsorship int
+	appParams.GetOrGenerate(OpWeightMsgFundSponsorship, &weightMsgFundSponsorship, nil, func(_ *rand.Rand) {
+		weightMsgFundSponsorship = DefaultWeightMsgFundSponsorship
+	})
+
+	var weightMsgCloseSponsorship int
+	appParams.GetOrGenerate(OpWeightMsgCloseSponsorship, &weightMsgCloseSponsorship, nil, func(_ *rand.Rand) {
+		weightMsgCloseSponsorship = DefaultWeightMsgCloseSponsorship
+	})
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgCreateSponsorship,
+			SimulateMsgCreateSponsorship(txConfig, ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgFundSponsorship,
+			SimulateMsgFundSponsorship(txConfig, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgCloseSponsorship,
+			SimulateMsgCloseSponsorship(txConfig, ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgCreateSponsorship generates a MsgCreateSponsorship with a random policy and budget.
+func SimulateMsgCreateSponsorship(txConfig client.TxConfig, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&ynxtypes.MsgCreateSponsorship{})
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		budget, ok := randomEVMAmount(r, ctx, bk, simAccount.Address)
+		if !ok {
+			return simtypes.NoOpMsg(ynxtypes.ModuleName, msgType, "insufficient funds"), nil, nil
+		}
+
+		contracts := make([]string, simtypes.RandIntBetween(r, 1, 4))
+		for i := range contracts {
+			acc, _ := simtypes.RandomAcc(r, accs)
+			contracts[i] = common.BytesToAddress(acc.Address).Hex()
+		}
+
+		msg := &ynxtypes.MsgCreateSponsorship{
+			Sponsor: simAccount.Address.String(),
+			Policy: ynxtypes.SponsorshipPolicy{
+				AllowedContracts: contracts,
+				MaxGasPerTx:      uint64(r.Intn(1_000_000)),
+				DailyCap:         simtypes.RandomAmount(r, budget),
+			},
+			Budget: budget,
+		}
+
+		return deliver(r, app, ctx, txConfig, ak, bk, simAccount, msg, budget)
+	}
+}
+
+// SimulateMsgFundSponsorship generates a MsgFundSponsorship for a random existing sponsorship.
+func SimulateMsgFundSponsorship(txConfig client.TxConfig, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k ynxkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&ynxtypes.MsgFundSponsorship{})
+
+		sp, simAccount, ok, err := randomSponsorship(r, ctx, k, accs)
+		if err != nil {
+			return simtypes.NoOpMsg(ynxtypes.ModuleName, msgType, "error getting sponsorships"), nil, err
+		}
+		if !ok {
+			return simtypes.NoOpMsg(ynxtypes.ModuleName, msgType, "no sponsorship"), nil, nil
+		}
+
+		amount, ok := randomEVMAmount(r, ctx, bk, simAccount.Address)
+		if !ok {
+			return simtypes.NoOpMsg(ynxtypes.ModuleName, msgType, "insufficient funds"), nil, nil
+		}
+
+		msg := &ynxtypes.MsgFundSponsorship{
+			Sponsor: simAccount.Address.String(),
+			Id:      sp.Id,
+			Amount:  amount,
+		}
+
+		return deliver(r, app, ctx, txConfig, ak, bk, simAccount, msg, amount)
+	}
+}
+
+// SimulateMsgCloseSponsorship generates a MsgCloseSponsorship for a random existing sponsorship.
+func SimulateMsgCloseSponsorship(txConfig client.TxConfig, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k ynxkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&ynxtypes.MsgCloseSponsorship{})
+
+		sp, simAccount, ok, err := randomSponsorship(r, ctx, k, accs)
+		if err != nil {
+			return simtypes.NoOpMsg(ynxtypes.ModuleName, msgType, "error getting sponsorships"), nil, err
+		}
+		if !ok {
+			return simtypes.NoOpMsg(ynxtypes.ModuleName, msgType, "no sponsorship"), nil, nil
+		}
+
+		msg := &ynxtypes.MsgCloseSponsorship{
+			Sponsor: simAccount.Address.String(),
+			Id:      sp.Id,
+		}
+
+		return deliver(r, app, ctx, txConfig, ak, bk, simAccount, msg, sdkmath.ZeroInt())
+	}
+}
+
+// randomEVMAmount returns a random amount of the EVM denom of at most half of the spendable balance
+// of addr, leaving the rest for fees.
+func randomEVMAmount(r *rand.Rand, ctx sdk.Context, bk bankkeeper.Keeper, addr sdk.AccAddress) (sdkmath.Int, bool) {
+	spendable := bk.SpendableCoins(ctx, addr).AmountOf(evmtypes.GetEVMCoinDenom())
+	half := spendable.QuoRaw(2)
+	if !half.IsPositive() {
+		return sdkmath.Int{}, false
+	}
+
+	amount, err := simtypes.RandPositiveInt(r, half)
+	if err != nil {
+		return sdkmath.Int{}, false
+	}
+	return amount, true
+}
+
+// randomSponsorship returns a random sponsorship whose sponsor is a simulation account.
+func randomSponsorship(r *rand.Rand, ctx sdk.Context, k ynxkeeper.Keeper, accs []simtypes.Account) (ynxtypes.Sponsorship, simtypes.Account, bool, error) {
+	sps, err := k.GetSponsorships(ctx, "")
+	if err != nil || len(sps) == 0 {
+		return ynxtypes.Sponsorship{}, simtypes.Account{}, false, err
+	}
+
+	sp := sps[r.Intn(len(sps))]
+	sponsor, err := sdk.AccAddressFromBech32(sp.Sponsor)
+	if err != nil {
+		return ynxtypes.Sponsorship{}, simtypes.Account{}, false, err
+	}
+	simAccount, found := simtypes.FindAccount(accs, sponsor)
+	if !found {
+		return ynxtypes.Sponsorship{}, simtypes.Account{}, false, nil
+	}
+	return sp, simAccount, true, nil
+}
+
+func deliver(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	txConfig client.TxConfig,
+	ak authkeeper.AccountKeeper,
+	bk bankkeeper.Keeper,
+	simAccount simtypes.Account,
+	msg sdk.Msg,
+	spent sdkmath.Int,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	txCtx := simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           txConfig,
+		Cdc:             nil,
+		Msg:             msg,
+		Context:         ctx,
+		SimAccount:      simAccount,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      ynxtypes.ModuleName,
+		CoinsSpentInMsg: sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), spent)),
+	}
+
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
+}
@@ -0,0 +1,125 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// CircuitBreakerPrecompileAddress is the precompile through which contracts check circuit
+	// breakers, the guardian trips them and the timelock resets them.
+	CircuitBreakerPrecompileAddress = "0x0000000000000000000000000000000000000814"
+
+	// DefaultCircuitBreakerMaxBlocks is the longest a guardian may trip a breaker for by default.
+	DefaultCircuitBreakerMaxBlocks = uint64(7 * 24 * 60 * 60) // 7d @ 1s blocks
+
+	// MaxCircuitBreakerReasonLength bounds the reason recorded with a circuit breaker.
+	MaxCircuitBreakerReasonLength = 256
+)
+
+// NormalizeCircuitBreaker validates the target and selector of a circuit breaker of kind and
+// returns them in the form breakers are stored and looked up under: message type URLs as given,
+// checksummed 0x addresses and lower-case 0x selectors.
+func NormalizeCircuitBreaker(kind CircuitBreakerKind, target, selector string) (string, string, error) {
+	switch kind {
+	case CircuitBreakerKind_CIRCUIT_BREAKER_KIND_MSG:
+		if err := ValidateMsgTypeURL(target); err != nil {
+			return "", "", err
+		}
+		if selector != "" {
+			return "", "", fmt.Errorf("message circuit breakers take no selector")
+		}
+		return target, "", nil
+	case CircuitBreakerKind_CIRCUIT_BREAKER_KIND_EVM_CALL, CircuitBreakerKind_CIRCUIT_BREAKER_KIND_PRECOMPILE:
+		addr, err := ParseContractAddress(target)
+		if err != nil {
+			return "", "", err
+		}
+		if selector == "" {
+			return addr.Hex(), "", nil
+		}
+		if kind == CircuitBreakerKind_CIRCUIT_BREAKER_KIND_PRECOMPILE {
+			return "", "", fmt.Errorf("precompile circuit breakers take no selector")
+		}
+		bz, err := hex.DecodeString(strings.TrimPrefix(strings.ToLower(selector), "0x"))
+		if err != nil || len(bz) != 4 || !strings.HasPrefix(strings.ToLower(selector), "0x") {
+			return "", "", fmt.Errorf("invalid function selector: %q", selector)
+		}
+		return addr.Hex(), FormatSelector(bz), nil
+	default:
+		return "", "", fmt.Errorf("invalid circuit breaker kind: %s", kind)
+	}
+}
+
+// FormatSelector formats the function selector of EVM call input as stored in circuit breakers.
+func FormatSelector(selector []byte) string {
+	return "0x" + hex.EncodeToString(selector)
+}
+
+// Active reports whether the breaker still blocks at height.
+func (b CircuitBreaker) Active(height int64) bool {
+	return height < b.ExpiryHeight
+}
+
+func (b CircuitBreaker) Validate() error {
+	target, selector, err := NormalizeCircuitBreaker(b.Kind, b.Target, b.Selector)
+	if err != nil {
+		return err
+	}
+	if target != b.Target || selector != b.Selector {
+		return fmt.Errorf("circuit breaker %s %s is not normalized", b.Target, b.Selector)
+	}
+	if _, err := sdk.AccAddressFromBech32(b.TrippedBy); err != nil {
+		return fmt.Errorf("circuit breaker %s: invalid tripped_by: %w", b.Target, err)
+	}
+	if b.TrippedHeight < 0 || b.ExpiryHeight <= b.TrippedHeight {
+		return fmt.Errorf("circuit breaker %s: expiry_height %d must be after tripped_height %d", b.Target, b.ExpiryHeight, b.TrippedHeight)
+	}
+	if len(b.Reason) > MaxCircuitBreakerReasonLength {
+		return fmt.Errorf("circuit breaker %s: reason longer than %d bytes", b.Target, MaxCircuitBreakerReasonLength)
+	}
+	if b.DeactivatedPrecompile && b.Kind != CircuitBreakerKind_CIRCUIT_BREAKER_KIND_PRECOMPILE {
+		return fmt.Errorf("circuit breaker %s: only precompile breakers deactivate precompiles", b.Target)
+	}
+	if b.OriginalCodeHash != "" {
+		if b.Kind != CircuitBreakerKind_CIRCUIT_BREAKER_KIND_EVM_CALL {
+			return fmt.Errorf("circuit breaker %s: only EVM call breakers swap code", b.Target)
+		}
+		if bz, err := hex.DecodeString(strings.TrimPrefix(b.OriginalCodeHash, "0x")); err != nil || len(bz) != 32 ||
+			!strings.HasPrefix(b.OriginalCodeHash, "0x") {
+			return fmt.Errorf("circuit breaker %s: invalid original_code_hash %q", b.Target, b.OriginalCodeHash)
+		}
+	}
+	return nil
+}
+
+func validateCircuitBreakers(breakers []CircuitBreaker) error {
+	type breakerKey struct {
+		kind             CircuitBreakerKind
+		target, selector string
+	}
+	seen := make(map[breakerKey]struct{}, len(breakers))
+	originalCodeHashes := make(map[string]string)
+	for _, b := range breakers {
+		if err := b.Validate(); err != nil {
+			return err
+		}
+		key := breakerKey{b.Kind, b.Target, b.Selector}
+		if _, ok := seen[key]; ok {
+			return fmt.Errorf("duplicate circuit breaker: %s %s %s", b.Kind, b.Target, b.Selector)
+		}
+		seen[key] = struct{}{}
+
+		if b.OriginalCodeHash == "" {
+			continue
+		}
+		if hash, ok := originalCodeHashes[b.Target]; ok && hash != b.OriginalCodeHash {
+			return fmt.Errorf("circuit breakers on %s record different original code hashes", b.Target)
+		}
+		originalCodeHashes[b.Target] = b.OriginalCodeHash
+	}
+	return nil
+}
@@ -0,0 +1,217 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ynx/ynx/v1/circuit.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	proto "github.com/cosmos/gogoproto/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// CircuitBreakerKind selects what a circuit breaker blocks.
+type CircuitBreakerKind int32
+
+const (
+	CircuitBreakerKind_CIRCUIT_BREAKER_KIND_UNSPECIFIED CircuitBreakerKind = 0
+	// CIRCUIT_BREAKER_KIND_MSG rejects transactions carrying a Cosmos message of type target, e.g.
+	// "/cosmos.bank.v1beta1.MsgSend", including messages nested in authz MsgExec.
+	CircuitBreakerKind_CIRCUIT_BREAKER_KIND_MSG CircuitBreakerKind = 1
+	// CIRCUIT_BREAKER_KIND_EVM_CALL reverts calls to the contract target, whether made by a
+	// transaction or by another contract. If selector is set, only calls starting with that function
+	// selector revert. While tripped, the code of target is swapped for a stub that reverts the
+	// blocked calls and delegates the others to the original code.
+	CircuitBreakerKind_CIRCUIT_BREAKER_KIND_EVM_CALL CircuitBreakerKind = 2
+	// CIRCUIT_BREAKER_KIND_PRECOMPILE deactivates the static precompile target while tripped, so that
+	// neither transactions nor contracts can call it.
+	CircuitBreakerKind_CIRCUIT_BREAKER_KIND_PRECOMPILE CircuitBreakerKind = 3
+)
+
+var CircuitBreakerKind_name = map[int32]string{
+	0: "CIRCUIT_BREAKER_KIND_UNSPECIFIED",
+	1: "CIRCUIT_BREAKER_KIND_MSG",
+	2: "CIRCUIT_BREAKER_KIND_EVM_CALL",
+	3: "CIRCUIT_BREAKER_KIND_PRECOMPILE",
+}
+
+var CircuitBreakerKind_value = map[string]int32{
+	"CIRCUIT_BREAKER_KIND_UNSPECIFIED": 0,
+	"CIRCUIT_BREAKER_KIND_MSG":         1,
+	"CIRCUIT_BREAKER_KIND_EVM_CALL":    2,
+	"CIRCUIT_BREAKER_KIND_PRECOMPILE":  3,
+}
+
+func (x CircuitBreakerKind) String() string {
+	return proto.EnumName(CircuitBreakerKind_name, int32(x))
+}
+
+func (CircuitBreakerKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b9f4c396c922955d, []int{0}
+}
+
+// CircuitBreaker is a tripped circuit breaker. It blocks its target until expiry_height or until it
+// is reset by the module authority or the timelock.
+type CircuitBreaker struct {
+	Kind CircuitBreakerKind `protobuf:"varint,1,opt,name=kind,proto3,enum=ynx.ynx.v1.CircuitBreakerKind" json:"kind,omitempty"`
+	// target is the message type URL of CIRCUIT_BREAKER_KIND_MSG, and the 0x-prefixed contract or
+	// precompile address otherwise.
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// selector is the 0x-prefixed 4-byte function selector of CIRCUIT_BREAKER_KIND_EVM_CALL. Empty
+	// blocks every call to target. It must be empty for the other kinds.
+	Selector string `protobuf:"bytes,3,opt,name=selector,proto3" json:"selector,omitempty"`
+	// tripped_by is the guardian that tripped the breaker.
+	TrippedBy string `protobuf:"bytes,4,opt,name=tripped_by,json=trippedBy,proto3" json:"tripped_by,omitempty"`
+	// tripped_height is the height the breaker was tripped at.
+	TrippedHeight int64 `protobuf:"varint,5,opt,name=tripped_height,json=trippedHeight,proto3" json:"tripped_height,omitempty"`
+	// expiry_height is the first height at which the breaker no longer blocks.
+	ExpiryHeight int64 `protobuf:"varint,6,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	// reason is a free-form explanation recorded by the guardian.
+	Reason string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	// deactivated_precompile is set when tripping a CIRCUIT_BREAKER_KIND_PRECOMPILE breaker removed
+	// target from the active static precompiles, which lifting the breaker restores.
+	DeactivatedPrecompile bool `protobuf:"varint,8,opt,name=deactivated_precompile,json=deactivatedPrecompile,proto3" json:"deactivated_precompile,omitempty"`
+	// original_code_hash is the 0x-prefixed code hash of target before its code was swapped for the
+	// stub of CIRCUIT_BREAKER_KIND_EVM_CALL, which lifting the last breaker on target restores.
+	OriginalCodeHash     string   `protobuf:"bytes,9,opt,name=original_code_hash,json=originalCodeHash,proto3" json:"original_code_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CircuitBreaker) Reset()         { *m = CircuitBreaker{} }
+func (m *CircuitBreaker) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreaker) ProtoMessage()    {}
+func (*CircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9f4c396c922955d, []int{0}
+}
+func (m *CircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CircuitBreaker.Unmarshal(m, b)
+}
+func (m *CircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CircuitBreaker.Marshal(b, m, deterministic)
+}
+func (m *CircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreaker.Merge(m, src)
+}
+func (m *CircuitBreaker) XXX_Size() int {
+	return xxx_messageInfo_CircuitBreaker.Size(m)
+}
+func (m *CircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreaker proto.InternalMessageInfo
+
+func (m *CircuitBreaker) GetKind() CircuitBreakerKind {
+	if m != nil {
+		return m.Kind
+	}
+	return CircuitBreakerKind_CIRCUIT_BREAKER_KIND_UNSPECIFIED
+}
+
+func (m *CircuitBreaker) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *CircuitBreaker) GetSelector() string {
+	if m != nil {
+		return m.Selector
+	}
+	return ""
+}
+
+func (m *CircuitBreaker) GetTrippedBy() string {
+	if m != nil {
+		return m.TrippedBy
+	}
+	return ""
+}
+
+func (m *CircuitBreaker) GetTrippedHeight() int64 {
+	if m != nil {
+		return m.TrippedHeight
+	}
+	return 0
+}
+
+func (m *CircuitBreaker) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *CircuitBreaker) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *CircuitBreaker) GetDeactivatedPrecompile() bool {
+	if m != nil {
+		return m.DeactivatedPrecompile
+	}
+	return false
+}
+
+func (m *CircuitBreaker) GetOriginalCodeHash() string {
+	if m != nil {
+		return m.OriginalCodeHash
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("ynx.ynx.v1.CircuitBreakerKind", CircuitBreakerKind_name, CircuitBreakerKind_value)
+	proto.RegisterType((*CircuitBreaker)(nil), "ynx.ynx.v1.CircuitBreaker")
+}
+
+func init() { proto.RegisterFile("ynx/ynx/v1/circuit.proto", fileDescriptor_b9f4c396c922955d) }
+
+var fileDescriptor_b9f4c396c922955d = []byte{
+	// 442 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xdd, 0x8a, 0xd3, 0x40,
+	0x14, 0xc7, 0x4d, 0xbb, 0xd6, 0xf6, 0xe0, 0x96, 0x30, 0x68, 0x19, 0x17, 0x3f, 0xa2, 0xab, 0x50,
+	0x64, 0x4d, 0xd8, 0x15, 0xf1, 0xba, 0xc9, 0x46, 0x37, 0xf6, 0xc3, 0x92, 0xba, 0xa2, 0xde, 0x84,
+	0x69, 0x66, 0x48, 0x86, 0x6d, 0x33, 0x61, 0x66, 0x5a, 0x9a, 0xb7, 0xf1, 0x21, 0x7c, 0x12, 0x1f,
+	0xc7, 0x2b, 0x69, 0x92, 0x56, 0xc5, 0x5e, 0xcc, 0xc5, 0xff, 0xfc, 0x7e, 0xe7, 0x70, 0x18, 0x0e,
+	0xe0, 0x22, 0xdb, 0x38, 0xdb, 0xb7, 0x3e, 0x77, 0x62, 0x2e, 0xe3, 0x15, 0xd7, 0x76, 0x2e, 0x85,
+	0x16, 0x08, 0x8a, 0x6c, 0x63, 0x6f, 0xdf, 0xfa, 0xfc, 0xe4, 0x41, 0x2c, 0xd4, 0x52, 0xa8, 0xa8,
+	0x24, 0x4e, 0x15, 0x2a, 0xed, 0xd9, 0xaf, 0x06, 0x74, 0xbd, 0xaa, 0xd1, 0x95, 0x8c, 0xdc, 0x30,
+	0x89, 0x2e, 0xe0, 0xe8, 0x86, 0x67, 0x14, 0x1b, 0x96, 0xd1, 0xef, 0x5e, 0x3c, 0xb6, 0xff, 0x0c,
+	0xb2, 0xff, 0x35, 0x87, 0x3c, 0xa3, 0x61, 0xe9, 0xa2, 0x1e, 0xb4, 0x34, 0x91, 0x09, 0xd3, 0xb8,
+	0x61, 0x19, 0xfd, 0x4e, 0x58, 0x27, 0x74, 0x02, 0x6d, 0xc5, 0x16, 0x2c, 0xd6, 0x42, 0xe2, 0x66,
+	0x49, 0xf6, 0x19, 0xbd, 0x05, 0xd0, 0x92, 0xe7, 0x39, 0xa3, 0xd1, 0xbc, 0xc0, 0x47, 0x5b, 0xea,
+	0xe2, 0x9f, 0x3f, 0x5e, 0xdd, 0xab, 0x17, 0x1c, 0x50, 0x2a, 0x99, 0x52, 0x33, 0x2d, 0x79, 0x96,
+	0x84, 0x9d, 0xda, 0x75, 0x0b, 0xf4, 0x02, 0xba, 0xbb, 0xc6, 0x94, 0xf1, 0x24, 0xd5, 0xf8, 0xb6,
+	0x65, 0xf4, 0x9b, 0xe1, 0x71, 0x5d, 0xbd, 0x2a, 0x8b, 0xe8, 0x14, 0x8e, 0xd9, 0x26, 0xe7, 0xb2,
+	0xd8, 0x59, 0xad, 0xd2, 0xba, 0x5b, 0x15, 0x6b, 0xa9, 0x07, 0x2d, 0xc9, 0x88, 0x12, 0x19, 0xbe,
+	0x53, 0x2d, 0x5e, 0x25, 0xf4, 0x06, 0x7a, 0x94, 0x91, 0x58, 0xf3, 0x35, 0xd1, 0x8c, 0x46, 0xb9,
+	0x64, 0xb1, 0x58, 0xe6, 0x7c, 0xc1, 0x70, 0xdb, 0x32, 0xfa, 0xed, 0xf0, 0xfe, 0x5f, 0x74, 0xba,
+	0x87, 0xe8, 0x0c, 0x90, 0x90, 0x3c, 0xe1, 0x19, 0x59, 0x44, 0xb1, 0xa0, 0x2c, 0x4a, 0x89, 0x4a,
+	0x71, 0xa7, 0x1c, 0x6d, 0xee, 0x88, 0x27, 0x28, 0xbb, 0x22, 0x2a, 0x7d, 0xf9, 0xdd, 0x00, 0xf4,
+	0xff, 0x97, 0xa2, 0xe7, 0x60, 0x79, 0x41, 0xe8, 0x5d, 0x07, 0x9f, 0x22, 0x37, 0xf4, 0x07, 0x43,
+	0x3f, 0x8c, 0x86, 0xc1, 0xe4, 0x32, 0xba, 0x9e, 0xcc, 0xa6, 0xbe, 0x17, 0xbc, 0x0b, 0xfc, 0x4b,
+	0xf3, 0x16, 0x7a, 0x08, 0xf8, 0xa0, 0x35, 0x9e, 0xbd, 0x37, 0x0d, 0xf4, 0x14, 0x1e, 0x1d, 0xa4,
+	0xfe, 0xe7, 0x71, 0xe4, 0x0d, 0x46, 0x23, 0xb3, 0x81, 0x4e, 0xe1, 0xc9, 0x41, 0x65, 0x1a, 0xfa,
+	0xde, 0xc7, 0xf1, 0x34, 0x18, 0xf9, 0x66, 0xd3, 0xb5, 0xbf, 0x9d, 0x25, 0x5c, 0xa7, 0xab, 0xb9,
+	0x1d, 0x8b, 0xa5, 0xf3, 0x81, 0x93, 0x94, 0x88, 0xc1, 0x62, 0xbe, 0x52, 0xce, 0xd7, 0xc9, 0x17,
+	0x27, 0x4e, 0x09, 0xcf, 0x9c, 0xea, 0x02, 0x75, 0x91, 0x33, 0x35, 0x6f, 0x95, 0x67, 0xf5, 0xfa,
+	0xf7, 0x00, 0xc9, 0xf3, 0x7c, 0x77, 0x99, 0x02, 0x00, 0x00,
+}
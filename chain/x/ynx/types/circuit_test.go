@@ -0,0 +1,93 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestNormalizeCircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		kind             CircuitBreakerKind
+		target, selector string
+		wantTarget       string
+		wantSelector     string
+		wantErr          bool
+	}{
+		{kind: CircuitBreakerKind_CIRCUIT_BREAKER_KIND_MSG, target: "/cosmos.bank.v1beta1.MsgSend", wantTarget: "/cosmos.bank.v1beta1.MsgSend"},
+		{kind: CircuitBreakerKind_CIRCUIT_BREAKER_KIND_MSG, target: "cosmos.bank.v1beta1.MsgSend", wantErr: true},
+		{kind: CircuitBreakerKind_CIRCUIT_BREAKER_KIND_MSG, target: "/cosmos.bank.v1beta1.MsgSend", selector: "0xa9059cbb", wantErr: true},
+		{
+			kind:         CircuitBreakerKind_CIRCUIT_BREAKER_KIND_EVM_CALL,
+			target:       "0xd4949664cd82660aae99bedc034a0dea8a0bd517",
+			selector:     "0xA9059CBB",
+			wantTarget:   "0xD4949664cD82660AaE99bEdc034a0deA8A0bd517",
+			wantSelector: "0xa9059cbb",
+		},
+		{kind: CircuitBreakerKind_CIRCUIT_BREAKER_KIND_EVM_CALL, target: "0xd4949664cd82660aae99bedc034a0dea8a0bd517", selector: "0xa9059c", wantErr: true},
+		{kind: CircuitBreakerKind_CIRCUIT_BREAKER_KIND_EVM_CALL, target: "0x0000000000000000000000000000000000000000", wantErr: true},
+		{
+			kind:       CircuitBreakerKind_CIRCUIT_BREAKER_KIND_PRECOMPILE,
+			target:     "0x0000000000000000000000000000000000000813",
+			wantTarget: "0x0000000000000000000000000000000000000813",
+		},
+		{kind: CircuitBreakerKind_CIRCUIT_BREAKER_KIND_PRECOMPILE, target: "0x0000000000000000000000000000000000000813", selector: "0xa9059cbb", wantErr: true},
+		{kind: CircuitBreakerKind_CIRCUIT_BREAKER_KIND_UNSPECIFIED, target: "/cosmos.bank.v1beta1.MsgSend", wantErr: true},
+	} {
+		target, selector, err := NormalizeCircuitBreaker(tc.kind, tc.target, tc.selector)
+		if tc.wantErr {
+			if err == nil {
+				t.Fatalf("%s %s %s: expected an error", tc.kind, tc.target, tc.selector)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s %s %s: %v", tc.kind, tc.target, tc.selector, err)
+		}
+		if target != tc.wantTarget || selector != tc.wantSelector {
+			t.Fatalf("%s %s %s: got %s %s", tc.kind, tc.target, tc.selector, target, selector)
+		}
+	}
+}
+
+func TestValidateCircuitBreakersOriginalCodeHash(t *testing.T) {
+	t.Parallel()
+
+	hash := "0x" + strings.Repeat("ab", 32)
+	breaker := func(kind CircuitBreakerKind, selector, originalCodeHash string) CircuitBreaker {
+		target := "0xD4949664cD82660AaE99bEdc034a0deA8A0bd517"
+		if kind == CircuitBreakerKind_CIRCUIT_BREAKER_KIND_PRECOMPILE {
+			target = "0x0000000000000000000000000000000000000813"
+		}
+		return CircuitBreaker{
+			Kind:             kind,
+			Target:           target,
+			Selector:         selector,
+			TrippedBy:        sdk.AccAddress(make([]byte, 20)).String(),
+			TrippedHeight:    1,
+			ExpiryHeight:     2,
+			OriginalCodeHash: originalCodeHash,
+		}
+	}
+	call := CircuitBreakerKind_CIRCUIT_BREAKER_KIND_EVM_CALL
+
+	for _, tc := range []struct {
+		name     string
+		breakers []CircuitBreaker
+		wantErr  bool
+	}{
+		{"recorded", []CircuitBreaker{breaker(call, "", hash), breaker(call, "0xa9059cbb", hash)}, false},
+		{"not recorded", []CircuitBreaker{breaker(call, "", "")}, false},
+		{"malformed", []CircuitBreaker{breaker(call, "", hash[:10])}, true},
+		{"not an EVM call", []CircuitBreaker{breaker(CircuitBreakerKind_CIRCUIT_BREAKER_KIND_PRECOMPILE, "", hash)}, true},
+		{"conflicting", []CircuitBreaker{breaker(call, "", hash), breaker(call, "0xa9059cbb", "0x"+strings.Repeat("cd", 32))}, true},
+	} {
+		err := validateCircuitBreakers(tc.breakers)
+		if tc.wantErr != (err != nil) {
+			t.Fatalf("%s: got error %v", tc.name, err)
+		}
+	}
+}
@@ -11,12 +11,38 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(Params{}, "ynx/x/ynx/Params", nil)
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "ynx/x/ynx/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelPendingParams{}, "ynx/x/ynx/MsgCancelPendingParams")
+	legacy.RegisterAminoMsg(cdc, &MsgRegisterContractRevenue{}, "ynx/x/ynx/MsgRegisterContractRevenue")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateContractRevenue{}, "ynx/x/ynx/MsgUpdateContractRevenue")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelContractRevenue{}, "ynx/x/ynx/MsgCancelContractRevenue")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateSponsorship{}, "ynx/x/ynx/MsgCreateSponsorship")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateSponsorship{}, "ynx/x/ynx/MsgUpdateSponsorship")
+	legacy.RegisterAminoMsg(cdc, &MsgFundSponsorship{}, "ynx/x/ynx/MsgFundSponsorship")
+	legacy.RegisterAminoMsg(cdc, &MsgCloseSponsorship{}, "ynx/x/ynx/MsgCloseSponsorship")
+	legacy.RegisterAminoMsg(cdc, &MsgDeploySystemContract{}, "ynx/x/ynx/MsgDeploySystemContract")
+	legacy.RegisterAminoMsg(cdc, &MsgSetSystemContract{}, "ynx/x/ynx/MsgSetSystemContract")
+	legacy.RegisterAminoMsg(cdc, &MsgTripCircuitBreaker{}, "ynx/x/ynx/MsgTripCircuitBreaker")
+	legacy.RegisterAminoMsg(cdc, &MsgResetCircuitBreaker{}, "ynx/x/ynx/MsgResetCircuitBreaker")
+	legacy.RegisterAminoMsg(cdc, &MsgSetPreconfirmSigners{}, "ynx/x/ynx/MsgSetPreconfirmSigners")
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgCancelPendingParams{},
+		&MsgRegisterContractRevenue{},
+		&MsgUpdateContractRevenue{},
+		&MsgCancelContractRevenue{},
+		&MsgCreateSponsorship{},
+		&MsgUpdateSponsorship{},
+		&MsgFundSponsorship{},
+		&MsgCloseSponsorship{},
+		&MsgDeploySystemContract{},
+		&MsgSetSystemContract{},
+		&MsgTripCircuitBreaker{},
+		&MsgResetCircuitBreaker{},
+		&MsgSetPreconfirmSigners{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
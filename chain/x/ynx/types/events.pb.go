@@ -0,0 +1,1559 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ynx/ynx/v1/events.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventFeeSplit is emitted every time a transaction fee is split.
+type EventFeeSplit struct {
+	Denom      string                `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Epoch      uint64                `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Total      cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=total,proto3,customtype=cosmossdk.io/math.Int" json:"total"`
+	Burned     cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=burned,proto3,customtype=cosmossdk.io/math.Int" json:"burned"`
+	Treasury   cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=treasury,proto3,customtype=cosmossdk.io/math.Int" json:"treasury"`
+	Founder    cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=founder,proto3,customtype=cosmossdk.io/math.Int" json:"founder"`
+	Validators cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=validators,proto3,customtype=cosmossdk.io/math.Int" json:"validators"`
+	// developer is the rebate sent to the withdraw address of the called contract.
+	Developer cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=developer,proto3,customtype=cosmossdk.io/math.Int" json:"developer"`
+	// contract is the 0x-prefixed address of the contract that earned the developer rebate.
+	Contract             string   `protobuf:"bytes,9,opt,name=contract,proto3" json:"contract,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EventFeeSplit) Reset()         { *m = EventFeeSplit{} }
+func (m *EventFeeSplit) String() string { return proto.CompactTextString(m) }
+func (*EventFeeSplit) ProtoMessage()    {}
+func (*EventFeeSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d58137fae98ba916, []int{0}
+}
+func (m *EventFeeSplit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventFeeSplit.Unmarshal(m, b)
+}
+func (m *EventFeeSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventFeeSplit.Marshal(b, m, deterministic)
+}
+func (m *EventFeeSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFeeSplit.Merge(m, src)
+}
+func (m *EventFeeSplit) XXX_Size() int {
+	return xxx_messageInfo_EventFeeSplit.Size(m)
+}
+func (m *EventFeeSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFeeSplit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFeeSplit proto.InternalMessageInfo
+
+func (m *EventFeeSplit) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventFeeSplit) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *EventFeeSplit) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+// EventInflationSplit is emitted every block in which minted inflation is split.
+type EventInflationSplit struct {
+	Denom      string                `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Epoch      uint64                `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Minted     cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=minted,proto3,customtype=cosmossdk.io/math.Int" json:"minted"`
+	Treasury   cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=treasury,proto3,customtype=cosmossdk.io/math.Int" json:"treasury"`
+	Validators cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=validators,proto3,customtype=cosmossdk.io/math.Int" json:"validators"`
+	// recipients are the shares sent to inflation_recipients, by recipient name.
+	Recipients           []InflationRecipientShare `protobuf:"bytes,6,rep,name=recipients,proto3" json:"recipients"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *EventInflationSplit) Reset()         { *m = EventInflationSplit{} }
+func (m *EventInflationSplit) String() string { return proto.CompactTextString(m) }
+func (*EventInflationSplit) ProtoMessage()    {}
+func (*EventInflationSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d58137fae98ba916, []int{1}
+}
+func (m *EventInflationSplit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventInflationSplit.Unmarshal(m, b)
+}
+func (m *EventInflationSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventInflationSplit.Marshal(b, m, deterministic)
+}
+func (m *EventInflationSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventInflationSplit.Merge(m, src)
+}
+func (m *EventInflationSplit) XXX_Size() int {
+	return xxx_messageInfo_EventInflationSplit.Size(m)
+}
+func (m *EventInflationSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventInflationSplit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventInflationSplit proto.InternalMessageInfo
+
+func (m *EventInflationSplit) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventInflationSplit) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *EventInflationSplit) GetRecipients() []InflationRecipientShare {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
+// InflationRecipientShare is the amount of minted inflation sent to a single inflation recipient.
+type InflationRecipientShare struct {
+	Name                 string                `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Amount               cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *InflationRecipientShare) Reset()         { *m = InflationRecipientShare{} }
+func (m *InflationRecipientShare) String() string { return proto.CompactTextString(m) }
+func (*InflationRecipientShare) ProtoMessage()    {}
+func (*InflationRecipientShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d58137fae98ba916, []int{2}
+}
+func (m *InflationRecipientShare) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InflationRecipientShare.Unmarshal(m, b)
+}
+func (m *InflationRecipientShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InflationRecipientShare.Marshal(b, m, deterministic)
+}
+func (m *InflationRecipientShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InflationRecipientShare.Merge(m, src)
+}
+func (m *InflationRecipientShare) XXX_Size() int {
+	return xxx_messageInfo_InflationRecipientShare.Size(m)
+}
+func (m *InflationRecipientShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_InflationRecipientShare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InflationRecipientShare proto.InternalMessageInfo
+
+func (m *InflationRecipientShare) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// EventParamsScheduled is emitted when a params change is scheduled.
+type EventParamsScheduled struct {
+	ActivationHeight     int64    `protobuf:"varint,1,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	Authority            string   `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EventParamsScheduled) Reset()         { *m = EventParamsScheduled{} }
+func (m *EventParamsScheduled) String() string { return proto.CompactTextString(m) }
+func (*EventParamsScheduled) ProtoMessage()    {}
+func (*EventParamsScheduled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d58137fae98ba916, []int{3}
+}
+func (m *EventParamsScheduled) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventParamsScheduled.Unmarshal(m, b)
+}
+func (m *EventParamsScheduled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventParamsScheduled.Marshal(b, m, deterministic)
+}
+func (m *EventParamsScheduled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventParamsScheduled.Merge(m, src)
+}
+func (m *EventParamsScheduled) XXX_Size() int {
+	return xxx_messageInfo_EventParamsScheduled.Size(m)
+}
+func (m *EventParamsScheduled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventParamsScheduled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventParamsScheduled proto.InternalMessageInfo
+
+func (m *EventParamsScheduled) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+func (m *EventParamsScheduled) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// EventParamsCancelled is emitted when a scheduled params change is cancelled.
+type EventParamsCancelled struct {
+	ActivationHeight     int64    `protobuf:"varint,1,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	Authority            string   `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EventParamsCancelled) Reset()         { *m = EventParamsCancelled{} }
+func (m *EventParamsCancelled) String() string { return proto.CompactTextString(m) }
+func (*EventParamsCancelled) ProtoMessage()    {}
+func (*EventParamsCancelled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d58137fae98ba916, []int{4}
+}
+func (m *EventParamsCancelled) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventParamsCancelled.Unmarshal(m, b)
+}
+func (m *EventParamsCancelled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventParamsCancelled.Marshal(b, m, deterministic)
+}
+func (m *EventParamsCancelled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventParamsCancelled.Merge(m, src)
+}
+func (m *EventParamsCancelled) XXX_Size() int {
+	return xxx_messageInfo_EventParamsCancelled.Size(m)
+}
+func (m *EventParamsCancelled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventParamsCancelled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventParamsCancelled proto.InternalMessageInfo
+
+func (m *EventParamsCancelled) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+func (m *EventParamsCancelled) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// EventParamsActivated is emitted when a scheduled params change takes effect.
+type EventParamsActivated struct {
+	ActivationHeight     int64    `protobuf:"varint,1,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	Authority            string   `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EventParamsActivated) Reset()         { *m = EventParamsActivated{} }
+func (m *EventParamsActivated) String() string { return proto.CompactTextString(m) }
+func (*EventParamsActivated) ProtoMessage()    {}
+func (*EventParamsActivated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d58137fae98ba916, []int{5}
+}
+func (m *EventParamsActivated) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventParamsActivated.Unmarshal(m, b)
+}
+func (m *EventParamsActivated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventParamsActivated.Marshal(b, m, deterministic)
+}
+func (m *EventParamsActivated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventParamsActivated.Merge(m, src)
+}
+func (m *EventParamsActivated) XXX_Size() int {
+	return xxx_messageInfo_EventParamsActivated.Size(m)
+}
+func (m *EventParamsActivated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventParamsActivated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventParamsActivated proto.InternalMessageInfo
+
+func (m *EventParamsActivated) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+func (m *EventParamsActivated) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// EventParamsActivationFailed is emitted when a scheduled params change is dropped at its
+// activation height because the params it yields are invalid.
+type EventParamsActivationFailed struct {
+	ActivationHeight     int64    `protobuf:"varint,1,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	Authority            string   `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EventParamsActivationFailed) Reset()         { *m = EventParamsActivationFailed{} }
+func (m *EventParamsActivationFailed) String() string { return proto.CompactTextString(m) }
+func (*EventParamsActivationFailed) ProtoMessage()    {}
+func (*EventParamsActivationFailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d58137fae98ba916, []int{6}
+}
+func (m *EventParamsActivationFailed) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventParamsActivationFailed.Unmarshal(m, b)
+}
+func (m *EventParamsActivationFailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventParamsActivationFailed.Marshal(b, m, deterministic)
+}
+func (m *EventParamsActivationFailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventParamsActivationFailed.Merge(m, src)
+}
+func (m *EventParamsActivationFailed) XXX_Size() int {
+	return xxx_messageInfo_EventParamsActivationFailed.Size(m)
+}
+func (m *EventParamsActivationFailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventParamsActivationFailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventParamsActivationFailed proto.InternalMessageInfo
+
+func (m *EventParamsActivationFailed) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+func (m *EventParamsActivationFailed) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *EventParamsActivationFailed) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// EventFeeSharesSettled is emitted when accrued protocol fee shares are settled.
+type EventFeeSharesSettled struct {
+	Burned               []types.Coin `protobuf:"bytes,1,rep,name=burned,proto3" json:"burned"`
+	Payouts              []FeePayout  `protobuf:"bytes,2,rep,name=payouts,proto3" json:"payouts"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *EventFeeSharesSettled) Reset()         { *m = EventFeeSharesSettled{} }
+func (m *EventFeeSharesSettled) String() string { return proto.CompactTextString(m) }
+func (*EventFeeSharesSettled) ProtoMessage()    {}
+func (*EventFeeSharesSettled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d58137fae98ba916, []int{7}
+}
+func (m *EventFeeSharesSettled) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventFeeSharesSettled.Unmarshal(m, b)
+}
+func (m *EventFeeSharesSettled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventFeeSharesSettled.Marshal(b, m, deterministic)
+}
+func (m *EventFeeSharesSettled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFeeSharesSettled.Merge(m, src)
+}
+func (m *EventFeeSharesSettled) XXX_Size() int {
+	return xxx_messageInfo_EventFeeSharesSettled.Size(m)
+}
+func (m *EventFeeSharesSettled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFeeSharesSettled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFeeSharesSettled proto.InternalMessageInfo
+
+func (m *EventFeeSharesSettled) GetBurned() []types.Coin {
+	if m != nil {
+		return m.Burned
+	}
+	return nil
+}
+
+func (m *EventFeeSharesSettled) GetPayouts() []FeePayout {
+	if m != nil {
+		return m.Payouts
+	}
+	return nil
+}
+
+// FeePayout is the amount paid to a single recipient in a fee settlement.
+type FeePayout struct {
+	Recipient            string       `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount               []types.Coin `protobuf:"bytes,2,rep,name=amount,proto3" json:"amount"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *FeePayout) Reset()         { *m = FeePayout{} }
+func (m *FeePayout) String() string { return proto.CompactTextString(m) }
+func (*FeePayout) ProtoMessage()    {}
+func (*FeePayout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d58137fae98ba916, []int{8}
+}
+func (m *FeePayout) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FeePayout.Unmarshal(m, b)
+}
+func (m *FeePayout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FeePayout.Marshal(b, m, deterministic)
+}
+func (m *FeePayout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeePayout.Merge(m, src)
+}
+func (m *FeePayout) XXX_Size() int {
+	return xxx_messageInfo_FeePayout.Size(m)
+}
+func (m *FeePayout) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeePayout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeePayout proto.InternalMessageInfo
+
+func (m *FeePayout) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *FeePayout) GetAmount() []types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// EventFeePayoutFailed is emitted when a fee settlement cannot burn or pay out the accrued shares
+// of a recipient. The shares stay accrued and are retried at the next settlement.
+type EventFeePayoutFailed struct {
+	// recipient is empty for the shares to burn.
+	Recipient            string       `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount               []types.Coin `protobuf:"bytes,2,rep,name=amount,proto3" json:"amount"`
+	Reason               string       `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *EventFeePayoutFailed) Reset()         { *m = EventFeePayoutFailed{} }
+func (m *EventFeePayoutFailed) String() string { return proto.CompactTextString(m) }
+func (*EventFeePayoutFailed) ProtoMessage()    {}
+func (*EventFeePayoutFailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d58137fae98ba916, []int{9}
+}
+func (m *EventFeePayoutFailed) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventFeePayoutFailed.Unmarshal(m, b)
+}
+func (m *EventFeePayoutFailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventFeePayoutFailed.Marshal(b, m, deterministic)
+}
+func (m *EventFeePayoutFailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFeePayoutFailed.Merge(m, src)
+}
+func (m *EventFeePayoutFailed) XXX_Size() int {
+	return xxx_messageInfo_EventFeePayoutFailed.Size(m)
+}
+func (m *EventFeePayoutFailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFeePayoutFailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFeePayoutFailed proto.InternalMessageInfo
+
+func (m *EventFeePayoutFailed) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *EventFeePayoutFailed) GetAmount() []types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *EventFeePayoutFailed) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// EventContractRevenueRegistered is emitted when a contract is registered for developer fee
+// rebates.
+type EventContractRevenueRegistered struct {
+	ContractAddress      string   `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	DeployerAddress      string   `protobuf:"bytes,2,opt,name=deployer_address,json=deployerAddress,proto3" json:"deployer_address,omitempty"`
+	WithdrawAddress      string   `protobuf:"bytes,3,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EventContractRevenueRegistered) Reset()         { *m = EventContractRevenueRegistered{} }
+func (m *EventContractRevenueRegistered) String() string { return proto.CompactTextString(m) }
+func (*EventContractRevenueRegistered) ProtoMessage()    {}
+func (*EventContractRevenueRegistered) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d58137fae98ba916, []int{10}
+}
+func (m *EventContractRevenueRegistered) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventContractRevenueRegistered.Unmarshal(m, b)
+}
+func (m *EventContractRevenueRegistered) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventContractRevenueRegistered.Marshal(b, m, deterministic)
+}
+func (m *EventContractRevenueRegistered) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventContractRevenueRegistered.Merge(m, src)
+}
+func (m *EventContractRevenueRegistered) XXX_Size() int {
+	return xxx_messageInfo_EventContractRevenueRegistered.Size(m)
+}
+func (m *EventContractRevenueRegistered) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventContractRevenueRegistered.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventContractRevenueRegistered proto.InternalMessageInfo
+
+func (m *EventContractRevenueRegistered) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *EventContractRevenueRegistered) GetDeployerAddress() string {
+	if m != nil {
+		return m.DeployerAddress
+	}
+	return ""
+}
+
+func (m *EventContractRevenueRegistered) GetWithdrawAddress() string {
+	if m != nil {
+		return m.WithdrawAddress
+	}
+	return ""
+}
+
+// EventContractRevenueUpdated is emitted when the withdraw address of a contract changes.
+type EventContractRevenueUpdated struct {
+	ContractAddress      string   `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	DeployerAddress      string   `protobuf:"bytes,2,opt,name=deployer_address,json=deployerAddress,proto3" json:"deployer_address,omitempty"`
+	WithdrawAddress      string   `protobuf:"bytes,3,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EventContractRevenueUpdated) Reset()         { *m = EventContractRevenueUpdated{} }
+func (m *EventContractRevenueUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventContractRevenueUpdated) ProtoMessage()    {}
+func (*EventContractRevenueUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d58137fae98ba916, []int{11}
+}
+func (m *EventContractRevenueUpdated) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventContractRevenueUpdated.Unmarshal(m, b)
+}
+func (m *EventContractRevenueUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventContractRevenueUpdated.Marshal(b, m, deterministic)
+}
+func (m *EventContractRevenueUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventContractRevenueUpdated.Merge(m, src)
+}
+func (m *EventContractRevenueUpdated) XXX_Size() int {
+	return xxx_messageInfo_EventContractRevenueUpdated.Size(m)
+}
+func (m *EventContractRevenueUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventContractRevenueUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventContractRevenueUpdated proto.InternalMessageInfo
+
+func (m *EventContractRevenueUpdated) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *EventContractRevenueUpdated) GetDeployerAddress() string {
+	if m != nil {
+		return m.DeployerAddress
+	}
+	return ""
+}
+
+func (m *EventContractRevenueUpdated) GetWithdrawAddress() string {
+	if m != nil {
+		return m.WithdrawAddress
+	}
+	return ""
+}
+
+// EventContractRevenueCancelled is emitted when a contract registration is removed.
+type EventContractRevenueCancelled struct {
+	ContractAddress      string   `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	DeployerAddress      string   `protobuf:"bytes,2,opt,name=deployer_address,json=deployerAddress,proto3" json:"deployer_address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EventContractRevenueCancelled) Reset()         { *m = EventContractRevenueCancelled{} }
+func (m *EventContractRevenueCancelled) String() string { return proto.CompactTextString(m) }
+func (*EventContractRevenueCancelled) ProtoMessage()    {}
+func (*EventContractRevenueCancelled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d58137fae98ba916, []int{12}
+}
+func (m *EventContractRevenueCancelled) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventContractRevenueCancelled.Unmarshal(m, b)
+}
+func (m *EventContractRevenueCancelled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventContractRevenueCancelled.Marshal(b, m, deterministic)
+}
+func (m *EventContractRevenueCancelled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventContractRevenueCancelled.Merge(m, src)
+}
+func (m *EventContractRevenueCancelled) XXX_Size() int {
+	return xxx_messageInfo_EventContractRevenueCancelled.Size(m)
+}
+func (m *EventContractRevenueCancelled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventContractRevenueCancelled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventContractRevenueCancelled proto.InternalMessageInfo
+
+func (m *EventContractRevenueCancelled) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *EventContractRevenueCancelled) GetDeployerAddress() string {
+	if m != nil {
+		return m.DeployerAddress
+	}
+	return ""
+}
+
+// EventSponsorshipCreated is emitted when a gas sponsorship is created.
+type EventSponsorshipCreated struct {
+	Id                   uint64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sponsor              string                `protobuf:"bytes,2,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	Budget               cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=budget,proto3,customtype=cosmossdk.io/math.Int" json:"budget"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *EventSponsorshipCreated) Reset()         { *m = EventSponsorshipCreated{} }
+func (m *EventSponsorshipCreated) String() string { return proto.CompactTextString(m) }
+func (*EventSponsorshipCreated) ProtoMessage()    {}
+func (*EventSponsorshipCreated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d58137fae98ba916, []int{13}
+}
+func (m *EventSponsorshipCreated) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventSponsorshipCreated.Unmarshal(m, b)
+}
+func (m *EventSponsorshipCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventSponsorshipCreated.Marshal(b, m, deterministic)
+}
+func (m *EventSponsorshipCreated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSponsorshipCreated.Merge(m, src)
+}
+func (m *EventSponsorshipCreated) XXX_Size() int {
+	return xxx_messageInfo_EventSponsorshipCreated.Size(m)
+}
+func (m *EventSponsorshipCreated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSponsorshipCreated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSponsorshipCreated proto.InternalMessageInfo
+
+func (m *EventSponsorshipCreated) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EventSponsorshipCreated) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+// EventSponsorshipUpdated is emitted when the policy of a gas sponsorship changes.
+type EventSponsorshipUpdated struct {
+	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sponsor              string   `protobuf:"bytes,2,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EventSponsorshipUpdated) Reset()         { *m = EventSponsorshipUpdated{} }
+func (m *EventSponsorshipUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventSponsorshipUpdated) ProtoMessage()    {}
+func (*EventSponsorshipUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d58137fae98ba916, []int{14}
+}
+func (m *EventSponsorshipUpdated) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventSponsorshipUpdated.Unmarshal(m, b)
+}
+func (m *EventSponsorshipUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventSponsorshipUpdated.Marshal(b, m, deterministic)
+}
+func (m *EventSponsorshipUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSponsorshipUpdated.Merge(m, src)
+}
+func (m *EventSponsorshipUpdated) XXX_Size() int {
+	return xxx_messageInfo_EventSponsorshipUpdated.Size(m)
+}
+func (m *EventSponsorshipUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSponsorshipUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSponsorshipUpdated proto.InternalMessageInfo
+
+func (m *EventSponsorshipUpdated) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EventSponsorshipUpdated) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+// EventSponsorshipFunded is emitted when a gas sponsorship budget is topped up.
+type EventSponsorshipFunded struct {
+	Id                   uint64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sponsor              string                `protobuf:"bytes,2,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	Amount               cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *EventSponsorshipFunded) Reset()         { *m = EventSponsorshipFunded{} }
+func (m *EventSponsorshipFunded) String() string { return proto.CompactTextString(m) }
+func (*EventSponsorshipFunded) ProtoMessage()    {}
+func (*EventSponsorshipFunded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d58137fae98ba916, []int{15}
+}
+func (m *EventSponsorshipFunded) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventSponsorshipFunded.Unmarshal(m, b)
+}
+func (m *EventSponsorshipFunded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventSponsorshipFunded.Marshal(b, m, deterministic)
+}
+func (m *EventSponsorshipFunded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSponsorshipFunded.Merge(m, src)
+}
+func (m *EventSponsorshipFunded) XXX_Size() int {
+	return xxx_messageInfo_EventSponsorshipFunded.Size(m)
+}
+func (m *EventSponsorshipFunded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSponsorshipFunded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSponsorshipFunded proto.InternalMessageInfo
+
+func (m *EventSponsorshipFunded) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EventSponsorshipFunded) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+// EventSponsorshipClosed is emitted when a gas sponsorship is closed and its remaining budget is
+// returned to the sponsor.
+type EventSponsorshipClosed struct {
+	Id                   uint64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sponsor              string                `protobuf:"bytes,2,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	Refund               cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=refund,proto3,customtype=cosmossdk.io/math.Int" json:"refund"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *EventSponsorshipClosed) Reset()         { *m = EventSponsorshipClosed{} }
+func (m *EventSponsorshipClosed) String() string { return proto.CompactTextString(m) }
+func (*EventSponsorshipClosed) ProtoMessage()    {}
+func (*EventSponsorshipClosed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d58137fae98ba916, []int{16}
+}
+func (m *EventSponsorshipClosed) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventSponsorshipClosed.Unmarshal(m, b)
+}
+func (m *EventSponsorshipClosed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventSponsorshipClosed.Marshal(b, m, deterministic)
+}
+func (m *EventSponsorshipClosed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSponsorshipClosed.Merge(m, src)
+}
+func (m *EventSponsorshipClosed) XXX_Size() int {
+	return xxx_messageInfo_EventSponsorshipClosed.Size(m)
+}
+func (m *EventSponsorshipClosed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSponsorshipClosed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSponsorshipClosed proto.InternalMessageInfo
+
+func (m *EventSponsorshipClosed) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EventSponsorshipClosed) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+// EventTxSponsored is emitted for every transaction whose fee was paid by a gas sponsorship. The
+// fee is the same amount that EventFeeSplit splits for the transaction.
+type EventTxSponsored struct {
+	SponsorshipId uint64 `protobuf:"varint,1,opt,name=sponsorship_id,json=sponsorshipId,proto3" json:"sponsorship_id,omitempty"`
+	Sponsor       string `protobuf:"bytes,2,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	Sender        string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	// contract is the 0x-prefixed address of the contract called by an EVM transaction. It is empty
+	// for Cosmos transactions.
+	Contract string                `protobuf:"bytes,4,opt,name=contract,proto3" json:"contract,omitempty"`
+	Fee      cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=fee,proto3,customtype=cosmossdk.io/math.Int" json:"fee"`
+	// msg_type_urls are the message type URLs of a Cosmos transaction. They are empty for EVM
+	// transactions.
+	MsgTypeUrls          []string `protobuf:"bytes,6,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EventTxSponsored) Reset()         { *m = EventTxSponsored{} }
+func (m *EventTxSponsored) String() string { return proto.CompactTextString(m) }
+func (*EventTxSponsored) ProtoMessage()    {}
+func (*EventTxSponsored) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d58137fae98ba916, []int{17}
+}
+func (m *EventTxSponsored) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventTxSponsored.Unmarshal(m, b)
+}
+func (m *EventTxSponsored) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventTxSponsored.Marshal(b, m, deterministic)
+}
+func (m *EventTxSponsored) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTxSponsored.Merge(m, src)
+}
+func (m *EventTxSponsored) XXX_Size() int {
+	return xxx_messageInfo_EventTxSponsored.Size(m)
+}
+func (m *EventTxSponsored) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTxSponsored.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTxSponsored proto.InternalMessageInfo
+
+func (m *EventTxSponsored) GetSponsorshipId() uint64 {
+	if m != nil {
+		return m.SponsorshipId
+	}
+	return 0
+}
+
+func (m *EventTxSponsored) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+func (m *EventTxSponsored) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *EventTxSponsored) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *EventTxSponsored) GetMsgTypeUrls() []string {
+	if m != nil {
+		return m.MsgTypeUrls
+	}
+	return nil
+}
+
+// EventSystemContractUpdated is emitted when a system_contracts entry changes.
+type EventSystemContractUpdated struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// old_address is empty when the entry was not set before.
+	OldAddress string `protobuf:"bytes,2,opt,name=old_address,json=oldAddress,proto3" json:"old_address,omitempty"`
+	NewAddress string `protobuf:"bytes,3,opt,name=new_address,json=newAddress,proto3" json:"new_address,omitempty"`
+	// authority is the x/gov authority or the timelock that made the change.
+	Authority string `protobuf:"bytes,4,opt,name=authority,proto3" json:"authority,omitempty"`
+	// artifact is the embedded artifact deployed at new_address. It is empty for supplied bytecode
+	// and for entries pointed at an existing contract.
+	Artifact             string   `protobuf:"bytes,5,opt,name=artifact,proto3" json:"artifact,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EventSystemContractUpdated) Reset()         { *m = EventSystemContractUpdated{} }
+func (m *EventSystemContractUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventSystemContractUpdated) ProtoMessage()    {}
+func (*EventSystemContractUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d58137fae98ba916, []int{18}
+}
+func (m *EventSystemContractUpdated) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventSystemContractUpdated.Unmarshal(m, b)
+}
+func (m *EventSystemContractUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventSystemContractUpdated.Marshal(b, m, deterministic)
+}
+func (m *EventSystemContractUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSystemContractUpdated.Merge(m, src)
+}
+func (m *EventSystemContractUpdated) XXX_Size() int {
+	return xxx_messageInfo_EventSystemContractUpdated.Size(m)
+}
+func (m *EventSystemContractUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSystemContractUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSystemContractUpdated proto.InternalMessageInfo
+
+func (m *EventSystemContractUpdated) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EventSystemContractUpdated) GetOldAddress() string {
+	if m != nil {
+		return m.OldAddress
+	}
+	return ""
+}
+
+func (m *EventSystemContractUpdated) GetNewAddress() string {
+	if m != nil {
+		return m.NewAddress
+	}
+	return ""
+}
+
+func (m *EventSystemContractUpdated) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *EventSystemContractUpdated) GetArtifact() string {
+	if m != nil {
+		return m.Artifact
+	}
+	return ""
+}
+
+// EventVotesLocked is emitted when native NYXT is locked for voting.
+type EventVotesLocked struct {
+	Account              string                `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Amount               cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *EventVotesLocked) Reset()         { *m = EventVotesLocked{} }
+func (m *EventVotesLocked) String() string { return proto.CompactTextString(m) }
+func (*EventVotesLocked) ProtoMessage()    {}
+func (*EventVotesLocked) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d58137fae98ba916, []int{19}
+}
+func (m *EventVotesLocked) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventVotesLocked.Unmarshal(m, b)
+}
+func (m *EventVotesLocked) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventVotesLocked.Marshal(b, m, deterministic)
+}
+func (m *EventVotesLocked) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventVotesLocked.Merge(m, src)
+}
+func (m *EventVotesLocked) XXX_Size() int {
+	return xxx_messageInfo_EventVotesLocked.Size(m)
+}
+func (m *EventVotesLocked) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventVotesLocked.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventVotesLocked proto.InternalMessageInfo
+
+func (m *EventVotesLocked) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+// EventVotesUnlocked is emitted when locked native NYXT is returned to its account.
+type EventVotesUnlocked struct {
+	Account              string                `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Amount               cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *EventVotesUnlocked) Reset()         { *m = EventVotesUnlocked{} }
+func (m *EventVotesUnlocked) String() string { return proto.CompactTextString(m) }
+func (*EventVotesUnlocked) ProtoMessage()    {}
+func (*EventVotesUnlocked) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d58137fae98ba916, []int{20}
+}
+func (m *EventVotesUnlocked) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventVotesUnlocked.Unmarshal(m, b)
+}
+func (m *EventVotesUnlocked) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventVotesUnlocked.Marshal(b, m, deterministic)
+}
+func (m *EventVotesUnlocked) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventVotesUnlocked.Merge(m, src)
+}
+func (m *EventVotesUnlocked) XXX_Size() int {
+	return xxx_messageInfo_EventVotesUnlocked.Size(m)
+}
+func (m *EventVotesUnlocked) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventVotesUnlocked.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventVotesUnlocked proto.InternalMessageInfo
+
+func (m *EventVotesUnlocked) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+// EventVotesDelegated is emitted when an account changes the delegatee of its votes.
+type EventVotesDelegated struct {
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// old_delegatee and new_delegatee are empty when the votes are not delegated.
+	OldDelegatee         string   `protobuf:"bytes,2,opt,name=old_delegatee,json=oldDelegatee,proto3" json:"old_delegatee,omitempty"`
+	NewDelegatee         string   `protobuf:"bytes,3,opt,name=new_delegatee,json=newDelegatee,proto3" json:"new_delegatee,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EventVotesDelegated) Reset()         { *m = EventVotesDelegated{} }
+func (m *EventVotesDelegated) String() string { return proto.CompactTextString(m) }
+func (*EventVotesDelegated) ProtoMessage()    {}
+func (*EventVotesDelegated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d58137fae98ba916, []int{21}
+}
+func (m *EventVotesDelegated) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventVotesDelegated.Unmarshal(m, b)
+}
+func (m *EventVotesDelegated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventVotesDelegated.Marshal(b, m, deterministic)
+}
+func (m *EventVotesDelegated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventVotesDelegated.Merge(m, src)
+}
+func (m *EventVotesDelegated) XXX_Size() int {
+	return xxx_messageInfo_EventVotesDelegated.Size(m)
+}
+func (m *EventVotesDelegated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventVotesDelegated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventVotesDelegated proto.InternalMessageInfo
+
+func (m *EventVotesDelegated) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *EventVotesDelegated) GetOldDelegatee() string {
+	if m != nil {
+		return m.OldDelegatee
+	}
+	return ""
+}
+
+func (m *EventVotesDelegated) GetNewDelegatee() string {
+	if m != nil {
+		return m.NewDelegatee
+	}
+	return ""
+}
+
+// EventLegacyNYXTRedeemed is emitted when standalone NYXT ERC20 tokens sent to the redemption
+// address are redeemed for native NYXT.
+type EventLegacyNYXTRedeemed struct {
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// contract is the 0x-prefixed address of the standalone NYXT ERC20.
+	Contract string                `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	Amount   cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// tx_hash is the 0x-prefixed hash of the EVM transaction that sent the tokens.
+	TxHash               string   `protobuf:"bytes,4,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EventLegacyNYXTRedeemed) Reset()         { *m = EventLegacyNYXTRedeemed{} }
+func (m *EventLegacyNYXTRedeemed) String() string { return proto.CompactTextString(m) }
+func (*EventLegacyNYXTRedeemed) ProtoMessage()    {}
+func (*EventLegacyNYXTRedeemed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d58137fae98ba916, []int{22}
+}
+func (m *EventLegacyNYXTRedeemed) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventLegacyNYXTRedeemed.Unmarshal(m, b)
+}
+func (m *EventLegacyNYXTRedeemed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventLegacyNYXTRedeemed.Marshal(b, m, deterministic)
+}
+func (m *EventLegacyNYXTRedeemed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventLegacyNYXTRedeemed.Merge(m, src)
+}
+func (m *EventLegacyNYXTRedeemed) XXX_Size() int {
+	return xxx_messageInfo_EventLegacyNYXTRedeemed.Size(m)
+}
+func (m *EventLegacyNYXTRedeemed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventLegacyNYXTRedeemed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventLegacyNYXTRedeemed proto.InternalMessageInfo
+
+func (m *EventLegacyNYXTRedeemed) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *EventLegacyNYXTRedeemed) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *EventLegacyNYXTRedeemed) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+// EventStakeVotesDelegated is emitted when a delegator overrides the validators its stake-weighted
+// votes go to, or resets the override. An empty delegatee stands for the validators.
+type EventStakeVotesDelegated struct {
+	Delegator            string   `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	OldDelegatee         string   `protobuf:"bytes,2,opt,name=old_delegatee,json=oldDelegatee,proto3" json:"old_delegatee,omitempty"`
+	NewDelegatee         string   `protobuf:"bytes,3,opt,name=new_delegatee,json=newDelegatee,proto3" json:"new_delegatee,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EventStakeVotesDelegated) Reset()         { *m = EventStakeVotesDelegated{} }
+func (m *EventStakeVotesDelegated) String() string { return proto.CompactTextString(m) }
+func (*EventStakeVotesDelegated) ProtoMessage()    {}
+func (*EventStakeVotesDelegated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d58137fae98ba916, []int{23}
+}
+func (m *EventStakeVotesDelegated) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventStakeVotesDelegated.Unmarshal(m, b)
+}
+func (m *EventStakeVotesDelegated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventStakeVotesDelegated.Marshal(b, m, deterministic)
+}
+func (m *EventStakeVotesDelegated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventStakeVotesDelegated.Merge(m, src)
+}
+func (m *EventStakeVotesDelegated) XXX_Size() int {
+	return xxx_messageInfo_EventStakeVotesDelegated.Size(m)
+}
+func (m *EventStakeVotesDelegated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventStakeVotesDelegated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventStakeVotesDelegated proto.InternalMessageInfo
+
+func (m *EventStakeVotesDelegated) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *EventStakeVotesDelegated) GetOldDelegatee() string {
+	if m != nil {
+		return m.OldDelegatee
+	}
+	return ""
+}
+
+func (m *EventStakeVotesDelegated) GetNewDelegatee() string {
+	if m != nil {
+		return m.NewDelegatee
+	}
+	return ""
+}
+
+// EventCircuitBreakerTripped is emitted when the circuit guardian trips a circuit breaker.
+type EventCircuitBreakerTripped struct {
+	CircuitBreaker       CircuitBreaker `protobuf:"bytes,1,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *EventCircuitBreakerTripped) Reset()         { *m = EventCircuitBreakerTripped{} }
+func (m *EventCircuitBreakerTripped) String() string { return proto.CompactTextString(m) }
+func (*EventCircuitBreakerTripped) ProtoMessage()    {}
+func (*EventCircuitBreakerTripped) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d58137fae98ba916, []int{24}
+}
+func (m *EventCircuitBreakerTripped) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventCircuitBreakerTripped.Unmarshal(m, b)
+}
+func (m *EventCircuitBreakerTripped) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventCircuitBreakerTripped.Marshal(b, m, deterministic)
+}
+func (m *EventCircuitBreakerTripped) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCircuitBreakerTripped.Merge(m, src)
+}
+func (m *EventCircuitBreakerTripped) XXX_Size() int {
+	return xxx_messageInfo_EventCircuitBreakerTripped.Size(m)
+}
+func (m *EventCircuitBreakerTripped) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCircuitBreakerTripped.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCircuitBreakerTripped proto.InternalMessageInfo
+
+func (m *EventCircuitBreakerTripped) GetCircuitBreaker() CircuitBreaker {
+	if m != nil {
+		return m.CircuitBreaker
+	}
+	return CircuitBreaker{}
+}
+
+// EventCircuitBreakerLifted is emitted when a circuit breaker is reset or expires.
+type EventCircuitBreakerLifted struct {
+	Kind     CircuitBreakerKind `protobuf:"varint,1,opt,name=kind,proto3,enum=ynx.ynx.v1.CircuitBreakerKind" json:"kind,omitempty"`
+	Target   string             `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	Selector string             `protobuf:"bytes,3,opt,name=selector,proto3" json:"selector,omitempty"`
+	// reset_by is the authority or timelock that reset the breaker. It is empty when the breaker
+	// expired.
+	ResetBy              string   `protobuf:"bytes,4,opt,name=reset_by,json=resetBy,proto3" json:"reset_by,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EventCircuitBreakerLifted) Reset()         { *m = EventCircuitBreakerLifted{} }
+func (m *EventCircuitBreakerLifted) String() string { return proto.CompactTextString(m) }
+func (*EventCircuitBreakerLifted) ProtoMessage()    {}
+func (*EventCircuitBreakerLifted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d58137fae98ba916, []int{25}
+}
+func (m *EventCircuitBreakerLifted) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventCircuitBreakerLifted.Unmarshal(m, b)
+}
+func (m *EventCircuitBreakerLifted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventCircuitBreakerLifted.Marshal(b, m, deterministic)
+}
+func (m *EventCircuitBreakerLifted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCircuitBreakerLifted.Merge(m, src)
+}
+func (m *EventCircuitBreakerLifted) XXX_Size() int {
+	return xxx_messageInfo_EventCircuitBreakerLifted.Size(m)
+}
+func (m *EventCircuitBreakerLifted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCircuitBreakerLifted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCircuitBreakerLifted proto.InternalMessageInfo
+
+func (m *EventCircuitBreakerLifted) GetKind() CircuitBreakerKind {
+	if m != nil {
+		return m.Kind
+	}
+	return CircuitBreakerKind_CIRCUIT_BREAKER_KIND_UNSPECIFIED
+}
+
+func (m *EventCircuitBreakerLifted) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *EventCircuitBreakerLifted) GetSelector() string {
+	if m != nil {
+		return m.Selector
+	}
+	return ""
+}
+
+func (m *EventCircuitBreakerLifted) GetResetBy() string {
+	if m != nil {
+		return m.ResetBy
+	}
+	return ""
+}
+
+// EventPreconfirmSignersSet is emitted when the authority or the timelock registers a preconfirm
+// signer set.
+type EventPreconfirmSignersSet struct {
+	SignerSet            PreconfirmSignerSet `protobuf:"bytes,1,opt,name=signer_set,json=signerSet,proto3" json:"signer_set"`
+	Authority            string              `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *EventPreconfirmSignersSet) Reset()         { *m = EventPreconfirmSignersSet{} }
+func (m *EventPreconfirmSignersSet) String() string { return proto.CompactTextString(m) }
+func (*EventPreconfirmSignersSet) ProtoMessage()    {}
+func (*EventPreconfirmSignersSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d58137fae98ba916, []int{26}
+}
+func (m *EventPreconfirmSignersSet) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventPreconfirmSignersSet.Unmarshal(m, b)
+}
+func (m *EventPreconfirmSignersSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventPreconfirmSignersSet.Marshal(b, m, deterministic)
+}
+func (m *EventPreconfirmSignersSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPreconfirmSignersSet.Merge(m, src)
+}
+func (m *EventPreconfirmSignersSet) XXX_Size() int {
+	return xxx_messageInfo_EventPreconfirmSignersSet.Size(m)
+}
+func (m *EventPreconfirmSignersSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPreconfirmSignersSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPreconfirmSignersSet proto.InternalMessageInfo
+
+func (m *EventPreconfirmSignersSet) GetSignerSet() PreconfirmSignerSet {
+	if m != nil {
+		return m.SignerSet
+	}
+	return PreconfirmSignerSet{}
+}
+
+func (m *EventPreconfirmSignersSet) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*EventFeeSplit)(nil), "ynx.ynx.v1.EventFeeSplit")
+	proto.RegisterType((*EventInflationSplit)(nil), "ynx.ynx.v1.EventInflationSplit")
+	proto.RegisterType((*InflationRecipientShare)(nil), "ynx.ynx.v1.InflationRecipientShare")
+	proto.RegisterType((*EventParamsScheduled)(nil), "ynx.ynx.v1.EventParamsScheduled")
+	proto.RegisterType((*EventParamsCancelled)(nil), "ynx.ynx.v1.EventParamsCancelled")
+	proto.RegisterType((*EventParamsActivated)(nil), "ynx.ynx.v1.EventParamsActivated")
+	proto.RegisterType((*EventParamsActivationFailed)(nil), "ynx.ynx.v1.EventParamsActivationFailed")
+	proto.RegisterType((*EventFeeSharesSettled)(nil), "ynx.ynx.v1.EventFeeSharesSettled")
+	proto.RegisterType((*FeePayout)(nil), "ynx.ynx.v1.FeePayout")
+	proto.RegisterType((*EventFeePayoutFailed)(nil), "ynx.ynx.v1.EventFeePayoutFailed")
+	proto.RegisterType((*EventContractRevenueRegistered)(nil), "ynx.ynx.v1.EventContractRevenueRegistered")
+	proto.RegisterType((*EventContractRevenueUpdated)(nil), "ynx.ynx.v1.EventContractRevenueUpdated")
+	proto.RegisterType((*EventContractRevenueCancelled)(nil), "ynx.ynx.v1.EventContractRevenueCancelled")
+	proto.RegisterType((*EventSponsorshipCreated)(nil), "ynx.ynx.v1.EventSponsorshipCreated")
+	proto.RegisterType((*EventSponsorshipUpdated)(nil), "ynx.ynx.v1.EventSponsorshipUpdated")
+	proto.RegisterType((*EventSponsorshipFunded)(nil), "ynx.ynx.v1.EventSponsorshipFunded")
+	proto.RegisterType((*EventSponsorshipClosed)(nil), "ynx.ynx.v1.EventSponsorshipClosed")
+	proto.RegisterType((*EventTxSponsored)(nil), "ynx.ynx.v1.EventTxSponsored")
+	proto.RegisterType((*EventSystemContractUpdated)(nil), "ynx.ynx.v1.EventSystemContractUpdated")
+	proto.RegisterType((*EventVotesLocked)(nil), "ynx.ynx.v1.EventVotesLocked")
+	proto.RegisterType((*EventVotesUnlocked)(nil), "ynx.ynx.v1.EventVotesUnlocked")
+	proto.RegisterType((*EventVotesDelegated)(nil), "ynx.ynx.v1.EventVotesDelegated")
+	proto.RegisterType((*EventLegacyNYXTRedeemed)(nil), "ynx.ynx.v1.EventLegacyNYXTRedeemed")
+	proto.RegisterType((*EventStakeVotesDelegated)(nil), "ynx.ynx.v1.EventStakeVotesDelegated")
+	proto.RegisterType((*EventCircuitBreakerTripped)(nil), "ynx.ynx.v1.EventCircuitBreakerTripped")
+	proto.RegisterType((*EventCircuitBreakerLifted)(nil), "ynx.ynx.v1.EventCircuitBreakerLifted")
+	proto.RegisterType((*EventPreconfirmSignersSet)(nil), "ynx.ynx.v1.EventPreconfirmSignersSet")
+}
+
+func init() { proto.RegisterFile("ynx/ynx/v1/events.proto", fileDescriptor_d58137fae98ba916) }
+
+var fileDescriptor_d58137fae98ba916 = []byte{
+	// 1325 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x5f, 0x6f, 0x13, 0xc7,
+	0x16, 0xbf, 0x76, 0x1c, 0x27, 0x3e, 0x21, 0x09, 0x77, 0x09, 0x64, 0x13, 0xb8, 0x04, 0x2d, 0xba,
+	0x12, 0x57, 0x5c, 0x6c, 0x25, 0x55, 0xdb, 0xa7, 0x3e, 0x24, 0x86, 0x94, 0x14, 0x84, 0xd0, 0x3a,
+	0x54, 0xd0, 0x17, 0x6b, 0xbc, 0x7b, 0xec, 0x1d, 0x65, 0x3d, 0xb3, 0x9d, 0x99, 0x35, 0xb1, 0x54,
+	0xa9, 0x55, 0xa5, 0x8a, 0x7e, 0x8a, 0x4a, 0x7d, 0x6f, 0xdf, 0xf8, 0x10, 0x7d, 0xe6, 0xb1, 0x0f,
+	0xbc, 0x56, 0xea, 0xa7, 0xa8, 0x66, 0x67, 0x76, 0xfd, 0x87, 0x10, 0xe1, 0x10, 0xaa, 0x3e, 0x58,
+	0xf2, 0x39, 0x73, 0xce, 0x9c, 0xdf, 0x9c, 0xff, 0x36, 0xac, 0x0f, 0xd9, 0x71, 0x43, 0x7f, 0x06,
+	0xdb, 0x0d, 0x1c, 0x20, 0x53, 0xb2, 0x9e, 0x08, 0xae, 0xb8, 0x03, 0x43, 0x76, 0x5c, 0xd7, 0x9f,
+	0xc1, 0xf6, 0xe6, 0xf5, 0x80, 0xcb, 0x3e, 0x97, 0x8d, 0x0e, 0x91, 0xd8, 0x18, 0x6c, 0x77, 0x50,
+	0x91, 0xed, 0x46, 0xc0, 0x29, 0x33, 0xb2, 0x9b, 0x1b, 0xe6, 0xbc, 0x9d, 0x51, 0x0d, 0x43, 0xd8,
+	0xa3, 0xb5, 0x1e, 0xef, 0x71, 0xc3, 0xd7, 0xdf, 0x2c, 0xd7, 0x1d, 0xb3, 0x1a, 0x50, 0x11, 0xa4,
+	0x54, 0xd9, 0x93, 0xab, 0x63, 0x27, 0x89, 0xc0, 0x80, 0xb3, 0x2e, 0x15, 0x7d, 0x73, 0xe8, 0xbd,
+	0xa8, 0xc0, 0xf2, 0x3d, 0x0d, 0x72, 0x1f, 0xb1, 0x95, 0xc4, 0x54, 0x39, 0x6b, 0x30, 0x1f, 0x22,
+	0xe3, 0x7d, 0xb7, 0x74, 0xa3, 0x74, 0xab, 0xe6, 0x1b, 0x42, 0x73, 0x31, 0xe1, 0x41, 0xe4, 0x96,
+	0x6f, 0x94, 0x6e, 0x55, 0x7c, 0x43, 0x38, 0xbb, 0x30, 0xaf, 0xb8, 0x22, 0xb1, 0x3b, 0xa7, 0x65,
+	0xf7, 0x6e, 0xff, 0xf6, 0x7a, 0xeb, 0x5f, 0xbf, 0xbf, 0xde, 0xba, 0x6c, 0xf0, 0xca, 0xf0, 0xa8,
+	0x4e, 0x79, 0xa3, 0x4f, 0x54, 0x54, 0x3f, 0x60, 0xea, 0xd5, 0xcb, 0x3b, 0x60, 0x1f, 0x72, 0xc0,
+	0x94, 0x6f, 0x34, 0x9d, 0x26, 0x54, 0x3b, 0xa9, 0x60, 0x18, 0xba, 0x95, 0xd9, 0xef, 0xb0, 0xaa,
+	0xce, 0xe7, 0xb0, 0xa8, 0x04, 0x12, 0x99, 0x8a, 0xa1, 0x3b, 0x3f, 0xfb, 0x35, 0x85, 0xb2, 0x73,
+	0x0f, 0x16, 0xba, 0x3c, 0x65, 0x21, 0x0a, 0xb7, 0x3a, 0xfb, 0x3d, 0xb9, 0xae, 0xf3, 0x00, 0x60,
+	0x40, 0x62, 0x1a, 0x12, 0xc5, 0x85, 0x74, 0x17, 0x66, 0xbf, 0x69, 0x4c, 0xdd, 0x39, 0x80, 0x5a,
+	0x88, 0x03, 0x8c, 0x79, 0x82, 0xc2, 0x5d, 0x9c, 0xfd, 0xae, 0x91, 0xb6, 0xb3, 0x09, 0x8b, 0x01,
+	0x67, 0x4a, 0x90, 0x40, 0xb9, 0xb5, 0x2c, 0xbc, 0x05, 0xed, 0xfd, 0x51, 0x86, 0x4b, 0x59, 0x26,
+	0x1c, 0xb0, 0x6e, 0x4c, 0x14, 0xe5, 0x6c, 0xf6, 0x7c, 0x68, 0x42, 0xb5, 0x4f, 0x99, 0xc2, 0xf0,
+	0x2c, 0x09, 0x61, 0x55, 0x27, 0x82, 0x59, 0x79, 0x9f, 0x60, 0x4e, 0x46, 0x61, 0xfe, 0x7d, 0xa3,
+	0x00, 0x02, 0x03, 0x9a, 0x50, 0x5d, 0xd0, 0x6e, 0xf5, 0xc6, 0xdc, 0xad, 0xa5, 0x9d, 0x9b, 0xf5,
+	0x51, 0x45, 0xd7, 0x0b, 0xb7, 0xf9, 0xb9, 0x58, 0x2b, 0x22, 0x02, 0xf7, 0x2a, 0xda, 0xa2, 0x3f,
+	0xa6, 0xec, 0x09, 0x58, 0x7f, 0x8b, 0xb0, 0xe3, 0x40, 0x85, 0x91, 0x3e, 0x5a, 0x5f, 0x67, 0xdf,
+	0xb5, 0x53, 0x49, 0x9f, 0xa7, 0x4c, 0xb9, 0xe5, 0xd9, 0x9f, 0x60, 0x55, 0x3d, 0x02, 0x6b, 0x59,
+	0x70, 0x1f, 0x13, 0x41, 0xfa, 0xb2, 0x15, 0x44, 0x18, 0xa6, 0x31, 0x86, 0xce, 0x6d, 0xf8, 0x37,
+	0x09, 0x14, 0x1d, 0x64, 0x60, 0xda, 0x11, 0xd2, 0x5e, 0xa4, 0x32, 0xeb, 0x73, 0xfe, 0xc5, 0xd1,
+	0xc1, 0xfd, 0x8c, 0xef, 0x5c, 0x83, 0x1a, 0x49, 0x55, 0xc4, 0x05, 0x55, 0x43, 0x03, 0xc6, 0x1f,
+	0x31, 0xa6, 0x4c, 0x34, 0x09, 0x0b, 0x30, 0xfe, 0xa0, 0x26, 0x76, 0x8d, 0xf2, 0xf9, 0x9a, 0xf8,
+	0xae, 0x04, 0x57, 0xdf, 0xb4, 0x41, 0x39, 0xdb, 0x27, 0xf4, 0x7c, 0x5f, 0xe3, 0x5c, 0x81, 0xaa,
+	0x4e, 0x55, 0xce, 0x4c, 0xb5, 0xf8, 0x96, 0xf2, 0x5e, 0x94, 0xe0, 0x72, 0xd1, 0x93, 0x75, 0x5a,
+	0xc8, 0x16, 0x2a, 0xa5, 0x8d, 0x7f, 0x5a, 0x34, 0xcb, 0x52, 0x96, 0x80, 0x1b, 0x75, 0x1b, 0x6a,
+	0x3d, 0x46, 0xea, 0x76, 0x8c, 0xd4, 0x9b, 0x9c, 0x32, 0x9b, 0x76, 0x79, 0x83, 0xfc, 0x18, 0x16,
+	0x12, 0x32, 0xe4, 0xa9, 0x92, 0x6e, 0x39, 0xd3, 0xbc, 0x3c, 0x9e, 0xba, 0xfb, 0x88, 0x8f, 0xb3,
+	0x53, 0xab, 0x95, 0xcb, 0x7a, 0xdf, 0x40, 0xad, 0x38, 0x73, 0x3e, 0x81, 0x5a, 0x91, 0xc4, 0x26,
+	0x41, 0xf7, 0xdc, 0x57, 0x2f, 0xef, 0xac, 0x59, 0x08, 0xbb, 0x61, 0x28, 0x50, 0xca, 0x96, 0x12,
+	0x94, 0xf5, 0xfc, 0x91, 0xa8, 0x06, 0x5d, 0xe4, 0xef, 0xbb, 0x81, 0xb6, 0x39, 0xfb, 0x43, 0xc9,
+	0x86, 0xbb, 0xc0, 0x60, 0x63, 0x70, 0xed, 0x0d, 0x24, 0xe7, 0x61, 0xef, 0xad, 0xf1, 0xf8, 0xb9,
+	0x04, 0xd7, 0x33, 0x1c, 0x4d, 0xdb, 0x2b, 0x7d, 0x3d, 0xd6, 0x53, 0xf4, 0xb1, 0x47, 0xa5, 0x42,
+	0x81, 0xa1, 0xf3, 0x3f, 0xb8, 0x98, 0x37, 0xd2, 0x36, 0x31, 0x8e, 0xb0, 0xc0, 0x56, 0x73, 0xbe,
+	0xf5, 0x8f, 0x16, 0x0d, 0x31, 0x89, 0xf9, 0x10, 0x45, 0x21, 0x6a, 0x52, 0x63, 0x35, 0xe7, 0x8f,
+	0x89, 0x3e, 0xa7, 0x2a, 0x0a, 0x05, 0x79, 0x5e, 0x88, 0x1a, 0x68, 0xab, 0x39, 0xdf, 0x8a, 0x7a,
+	0x3f, 0xe5, 0x69, 0x3b, 0x85, 0xf1, 0x49, 0x12, 0x12, 0xf5, 0x4f, 0x00, 0x98, 0xc2, 0x7f, 0x4e,
+	0xc2, 0x37, 0x6a, 0x13, 0x1f, 0x04, 0xa1, 0xf7, 0x63, 0x09, 0xd6, 0x33, 0xbb, 0xad, 0x84, 0x33,
+	0xc9, 0x85, 0x8c, 0x68, 0xd2, 0x14, 0x98, 0xf9, 0x64, 0x05, 0xca, 0x34, 0xcc, 0x6c, 0x54, 0xfc,
+	0x32, 0x0d, 0x1d, 0x17, 0x16, 0xa4, 0x91, 0xb2, 0xb7, 0xe5, 0xa4, 0x59, 0x52, 0xc2, 0x1e, 0xaa,
+	0x33, 0xcd, 0x35, 0xa3, 0xea, 0x35, 0xdf, 0x44, 0x92, 0x47, 0xe7, 0x9d, 0x91, 0xe8, 0xde, 0x70,
+	0x65, 0xfa, 0x96, 0x7d, 0xbd, 0x74, 0xcc, 0xf8, 0x1c, 0x5b, 0x21, 0x73, 0x67, 0x9f, 0x28, 0x27,
+	0x21, 0x69, 0xc6, 0x5c, 0xce, 0x8a, 0x44, 0x60, 0x37, 0x65, 0x67, 0x5b, 0x18, 0x8c, 0xaa, 0xf7,
+	0x67, 0x09, 0x2e, 0x66, 0x48, 0x0e, 0x8f, 0x2d, 0x16, 0x0c, 0x9d, 0xff, 0xc2, 0x8a, 0x1c, 0x01,
+	0x6b, 0x17, 0x78, 0x96, 0xc7, 0xb8, 0x07, 0xa7, 0x41, 0xbb, 0x02, 0x55, 0x89, 0xd9, 0x26, 0x68,
+	0xbb, 0x81, 0xa1, 0x26, 0x76, 0xa8, 0xca, 0xe4, 0x0e, 0xe5, 0x7c, 0x06, 0x73, 0x5d, 0xc4, 0xb3,
+	0xac, 0x1a, 0x5a, 0xcf, 0xf1, 0x60, 0xb9, 0x2f, 0x7b, 0x6d, 0x35, 0x4c, 0xb0, 0x9d, 0x8a, 0xd8,
+	0xac, 0x19, 0x35, 0x7f, 0xa9, 0x2f, 0x7b, 0x87, 0xc3, 0x04, 0x9f, 0x88, 0x58, 0x7a, 0xbf, 0x94,
+	0x60, 0xd3, 0xb8, 0x7d, 0x28, 0x15, 0xf6, 0xf3, 0x72, 0xca, 0x33, 0xe9, 0xa4, 0x05, 0x62, 0x0b,
+	0x96, 0x78, 0x1c, 0x4e, 0x55, 0x0a, 0xf0, 0x38, 0xcc, 0xeb, 0x69, 0x0b, 0x96, 0x18, 0x4e, 0x57,
+	0x30, 0x30, 0xcc, 0x8b, 0x77, 0x72, 0x8e, 0x55, 0xa6, 0xe7, 0xd8, 0x26, 0x2c, 0x12, 0xa1, 0x68,
+	0x57, 0x7b, 0x64, 0xde, 0x78, 0x24, 0xa7, 0xbd, 0xaf, 0x6d, 0x68, 0xbe, 0xe4, 0x0a, 0xe5, 0x43,
+	0x1e, 0x1c, 0x61, 0xe6, 0x73, 0x12, 0x04, 0x59, 0xfe, 0x19, 0x98, 0x39, 0x79, 0x3e, 0xab, 0x8e,
+	0x04, 0x67, 0x64, 0xf2, 0x09, 0x8b, 0xff, 0x16, 0xa3, 0x43, 0xb8, 0x34, 0x32, 0x7a, 0x17, 0x63,
+	0xec, 0x11, 0x75, 0xaa, 0xd5, 0x9b, 0xb0, 0xac, 0x83, 0x12, 0x5a, 0x51, 0xb4, 0x61, 0xb9, 0xc0,
+	0xe3, 0x30, 0x57, 0x47, 0x2d, 0xa4, 0x03, 0x33, 0x12, 0x32, 0xa1, 0xb9, 0xc0, 0xf0, 0x79, 0x21,
+	0xe4, 0xfd, 0x9a, 0xb7, 0xb8, 0x87, 0xd8, 0x23, 0xc1, 0xf0, 0xd1, 0xb3, 0xa7, 0x87, 0x3e, 0x86,
+	0x88, 0xfd, 0x53, 0xed, 0x8f, 0xa7, 0x71, 0x79, 0x2a, 0x8d, 0xcf, 0xa3, 0x3f, 0x38, 0xeb, 0xb0,
+	0xa0, 0x8e, 0xdb, 0x11, 0x91, 0x91, 0xcd, 0x98, 0xaa, 0x3a, 0xbe, 0x4f, 0x64, 0xe4, 0x7d, 0x5f,
+	0x02, 0xd7, 0x64, 0xb0, 0x22, 0x47, 0x38, 0xe5, 0xb0, 0x6b, 0xfa, 0xc7, 0x4e, 0x46, 0x70, 0x91,
+	0x8f, 0xf6, 0x82, 0x71, 0x8e, 0x4e, 0xeb, 0xd9, 0x2a, 0x6a, 0x9a, 0x9f, 0xca, 0x7b, 0x02, 0xc9,
+	0x11, 0x8a, 0x43, 0x41, 0x93, 0x04, 0x43, 0xe7, 0x00, 0x56, 0xed, 0x6f, 0xe8, 0x76, 0xc7, 0x9c,
+	0x64, 0x58, 0x96, 0x76, 0x36, 0xc7, 0xd7, 0xa6, 0x49, 0x5d, 0xbb, 0x4c, 0xac, 0x04, 0x13, 0x5c,
+	0x3d, 0x98, 0x37, 0x4e, 0xb0, 0xf4, 0x90, 0x76, 0xf5, 0x73, 0x77, 0xa0, 0x72, 0x44, 0x99, 0xe9,
+	0x4d, 0x2b, 0x3b, 0xd7, 0xdf, 0x7e, 0xfb, 0x03, 0xca, 0x42, 0x3f, 0x93, 0xd5, 0x8d, 0x49, 0x11,
+	0xa1, 0x87, 0x51, 0xd9, 0xfa, 0x35, 0xa3, 0x74, 0x44, 0x25, 0xc6, 0x18, 0x68, 0xcf, 0x99, 0x27,
+	0x17, 0xb4, 0xb3, 0x01, 0x8b, 0x02, 0x25, 0xaa, 0x76, 0x27, 0xaf, 0xdf, 0x85, 0x8c, 0xde, 0x1b,
+	0x7a, 0xdf, 0x5a, 0x7c, 0x8f, 0x8b, 0xbf, 0x06, 0x5a, 0xb4, 0xc7, 0x50, 0xe8, 0xa5, 0xd3, 0xb9,
+	0x0b, 0x20, 0x33, 0xaa, 0x2d, 0x51, 0x59, 0x1f, 0x6c, 0x8d, 0xa3, 0x9c, 0xd6, 0x6a, 0x61, 0xbe,
+	0x44, 0xd6, 0x64, 0xce, 0x38, 0x7d, 0x0d, 0xde, 0xab, 0x7f, 0xf5, 0xff, 0x1e, 0x55, 0x51, 0xda,
+	0xa9, 0x07, 0xbc, 0xdf, 0xf8, 0x82, 0x92, 0x88, 0xf0, 0xdd, 0xb8, 0x93, 0xca, 0xc6, 0xb3, 0x47,
+	0x4f, 0x1b, 0x41, 0x44, 0x28, 0x6b, 0x98, 0x3f, 0x30, 0x74, 0x63, 0x94, 0x9d, 0x6a, 0xf6, 0xcf,
+	0xc5, 0x47, 0x7f, 0x0d, 0x00, 0x54, 0x29, 0xbc, 0xac, 0x68, 0x11, 0x00, 0x00,
+}
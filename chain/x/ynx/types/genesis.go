@@ -3,6 +3,8 @@ package types
 import (
 	"fmt"
 	"math/big"
+
+	sdkmath "cosmossdk.io/math"
 )
 
 const (
@@ -40,19 +42,166 @@ func DefaultSystemConfig() SystemConfig {
 
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params:          DefaultParams(),
-		System:          DefaultSystemConfig(),
-		SystemContracts: SystemContracts{},
+		Params:                     DefaultParams(),
+		System:                     DefaultSystemConfig(),
+		SystemContracts:            SystemContracts{},
+		Epoch:                      EpochInfo{},
+		Revenue:                    []RevenueRecord{},
+		EpochRevenue:               []EpochRevenue{},
+		PendingParams:              []PendingParams{},
+		AccruedFeeShares:           []AccruedFeeShare{},
+		AccruedTreasuryShares:      []AccruedFeeShare{},
+		ContractRevenues:           []ContractRevenue{},
+		Sponsorships:               []Sponsorship{},
+		NextSponsorshipId:          1,
+		Reconciliation:             []ReconciliationRecord{},
+		VoteLocks:                  []VoteLock{},
+		VoteCheckpoints:            []VoteCheckpoint{},
+		VoteSupplyCheckpoints:      []VoteCheckpoint{},
+		StakeVoters:                []StakeVoter{},
+		StakeVoteCheckpoints:       []VoteCheckpoint{},
+		StakeVoteSupplyCheckpoints: []VoteCheckpoint{},
+		CircuitBreakers:            []CircuitBreaker{},
+		PreconfirmSignerSets:       []PreconfirmSignerSet{},
 	}
 }
 
 func (g GenesisState) Validate() error {
-	if err := g.Params.Validate(); err != nil {
+	// Genesis files exported before inflation_treasury_bps was deprecated still carry it;
+	// InitGenesis migrates it.
+	params := g.Params
+	if err := params.MigrateInflationTreasuryBps(); err != nil {
+		return err
+	}
+	if err := params.Validate(); err != nil {
 		return err
 	}
 	if err := g.System.Validate(); err != nil {
 		return err
 	}
+	// Genesis files exported before the registry became a name→address map still carry the v0
+	// fields; InitGenesis migrates them.
+	contracts := g.SystemContracts
+	if err := contracts.MigrateLegacyEntries(); err != nil {
+		return err
+	}
+	if err := contracts.Validate(); err != nil {
+		return err
+	}
+
+	if err := validateRevenueRecords(g.Revenue); err != nil {
+		return err
+	}
+	seenEpochs := make(map[uint64]struct{}, len(g.EpochRevenue))
+	for _, er := range g.EpochRevenue {
+		if er.Epoch > g.Epoch.Number {
+			return fmt.Errorf("epoch revenue for future epoch %d (current %d)", er.Epoch, g.Epoch.Number)
+		}
+		if _, ok := seenEpochs[er.Epoch]; ok {
+			return fmt.Errorf("duplicate epoch revenue: %d", er.Epoch)
+		}
+		seenEpochs[er.Epoch] = struct{}{}
+		if err := validateRevenueRecords(er.Revenue); err != nil {
+			return fmt.Errorf("epoch %d: %w", er.Epoch, err)
+		}
+	}
+
+	seenHeights := make(map[int64]struct{}, len(g.PendingParams))
+	for _, pp := range g.PendingParams {
+		if err := pp.MigrateInflationTreasuryBps(); err != nil {
+			return err
+		}
+		if err := pp.Validate(); err != nil {
+			return err
+		}
+		if _, ok := seenHeights[pp.ActivationHeight]; ok {
+			return fmt.Errorf("duplicate pending params activation height: %d", pp.ActivationHeight)
+		}
+		seenHeights[pp.ActivationHeight] = struct{}{}
+	}
+
+	seenShares := make(map[[2]string]sdkmath.Int, len(g.AccruedFeeShares))
+	for _, share := range g.AccruedFeeShares {
+		if err := share.Validate(); err != nil {
+			return err
+		}
+		key := [2]string{share.Recipient, share.Denom}
+		if _, ok := seenShares[key]; ok {
+			return fmt.Errorf("duplicate accrued fee share: %q %s", share.Recipient, share.Denom)
+		}
+		seenShares[key] = share.Amount
+	}
+
+	seenTreasuryShares := make(map[[2]string]struct{}, len(g.AccruedTreasuryShares))
+	for _, share := range g.AccruedTreasuryShares {
+		if err := share.Validate(); err != nil {
+			return err
+		}
+		key := [2]string{share.Recipient, share.Denom}
+		if _, ok := seenTreasuryShares[key]; ok {
+			return fmt.Errorf("duplicate accrued treasury share: %q %s", share.Recipient, share.Denom)
+		}
+		seenTreasuryShares[key] = struct{}{}
+		if accrued, ok := seenShares[key]; !ok || share.Amount.GT(accrued) {
+			return fmt.Errorf("accrued treasury share %q %s exceeds the accrued fee share", share.Recipient, share.Denom)
+		}
+	}
+
+	seenContracts := make(map[string]struct{}, len(g.ContractRevenues))
+	for _, cr := range g.ContractRevenues {
+		if err := cr.Validate(); err != nil {
+			return err
+		}
+		contract, _ := ParseContractAddress(cr.ContractAddress)
+		if _, ok := seenContracts[contract.Hex()]; ok {
+			return fmt.Errorf("duplicate contract revenue: %s", contract.Hex())
+		}
+		seenContracts[contract.Hex()] = struct{}{}
+	}
+
+	seenSponsorships := make(map[uint64]struct{}, len(g.Sponsorships))
+	for _, sp := range g.Sponsorships {
+		if err := sp.Validate(); err != nil {
+			return err
+		}
+		if sp.Id >= g.NextSponsorshipId {
+			return fmt.Errorf("sponsorship id %d must be below next_sponsorship_id %d", sp.Id, g.NextSponsorshipId)
+		}
+		if _, ok := seenSponsorships[sp.Id]; ok {
+			return fmt.Errorf("duplicate sponsorship id: %d", sp.Id)
+		}
+		seenSponsorships[sp.Id] = struct{}{}
+	}
+
+	seenReconciliation := make(map[string]struct{}, len(g.Reconciliation))
+	for _, r := range g.Reconciliation {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+		if _, ok := seenReconciliation[r.Denom]; ok {
+			return fmt.Errorf("duplicate reconciliation denom: %s", r.Denom)
+		}
+		seenReconciliation[r.Denom] = struct{}{}
+	}
+
+	if err := validateVotes(g.VoteLocks, g.VoteCheckpoints, g.VoteSupplyCheckpoints); err != nil {
+		return err
+	}
+	if err := validateStakeVotes(g.StakeVoters, g.StakeVoteCheckpoints, g.StakeVoteSupplyCheckpoints); err != nil {
+		return err
+	}
+	if err := validateCircuitBreakers(g.CircuitBreakers); err != nil {
+		return err
+	}
+	if err := validatePreconfirmSignerSets(g.PreconfirmSignerSets); err != nil {
+		return err
+	}
+	if g.LegacyNyxt != nil {
+		if err := g.LegacyNyxt.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -81,6 +230,15 @@ func (cfg SystemConfig) Validate() error {
 	if _, ok := new(big.Int).SetString(cfg.ProposalDeposit, 10); !ok {
 		return fmt.Errorf("invalid system.proposal_deposit (base-10 uint256 string)")
 	}
+	if _, ok := SystemDeployMode_name[int32(cfg.DeployMode)]; !ok {
+		return fmt.Errorf("invalid system.deploy_mode: %s", cfg.DeployMode)
+	}
+	if err := cfg.Airdrop.Validate(); err != nil {
+		return fmt.Errorf("invalid system.airdrop: %w", err)
+	}
+	if err := cfg.DeployManifest().Validate(); err != nil {
+		return fmt.Errorf("invalid system.manifest: %w", err)
+	}
 
 	return nil
 }
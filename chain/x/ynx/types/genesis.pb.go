@@ -21,6 +21,35 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type SystemDeployMode int32
+
+const (
+	// SYSTEM_DEPLOY_MODE_CREATE deploys from deployer_address with CREATE. The addresses depend on the
+	// deployer nonce and the deployment order.
+	SystemDeployMode_SYSTEM_DEPLOY_MODE_CREATE SystemDeployMode = 0
+	// SYSTEM_DEPLOY_MODE_CREATE2 deploys through the CREATE2 factory of the EVM preinstalls, with a
+	// salt derived from the contract name and version. The addresses only depend on the system config.
+	SystemDeployMode_SYSTEM_DEPLOY_MODE_CREATE2 SystemDeployMode = 1
+)
+
+var SystemDeployMode_name = map[int32]string{
+	0: "SYSTEM_DEPLOY_MODE_CREATE",
+	1: "SYSTEM_DEPLOY_MODE_CREATE2",
+}
+
+var SystemDeployMode_value = map[string]int32{
+	"SYSTEM_DEPLOY_MODE_CREATE":  0,
+	"SYSTEM_DEPLOY_MODE_CREATE2": 1,
+}
+
+func (x SystemDeployMode) String() string {
+	return proto.EnumName(SystemDeployMode_name, int32(x))
+}
+
+func (SystemDeployMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_dfacd17f76421fa4, []int{0}
+}
+
 type SystemConfig struct {
 	// enabled controls whether the chain deploys the system contracts during InitGenesis.
 	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
@@ -31,9 +60,11 @@ type SystemConfig struct {
 	// It MAY be provided as 0x... (hex) or a chain bech32 address.
 	TeamBeneficiaryAddress string `protobuf:"bytes,3,opt,name=team_beneficiary_address,json=teamBeneficiaryAddress,proto3" json:"team_beneficiary_address,omitempty"`
 	// community_recipient_address receives the community allocation.
+	// If unset, it defaults to the deployer address.
 	// It MAY be provided as 0x... (hex) or a chain bech32 address.
 	CommunityRecipientAddress string `protobuf:"bytes,4,opt,name=community_recipient_address,json=communityRecipientAddress,proto3" json:"community_recipient_address,omitempty"`
-	// genesis_supply is the NYXT ERC20 genesis supply (uint256) as a base-10 string.
+	// genesis_supply is the NYXT ERC20 genesis supply (uint256) as a base-10 string. With native_nyxt
+	// it is the amount of the native denom the deployer allocates instead.
 	GenesisSupply string `protobuf:"bytes,5,opt,name=genesis_supply,json=genesisSupply,proto3" json:"genesis_supply,omitempty"`
 	// Allocation percentages (must sum to 100).
 	TeamPercent      uint32 `protobuf:"varint,6,opt,name=team_percent,json=teamPercent,proto3" json:"team_percent,omitempty"`
@@ -47,11 +78,29 @@ type SystemConfig struct {
 	QuorumPercent        uint64 `protobuf:"varint,13,opt,name=quorum_percent,json=quorumPercent,proto3" json:"quorum_percent,omitempty"`
 	TimelockDelaySeconds uint64 `protobuf:"varint,14,opt,name=timelock_delay_seconds,json=timelockDelaySeconds,proto3" json:"timelock_delay_seconds,omitempty"`
 	// Vesting params.
-	VestingCliffSeconds    uint64   `protobuf:"varint,15,opt,name=vesting_cliff_seconds,json=vestingCliffSeconds,proto3" json:"vesting_cliff_seconds,omitempty"`
-	VestingDurationSeconds uint64   `protobuf:"varint,16,opt,name=vesting_duration_seconds,json=vestingDurationSeconds,proto3" json:"vesting_duration_seconds,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
-	XXX_unrecognized       []byte   `json:"-"`
-	XXX_sizecache          int32    `json:"-"`
+	VestingCliffSeconds    uint64 `protobuf:"varint,15,opt,name=vesting_cliff_seconds,json=vestingCliffSeconds,proto3" json:"vesting_cliff_seconds,omitempty"`
+	VestingDurationSeconds uint64 `protobuf:"varint,16,opt,name=vesting_duration_seconds,json=vestingDurationSeconds,proto3" json:"vesting_duration_seconds,omitempty"`
+	// deploy_mode selects how the system contract addresses are derived.
+	DeployMode SystemDeployMode `protobuf:"varint,17,opt,name=deploy_mode,json=deployMode,proto3,enum=ynx.ynx.v1.SystemDeployMode" json:"deploy_mode,omitempty"`
+	// vesting_reference_time is the unix time the team vesting cliff is counted from. Zero counts it
+	// from the genesis block time.
+	VestingReferenceTime uint64 `protobuf:"varint,18,opt,name=vesting_reference_time,json=vestingReferenceTime,proto3" json:"vesting_reference_time,omitempty"`
+	// manifest declares the system contracts to deploy. An empty manifest deploys the default v0
+	// system contracts of deploy_mode.
+	Manifest SystemManifest `protobuf:"bytes,19,opt,name=manifest,proto3" json:"manifest"`
+	// airdrop distributes part of the community allocation through a merkle distributor contract.
+	Airdrop SystemAirdrop `protobuf:"bytes,20,opt,name=airdrop,proto3" json:"airdrop"`
+	// native_nyxt makes the native denom the NYXT of the system contracts instead of a standalone
+	// NYXT ERC20: the default manifest deploys no nyxt contract, the governor takes its votes from
+	// the NYXT votes precompile and its deposits through the native NYXT ERC20, and the allocations
+	// are sent from the deployer's native balance, which must cover genesis_supply.
+	NativeNyxt bool `protobuf:"varint,21,opt,name=native_nyxt,json=nativeNyxt,proto3" json:"native_nyxt,omitempty"`
+	// stake_weighted_votes makes the governor of the default manifest count the bonded stake of the
+	// stake votes precompile instead of NYXT votes.
+	StakeWeightedVotes   bool     `protobuf:"varint,22,opt,name=stake_weighted_votes,json=stakeWeightedVotes,proto3" json:"stake_weighted_votes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SystemConfig) Reset()         { *m = SystemConfig{} }
@@ -190,26 +239,397 @@ func (m *SystemConfig) GetVestingDurationSeconds() uint64 {
 	return 0
 }
 
+func (m *SystemConfig) GetDeployMode() SystemDeployMode {
+	if m != nil {
+		return m.DeployMode
+	}
+	return SystemDeployMode_SYSTEM_DEPLOY_MODE_CREATE
+}
+
+func (m *SystemConfig) GetVestingReferenceTime() uint64 {
+	if m != nil {
+		return m.VestingReferenceTime
+	}
+	return 0
+}
+
+func (m *SystemConfig) GetManifest() SystemManifest {
+	if m != nil {
+		return m.Manifest
+	}
+	return SystemManifest{}
+}
+
+func (m *SystemConfig) GetAirdrop() SystemAirdrop {
+	if m != nil {
+		return m.Airdrop
+	}
+	return SystemAirdrop{}
+}
+
+func (m *SystemConfig) GetNativeNyxt() bool {
+	if m != nil {
+		return m.NativeNyxt
+	}
+	return false
+}
+
+func (m *SystemConfig) GetStakeWeightedVotes() bool {
+	if m != nil {
+		return m.StakeWeightedVotes
+	}
+	return false
+}
+
+// SystemAirdrop configures the genesis NYXT airdrop. The airdrop is enabled when merkle_root is set:
+// the default manifest then deploys a YNXMerkleDistributor and funds it with total_amount out of the
+// community allocation. `ynxd genesis ynx airdrop build` computes merkle_root and the claim proofs.
+type SystemAirdrop struct {
+	// merkle_root is the 0x root of the claim tree. Each leaf is
+	// keccak256(keccak256(abi.encode(uint256 index, address account, uint256 amount))).
+	MerkleRoot string `protobuf:"bytes,1,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	// total_amount is the NYXT amount (uint256) claimable through the tree, as a base-10 string. It
+	// must not exceed the community allocation.
+	TotalAmount string `protobuf:"bytes,2,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	// claim_deadline is the unix time after which claims are closed and the unclaimed balance can be
+	// swept.
+	ClaimDeadline uint64 `protobuf:"varint,3,opt,name=claim_deadline,json=claimDeadline,proto3" json:"claim_deadline,omitempty"`
+	// sweep_recipient_address receives the unclaimed balance after claim_deadline.
+	// It MAY be provided as 0x... (hex) or a chain bech32 address.
+	SweepRecipientAddress string   `protobuf:"bytes,4,opt,name=sweep_recipient_address,json=sweepRecipientAddress,proto3" json:"sweep_recipient_address,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
+}
+
+func (m *SystemAirdrop) Reset()         { *m = SystemAirdrop{} }
+func (m *SystemAirdrop) String() string { return proto.CompactTextString(m) }
+func (*SystemAirdrop) ProtoMessage()    {}
+func (*SystemAirdrop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dfacd17f76421fa4, []int{1}
+}
+func (m *SystemAirdrop) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SystemAirdrop.Unmarshal(m, b)
+}
+func (m *SystemAirdrop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SystemAirdrop.Marshal(b, m, deterministic)
+}
+func (m *SystemAirdrop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SystemAirdrop.Merge(m, src)
+}
+func (m *SystemAirdrop) XXX_Size() int {
+	return xxx_messageInfo_SystemAirdrop.Size(m)
+}
+func (m *SystemAirdrop) XXX_DiscardUnknown() {
+	xxx_messageInfo_SystemAirdrop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SystemAirdrop proto.InternalMessageInfo
+
+func (m *SystemAirdrop) GetMerkleRoot() string {
+	if m != nil {
+		return m.MerkleRoot
+	}
+	return ""
+}
+
+func (m *SystemAirdrop) GetTotalAmount() string {
+	if m != nil {
+		return m.TotalAmount
+	}
+	return ""
+}
+
+func (m *SystemAirdrop) GetClaimDeadline() uint64 {
+	if m != nil {
+		return m.ClaimDeadline
+	}
+	return 0
+}
+
+func (m *SystemAirdrop) GetSweepRecipientAddress() string {
+	if m != nil {
+		return m.SweepRecipientAddress
+	}
+	return ""
+}
+
+// SystemManifest declares a genesis system contract deployment: the contracts to deploy, in order,
+// and the calls the deployer makes once all of them are deployed.
+type SystemManifest struct {
+	Contracts            []SystemManifestContract `protobuf:"bytes,1,rep,name=contracts,proto3" json:"contracts"`
+	Calls                []SystemManifestCall     `protobuf:"bytes,2,rep,name=calls,proto3" json:"calls"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *SystemManifest) Reset()         { *m = SystemManifest{} }
+func (m *SystemManifest) String() string { return proto.CompactTextString(m) }
+func (*SystemManifest) ProtoMessage()    {}
+func (*SystemManifest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dfacd17f76421fa4, []int{2}
+}
+func (m *SystemManifest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SystemManifest.Unmarshal(m, b)
+}
+func (m *SystemManifest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SystemManifest.Marshal(b, m, deterministic)
+}
+func (m *SystemManifest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SystemManifest.Merge(m, src)
+}
+func (m *SystemManifest) XXX_Size() int {
+	return xxx_messageInfo_SystemManifest.Size(m)
+}
+func (m *SystemManifest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SystemManifest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SystemManifest proto.InternalMessageInfo
+
+func (m *SystemManifest) GetContracts() []SystemManifestContract {
+	if m != nil {
+		return m.Contracts
+	}
+	return nil
+}
+
+func (m *SystemManifest) GetCalls() []SystemManifestCall {
+	if m != nil {
+		return m.Calls
+	}
+	return nil
+}
+
+// SystemManifestContract deploys an embedded artifact and registers it as a system contract.
+type SystemManifestContract struct {
+	// name is the system_contracts entry, e.g. "timelock".
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// artifact is the embedded hardhat artifact, e.g. "YNXTimelock".
+	Artifact string `protobuf:"bytes,2,opt,name=artifact,proto3" json:"artifact,omitempty"`
+	// version is the CREATE2 salt version. Bump it to move the contract to a fresh address. Zero
+	// means version 1.
+	Version uint32 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	// constructor_args are the constructor arguments, in ABI order.
+	ConstructorArgs      []SystemManifestArg `protobuf:"bytes,4,rep,name=constructor_args,json=constructorArgs,proto3" json:"constructor_args"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *SystemManifestContract) Reset()         { *m = SystemManifestContract{} }
+func (m *SystemManifestContract) String() string { return proto.CompactTextString(m) }
+func (*SystemManifestContract) ProtoMessage()    {}
+func (*SystemManifestContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dfacd17f76421fa4, []int{3}
+}
+func (m *SystemManifestContract) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SystemManifestContract.Unmarshal(m, b)
+}
+func (m *SystemManifestContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SystemManifestContract.Marshal(b, m, deterministic)
+}
+func (m *SystemManifestContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SystemManifestContract.Merge(m, src)
+}
+func (m *SystemManifestContract) XXX_Size() int {
+	return xxx_messageInfo_SystemManifestContract.Size(m)
+}
+func (m *SystemManifestContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_SystemManifestContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SystemManifestContract proto.InternalMessageInfo
+
+func (m *SystemManifestContract) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SystemManifestContract) GetArtifact() string {
+	if m != nil {
+		return m.Artifact
+	}
+	return ""
+}
+
+func (m *SystemManifestContract) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *SystemManifestContract) GetConstructorArgs() []SystemManifestArg {
+	if m != nil {
+		return m.ConstructorArgs
+	}
+	return nil
+}
+
+// SystemManifestCall is a call from the deployer to a contract of the manifest.
+type SystemManifestCall struct {
+	// contract is the name of the manifest contract that is called.
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// method is the ABI method name, e.g. "grantRole".
+	Method               string              `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Args                 []SystemManifestArg `protobuf:"bytes,3,rep,name=args,proto3" json:"args"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *SystemManifestCall) Reset()         { *m = SystemManifestCall{} }
+func (m *SystemManifestCall) String() string { return proto.CompactTextString(m) }
+func (*SystemManifestCall) ProtoMessage()    {}
+func (*SystemManifestCall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dfacd17f76421fa4, []int{4}
+}
+func (m *SystemManifestCall) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SystemManifestCall.Unmarshal(m, b)
+}
+func (m *SystemManifestCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SystemManifestCall.Marshal(b, m, deterministic)
+}
+func (m *SystemManifestCall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SystemManifestCall.Merge(m, src)
+}
+func (m *SystemManifestCall) XXX_Size() int {
+	return xxx_messageInfo_SystemManifestCall.Size(m)
+}
+func (m *SystemManifestCall) XXX_DiscardUnknown() {
+	xxx_messageInfo_SystemManifestCall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SystemManifestCall proto.InternalMessageInfo
+
+func (m *SystemManifestCall) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *SystemManifestCall) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *SystemManifestCall) GetArgs() []SystemManifestArg {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+// SystemManifestArg is an ABI argument. Exactly one of contract, config, value and keccak256 is
+// set, except for array arguments, which list their elements in elements.
+type SystemManifestArg struct {
+	// contract is the address of the manifest contract with that name. In CREATE2 mode only
+	// contracts deployed earlier can be referenced.
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// config is a value derived from the system config, e.g. "deployer" or "genesis_supply".
+	Config string `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	// value is a literal: a 0x address, a decimal integer, true or false, a string or 0x hex bytes.
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// keccak256 is the keccak256 hash of the string, e.g. a role name.
+	Keccak256            string              `protobuf:"bytes,4,opt,name=keccak256,proto3" json:"keccak256,omitempty"`
+	Elements             []SystemManifestArg `protobuf:"bytes,5,rep,name=elements,proto3" json:"elements"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *SystemManifestArg) Reset()         { *m = SystemManifestArg{} }
+func (m *SystemManifestArg) String() string { return proto.CompactTextString(m) }
+func (*SystemManifestArg) ProtoMessage()    {}
+func (*SystemManifestArg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dfacd17f76421fa4, []int{5}
+}
+func (m *SystemManifestArg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SystemManifestArg.Unmarshal(m, b)
+}
+func (m *SystemManifestArg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SystemManifestArg.Marshal(b, m, deterministic)
+}
+func (m *SystemManifestArg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SystemManifestArg.Merge(m, src)
+}
+func (m *SystemManifestArg) XXX_Size() int {
+	return xxx_messageInfo_SystemManifestArg.Size(m)
+}
+func (m *SystemManifestArg) XXX_DiscardUnknown() {
+	xxx_messageInfo_SystemManifestArg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SystemManifestArg proto.InternalMessageInfo
+
+func (m *SystemManifestArg) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *SystemManifestArg) GetConfig() string {
+	if m != nil {
+		return m.Config
+	}
+	return ""
+}
+
+func (m *SystemManifestArg) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *SystemManifestArg) GetKeccak256() string {
+	if m != nil {
+		return m.Keccak256
+	}
+	return ""
+}
+
+func (m *SystemManifestArg) GetElements() []SystemManifestArg {
+	if m != nil {
+		return m.Elements
+	}
+	return nil
+}
+
+// SystemContracts is the registry of system contracts.
 type SystemContracts struct {
-	Nyxt                 string   `protobuf:"bytes,1,opt,name=nyxt,proto3" json:"nyxt,omitempty"`
-	Timelock             string   `protobuf:"bytes,2,opt,name=timelock,proto3" json:"timelock,omitempty"`
-	Treasury             string   `protobuf:"bytes,3,opt,name=treasury,proto3" json:"treasury,omitempty"`
-	Governor             string   `protobuf:"bytes,4,opt,name=governor,proto3" json:"governor,omitempty"`
-	TeamVesting          string   `protobuf:"bytes,5,opt,name=team_vesting,json=teamVesting,proto3" json:"team_vesting,omitempty"`
-	OrgRegistry          string   `protobuf:"bytes,6,opt,name=org_registry,json=orgRegistry,proto3" json:"org_registry,omitempty"`
-	SubjectRegistry      string   `protobuf:"bytes,7,opt,name=subject_registry,json=subjectRegistry,proto3" json:"subject_registry,omitempty"`
-	Arbitration          string   `protobuf:"bytes,8,opt,name=arbitration,proto3" json:"arbitration,omitempty"`
-	DomainInbox          string   `protobuf:"bytes,9,opt,name=domain_inbox,json=domainInbox,proto3" json:"domain_inbox,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	// The v0 entries. Deprecated: the 2 to 3 store migration moves them to contracts.
+	Nyxt            string `protobuf:"bytes,1,opt,name=nyxt,proto3" json:"nyxt,omitempty"`                                              // Deprecated: Do not use.
+	Timelock        string `protobuf:"bytes,2,opt,name=timelock,proto3" json:"timelock,omitempty"`                                      // Deprecated: Do not use.
+	Treasury        string `protobuf:"bytes,3,opt,name=treasury,proto3" json:"treasury,omitempty"`                                      // Deprecated: Do not use.
+	Governor        string `protobuf:"bytes,4,opt,name=governor,proto3" json:"governor,omitempty"`                                      // Deprecated: Do not use.
+	TeamVesting     string `protobuf:"bytes,5,opt,name=team_vesting,json=teamVesting,proto3" json:"team_vesting,omitempty"`             // Deprecated: Do not use.
+	OrgRegistry     string `protobuf:"bytes,6,opt,name=org_registry,json=orgRegistry,proto3" json:"org_registry,omitempty"`             // Deprecated: Do not use.
+	SubjectRegistry string `protobuf:"bytes,7,opt,name=subject_registry,json=subjectRegistry,proto3" json:"subject_registry,omitempty"` // Deprecated: Do not use.
+	Arbitration     string `protobuf:"bytes,8,opt,name=arbitration,proto3" json:"arbitration,omitempty"`                                // Deprecated: Do not use.
+	DomainInbox     string `protobuf:"bytes,9,opt,name=domain_inbox,json=domainInbox,proto3" json:"domain_inbox,omitempty"`             // Deprecated: Do not use.
+	// attestations record the code deployed at each entry when it was set, at most one per entry.
+	Attestations []SystemContractAttestation `protobuf:"bytes,10,rep,name=attestations,proto3" json:"attestations"`
+	// contracts maps the system contract names to their addresses, ordered by name.
+	Contracts            []SystemContractEntry `protobuf:"bytes,11,rep,name=contracts,proto3" json:"contracts"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *SystemContracts) Reset()         { *m = SystemContracts{} }
 func (m *SystemContracts) String() string { return proto.CompactTextString(m) }
 func (*SystemContracts) ProtoMessage()    {}
 func (*SystemContracts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dfacd17f76421fa4, []int{1}
+	return fileDescriptor_dfacd17f76421fa4, []int{6}
 }
 func (m *SystemContracts) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SystemContracts.Unmarshal(m, b)
@@ -229,6 +649,7 @@ func (m *SystemContracts) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SystemContracts proto.InternalMessageInfo
 
+// Deprecated: Do not use.
 func (m *SystemContracts) GetNyxt() string {
 	if m != nil {
 		return m.Nyxt
@@ -236,6 +657,7 @@ func (m *SystemContracts) GetNyxt() string {
 	return ""
 }
 
+// Deprecated: Do not use.
 func (m *SystemContracts) GetTimelock() string {
 	if m != nil {
 		return m.Timelock
@@ -243,6 +665,7 @@ func (m *SystemContracts) GetTimelock() string {
 	return ""
 }
 
+// Deprecated: Do not use.
 func (m *SystemContracts) GetTreasury() string {
 	if m != nil {
 		return m.Treasury
@@ -250,6 +673,7 @@ func (m *SystemContracts) GetTreasury() string {
 	return ""
 }
 
+// Deprecated: Do not use.
 func (m *SystemContracts) GetGovernor() string {
 	if m != nil {
 		return m.Governor
@@ -257,6 +681,7 @@ func (m *SystemContracts) GetGovernor() string {
 	return ""
 }
 
+// Deprecated: Do not use.
 func (m *SystemContracts) GetTeamVesting() string {
 	if m != nil {
 		return m.TeamVesting
@@ -264,6 +689,7 @@ func (m *SystemContracts) GetTeamVesting() string {
 	return ""
 }
 
+// Deprecated: Do not use.
 func (m *SystemContracts) GetOrgRegistry() string {
 	if m != nil {
 		return m.OrgRegistry
@@ -271,6 +697,7 @@ func (m *SystemContracts) GetOrgRegistry() string {
 	return ""
 }
 
+// Deprecated: Do not use.
 func (m *SystemContracts) GetSubjectRegistry() string {
 	if m != nil {
 		return m.SubjectRegistry
@@ -278,6 +705,7 @@ func (m *SystemContracts) GetSubjectRegistry() string {
 	return ""
 }
 
+// Deprecated: Do not use.
 func (m *SystemContracts) GetArbitration() string {
 	if m != nil {
 		return m.Arbitration
@@ -285,6 +713,7 @@ func (m *SystemContracts) GetArbitration() string {
 	return ""
 }
 
+// Deprecated: Do not use.
 func (m *SystemContracts) GetDomainInbox() string {
 	if m != nil {
 		return m.DomainInbox
@@ -292,20 +721,181 @@ func (m *SystemContracts) GetDomainInbox() string {
 	return ""
 }
 
+func (m *SystemContracts) GetAttestations() []SystemContractAttestation {
+	if m != nil {
+		return m.Attestations
+	}
+	return nil
+}
+
+func (m *SystemContracts) GetContracts() []SystemContractEntry {
+	if m != nil {
+		return m.Contracts
+	}
+	return nil
+}
+
+// SystemContractEntry is a system contract name and its 0x address.
+type SystemContractEntry struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SystemContractEntry) Reset()         { *m = SystemContractEntry{} }
+func (m *SystemContractEntry) String() string { return proto.CompactTextString(m) }
+func (*SystemContractEntry) ProtoMessage()    {}
+func (*SystemContractEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dfacd17f76421fa4, []int{7}
+}
+func (m *SystemContractEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SystemContractEntry.Unmarshal(m, b)
+}
+func (m *SystemContractEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SystemContractEntry.Marshal(b, m, deterministic)
+}
+func (m *SystemContractEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SystemContractEntry.Merge(m, src)
+}
+func (m *SystemContractEntry) XXX_Size() int {
+	return xxx_messageInfo_SystemContractEntry.Size(m)
+}
+func (m *SystemContractEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_SystemContractEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SystemContractEntry proto.InternalMessageInfo
+
+func (m *SystemContractEntry) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SystemContractEntry) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// SystemContractAttestation records the runtime code of a system contract entry.
+type SystemContractAttestation struct {
+	// name is the system_contracts entry, e.g. "timelock".
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// code_hash is the 0x hex keccak256 hash of the runtime code at the entry's address.
+	CodeHash string `protobuf:"bytes,2,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
+	// artifact is the embedded hardhat artifact the contract was deployed from. It is empty for
+	// contracts deployed from raw bytecode or set to an existing address that matches no artifact.
+	Artifact string `protobuf:"bytes,3,opt,name=artifact,proto3" json:"artifact,omitempty"`
+	// artifact_version is the solc build info id of the artifact.
+	ArtifactVersion      string   `protobuf:"bytes,4,opt,name=artifact_version,json=artifactVersion,proto3" json:"artifact_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SystemContractAttestation) Reset()         { *m = SystemContractAttestation{} }
+func (m *SystemContractAttestation) String() string { return proto.CompactTextString(m) }
+func (*SystemContractAttestation) ProtoMessage()    {}
+func (*SystemContractAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dfacd17f76421fa4, []int{8}
+}
+func (m *SystemContractAttestation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SystemContractAttestation.Unmarshal(m, b)
+}
+func (m *SystemContractAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SystemContractAttestation.Marshal(b, m, deterministic)
+}
+func (m *SystemContractAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SystemContractAttestation.Merge(m, src)
+}
+func (m *SystemContractAttestation) XXX_Size() int {
+	return xxx_messageInfo_SystemContractAttestation.Size(m)
+}
+func (m *SystemContractAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_SystemContractAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SystemContractAttestation proto.InternalMessageInfo
+
+func (m *SystemContractAttestation) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SystemContractAttestation) GetCodeHash() string {
+	if m != nil {
+		return m.CodeHash
+	}
+	return ""
+}
+
+func (m *SystemContractAttestation) GetArtifact() string {
+	if m != nil {
+		return m.Artifact
+	}
+	return ""
+}
+
+func (m *SystemContractAttestation) GetArtifactVersion() string {
+	if m != nil {
+		return m.ArtifactVersion
+	}
+	return ""
+}
+
 type GenesisState struct {
-	Params               Params          `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	System               SystemConfig    `protobuf:"bytes,2,opt,name=system,proto3" json:"system"`
-	SystemContracts      SystemContracts `protobuf:"bytes,3,opt,name=system_contracts,json=systemContracts,proto3" json:"system_contracts"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Params          Params          `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	System          SystemConfig    `protobuf:"bytes,2,opt,name=system,proto3" json:"system"`
+	SystemContracts SystemContracts `protobuf:"bytes,3,opt,name=system_contracts,json=systemContracts,proto3" json:"system_contracts"`
+	// Revenue ledger.
+	Epoch        EpochInfo       `protobuf:"bytes,4,opt,name=epoch,proto3" json:"epoch"`
+	Revenue      []RevenueRecord `protobuf:"bytes,5,rep,name=revenue,proto3" json:"revenue"`
+	EpochRevenue []EpochRevenue  `protobuf:"bytes,6,rep,name=epoch_revenue,json=epochRevenue,proto3" json:"epoch_revenue"`
+	// Scheduled params changes.
+	PendingParams []PendingParams `protobuf:"bytes,7,rep,name=pending_params,json=pendingParams,proto3" json:"pending_params"`
+	// Protocol fee shares awaiting settlement.
+	AccruedFeeShares []AccruedFeeShare `protobuf:"bytes,8,rep,name=accrued_fee_shares,json=accruedFeeShares,proto3" json:"accrued_fee_shares"`
+	// Contracts registered for developer fee rebates.
+	ContractRevenues []ContractRevenue `protobuf:"bytes,9,rep,name=contract_revenues,json=contractRevenues,proto3" json:"contract_revenues"`
+	// Gas sponsorships and the id assigned to the next one.
+	Sponsorships      []Sponsorship `protobuf:"bytes,10,rep,name=sponsorships,proto3" json:"sponsorships"`
+	NextSponsorshipId uint64        `protobuf:"varint,11,opt,name=next_sponsorship_id,json=nextSponsorshipId,proto3" json:"next_sponsorship_id,omitempty"`
+	// Observed burns and treasury inflows reconciled by the x/ynx invariants.
+	Reconciliation []ReconciliationRecord `protobuf:"bytes,12,rep,name=reconciliation,proto3" json:"reconciliation"`
+	// Native NYXT locked for voting and the vote checkpoints of the NYXT votes precompile.
+	VoteLocks             []VoteLock       `protobuf:"bytes,13,rep,name=vote_locks,json=voteLocks,proto3" json:"vote_locks"`
+	VoteCheckpoints       []VoteCheckpoint `protobuf:"bytes,14,rep,name=vote_checkpoints,json=voteCheckpoints,proto3" json:"vote_checkpoints"`
+	VoteSupplyCheckpoints []VoteCheckpoint `protobuf:"bytes,15,rep,name=vote_supply_checkpoints,json=voteSupplyCheckpoints,proto3" json:"vote_supply_checkpoints"`
+	// Stake-weighted votes of the delegators and the vote checkpoints of the stake votes precompile.
+	StakeVoters                []StakeVoter     `protobuf:"bytes,16,rep,name=stake_voters,json=stakeVoters,proto3" json:"stake_voters"`
+	StakeVoteCheckpoints       []VoteCheckpoint `protobuf:"bytes,17,rep,name=stake_vote_checkpoints,json=stakeVoteCheckpoints,proto3" json:"stake_vote_checkpoints"`
+	StakeVoteSupplyCheckpoints []VoteCheckpoint `protobuf:"bytes,18,rep,name=stake_vote_supply_checkpoints,json=stakeVoteSupplyCheckpoints,proto3" json:"stake_vote_supply_checkpoints"`
+	// Tripped circuit breakers.
+	CircuitBreakers []CircuitBreaker `protobuf:"bytes,19,rep,name=circuit_breakers,json=circuitBreakers,proto3" json:"circuit_breakers"`
+	// Registered preconfirm signer sets, ordered by activation epoch.
+	PreconfirmSignerSets []PreconfirmSignerSet `protobuf:"bytes,20,rep,name=preconfirm_signer_sets,json=preconfirmSignerSets,proto3" json:"preconfirm_signer_sets"`
+	// The retired standalone NYXT ERC20, unset until it is retired.
+	LegacyNyxt *LegacyNYXT `protobuf:"bytes,21,opt,name=legacy_nyxt,json=legacyNyxt,proto3" json:"legacy_nyxt,omitempty"`
+	// The accrued fee shares of treasury_address, which are recorded as treasury inflows when they
+	// are settled.
+	AccruedTreasuryShares []AccruedFeeShare `protobuf:"bytes,22,rep,name=accrued_treasury_shares,json=accruedTreasuryShares,proto3" json:"accrued_treasury_shares"`
+	XXX_NoUnkeyedLiteral  struct{}          `json:"-"`
+	XXX_unrecognized      []byte            `json:"-"`
+	XXX_sizecache         int32             `json:"-"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dfacd17f76421fa4, []int{2}
+	return fileDescriptor_dfacd17f76421fa4, []int{9}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GenesisState.Unmarshal(m, b)
@@ -346,59 +936,268 @@ func (m *GenesisState) GetSystemContracts() SystemContracts {
 	return SystemContracts{}
 }
 
+func (m *GenesisState) GetEpoch() EpochInfo {
+	if m != nil {
+		return m.Epoch
+	}
+	return EpochInfo{}
+}
+
+func (m *GenesisState) GetRevenue() []RevenueRecord {
+	if m != nil {
+		return m.Revenue
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEpochRevenue() []EpochRevenue {
+	if m != nil {
+		return m.EpochRevenue
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingParams() []PendingParams {
+	if m != nil {
+		return m.PendingParams
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAccruedFeeShares() []AccruedFeeShare {
+	if m != nil {
+		return m.AccruedFeeShares
+	}
+	return nil
+}
+
+func (m *GenesisState) GetContractRevenues() []ContractRevenue {
+	if m != nil {
+		return m.ContractRevenues
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSponsorships() []Sponsorship {
+	if m != nil {
+		return m.Sponsorships
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextSponsorshipId() uint64 {
+	if m != nil {
+		return m.NextSponsorshipId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetReconciliation() []ReconciliationRecord {
+	if m != nil {
+		return m.Reconciliation
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVoteLocks() []VoteLock {
+	if m != nil {
+		return m.VoteLocks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVoteCheckpoints() []VoteCheckpoint {
+	if m != nil {
+		return m.VoteCheckpoints
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVoteSupplyCheckpoints() []VoteCheckpoint {
+	if m != nil {
+		return m.VoteSupplyCheckpoints
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStakeVoters() []StakeVoter {
+	if m != nil {
+		return m.StakeVoters
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStakeVoteCheckpoints() []VoteCheckpoint {
+	if m != nil {
+		return m.StakeVoteCheckpoints
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStakeVoteSupplyCheckpoints() []VoteCheckpoint {
+	if m != nil {
+		return m.StakeVoteSupplyCheckpoints
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCircuitBreakers() []CircuitBreaker {
+	if m != nil {
+		return m.CircuitBreakers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPreconfirmSignerSets() []PreconfirmSignerSet {
+	if m != nil {
+		return m.PreconfirmSignerSets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLegacyNyxt() *LegacyNYXT {
+	if m != nil {
+		return m.LegacyNyxt
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAccruedTreasuryShares() []AccruedFeeShare {
+	if m != nil {
+		return m.AccruedTreasuryShares
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("ynx.ynx.v1.SystemDeployMode", SystemDeployMode_name, SystemDeployMode_value)
 	proto.RegisterType((*SystemConfig)(nil), "ynx.ynx.v1.SystemConfig")
+	proto.RegisterType((*SystemAirdrop)(nil), "ynx.ynx.v1.SystemAirdrop")
+	proto.RegisterType((*SystemManifest)(nil), "ynx.ynx.v1.SystemManifest")
+	proto.RegisterType((*SystemManifestContract)(nil), "ynx.ynx.v1.SystemManifestContract")
+	proto.RegisterType((*SystemManifestCall)(nil), "ynx.ynx.v1.SystemManifestCall")
+	proto.RegisterType((*SystemManifestArg)(nil), "ynx.ynx.v1.SystemManifestArg")
 	proto.RegisterType((*SystemContracts)(nil), "ynx.ynx.v1.SystemContracts")
+	proto.RegisterType((*SystemContractEntry)(nil), "ynx.ynx.v1.SystemContractEntry")
+	proto.RegisterType((*SystemContractAttestation)(nil), "ynx.ynx.v1.SystemContractAttestation")
 	proto.RegisterType((*GenesisState)(nil), "ynx.ynx.v1.GenesisState")
 }
 
 func init() { proto.RegisterFile("ynx/ynx/v1/genesis.proto", fileDescriptor_dfacd17f76421fa4) }
 
 var fileDescriptor_dfacd17f76421fa4 = []byte{
-	// 717 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0xdd, 0x6e, 0xdb, 0x36,
-	0x14, 0x9e, 0x33, 0xc7, 0x89, 0x29, 0x3b, 0x76, 0x98, 0x2c, 0xd3, 0x92, 0x8b, 0x79, 0x01, 0x06,
-	0x78, 0xd8, 0x66, 0x27, 0xde, 0x30, 0xe4, 0x6a, 0x40, 0x7e, 0x80, 0x61, 0xc3, 0x30, 0x04, 0xca,
-	0x50, 0xb4, 0xbd, 0x11, 0x28, 0x89, 0x96, 0xd9, 0x4a, 0xa4, 0x4a, 0x52, 0x86, 0xf5, 0x54, 0x7d,
-	0x8b, 0xa2, 0x4f, 0xd1, 0xc7, 0xe8, 0x75, 0xc1, 0x43, 0x52, 0x36, 0x8a, 0x5e, 0x08, 0x10, 0xbf,
-	0x1f, 0xf9, 0x9c, 0xc3, 0xcf, 0x07, 0x85, 0x0d, 0xdf, 0xcc, 0xcd, 0xb3, 0xbe, 0x9e, 0xe7, 0x94,
-	0x53, 0xc5, 0xd4, 0xac, 0x92, 0x42, 0x0b, 0x8c, 0x1a, 0xbe, 0x99, 0x99, 0x67, 0x7d, 0x7d, 0x7e,
-	0x9a, 0x8b, 0x5c, 0x00, 0x3c, 0x37, 0x6f, 0x56, 0x71, 0xfe, 0xed, 0x8e, 0xb7, 0x22, 0x92, 0x94,
-	0xce, 0x7a, 0xf9, 0x71, 0x1f, 0x0d, 0x9e, 0x1a, 0xa5, 0x69, 0x79, 0x2f, 0xf8, 0x92, 0xe5, 0x38,
-	0x44, 0x07, 0x94, 0x93, 0xa4, 0xa0, 0x59, 0xd8, 0x99, 0x74, 0xa6, 0x87, 0x91, 0x3f, 0xe2, 0x9f,
-	0xd0, 0x38, 0xa3, 0x55, 0x21, 0x1a, 0x2a, 0x63, 0x92, 0x65, 0x92, 0x2a, 0x15, 0xee, 0x4d, 0x3a,
-	0xd3, 0x7e, 0x34, 0xf2, 0xf8, 0xad, 0x85, 0xf1, 0x0d, 0x0a, 0x35, 0x25, 0x65, 0x9c, 0x50, 0x4e,
-	0x97, 0x2c, 0x65, 0x44, 0x36, 0xad, 0xe5, 0x6b, 0xb0, 0x9c, 0x19, 0xfe, 0x6e, 0x4b, 0x7b, 0xe7,
-	0x9f, 0xe8, 0x22, 0x15, 0x65, 0x59, 0x73, 0xa6, 0x9b, 0x58, 0xd2, 0x94, 0x55, 0x8c, 0x72, 0xdd,
-	0x9a, 0xbb, 0x60, 0xfe, 0xae, 0x95, 0x44, 0x5e, 0xe1, 0xfd, 0x3f, 0xa2, 0x23, 0x37, 0x9b, 0x58,
-	0xd5, 0x55, 0x55, 0x34, 0xe1, 0x3e, 0x58, 0x86, 0x0e, 0x7d, 0x02, 0x10, 0xff, 0x80, 0x06, 0x50,
-	0x60, 0x45, 0x65, 0x4a, 0xb9, 0x0e, 0x7b, 0x93, 0xce, 0x74, 0x18, 0x05, 0x06, 0x7b, 0xb4, 0x90,
-	0x69, 0x57, 0x4b, 0x4a, 0x54, 0x2d, 0x9b, 0x56, 0x76, 0x00, 0xb2, 0x91, 0xc7, 0xbd, 0xf4, 0x67,
-	0x74, 0xbc, 0x2d, 0xda, 0x6b, 0x0f, 0x41, 0x3b, 0x6e, 0x09, 0x2f, 0x9e, 0xa1, 0x93, 0xb5, 0xd0,
-	0x8c, 0xe7, 0x71, 0x46, 0x0b, 0xd2, 0xc4, 0x49, 0x21, 0xd2, 0xd7, 0x2a, 0xec, 0x4f, 0x3a, 0xd3,
-	0x6e, 0x74, 0x6c, 0xa9, 0x07, 0xc3, 0xdc, 0x01, 0x81, 0xaf, 0xd0, 0xa9, 0xd3, 0x57, 0x54, 0x32,
-	0x91, 0x79, 0x03, 0x02, 0x03, 0xb6, 0xdc, 0x23, 0x50, 0xce, 0xf1, 0x2b, 0xc2, 0x95, 0x14, 0x95,
-	0x50, 0xa4, 0x88, 0xf5, 0x4a, 0x52, 0xb5, 0x12, 0x45, 0x16, 0x06, 0x30, 0x87, 0x63, 0xcf, 0xfc,
-	0xef, 0x09, 0xd3, 0x68, 0x2b, 0xcf, 0x68, 0x25, 0x14, 0xd3, 0xe1, 0xc0, 0xde, 0xab, 0xc7, 0x1f,
-	0x2c, 0x6c, 0xa6, 0xfb, 0xa6, 0x16, 0xb2, 0xde, 0x0e, 0x6e, 0x08, 0x55, 0x0c, 0x2d, 0xea, 0x5b,
-	0xfc, 0x1d, 0x9d, 0x69, 0x56, 0x52, 0x53, 0x8d, 0x6b, 0x52, 0xd1, 0x54, 0xf0, 0x4c, 0x85, 0x47,
-	0x20, 0x3f, 0xf5, 0x2c, 0xf4, 0xf9, 0x64, 0x39, 0xbc, 0x40, 0xdf, 0xac, 0xa9, 0x82, 0x4e, 0xd3,
-	0x82, 0x2d, 0x97, 0xad, 0x69, 0x04, 0xa6, 0x13, 0x47, 0xde, 0x1b, 0xce, 0x7b, 0x6e, 0x50, 0xe8,
-	0x3d, 0x59, 0x2d, 0x89, 0x66, 0x82, 0xb7, 0xb6, 0x31, 0xd8, 0xce, 0x1c, 0xff, 0xe0, 0x68, 0xe7,
-	0xbc, 0x7c, 0xbb, 0x87, 0x46, 0x6d, 0xf0, 0xb5, 0x24, 0xa9, 0x56, 0x18, 0xa3, 0x2e, 0x6f, 0x36,
-	0x1a, 0x82, 0xdf, 0x8f, 0xe0, 0x1d, 0x9f, 0xa3, 0x43, 0x5f, 0xad, 0x4b, 0x7b, 0x7b, 0x06, 0xce,
-	0x45, 0xc1, 0xc5, 0xba, 0x3d, 0x1b, 0x2e, 0x17, 0x6b, 0x2a, 0xb9, 0x90, 0x2e, 0xb5, 0xed, 0xb9,
-	0x4d, 0x9f, 0x2b, 0xcd, 0x45, 0x14, 0xd2, 0xf7, 0xcc, 0x42, 0x46, 0x22, 0x64, 0x1e, 0x4b, 0x9a,
-	0x33, 0xa5, 0x65, 0x03, 0x01, 0xed, 0x47, 0x81, 0x90, 0x79, 0xe4, 0x20, 0x73, 0x6f, 0xaa, 0x4e,
-	0x5e, 0xd1, 0x54, 0x6f, 0x65, 0x07, 0xf6, 0xde, 0x1c, 0xde, 0x4a, 0x27, 0x28, 0x20, 0x32, 0x61,
-	0xda, 0x8e, 0x00, 0xa2, 0xd9, 0x8f, 0x76, 0x21, 0xf3, 0x7b, 0x99, 0x28, 0x09, 0xe3, 0x31, 0xe3,
-	0x89, 0xd8, 0x40, 0x1c, 0xfb, 0x51, 0x60, 0xb1, 0xbf, 0x0d, 0x74, 0xf9, 0xae, 0x83, 0x06, 0x7f,
-	0xb9, 0x7f, 0x91, 0x26, 0x9a, 0xe2, 0x2b, 0xd4, 0xb3, 0xbb, 0x04, 0x06, 0x16, 0x2c, 0xf0, 0x6c,
-	0xbb, 0x87, 0x66, 0x8f, 0xc0, 0xdc, 0x75, 0xdf, 0x7f, 0xf8, 0xfe, 0xab, 0xc8, 0xe9, 0xf0, 0x1f,
-	0xa8, 0xa7, 0x60, 0xe6, 0x30, 0xca, 0x60, 0x11, 0xee, 0x3a, 0x76, 0xd7, 0x90, 0xf7, 0x59, 0x35,
-	0xfe, 0x17, 0x8d, 0xed, 0x5b, 0x9c, 0xfa, 0xcb, 0x82, 0x81, 0x07, 0x8b, 0x8b, 0x2f, 0x7e, 0xc1,
-	0x4a, 0xdc, 0x47, 0x46, 0xea, 0x33, 0x78, 0xf6, 0xf2, 0x97, 0x9c, 0xe9, 0x55, 0x9d, 0xcc, 0x52,
-	0x51, 0xce, 0xff, 0x61, 0x64, 0x45, 0xc4, 0x6d, 0x91, 0xd4, 0x6a, 0xfe, 0xe2, 0xbf, 0xe7, 0xf3,
-	0x74, 0x45, 0x18, 0x9f, 0xdb, 0x6d, 0xa9, 0x9b, 0x8a, 0xaa, 0xa4, 0x07, 0xab, 0xf2, 0xb7, 0x4f,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0xdd, 0x06, 0x6e, 0x52, 0x81, 0x05, 0x00, 0x00,
+	// 1806 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x58, 0xcd, 0x72, 0x23, 0x49,
+	0x11, 0x5e, 0xad, 0xfc, 0x9b, 0xb2, 0x6c, 0xb9, 0xc6, 0xd6, 0xf4, 0x78, 0xfe, 0x84, 0x82, 0x89,
+	0xf0, 0x02, 0x6b, 0xef, 0x18, 0x98, 0xdd, 0x25, 0x80, 0x0d, 0xf9, 0x67, 0x60, 0xd8, 0x19, 0x8f,
+	0x69, 0x3b, 0x86, 0x31, 0x1c, 0x3a, 0x4a, 0xdd, 0x29, 0xa9, 0x70, 0xab, 0xab, 0xa9, 0x2a, 0x69,
+	0xad, 0x23, 0xcf, 0x00, 0x4f, 0xc2, 0x85, 0x0b, 0x37, 0x2e, 0x3c, 0x05, 0x77, 0xde, 0x82, 0xa8,
+	0xbf, 0x56, 0xcb, 0xb2, 0xc1, 0x07, 0x47, 0xa8, 0xbe, 0xef, 0xcb, 0xac, 0xcc, 0xac, 0xae, 0xac,
+	0x2a, 0x43, 0x30, 0xc9, 0xae, 0xf7, 0xf5, 0xdf, 0xf8, 0xe5, 0x7e, 0x1f, 0x33, 0x94, 0x4c, 0xee,
+	0xe5, 0x82, 0x2b, 0x4e, 0x60, 0x92, 0x5d, 0xef, 0xe9, 0xbf, 0xf1, 0xcb, 0x9d, 0xad, 0x3e, 0xef,
+	0x73, 0x03, 0xef, 0xeb, 0x5f, 0x56, 0xb1, 0x53, 0xb6, 0x8d, 0x99, 0x88, 0x47, 0x4c, 0x39, 0xe6,
+	0x61, 0x89, 0xc9, 0xa9, 0xa0, 0x43, 0xe7, 0x74, 0xe7, 0x71, 0x99, 0x10, 0x18, 0xf3, 0xac, 0xc7,
+	0xc4, 0xf0, 0x16, 0x7f, 0x02, 0xc7, 0x98, 0x8d, 0xd0, 0x31, 0x4f, 0x4a, 0x8c, 0xcc, 0x79, 0x26,
+	0xb9, 0x90, 0x03, 0x96, 0x3b, 0xb6, 0x59, 0x62, 0xc7, 0x5c, 0xa1, 0x9b, 0xac, 0xfd, 0xf7, 0x15,
+	0x58, 0x3b, 0x9f, 0x48, 0x85, 0xc3, 0x23, 0x3d, 0x4f, 0x9f, 0x04, 0xb0, 0x8c, 0x19, 0xed, 0xa6,
+	0x98, 0x04, 0x95, 0x56, 0x65, 0x77, 0x25, 0xf4, 0x43, 0xf2, 0x19, 0x34, 0x12, 0xcc, 0x53, 0x3e,
+	0x41, 0x11, 0xd1, 0x24, 0x11, 0x28, 0x65, 0xf0, 0x69, 0xab, 0xb2, 0xbb, 0x1a, 0x6e, 0x78, 0xbc,
+	0x63, 0x61, 0xf2, 0x15, 0x04, 0x0a, 0xe9, 0x30, 0xea, 0x62, 0x86, 0x3d, 0x16, 0x33, 0x2a, 0x26,
+	0x85, 0x49, 0xd5, 0x98, 0x34, 0x35, 0x7f, 0x38, 0xa5, 0xbd, 0xe5, 0x2f, 0xe1, 0x71, 0xcc, 0x87,
+	0xc3, 0x51, 0xc6, 0xd4, 0x24, 0x12, 0x18, 0xb3, 0x9c, 0x61, 0xa6, 0x0a, 0xe3, 0x05, 0x63, 0xfc,
+	0xa8, 0x90, 0x84, 0x5e, 0xe1, 0xed, 0x5f, 0xc0, 0xba, 0x5b, 0xa2, 0x48, 0x8e, 0xf2, 0x3c, 0x9d,
+	0x04, 0x8b, 0xc6, 0xa4, 0xee, 0xd0, 0x73, 0x03, 0x92, 0xef, 0xc1, 0x9a, 0x09, 0x30, 0x47, 0x11,
+	0x63, 0xa6, 0x82, 0xa5, 0x56, 0x65, 0xb7, 0x1e, 0xd6, 0x34, 0x76, 0x66, 0x21, 0x9d, 0xae, 0x12,
+	0x48, 0xe5, 0x48, 0x4c, 0x0a, 0xd9, 0xb2, 0x91, 0x6d, 0x78, 0xdc, 0x4b, 0x7f, 0x08, 0x9b, 0xd3,
+	0xa0, 0xbd, 0x76, 0xc5, 0x68, 0x1b, 0x05, 0xe1, 0xc5, 0x7b, 0xf0, 0x60, 0xcc, 0x15, 0xcb, 0xfa,
+	0x51, 0x82, 0x29, 0x9d, 0x44, 0xdd, 0x94, 0xc7, 0x57, 0x32, 0x58, 0x6d, 0x55, 0x76, 0x17, 0xc2,
+	0x4d, 0x4b, 0x1d, 0x6b, 0xe6, 0xd0, 0x10, 0xe4, 0x0b, 0xd8, 0x72, 0xfa, 0x1c, 0x05, 0xe3, 0x89,
+	0x37, 0x00, 0x63, 0x40, 0x2c, 0x77, 0x66, 0x28, 0x67, 0xf1, 0x39, 0x90, 0x5c, 0xf0, 0x9c, 0x4b,
+	0x9a, 0x46, 0x6a, 0x20, 0x50, 0x0e, 0x78, 0x9a, 0x04, 0x35, 0x53, 0x87, 0x4d, 0xcf, 0x5c, 0x78,
+	0x42, 0x27, 0x5a, 0xc8, 0x13, 0xcc, 0xb9, 0x64, 0x2a, 0x58, 0xb3, 0xeb, 0xea, 0xf1, 0x63, 0x0b,
+	0xeb, 0xea, 0xfe, 0x69, 0xc4, 0xc5, 0x68, 0x5a, 0xb8, 0xba, 0x89, 0xa2, 0x6e, 0x51, 0x9f, 0xe2,
+	0x4f, 0xa0, 0xa9, 0xd8, 0x10, 0x75, 0x34, 0x2e, 0x49, 0xa9, 0x3f, 0xe3, 0x44, 0x06, 0xeb, 0x46,
+	0xbe, 0xe5, 0x59, 0x93, 0xe7, 0xb9, 0xe5, 0xc8, 0x01, 0x6c, 0x8f, 0x51, 0x9a, 0x4c, 0xe3, 0x94,
+	0xf5, 0x7a, 0x85, 0xd1, 0x86, 0x31, 0x7a, 0xe0, 0xc8, 0x23, 0xcd, 0x79, 0x9b, 0xaf, 0x20, 0xf0,
+	0x36, 0xc9, 0x48, 0x50, 0xc5, 0x78, 0x56, 0x98, 0x35, 0x8c, 0x59, 0xd3, 0xf1, 0xc7, 0x8e, 0xf6,
+	0x96, 0xbf, 0x80, 0x9a, 0xfd, 0x6a, 0xa3, 0x21, 0x4f, 0x30, 0xd8, 0x6c, 0x55, 0x76, 0xd7, 0x0f,
+	0x9e, 0xec, 0x4d, 0x37, 0xf4, 0x9e, 0xdd, 0x16, 0xc7, 0x46, 0xf4, 0x8e, 0x27, 0x18, 0x42, 0x52,
+	0xfc, 0xd6, 0x29, 0xfa, 0x89, 0x05, 0xf6, 0x50, 0x60, 0x16, 0x63, 0xa4, 0xd3, 0x0a, 0x88, 0x4d,
+	0xd1, 0xb1, 0xa1, 0x27, 0x2f, 0xd8, 0x10, 0xc9, 0xcf, 0x61, 0x65, 0x48, 0x33, 0xd6, 0x43, 0xa9,
+	0x82, 0x07, 0xad, 0xca, 0x6e, 0xed, 0x60, 0x67, 0x7e, 0xc6, 0x77, 0x4e, 0x71, 0xb8, 0xf0, 0xaf,
+	0x7f, 0x3f, 0xff, 0x24, 0x2c, 0x2c, 0xc8, 0xd7, 0xb0, 0x4c, 0x99, 0x48, 0x04, 0xcf, 0x83, 0x2d,
+	0x63, 0xfc, 0x68, 0xde, 0xb8, 0x63, 0x05, 0xce, 0xd6, 0xeb, 0xc9, 0x73, 0xa8, 0x65, 0x54, 0xb1,
+	0x31, 0x46, 0xd9, 0xe4, 0x5a, 0x05, 0xdb, 0x66, 0x67, 0x83, 0x85, 0x4e, 0x27, 0xd7, 0x4a, 0x7f,
+	0x65, 0x52, 0xd1, 0x2b, 0x8c, 0xbe, 0x43, 0xd6, 0x1f, 0x28, 0x4c, 0x22, 0xd3, 0x25, 0x82, 0xa6,
+	0x51, 0x12, 0xc3, 0xfd, 0xce, 0x51, 0x1f, 0x34, 0xd3, 0xfe, 0x5b, 0x05, 0xea, 0x33, 0x73, 0xea,
+	0x49, 0x86, 0x28, 0xae, 0x52, 0x8c, 0x04, 0xe7, 0xca, 0xb4, 0x8f, 0xd5, 0x10, 0x2c, 0x14, 0x72,
+	0xae, 0xcc, 0xae, 0xe3, 0x8a, 0xa6, 0x11, 0x1d, 0xf2, 0x51, 0xa6, 0x5c, 0xf7, 0xa8, 0x19, 0xac,
+	0x63, 0x20, 0xfd, 0x85, 0xc5, 0x29, 0x65, 0xc3, 0x28, 0x41, 0x9a, 0xa4, 0x2c, 0x43, 0xd3, 0x2f,
+	0x16, 0xc2, 0xba, 0x41, 0x8f, 0x1d, 0x48, 0x5e, 0xc1, 0x43, 0xf9, 0x1d, 0x62, 0x7e, 0x67, 0x8b,
+	0xd8, 0x36, 0xf4, 0xcd, 0xf6, 0xd0, 0xfe, 0x6b, 0x05, 0xd6, 0x67, 0xab, 0x4c, 0x5e, 0xc3, 0x6a,
+	0xcc, 0x33, 0x25, 0x68, 0xac, 0x64, 0x50, 0x69, 0x55, 0x77, 0x6b, 0x07, 0xed, 0xbb, 0x17, 0xe5,
+	0xc8, 0x49, 0x5d, 0x81, 0xa7, 0xa6, 0xe4, 0x67, 0xb0, 0x18, 0xd3, 0x34, 0xd5, 0x3d, 0x51, 0xfb,
+	0x78, 0xf6, 0x3f, 0x7c, 0xd0, 0x34, 0x75, 0xf6, 0xd6, 0x44, 0xd7, 0xb2, 0x79, 0xfb, 0x3c, 0x84,
+	0xc0, 0x42, 0x46, 0x87, 0xe8, 0xaa, 0x69, 0x7e, 0x93, 0x1d, 0x58, 0xa1, 0x42, 0xb1, 0x1e, 0x8d,
+	0x7d, 0x0d, 0x8b, 0xb1, 0xee, 0xdf, 0x63, 0x14, 0x92, 0xf1, 0xcc, 0x54, 0xae, 0x1e, 0xfa, 0x21,
+	0x39, 0x85, 0x46, 0xcc, 0x33, 0xa9, 0xc4, 0x28, 0x56, 0x5c, 0x44, 0x54, 0xf4, 0x75, 0xb1, 0x74,
+	0xac, 0x4f, 0xef, 0x8e, 0xb5, 0x23, 0xfa, 0x2e, 0xd4, 0x8d, 0x92, 0x71, 0x47, 0xf4, 0x65, 0xfb,
+	0xcf, 0x15, 0x20, 0xf3, 0x89, 0xe9, 0xe0, 0x7c, 0x51, 0x5c, 0xd0, 0xc5, 0x98, 0x34, 0x61, 0x69,
+	0x88, 0x6a, 0xc0, 0x13, 0x17, 0xb6, 0x1b, 0x91, 0x2f, 0x61, 0xc1, 0x84, 0x53, 0xbd, 0x7f, 0x38,
+	0xc6, 0xa0, 0xfd, 0x8f, 0x0a, 0x6c, 0xce, 0x29, 0xfe, 0x5f, 0x08, 0xe6, 0x44, 0xed, 0xfb, 0x10,
+	0xec, 0x88, 0x6c, 0xc1, 0xe2, 0x98, 0xa6, 0x23, 0x74, 0xe7, 0x93, 0x1d, 0x90, 0x27, 0xb0, 0x7a,
+	0x85, 0x71, 0x4c, 0xaf, 0x0e, 0x7e, 0xfa, 0xca, 0x7d, 0x59, 0x53, 0x80, 0x7c, 0x03, 0x2b, 0x98,
+	0xe2, 0x10, 0x33, 0x25, 0x83, 0xc5, 0xfb, 0x87, 0x5e, 0x18, 0xb5, 0xff, 0x53, 0x85, 0x8d, 0xe2,
+	0xf4, 0x75, 0xdf, 0x51, 0x13, 0x16, 0xcc, 0x1e, 0x35, 0x81, 0x1f, 0x7e, 0x1a, 0x54, 0x42, 0x33,
+	0x26, 0xcf, 0x60, 0xc5, 0xb7, 0xcd, 0xe0, 0xd3, 0x82, 0x2b, 0x30, 0xc3, 0xbb, 0x73, 0x29, 0xa8,
+	0x96, 0x78, 0x87, 0x69, 0xbe, 0xcf, 0xc7, 0x28, 0x32, 0x2e, 0x82, 0x85, 0x29, 0xef, 0x31, 0xf2,
+	0xc2, 0x1d, 0x89, 0xae, 0x71, 0x05, 0x8b, 0x85, 0xc6, 0x1c, 0x8b, 0x1f, 0x2c, 0xac, 0x65, 0x5c,
+	0xe8, 0xa6, 0xd7, 0x67, 0x52, 0x89, 0x49, 0xb0, 0x34, 0x95, 0x71, 0xd1, 0x0f, 0x1d, 0x4c, 0x3e,
+	0x87, 0x86, 0x1c, 0x75, 0xff, 0x88, 0xb1, 0x9a, 0x4a, 0x97, 0x0b, 0xe9, 0x86, 0xe3, 0x0a, 0xf9,
+	0xf7, 0xa1, 0x46, 0x45, 0x97, 0x29, 0xdb, 0xa3, 0x83, 0x95, 0x42, 0x59, 0x86, 0xf5, 0xdc, 0x09,
+	0x1f, 0x52, 0x96, 0x45, 0x2c, 0xeb, 0xf2, 0xeb, 0x60, 0x75, 0x2a, 0xb3, 0xf8, 0x1b, 0x0d, 0x93,
+	0xf7, 0xb0, 0x46, 0x95, 0x42, 0xa9, 0x8c, 0x95, 0x3e, 0x29, 0xf5, 0xd2, 0xbc, 0x98, 0x5f, 0x1a,
+	0x5f, 0xf4, 0xce, 0x54, 0xed, 0x96, 0x68, 0xc6, 0x01, 0x39, 0x2a, 0xb7, 0x88, 0x9a, 0xf1, 0xf6,
+	0xfc, 0x6e, 0x6f, 0x27, 0x99, 0x12, 0x93, 0xb9, 0xfe, 0xd0, 0x3e, 0x82, 0x07, 0xb7, 0xe8, 0x6e,
+	0xdd, 0xdf, 0x01, 0x2c, 0xcf, 0x5e, 0xb0, 0xfc, 0xb0, 0xfd, 0x97, 0x0a, 0x3c, 0xba, 0x33, 0xf6,
+	0x5b, 0x7d, 0x3d, 0xd6, 0xb1, 0x27, 0x18, 0x0d, 0xa8, 0x1c, 0xf8, 0x66, 0xa1, 0x81, 0x5f, 0x53,
+	0x39, 0x98, 0x69, 0x24, 0xd5, 0x1b, 0x8d, 0xe4, 0x33, 0x68, 0xf8, 0xdf, 0x91, 0xef, 0x28, 0x76,
+	0x07, 0x6c, 0x78, 0xfc, 0x83, 0x85, 0xdb, 0xff, 0x5c, 0x83, 0xb5, 0x5f, 0xb9, 0xfb, 0x95, 0xa2,
+	0x0a, 0xc9, 0x17, 0xb0, 0x64, 0xaf, 0xb4, 0x26, 0x94, 0xda, 0x01, 0x29, 0x57, 0xeb, 0xcc, 0x30,
+	0xae, 0x40, 0x4e, 0x47, 0x5e, 0xc1, 0x92, 0x34, 0x79, 0x99, 0x18, 0x6b, 0x07, 0xc1, 0xad, 0xf5,
+	0xed, 0x31, 0xbf, 0x87, 0x9c, 0x9a, 0xbc, 0x85, 0x86, 0xfd, 0x15, 0x4d, 0x57, 0xa8, 0x6a, 0x3c,
+	0x3c, 0xbe, 0x7b, 0x85, 0xfc, 0xe4, 0x1b, 0xf2, 0xc6, 0xde, 0x7b, 0x09, 0x8b, 0x98, 0xf3, 0x78,
+	0x60, 0x12, 0xad, 0x1d, 0x6c, 0x97, 0x5d, 0x9c, 0x68, 0xe2, 0x4d, 0xd6, 0xe3, 0xbe, 0x75, 0x1b,
+	0xa5, 0x3e, 0x94, 0xdd, 0x3d, 0xdc, 0xb5, 0x80, 0x99, 0x43, 0x39, 0xb4, 0x54, 0x88, 0x31, 0x17,
+	0x89, 0x3f, 0x94, 0x9d, 0x9e, 0x1c, 0x41, 0xdd, 0xf8, 0x88, 0xbc, 0x83, 0xa5, 0x56, 0xf5, 0x66,
+	0xea, 0x66, 0x56, 0xe7, 0xc5, 0x7f, 0x9b, 0x58, 0xc2, 0xc8, 0x6b, 0x58, 0xcf, 0x31, 0x4b, 0xcc,
+	0xfd, 0xd0, 0x96, 0x7c, 0x79, 0x3e, 0x8c, 0x33, 0xab, 0x98, 0xa9, 0x7c, 0x3d, 0x2f, 0x83, 0xe4,
+	0x3d, 0x10, 0x1a, 0xc7, 0x62, 0x84, 0x49, 0xd4, 0x43, 0x8c, 0xe4, 0x80, 0x0a, 0x94, 0xc1, 0x4a,
+	0xab, 0x7a, 0xb3, 0x94, 0x1d, 0xab, 0x7a, 0x8d, 0x78, 0xae, 0x35, 0xce, 0x5b, 0x83, 0xce, 0xc2,
+	0x92, 0x9c, 0xea, 0x4b, 0xb1, 0x2d, 0xac, 0x4f, 0x50, 0xdf, 0x72, 0xe7, 0xfc, 0xf9, 0xea, 0xcf,
+	0x26, 0xd9, 0x88, 0x67, 0x61, 0x49, 0x3a, 0xb0, 0x56, 0x7a, 0xd6, 0xf8, 0x5d, 0xfd, 0x70, 0x66,
+	0x95, 0xa7, 0xbc, 0xaf, 0x55, 0xd9, 0x44, 0x5f, 0xbd, 0x33, 0xbc, 0x56, 0x51, 0x09, 0x8c, 0x98,
+	0xbd, 0x19, 0x2f, 0x84, 0x9b, 0x9a, 0x2a, 0x79, 0x78, 0x93, 0x90, 0x53, 0x58, 0x37, 0xef, 0xaf,
+	0x98, 0xa5, 0xcc, 0x36, 0xa6, 0x35, 0x33, 0x69, 0x6b, 0x76, 0x89, 0xcb, 0x8a, 0x99, 0x95, 0xbe,
+	0x61, 0x4d, 0xbe, 0x06, 0xd0, 0xb7, 0xaa, 0xc8, 0x5e, 0xe0, 0xeb, 0xc6, 0xd7, 0x56, 0xd9, 0x97,
+	0xbe, 0x59, 0xbd, 0xe5, 0xf1, 0x95, 0xef, 0x1e, 0x63, 0x37, 0x96, 0xe4, 0x5b, 0x68, 0x18, 0xd3,
+	0x78, 0x80, 0xf1, 0x55, 0xce, 0x99, 0x3e, 0x72, 0xd6, 0x5b, 0xd5, 0x9b, 0x37, 0x48, 0xed, 0xe0,
+	0xa8, 0x90, 0xf8, 0xcf, 0x7c, 0x3c, 0x83, 0x4a, 0xf2, 0x11, 0x1e, 0x1a, 0x67, 0xf6, 0x85, 0x34,
+	0xe3, 0x73, 0xe3, 0x9e, 0x3e, 0xb7, 0xb5, 0x03, 0xfb, 0x98, 0x2a, 0x7b, 0xfe, 0x06, 0xd6, 0xec,
+	0x35, 0x52, 0xd3, 0x42, 0xdf, 0xc1, 0xb5, 0xbb, 0xe6, 0xcc, 0x22, 0x69, 0x5e, 0xfb, 0x14, 0xce,
+	0x55, 0x4d, 0x16, 0x88, 0x24, 0x1f, 0xa0, 0x39, 0x75, 0x30, 0x13, 0xd9, 0xe6, 0x3d, 0x23, 0xdb,
+	0x2a, 0xdc, 0x95, 0x03, 0x8b, 0xe1, 0x69, 0xc9, 0xef, 0x2d, 0x89, 0x93, 0x7b, 0xba, 0xdf, 0x29,
+	0xdc, 0xcf, 0x67, 0xff, 0x2d, 0x34, 0xdc, 0x1b, 0x3f, 0xea, 0x0a, 0xa4, 0x57, 0xba, 0x02, 0x0f,
+	0xe6, 0xfd, 0x1e, 0x59, 0xcd, 0xa1, 0x95, 0x14, 0xd7, 0xab, 0x19, 0x54, 0x92, 0x3f, 0x40, 0x73,
+	0xfa, 0xfa, 0x8f, 0x24, 0xeb, 0x67, 0x28, 0x22, 0x89, 0x4a, 0x06, 0x5b, 0xf3, 0x27, 0xd0, 0x59,
+	0xa1, 0x3c, 0x37, 0xc2, 0x73, 0x2c, 0xca, 0x91, 0xcf, 0x53, 0x92, 0x7c, 0x09, 0xb5, 0x14, 0xfb,
+	0x34, 0x9e, 0x4c, 0xdf, 0x03, 0x37, 0x96, 0xe9, 0xad, 0xa1, 0x4f, 0x2f, 0x3f, 0x5e, 0x84, 0x60,
+	0xa5, 0xe6, 0x9d, 0x70, 0x09, 0x0f, 0x7d, 0x9b, 0x28, 0x5e, 0xc7, 0xae, 0x57, 0x34, 0xef, 0xdb,
+	0x2b, 0xb6, 0x9d, 0x87, 0x0b, 0xe7, 0xc0, 0x70, 0xf2, 0x07, 0xbf, 0x85, 0xc6, 0xcd, 0x27, 0x17,
+	0x79, 0x0a, 0x8f, 0xce, 0x2f, 0xcf, 0x2f, 0x4e, 0xde, 0x45, 0xc7, 0x27, 0x67, 0x6f, 0xdf, 0x5f,
+	0x46, 0xef, 0xde, 0x1f, 0x9f, 0x44, 0x47, 0xe1, 0x49, 0xe7, 0xe2, 0xa4, 0xf1, 0x09, 0x79, 0x06,
+	0x3b, 0x77, 0xd2, 0x07, 0x8d, 0xca, 0xe1, 0xde, 0xef, 0x7f, 0xd4, 0x67, 0x6a, 0x30, 0xea, 0xee,
+	0xc5, 0x7c, 0xb8, 0xff, 0x1b, 0x46, 0x07, 0x94, 0x77, 0xd2, 0xee, 0x48, 0xee, 0x5f, 0x9e, 0x7e,
+	0xdc, 0x8f, 0x07, 0x94, 0x65, 0xfb, 0xf6, 0xdf, 0x22, 0x6a, 0x92, 0xa3, 0xec, 0x2e, 0x99, 0x7f,
+	0x8a, 0xfc, 0xf8, 0xbf, 0x03, 0x00, 0x58, 0x26, 0x44, 0xab, 0xf2, 0x11, 0x00, 0x00,
 }
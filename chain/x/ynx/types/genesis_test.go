@@ -1,6 +1,12 @@
 package types
 
-import "testing"
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 func TestDefaultGenesisValidates(t *testing.T) {
 	t.Parallel()
@@ -32,4 +38,74 @@ func TestSystemConfigValidateRejectsBadEnabledConfig(t *testing.T) {
 	if err := cfg.Validate(); err == nil {
 		t.Fatal("expected invalid numeric genesis supply to fail validation")
 	}
+
+	cfg.GenesisSupply = "1000"
+	cfg.ProposalThreshold = "0"
+	cfg.ProposalDeposit = "0"
+	cfg.DeployMode = SystemDeployMode(7)
+	if err := cfg.Validate(); err == nil {
+		t.Fatal("expected unknown deploy mode to fail validation")
+	}
+}
+
+func TestGenesisValidateRejectsBadRevenueLedger(t *testing.T) {
+	t.Parallel()
+
+	gs := DefaultGenesis()
+	gs.Revenue = []RevenueRecord{NewRevenueRecord("anyxt"), NewRevenueRecord("anyxt")}
+	if err := gs.Validate(); err == nil {
+		t.Fatal("expected duplicate revenue denom to fail validation")
+	}
+
+	gs = DefaultGenesis()
+	gs.Epoch = EpochInfo{Number: 1}
+	gs.EpochRevenue = []EpochRevenue{{Epoch: 2, Revenue: []RevenueRecord{NewRevenueRecord("anyxt")}}}
+	if err := gs.Validate(); err == nil {
+		t.Fatal("expected revenue for a future epoch to fail validation")
+	}
+}
+
+func TestGenesisValidateRejectsBadAccruedFeeShares(t *testing.T) {
+	t.Parallel()
+
+	gs := DefaultGenesis()
+	gs.AccruedFeeShares = []AccruedFeeShare{{Denom: "anyxt", Amount: sdkmath.NewInt(1)}}
+	if err := gs.Validate(); err != nil {
+		t.Fatalf("expected accrued burn share to validate, got error: %v", err)
+	}
+
+	gs.AccruedFeeShares = append(gs.AccruedFeeShares, AccruedFeeShare{Denom: "anyxt", Amount: sdkmath.NewInt(2)})
+	if err := gs.Validate(); err == nil {
+		t.Fatal("expected duplicate accrued fee share to fail validation")
+	}
+
+	gs.AccruedFeeShares = []AccruedFeeShare{{Denom: "anyxt", Amount: sdkmath.ZeroInt()}}
+	if err := gs.Validate(); err == nil {
+		t.Fatal("expected zero accrued fee share to fail validation")
+	}
+}
+
+func TestGenesisValidateRejectsSponsorshipIDAtNextID(t *testing.T) {
+	t.Parallel()
+
+	gs := DefaultGenesis()
+	gs.Sponsorships = []Sponsorship{{
+		Id:      1,
+		Sponsor: sdk.AccAddress(make([]byte, 20)).String(),
+		Policy: SponsorshipPolicy{
+			AllowedContracts: []string{"0x1111111111111111111111111111111111111111"},
+			DailyCap:         sdkmath.ZeroInt(),
+		},
+		Budget:     sdkmath.NewInt(10),
+		SpentToday: sdkmath.ZeroInt(),
+	}}
+
+	if err := gs.Validate(); err == nil {
+		t.Fatal("expected a sponsorship id equal to next_sponsorship_id to fail validation")
+	}
+
+	gs.NextSponsorshipId = 2
+	if err := gs.Validate(); err != nil {
+		t.Fatalf("expected genesis to validate, got error: %v", err)
+	}
 }
@@ -6,10 +6,54 @@ var (
 	ParamsKey          = collections.NewPrefix(0)
 	SystemConfigKey    = collections.NewPrefix(1)
 	SystemContractsKey = collections.NewPrefix(2)
+	EpochKey           = collections.NewPrefix(3)
+	RevenueKey         = collections.NewPrefix(4)
+	EpochRevenueKey    = collections.NewPrefix(5)
+	PendingParamsKey   = collections.NewPrefix(6)
+	AccruedFeeShareKey = collections.NewPrefix(7)
+	ContractRevenueKey = collections.NewPrefix(8)
+
+	SponsorshipKey           = collections.NewPrefix(9)
+	SponsorshipSeqKey        = collections.NewPrefix(10)
+	SponsorshipByContractKey = collections.NewPrefix(11)
+
+	ReconciliationKey = collections.NewPrefix(12)
+
+	VoteLockKey             = collections.NewPrefix(13)
+	VoteCheckpointKey       = collections.NewPrefix(14)
+	VoteSupplyCheckpointKey = collections.NewPrefix(15)
+
+	StakeVoterKey                = collections.NewPrefix(16)
+	StakeVoteCheckpointKey       = collections.NewPrefix(17)
+	StakeVoteSupplyCheckpointKey = collections.NewPrefix(18)
+	StakeVotesDirtyKey           = collections.NewPrefix(19)
+	StakeVotesDirtyValidatorKey  = collections.NewPrefix(20)
+
+	CircuitBreakerKey = collections.NewPrefix(21)
+
+	PreconfirmSignerSetKey = collections.NewPrefix(22)
+
+	HeldTxFeeKey = collections.NewPrefix(23)
+
+	LegacyNYXTKey = collections.NewPrefix(24)
+
+	AccruedTreasuryShareKey = collections.NewPrefix(25)
+
+	SponsorshipByMsgTypeKey = collections.NewPrefix(26)
 )
 
 const (
 	ModuleName = "ynx"
 	StoreKey   = ModuleName
+
+	// SponsorshipPoolName is the module account holding gas sponsorship budgets.
+	SponsorshipPoolName = "ynx_sponsorship"
+
+	// VoteEscrowName is the module account holding the native NYXT locked for voting.
+	VoteEscrowName = "ynx_vote_escrow"
+
+	// SystemDeployerName derives the address that deploys system contracts after genesis and makes
+	// their migration calls.
+	SystemDeployerName = "ynx_system_deployer"
 )
 
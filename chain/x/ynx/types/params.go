@@ -1,23 +1,43 @@
 package types
 
 import (
+	"bytes"
 	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/cosmos/gogoproto/proto"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
 	BPSDenominator = 10_000
+
+	DefaultEpochLengthBlocks = uint64(24 * 60 * 60) // 1d @ 1s blocks
+
+	// InflationTreasuryRecipientName names the inflation recipient of kind
+	// INFLATION_RECIPIENT_KIND_TREASURY that the default params and the migration of
+	// inflation_treasury_bps create.
+	InflationTreasuryRecipientName = "treasury"
 )
 
 func DefaultParams() Params {
 	return Params{
-		FounderAddress:        "",
-		TreasuryAddress:       "",
-		FeeBurnBps:            4_000,
-		FeeTreasuryBps:        1_000,
-		FeeFounderBps:         0,
-		InflationTreasuryBps:  3_000,
+		FounderAddress:    "",
+		TreasuryAddress:   "",
+		FeeBurnBps:        4_000,
+		FeeTreasuryBps:    1_000,
+		FeeFounderBps:     0,
+		EpochLengthBlocks: DefaultEpochLengthBlocks,
+		FeeDenomPolicies:  []FeeDenomPolicy{},
+		InflationRecipients: []InflationRecipient{
+			{Name: InflationTreasuryRecipientName, Kind: InflationRecipientKind_INFLATION_RECIPIENT_KIND_TREASURY, Bps: 3_000},
+		},
+
+		CircuitBreakerMaxBlocks: DefaultCircuitBreakerMaxBlocks,
 	}
 }
 
@@ -43,13 +63,364 @@ func (p Params) Validate() error {
 	if p.FeeFounderBps > BPSDenominator {
 		return fmt.Errorf("fee_founder_bps out of range: %d", p.FeeFounderBps)
 	}
-	if sum := uint64(p.FeeBurnBps) + uint64(p.FeeTreasuryBps) + uint64(p.FeeFounderBps); sum > BPSDenominator {
+	if p.FeeDeveloperBps > BPSDenominator {
+		return fmt.Errorf("fee_developer_bps out of range: %d", p.FeeDeveloperBps)
+	}
+	if sum := uint64(p.FeeBurnBps) + uint64(p.FeeTreasuryBps) + uint64(p.FeeFounderBps) + uint64(p.FeeDeveloperBps); sum > BPSDenominator {
 		return fmt.Errorf("fee split bps must be <= %d, got %d", BPSDenominator, sum)
 	}
 
-	if p.InflationTreasuryBps > BPSDenominator {
-		return fmt.Errorf("inflation_treasury_bps out of range: %d", p.InflationTreasuryBps)
+	if p.FounderFeeDecay != nil {
+		if err := p.FounderFeeDecay.Validate(); err != nil {
+			return fmt.Errorf("invalid founder_fee_decay: %w", err)
+		}
+		// The decay never goes above start_bps, so checking it bounds the split at every height.
+		if sum := uint64(p.FeeBurnBps) + uint64(p.FeeTreasuryBps) + uint64(p.FounderFeeDecay.StartBps) + uint64(p.FeeDeveloperBps); sum > BPSDenominator {
+			return fmt.Errorf("fee split bps with founder_fee_decay start_bps must be <= %d, got %d", BPSDenominator, sum)
+		}
+	}
+
+	if p.InflationTreasuryBps != 0 { //nolint:staticcheck // rejects the deprecated field
+		return fmt.Errorf("inflation_treasury_bps is deprecated and must be zero; use an inflation_recipients entry of kind %s", InflationRecipientKind_INFLATION_RECIPIENT_KIND_TREASURY)
+	}
+
+	inflationBps := uint64(0)
+	treasuryRecipients := 0
+	names := make(map[string]struct{}, len(p.InflationRecipients))
+	for _, r := range p.InflationRecipients {
+		if err := r.Validate(); err != nil {
+			return fmt.Errorf("invalid inflation_recipients %q: %w", r.Name, err)
+		}
+		if _, ok := names[r.Name]; ok {
+			return fmt.Errorf("duplicate inflation_recipients name: %s", r.Name)
+		}
+		names[r.Name] = struct{}{}
+		if r.Kind == InflationRecipientKind_INFLATION_RECIPIENT_KIND_TREASURY {
+			treasuryRecipients++
+		}
+		inflationBps += uint64(r.Bps)
+	}
+	if treasuryRecipients > 1 {
+		return fmt.Errorf("at most one inflation_recipients entry may be of kind %s, got %d", InflationRecipientKind_INFLATION_RECIPIENT_KIND_TREASURY, treasuryRecipients)
+	}
+	if inflationBps > BPSDenominator {
+		return fmt.Errorf("inflation split bps must be <= %d, got %d", BPSDenominator, inflationBps)
+	}
+
+	if p.EpochLengthBlocks == 0 || p.EpochLengthBlocks > math.MaxInt64 {
+		return fmt.Errorf("epoch_length_blocks out of range: %d", p.EpochLengthBlocks)
+	}
+
+	if p.FeeSettlementIntervalBlocks > math.MaxInt64 {
+		return fmt.Errorf("fee_settlement_interval_blocks out of range: %d", p.FeeSettlementIntervalBlocks)
+	}
+
+	if p.CircuitGuardianAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(p.CircuitGuardianAddress); err != nil {
+			return fmt.Errorf("invalid circuit_guardian_address: %w", err)
+		}
+	}
+	if p.CircuitBreakerMaxBlocks > math.MaxInt32 {
+		return fmt.Errorf("circuit_breaker_max_blocks out of range: %d", p.CircuitBreakerMaxBlocks)
+	}
+
+	seen := make(map[string]struct{}, len(p.FeeDenomPolicies))
+	for _, policy := range p.FeeDenomPolicies {
+		if err := sdk.ValidateDenom(policy.Denom); err != nil {
+			return fmt.Errorf("invalid fee_denom_policies denom: %w", err)
+		}
+		if _, ok := seen[policy.Denom]; ok {
+			return fmt.Errorf("duplicate fee_denom_policies denom: %s", policy.Denom)
+		}
+		seen[policy.Denom] = struct{}{}
+
+		if _, ok := FeeSplitMode_name[int32(policy.Mode)]; !ok || policy.Mode == FeeSplitMode_FEE_SPLIT_MODE_UNSPECIFIED {
+			return fmt.Errorf("invalid fee split mode for %s: %s", policy.Denom, policy.Mode)
+		}
 	}
 
 	return nil
 }
+
+// FeeSplitModeFor returns the fee split mode that applies to denom. Denoms without a policy use
+// FEE_SPLIT_MODE_FULL for the mint denom and FEE_SPLIT_MODE_PASSTHROUGH otherwise.
+func (p Params) FeeSplitModeFor(denom, mintDenom string) FeeSplitMode {
+	for _, policy := range p.FeeDenomPolicies {
+		if policy.Denom == denom {
+			return policy.Mode
+		}
+	}
+	if denom == mintDenom {
+		return FeeSplitMode_FEE_SPLIT_MODE_FULL
+	}
+	return FeeSplitMode_FEE_SPLIT_MODE_PASSTHROUGH
+}
+
+// EffectiveFeeFounderBps returns the founder fee share at height: fee_founder_bps, or the
+// founder_fee_decay schedule evaluated at height if one is set.
+func (p Params) EffectiveFeeFounderBps(height int64) uint32 {
+	if p.FounderFeeDecay == nil {
+		return p.FeeFounderBps
+	}
+	return p.FounderFeeDecay.BpsAt(height)
+}
+
+func (d FounderFeeDecay) Validate() error {
+	if d.StartHeight < 0 {
+		return fmt.Errorf("start_height must not be negative, got %d", d.StartHeight)
+	}
+	if d.EndHeight <= d.StartHeight {
+		return fmt.Errorf("end_height %d must be after start_height %d", d.EndHeight, d.StartHeight)
+	}
+	if d.StartBps > BPSDenominator {
+		return fmt.Errorf("start_bps out of range: %d", d.StartBps)
+	}
+	if d.EndBps > d.StartBps {
+		return fmt.Errorf("end_bps %d must not exceed start_bps %d", d.EndBps, d.StartBps)
+	}
+
+	switch d.Mode {
+	case FounderFeeDecayMode_FOUNDER_FEE_DECAY_MODE_LINEAR:
+		if d.StepBlocks != 0 {
+			return fmt.Errorf("step_blocks must be zero in linear mode, got %d", d.StepBlocks)
+		}
+	case FounderFeeDecayMode_FOUNDER_FEE_DECAY_MODE_STEP:
+		if d.StepBlocks == 0 || d.StepBlocks > uint64(d.EndHeight-d.StartHeight) {
+			return fmt.Errorf("step_blocks out of range: %d", d.StepBlocks)
+		}
+	default:
+		return fmt.Errorf("invalid mode: %s", d.Mode)
+	}
+
+	return nil
+}
+
+// BpsAt returns the scheduled founder fee share at height.
+func (d FounderFeeDecay) BpsAt(height int64) uint32 {
+	if height <= d.StartHeight {
+		return d.StartBps
+	}
+	if height >= d.EndHeight {
+		return d.EndBps
+	}
+
+	elapsed := uint64(height - d.StartHeight)
+	if d.Mode == FounderFeeDecayMode_FOUNDER_FEE_DECAY_MODE_STEP && d.StepBlocks > 0 {
+		elapsed -= elapsed % d.StepBlocks
+	}
+
+	// elapsed < duration and the bps delta is at most BPSDenominator, so the product cannot overflow.
+	duration := uint64(d.EndHeight - d.StartHeight)
+	decayed := uint64(d.StartBps-d.EndBps) * elapsed / duration
+	return d.StartBps - uint32(decayed)
+}
+
+func (r InflationRecipient) Validate() error {
+	if strings.TrimSpace(r.Name) == "" {
+		return fmt.Errorf("name must not be empty")
+	}
+	if r.Bps > BPSDenominator {
+		return fmt.Errorf("bps out of range: %d", r.Bps)
+	}
+
+	switch r.Kind {
+	case InflationRecipientKind_INFLATION_RECIPIENT_KIND_ACCOUNT:
+		if _, err := sdk.AccAddressFromBech32(r.Address); err != nil {
+			return fmt.Errorf("invalid address: %w", err)
+		}
+	case InflationRecipientKind_INFLATION_RECIPIENT_KIND_COMMUNITY_POOL:
+		if r.Address != "" {
+			return fmt.Errorf("community pool recipient must not set an address")
+		}
+	case InflationRecipientKind_INFLATION_RECIPIENT_KIND_TREASURY:
+		if r.Address != "" {
+			return fmt.Errorf("treasury recipient must not set an address")
+		}
+	default:
+		return fmt.Errorf("invalid kind: %s", r.Kind)
+	}
+
+	return nil
+}
+
+// InflationTreasuryShareBps returns the bps of the inflation recipient of kind
+// INFLATION_RECIPIENT_KIND_TREASURY, or zero if there is none.
+func (p Params) InflationTreasuryShareBps() uint32 {
+	for _, r := range p.InflationRecipients {
+		if r.Kind == InflationRecipientKind_INFLATION_RECIPIENT_KIND_TREASURY {
+			return r.Bps
+		}
+	}
+	return 0
+}
+
+// SetInflationTreasuryShareBps sets the bps of the inflation recipient of kind
+// INFLATION_RECIPIENT_KIND_TREASURY. Without one, a recipient named
+// InflationTreasuryRecipientName is added in front of the list; zero bps remove it.
+func (p *Params) SetInflationTreasuryShareBps(bps uint32) {
+	recipients := make([]InflationRecipient, 0, len(p.InflationRecipients)+1)
+	found := false
+	for _, r := range p.InflationRecipients {
+		if r.Kind == InflationRecipientKind_INFLATION_RECIPIENT_KIND_TREASURY {
+			found = true
+			if bps == 0 {
+				continue
+			}
+			r.Bps = bps
+		}
+		recipients = append(recipients, r)
+	}
+	if !found && bps != 0 {
+		recipients = append([]InflationRecipient{{
+			Name: p.unusedInflationRecipientName(InflationTreasuryRecipientName),
+			Kind: InflationRecipientKind_INFLATION_RECIPIENT_KIND_TREASURY,
+			Bps:  bps,
+		}}, recipients...)
+	}
+	p.InflationRecipients = recipients
+}
+
+// MigrateInflationTreasuryBps moves the deprecated inflation_treasury_bps into an inflation
+// recipient of kind INFLATION_RECIPIENT_KIND_TREASURY and clears it.
+func (p *Params) MigrateInflationTreasuryBps() error {
+	bps := p.InflationTreasuryBps //nolint:staticcheck // migrates the deprecated field
+	if bps == 0 {
+		return nil
+	}
+	if p.InflationTreasuryShareBps() != 0 {
+		return fmt.Errorf("inflation treasury share is set both in inflation_treasury_bps and in inflation_recipients")
+	}
+	p.SetInflationTreasuryShareBps(bps)
+	p.InflationTreasuryBps = 0 //nolint:staticcheck // migrates the deprecated field
+	return nil
+}
+
+// MigrateInflationTreasuryBps moves the deprecated inflation_treasury_bps of the scheduled params
+// into inflation_recipients. A change of inflation_treasury_bps becomes a change of
+// inflation_recipients.
+func (pp *PendingParams) MigrateInflationTreasuryBps() error {
+	if err := pp.Params.MigrateInflationTreasuryBps(); err != nil {
+		return fmt.Errorf("pending params at height %d: %w", pp.ActivationHeight, err)
+	}
+
+	fields := make([]string, 0, len(pp.Fields))
+	for _, field := range pp.Fields {
+		if field == "inflation_treasury_bps" {
+			field = "inflation_recipients"
+		}
+		if !slices.Contains(fields, field) {
+			fields = append(fields, field)
+		}
+	}
+	if pp.Fields != nil {
+		pp.Fields = fields
+	}
+	return nil
+}
+
+// unusedInflationRecipientName returns name, or name with the first numeric suffix that no
+// inflation recipient uses.
+func (p Params) unusedInflationRecipientName(name string) string {
+	used := make(map[string]bool, len(p.InflationRecipients))
+	for _, r := range p.InflationRecipients {
+		used[r.Name] = true
+	}
+	candidate := name
+	for i := 2; used[candidate]; i++ {
+		candidate = fmt.Sprintf("%s_%d", name, i)
+	}
+	return candidate
+}
+
+func (pp PendingParams) Validate() error {
+	if pp.ActivationHeight <= 0 {
+		return fmt.Errorf("pending params activation_height must be positive, got %d", pp.ActivationHeight)
+	}
+	if _, err := sdk.AccAddressFromBech32(pp.Authority); err != nil {
+		return fmt.Errorf("invalid pending params authority: %w", err)
+	}
+	if err := pp.Params.Validate(); err != nil {
+		return fmt.Errorf("pending params at height %d: %w", pp.ActivationHeight, err)
+	}
+
+	seen := make(map[string]bool, len(pp.Fields))
+	for _, field := range pp.Fields {
+		if _, ok := paramsFieldIndex[field]; !ok {
+			return fmt.Errorf("pending params at height %d: unknown field %q", pp.ActivationHeight, field)
+		}
+		if seen[field] {
+			return fmt.Errorf("pending params at height %d: duplicate field %q", pp.ActivationHeight, field)
+		}
+		seen[field] = true
+	}
+	return nil
+}
+
+// Apply returns the params in effect after the change is applied on top of current: the fields
+// listed in pp.Fields are taken from pp.Params and all others from current. A change without
+// fields replaces current in full.
+func (pp PendingParams) Apply(current Params) Params {
+	if len(pp.Fields) == 0 {
+		return pp.Params
+	}
+
+	merged := current
+	dst := reflect.ValueOf(&merged).Elem()
+	src := reflect.ValueOf(pp.Params)
+	for _, field := range pp.Fields {
+		if i, ok := paramsFieldIndex[field]; ok {
+			dst.Field(i).Set(src.Field(i))
+		}
+	}
+	return merged
+}
+
+// ChangedParamsFields returns the names of the Params fields whose values differ between from
+// and to, in field number order. Fields are compared by their encoding, so that e.g. an empty and
+// a nil list are equal.
+func ChangedParamsFields(from, to Params) ([]string, error) {
+	fromValue, toValue := reflect.ValueOf(from), reflect.ValueOf(to)
+
+	var fields []string
+	for _, field := range paramsFields {
+		var a, b Params
+		reflect.ValueOf(&a).Elem().Field(field.index).Set(fromValue.Field(field.index))
+		reflect.ValueOf(&b).Elem().Field(field.index).Set(toValue.Field(field.index))
+
+		aBz, err := proto.Marshal(&a)
+		if err != nil {
+			return nil, err
+		}
+		bBz, err := proto.Marshal(&b)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(aBz, bBz) {
+			fields = append(fields, field.name)
+		}
+	}
+	return fields, nil
+}
+
+type paramsField struct {
+	name  string
+	index int
+}
+
+// paramsFields lists the proto fields of Params by name and Go struct field index, and
+// paramsFieldIndex maps their names to the index.
+var paramsFields, paramsFieldIndex = func() ([]paramsField, map[string]int) {
+	var fields []paramsField
+	index := map[string]int{}
+
+	t := reflect.TypeOf(Params{})
+	for i := 0; i < t.NumField(); i++ {
+		for _, part := range strings.Split(t.Field(i).Tag.Get("protobuf"), ",") {
+			if name, ok := strings.CutPrefix(part, "name="); ok {
+				fields = append(fields, paramsField{name: name, index: i})
+				index[name] = i
+			}
+		}
+	}
+	return fields, index
+}()
@@ -22,6 +22,117 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// InflationRecipientKind selects where an inflation recipient's share is sent.
+type InflationRecipientKind int32
+
+const (
+	InflationRecipientKind_INFLATION_RECIPIENT_KIND_UNSPECIFIED InflationRecipientKind = 0
+	// INFLATION_RECIPIENT_KIND_ACCOUNT sends the share to address.
+	InflationRecipientKind_INFLATION_RECIPIENT_KIND_ACCOUNT InflationRecipientKind = 1
+	// INFLATION_RECIPIENT_KIND_COMMUNITY_POOL funds the x/distribution community pool. address
+	// must be empty.
+	InflationRecipientKind_INFLATION_RECIPIENT_KIND_COMMUNITY_POOL InflationRecipientKind = 2
+	// INFLATION_RECIPIENT_KIND_TREASURY sends the share to treasury_address and records it as
+	// treasury inflation in the revenue ledger. address must be empty, and at most one recipient
+	// may have this kind. Without a treasury_address the share is left for validators.
+	InflationRecipientKind_INFLATION_RECIPIENT_KIND_TREASURY InflationRecipientKind = 3
+)
+
+var InflationRecipientKind_name = map[int32]string{
+	0: "INFLATION_RECIPIENT_KIND_UNSPECIFIED",
+	1: "INFLATION_RECIPIENT_KIND_ACCOUNT",
+	2: "INFLATION_RECIPIENT_KIND_COMMUNITY_POOL",
+	3: "INFLATION_RECIPIENT_KIND_TREASURY",
+}
+
+var InflationRecipientKind_value = map[string]int32{
+	"INFLATION_RECIPIENT_KIND_UNSPECIFIED":    0,
+	"INFLATION_RECIPIENT_KIND_ACCOUNT":        1,
+	"INFLATION_RECIPIENT_KIND_COMMUNITY_POOL": 2,
+	"INFLATION_RECIPIENT_KIND_TREASURY":       3,
+}
+
+func (x InflationRecipientKind) String() string {
+	return proto.EnumName(InflationRecipientKind_name, int32(x))
+}
+
+func (InflationRecipientKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fb9197a7cc13a468, []int{0}
+}
+
+// FounderFeeDecayMode selects how the founder fee share moves from start_bps to end_bps.
+type FounderFeeDecayMode int32
+
+const (
+	FounderFeeDecayMode_FOUNDER_FEE_DECAY_MODE_UNSPECIFIED FounderFeeDecayMode = 0
+	// FOUNDER_FEE_DECAY_MODE_LINEAR interpolates the share at every block.
+	FounderFeeDecayMode_FOUNDER_FEE_DECAY_MODE_LINEAR FounderFeeDecayMode = 1
+	// FOUNDER_FEE_DECAY_MODE_STEP interpolates the share once every step_blocks blocks and keeps it
+	// constant in between.
+	FounderFeeDecayMode_FOUNDER_FEE_DECAY_MODE_STEP FounderFeeDecayMode = 2
+)
+
+var FounderFeeDecayMode_name = map[int32]string{
+	0: "FOUNDER_FEE_DECAY_MODE_UNSPECIFIED",
+	1: "FOUNDER_FEE_DECAY_MODE_LINEAR",
+	2: "FOUNDER_FEE_DECAY_MODE_STEP",
+}
+
+var FounderFeeDecayMode_value = map[string]int32{
+	"FOUNDER_FEE_DECAY_MODE_UNSPECIFIED": 0,
+	"FOUNDER_FEE_DECAY_MODE_LINEAR":      1,
+	"FOUNDER_FEE_DECAY_MODE_STEP":        2,
+}
+
+func (x FounderFeeDecayMode) String() string {
+	return proto.EnumName(FounderFeeDecayMode_name, int32(x))
+}
+
+func (FounderFeeDecayMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fb9197a7cc13a468, []int{1}
+}
+
+// FeeSplitMode selects which parts of the fee split apply to a fee denom.
+type FeeSplitMode int32
+
+const (
+	FeeSplitMode_FEE_SPLIT_MODE_UNSPECIFIED FeeSplitMode = 0
+	// FEE_SPLIT_MODE_FULL applies the burn, treasury and founder shares.
+	FeeSplitMode_FEE_SPLIT_MODE_FULL FeeSplitMode = 1
+	// FEE_SPLIT_MODE_NO_BURN applies the treasury and founder shares; the burn share is left for
+	// validators. Use it for denoms that must not be burned, e.g. IBC vouchers.
+	FeeSplitMode_FEE_SPLIT_MODE_NO_BURN FeeSplitMode = 2
+	// FEE_SPLIT_MODE_TREASURY_ONLY sends the whole protocol share (burn + treasury + founder) to
+	// treasury_address.
+	FeeSplitMode_FEE_SPLIT_MODE_TREASURY_ONLY FeeSplitMode = 3
+	// FEE_SPLIT_MODE_PASSTHROUGH leaves the whole fee for validators.
+	FeeSplitMode_FEE_SPLIT_MODE_PASSTHROUGH FeeSplitMode = 4
+)
+
+var FeeSplitMode_name = map[int32]string{
+	0: "FEE_SPLIT_MODE_UNSPECIFIED",
+	1: "FEE_SPLIT_MODE_FULL",
+	2: "FEE_SPLIT_MODE_NO_BURN",
+	3: "FEE_SPLIT_MODE_TREASURY_ONLY",
+	4: "FEE_SPLIT_MODE_PASSTHROUGH",
+}
+
+var FeeSplitMode_value = map[string]int32{
+	"FEE_SPLIT_MODE_UNSPECIFIED":   0,
+	"FEE_SPLIT_MODE_FULL":          1,
+	"FEE_SPLIT_MODE_NO_BURN":       2,
+	"FEE_SPLIT_MODE_TREASURY_ONLY": 3,
+	"FEE_SPLIT_MODE_PASSTHROUGH":   4,
+}
+
+func (x FeeSplitMode) String() string {
+	return proto.EnumName(FeeSplitMode_name, int32(x))
+}
+
+func (FeeSplitMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fb9197a7cc13a468, []int{2}
+}
+
 type Params struct {
 	// founder_address receives the protocol fee share (if enabled).
 	FounderAddress string `protobuf:"bytes,1,opt,name=founder_address,json=founderAddress,proto3" json:"founder_address,omitempty"`
@@ -33,12 +144,41 @@ type Params struct {
 	FeeTreasuryBps uint32 `protobuf:"varint,4,opt,name=fee_treasury_bps,json=feeTreasuryBps,proto3" json:"fee_treasury_bps,omitempty"`
 	// fee_founder_bps is the basis-points share of transaction fees that is sent to founder_address.
 	FeeFounderBps uint32 `protobuf:"varint,5,opt,name=fee_founder_bps,json=feeFounderBps,proto3" json:"fee_founder_bps,omitempty"`
-	// inflation_treasury_bps is the basis-points share of minted inflation (per-block provision)
-	// that is sent to treasury_address before distribution.
-	InflationTreasuryBps uint32   `protobuf:"varint,6,opt,name=inflation_treasury_bps,json=inflationTreasuryBps,proto3" json:"inflation_treasury_bps,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	// Deprecated: inflation_treasury_bps was the basis-points share of minted inflation sent to
+	// treasury_address. That share is now an inflation_recipients entry of kind
+	// INFLATION_RECIPIENT_KIND_TREASURY, and this field must be zero. The v8 upgrade and InitGenesis
+	// move a non-zero value into the list.
+	InflationTreasuryBps uint32 `protobuf:"varint,6,opt,name=inflation_treasury_bps,json=inflationTreasuryBps,proto3" json:"inflation_treasury_bps,omitempty"` // Deprecated: Do not use.
+	// epoch_length_blocks is the number of blocks per revenue accounting epoch.
+	EpochLengthBlocks uint64 `protobuf:"varint,7,opt,name=epoch_length_blocks,json=epochLengthBlocks,proto3" json:"epoch_length_blocks,omitempty"`
+	// fee_denom_policies overrides how transaction fees of individual denoms are split.
+	//
+	// Denoms without a policy use FEE_SPLIT_MODE_FULL for the mint denom and
+	// FEE_SPLIT_MODE_PASSTHROUGH otherwise.
+	FeeDenomPolicies []FeeDenomPolicy `protobuf:"bytes,8,rep,name=fee_denom_policies,json=feeDenomPolicies,proto3" json:"fee_denom_policies"`
+	// founder_fee_decay, if set, replaces fee_founder_bps with a share that winds down over a
+	// range of block heights.
+	FounderFeeDecay *FounderFeeDecay `protobuf:"bytes,9,opt,name=founder_fee_decay,json=founderFeeDecay,proto3" json:"founder_fee_decay,omitempty"`
+	// inflation_recipients receive shares of minted inflation (per-block provision) before
+	// distribution. The sum of their bps must not exceed 10000; the rest is left for validators.
+	InflationRecipients []InflationRecipient `protobuf:"bytes,10,rep,name=inflation_recipients,json=inflationRecipients,proto3" json:"inflation_recipients"`
+	// fee_settlement_interval_blocks batches the protocol fee shares. Zero burns and pays out the
+	// shares in every transaction. Otherwise the shares are held in the x/ynx module account and
+	// settled at the BeginBlock of every height divisible by fee_settlement_interval_blocks.
+	FeeSettlementIntervalBlocks uint64 `protobuf:"varint,11,opt,name=fee_settlement_interval_blocks,json=feeSettlementIntervalBlocks,proto3" json:"fee_settlement_interval_blocks,omitempty"`
+	// fee_developer_bps is the basis-points share of an EVM transaction fee that is sent to the
+	// withdraw address registered for the called contract. Calls to unregistered contracts leave it
+	// to validators.
+	FeeDeveloperBps uint32 `protobuf:"varint,12,opt,name=fee_developer_bps,json=feeDeveloperBps,proto3" json:"fee_developer_bps,omitempty"`
+	// circuit_guardian_address may trip circuit breakers, e.g. an emergency multisig account or
+	// contract. Empty disables tripping; the module authority and the timelock can always reset.
+	CircuitGuardianAddress string `protobuf:"bytes,13,opt,name=circuit_guardian_address,json=circuitGuardianAddress,proto3" json:"circuit_guardian_address,omitempty"`
+	// circuit_breaker_max_blocks bounds how many blocks a circuit breaker tripped by the guardian
+	// stays tripped.
+	CircuitBreakerMaxBlocks uint64   `protobuf:"varint,14,opt,name=circuit_breaker_max_blocks,json=circuitBreakerMaxBlocks,proto3" json:"circuit_breaker_max_blocks,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
+	XXX_unrecognized        []byte   `json:"-"`
+	XXX_sizecache           int32    `json:"-"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -100,6 +240,7 @@ func (m *Params) GetFeeFounderBps() uint32 {
 	return 0
 }
 
+// Deprecated: Do not use.
 func (m *Params) GetInflationTreasuryBps() uint32 {
 	if m != nil {
 		return m.InflationTreasuryBps
@@ -107,31 +248,402 @@ func (m *Params) GetInflationTreasuryBps() uint32 {
 	return 0
 }
 
+func (m *Params) GetEpochLengthBlocks() uint64 {
+	if m != nil {
+		return m.EpochLengthBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetFeeDenomPolicies() []FeeDenomPolicy {
+	if m != nil {
+		return m.FeeDenomPolicies
+	}
+	return nil
+}
+
+func (m *Params) GetFounderFeeDecay() *FounderFeeDecay {
+	if m != nil {
+		return m.FounderFeeDecay
+	}
+	return nil
+}
+
+func (m *Params) GetInflationRecipients() []InflationRecipient {
+	if m != nil {
+		return m.InflationRecipients
+	}
+	return nil
+}
+
+func (m *Params) GetFeeSettlementIntervalBlocks() uint64 {
+	if m != nil {
+		return m.FeeSettlementIntervalBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetFeeDeveloperBps() uint32 {
+	if m != nil {
+		return m.FeeDeveloperBps
+	}
+	return 0
+}
+
+func (m *Params) GetCircuitGuardianAddress() string {
+	if m != nil {
+		return m.CircuitGuardianAddress
+	}
+	return ""
+}
+
+func (m *Params) GetCircuitBreakerMaxBlocks() uint64 {
+	if m != nil {
+		return m.CircuitBreakerMaxBlocks
+	}
+	return 0
+}
+
+// InflationRecipient is a named sink for a share of minted inflation.
+type InflationRecipient struct {
+	// name identifies the recipient in events and the revenue ledger, e.g. "ecosystem_fund".
+	Name                 string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Kind                 InflationRecipientKind `protobuf:"varint,2,opt,name=kind,proto3,enum=ynx.ynx.v1.InflationRecipientKind" json:"kind,omitempty"`
+	Address              string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Bps                  uint32                 `protobuf:"varint,4,opt,name=bps,proto3" json:"bps,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *InflationRecipient) Reset()         { *m = InflationRecipient{} }
+func (m *InflationRecipient) String() string { return proto.CompactTextString(m) }
+func (*InflationRecipient) ProtoMessage()    {}
+func (*InflationRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb9197a7cc13a468, []int{1}
+}
+func (m *InflationRecipient) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InflationRecipient.Unmarshal(m, b)
+}
+func (m *InflationRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InflationRecipient.Marshal(b, m, deterministic)
+}
+func (m *InflationRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InflationRecipient.Merge(m, src)
+}
+func (m *InflationRecipient) XXX_Size() int {
+	return xxx_messageInfo_InflationRecipient.Size(m)
+}
+func (m *InflationRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_InflationRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InflationRecipient proto.InternalMessageInfo
+
+func (m *InflationRecipient) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *InflationRecipient) GetKind() InflationRecipientKind {
+	if m != nil {
+		return m.Kind
+	}
+	return InflationRecipientKind_INFLATION_RECIPIENT_KIND_UNSPECIFIED
+}
+
+func (m *InflationRecipient) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *InflationRecipient) GetBps() uint32 {
+	if m != nil {
+		return m.Bps
+	}
+	return 0
+}
+
+// FounderFeeDecay is a founder fee share schedule. The share is start_bps up to start_height and
+// end_bps from end_height on.
+type FounderFeeDecay struct {
+	StartHeight int64               `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	EndHeight   int64               `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	StartBps    uint32              `protobuf:"varint,3,opt,name=start_bps,json=startBps,proto3" json:"start_bps,omitempty"`
+	EndBps      uint32              `protobuf:"varint,4,opt,name=end_bps,json=endBps,proto3" json:"end_bps,omitempty"`
+	Mode        FounderFeeDecayMode `protobuf:"varint,5,opt,name=mode,proto3,enum=ynx.ynx.v1.FounderFeeDecayMode" json:"mode,omitempty"`
+	// step_blocks is the step length of FOUNDER_FEE_DECAY_MODE_STEP. It must be zero otherwise.
+	StepBlocks           uint64   `protobuf:"varint,6,opt,name=step_blocks,json=stepBlocks,proto3" json:"step_blocks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FounderFeeDecay) Reset()         { *m = FounderFeeDecay{} }
+func (m *FounderFeeDecay) String() string { return proto.CompactTextString(m) }
+func (*FounderFeeDecay) ProtoMessage()    {}
+func (*FounderFeeDecay) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb9197a7cc13a468, []int{2}
+}
+func (m *FounderFeeDecay) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FounderFeeDecay.Unmarshal(m, b)
+}
+func (m *FounderFeeDecay) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FounderFeeDecay.Marshal(b, m, deterministic)
+}
+func (m *FounderFeeDecay) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FounderFeeDecay.Merge(m, src)
+}
+func (m *FounderFeeDecay) XXX_Size() int {
+	return xxx_messageInfo_FounderFeeDecay.Size(m)
+}
+func (m *FounderFeeDecay) XXX_DiscardUnknown() {
+	xxx_messageInfo_FounderFeeDecay.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FounderFeeDecay proto.InternalMessageInfo
+
+func (m *FounderFeeDecay) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *FounderFeeDecay) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *FounderFeeDecay) GetStartBps() uint32 {
+	if m != nil {
+		return m.StartBps
+	}
+	return 0
+}
+
+func (m *FounderFeeDecay) GetEndBps() uint32 {
+	if m != nil {
+		return m.EndBps
+	}
+	return 0
+}
+
+func (m *FounderFeeDecay) GetMode() FounderFeeDecayMode {
+	if m != nil {
+		return m.Mode
+	}
+	return FounderFeeDecayMode_FOUNDER_FEE_DECAY_MODE_UNSPECIFIED
+}
+
+func (m *FounderFeeDecay) GetStepBlocks() uint64 {
+	if m != nil {
+		return m.StepBlocks
+	}
+	return 0
+}
+
+// FeeDenomPolicy is the fee split mode of a single fee denom.
+type FeeDenomPolicy struct {
+	Denom                string       `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Mode                 FeeSplitMode `protobuf:"varint,2,opt,name=mode,proto3,enum=ynx.ynx.v1.FeeSplitMode" json:"mode,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *FeeDenomPolicy) Reset()         { *m = FeeDenomPolicy{} }
+func (m *FeeDenomPolicy) String() string { return proto.CompactTextString(m) }
+func (*FeeDenomPolicy) ProtoMessage()    {}
+func (*FeeDenomPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb9197a7cc13a468, []int{3}
+}
+func (m *FeeDenomPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FeeDenomPolicy.Unmarshal(m, b)
+}
+func (m *FeeDenomPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FeeDenomPolicy.Marshal(b, m, deterministic)
+}
+func (m *FeeDenomPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDenomPolicy.Merge(m, src)
+}
+func (m *FeeDenomPolicy) XXX_Size() int {
+	return xxx_messageInfo_FeeDenomPolicy.Size(m)
+}
+func (m *FeeDenomPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDenomPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDenomPolicy proto.InternalMessageInfo
+
+func (m *FeeDenomPolicy) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FeeDenomPolicy) GetMode() FeeSplitMode {
+	if m != nil {
+		return m.Mode
+	}
+	return FeeSplitMode_FEE_SPLIT_MODE_UNSPECIFIED
+}
+
+// PendingParams is a params change scheduled to take effect at activation_height.
+type PendingParams struct {
+	// activation_height is the block height at whose BeginBlock params are applied.
+	ActivationHeight int64 `protobuf:"varint,1,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	// authority is the address that scheduled the change. Only it may cancel the change.
+	Authority string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+	Params    Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	// fields are the Params field names, e.g. "fee_burn_bps", in which params differed from the
+	// params in effect when the change was scheduled. Only these fields are copied from params at
+	// activation, so changes made in the meantime to the other fields are kept. An empty list
+	// applies params in full.
+	Fields               []string `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PendingParams) Reset()         { *m = PendingParams{} }
+func (m *PendingParams) String() string { return proto.CompactTextString(m) }
+func (*PendingParams) ProtoMessage()    {}
+func (*PendingParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb9197a7cc13a468, []int{4}
+}
+func (m *PendingParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingParams.Unmarshal(m, b)
+}
+func (m *PendingParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingParams.Marshal(b, m, deterministic)
+}
+func (m *PendingParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingParams.Merge(m, src)
+}
+func (m *PendingParams) XXX_Size() int {
+	return xxx_messageInfo_PendingParams.Size(m)
+}
+func (m *PendingParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingParams proto.InternalMessageInfo
+
+func (m *PendingParams) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+func (m *PendingParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *PendingParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *PendingParams) GetFields() []string {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("ynx.ynx.v1.InflationRecipientKind", InflationRecipientKind_name, InflationRecipientKind_value)
+	proto.RegisterEnum("ynx.ynx.v1.FounderFeeDecayMode", FounderFeeDecayMode_name, FounderFeeDecayMode_value)
+	proto.RegisterEnum("ynx.ynx.v1.FeeSplitMode", FeeSplitMode_name, FeeSplitMode_value)
 	proto.RegisterType((*Params)(nil), "ynx.ynx.v1.Params")
+	proto.RegisterType((*InflationRecipient)(nil), "ynx.ynx.v1.InflationRecipient")
+	proto.RegisterType((*FounderFeeDecay)(nil), "ynx.ynx.v1.FounderFeeDecay")
+	proto.RegisterType((*FeeDenomPolicy)(nil), "ynx.ynx.v1.FeeDenomPolicy")
+	proto.RegisterType((*PendingParams)(nil), "ynx.ynx.v1.PendingParams")
 }
 
 func init() { proto.RegisterFile("ynx/ynx/v1/params.proto", fileDescriptor_fb9197a7cc13a468) }
 
 var fileDescriptor_fb9197a7cc13a468 = []byte{
-	// 303 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xcd, 0x4a, 0xf4, 0x30,
-	0x14, 0x86, 0x99, 0xf9, 0x3e, 0x07, 0x0c, 0xce, 0x0f, 0x65, 0xd0, 0x71, 0x56, 0x83, 0x0b, 0x99,
-	0x85, 0x36, 0x0c, 0x7a, 0x03, 0xad, 0xe0, 0xc2, 0x85, 0xc8, 0xe8, 0x42, 0xdd, 0x94, 0xb4, 0x3d,
-	0x69, 0x03, 0xd3, 0xa4, 0xe4, 0x67, 0x98, 0xde, 0x97, 0x2b, 0x2f, 0xc3, 0x2b, 0x92, 0x24, 0x6d,
-	0xd1, 0x95, 0x8b, 0x03, 0xc9, 0x7b, 0x9e, 0xf3, 0x84, 0x24, 0xe8, 0xac, 0xe1, 0x07, 0x6c, 0x6b,
-	0xbf, 0xc1, 0x35, 0x91, 0xa4, 0x52, 0x61, 0x2d, 0x85, 0x16, 0x01, 0x6a, 0xf8, 0x21, 0xb4, 0xb5,
-	0xdf, 0x2c, 0xcf, 0x33, 0xa1, 0x2a, 0xa1, 0x12, 0xd7, 0xc1, 0x7e, 0xe3, 0xb1, 0xe5, 0xbc, 0x10,
-	0x85, 0xf0, 0xb9, 0x5d, 0xf9, 0xf4, 0xe2, 0x73, 0x88, 0x46, 0x4f, 0xce, 0x16, 0x44, 0x68, 0x4a,
-	0x85, 0xe1, 0x39, 0xc8, 0x84, 0xe4, 0xb9, 0x04, 0xa5, 0x16, 0x83, 0xd5, 0x60, 0x7d, 0x1c, 0x2f,
-	0xbe, 0x3e, 0xae, 0xe7, 0xad, 0x2b, 0xf2, 0x9d, 0x67, 0x2d, 0x19, 0x2f, 0xb6, 0x93, 0x76, 0xa0,
-	0x4d, 0x83, 0x3b, 0x34, 0xd3, 0x12, 0x88, 0x32, 0xb2, 0xe9, 0x1d, 0xc3, 0x3f, 0x1c, 0xd3, 0x6e,
-	0xa2, 0x93, 0xac, 0xd0, 0x09, 0x05, 0x48, 0x52, 0x23, 0x79, 0x92, 0xd6, 0x6a, 0xf1, 0x6f, 0x35,
-	0x58, 0x8f, 0xb7, 0x88, 0x02, 0xc4, 0x46, 0xf2, 0xb8, 0x56, 0xc1, 0x1a, 0xcd, 0x2c, 0xd1, 0x1f,
-	0x65, 0xa9, 0xff, 0x8e, 0x9a, 0x50, 0x80, 0x97, 0x36, 0xb6, 0xe4, 0x25, 0x9a, 0x5a, 0xb2, 0xbb,
-	0x97, 0x05, 0x8f, 0x1c, 0x38, 0xa6, 0x00, 0xf7, 0x3e, 0xb5, 0xdc, 0x2d, 0x3a, 0x65, 0x9c, 0xee,
-	0x88, 0x66, 0x82, 0xff, 0xf6, 0x8e, 0x1c, 0x3e, 0xef, 0xbb, 0x3f, 0xec, 0x71, 0xf8, 0x7e, 0x55,
-	0x30, 0x5d, 0x9a, 0x34, 0xcc, 0x44, 0x85, 0x1f, 0x18, 0x29, 0x89, 0x88, 0x76, 0xa9, 0x51, 0xf8,
-	0xed, 0xf1, 0x15, 0x67, 0x25, 0x61, 0x1c, 0xfb, 0x3f, 0xd3, 0x4d, 0x0d, 0x2a, 0x1d, 0xb9, 0x37,
-	0xbf, 0xf9, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xda, 0x08, 0x66, 0x64, 0xcb, 0x01, 0x00, 0x00,
+	// 1041 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x96, 0xdd, 0x6e, 0xe2, 0x46,
+	0x14, 0xc7, 0xd7, 0xc0, 0xb2, 0xcb, 0x21, 0x01, 0x67, 0x12, 0x25, 0x94, 0x74, 0x37, 0x04, 0x6d,
+	0xb7, 0x28, 0xbb, 0x85, 0x2e, 0x2b, 0xad, 0x2a, 0xf5, 0x8a, 0x0f, 0x93, 0xb8, 0x4b, 0x0c, 0x32,
+	0xa0, 0x36, 0xbd, 0xb1, 0x8c, 0x3d, 0xc0, 0x28, 0x30, 0xb6, 0xec, 0x21, 0x0a, 0x97, 0x7d, 0x98,
+	0xf6, 0x09, 0x7a, 0x59, 0xf5, 0xba, 0xd7, 0x7d, 0x80, 0x56, 0xea, 0x93, 0x54, 0x33, 0x1e, 0x87,
+	0x84, 0x4d, 0x36, 0x17, 0x48, 0xf6, 0xf9, 0xff, 0xe6, 0xcc, 0xf9, 0x04, 0xe0, 0x60, 0x45, 0xaf,
+	0x6b, 0xfc, 0x73, 0xf5, 0xae, 0xe6, 0xdb, 0x81, 0xbd, 0x08, 0xab, 0x7e, 0xe0, 0x31, 0x0f, 0xc1,
+	0x8a, 0x5e, 0x57, 0xf9, 0xe7, 0xea, 0x5d, 0xf1, 0x0b, 0xc7, 0x0b, 0x17, 0x5e, 0x68, 0x09, 0xa5,
+	0x16, 0xbd, 0x44, 0x58, 0x71, 0x6f, 0xea, 0x4d, 0xbd, 0xc8, 0xce, 0x9f, 0x22, 0x6b, 0xf9, 0xbf,
+	0x34, 0xa4, 0xfb, 0xc2, 0x1b, 0x6a, 0x40, 0x7e, 0xe2, 0x2d, 0xa9, 0x8b, 0x03, 0xcb, 0x76, 0xdd,
+	0x00, 0x87, 0x61, 0x41, 0x29, 0x29, 0x95, 0x4c, 0xb3, 0xf0, 0xf7, 0xef, 0xdf, 0xec, 0x49, 0x5f,
+	0x8d, 0x48, 0x19, 0xb0, 0x80, 0xd0, 0xa9, 0x99, 0x93, 0x07, 0xa4, 0x15, 0xb5, 0x40, 0x65, 0x01,
+	0xb6, 0xc3, 0x65, 0xb0, 0xba, 0xf1, 0x91, 0x78, 0xc4, 0x47, 0x3e, 0x3e, 0x11, 0x3b, 0x29, 0xc1,
+	0xd6, 0x04, 0x63, 0x6b, 0xbc, 0x0c, 0xa8, 0x35, 0xf6, 0xc3, 0x42, 0xb2, 0xa4, 0x54, 0xb6, 0x4d,
+	0x98, 0x60, 0xdc, 0x5c, 0x06, 0xb4, 0xe9, 0x87, 0xa8, 0x02, 0x2a, 0x27, 0x6e, 0xae, 0xe2, 0x54,
+	0x4a, 0x50, 0xb9, 0x09, 0xc6, 0x43, 0x69, 0xe6, 0xe4, 0x6b, 0xc8, 0x73, 0x32, 0xce, 0x8b, 0x83,
+	0x4f, 0x05, 0xb8, 0x3d, 0xc1, 0xb8, 0x13, 0x59, 0x39, 0xf7, 0x1d, 0xec, 0x13, 0x3a, 0x99, 0xdb,
+	0x8c, 0x78, 0xf4, 0xae, 0xdf, 0x34, 0xc7, 0x9b, 0x89, 0x82, 0x62, 0xee, 0xdd, 0x10, 0xb7, 0x6f,
+	0xa8, 0xc2, 0x2e, 0xf6, 0x3d, 0x67, 0x66, 0xcd, 0x31, 0x9d, 0xb2, 0x99, 0x35, 0x9e, 0x7b, 0xce,
+	0x65, 0x58, 0x78, 0x56, 0x52, 0x2a, 0x29, 0x73, 0x47, 0x48, 0x5d, 0xa1, 0x34, 0x85, 0x80, 0x0c,
+	0x40, 0x3c, 0x22, 0x17, 0x53, 0x6f, 0x61, 0xf9, 0xde, 0x9c, 0x38, 0x04, 0x87, 0x85, 0xe7, 0xa5,
+	0x64, 0x25, 0x5b, 0x2f, 0x56, 0xd7, 0xad, 0xac, 0x76, 0x30, 0x6e, 0x73, 0xa8, 0xcf, 0x99, 0x55,
+	0x33, 0xf5, 0xd7, 0x3f, 0x47, 0x4f, 0x4c, 0x75, 0x72, 0xdb, 0x4a, 0x70, 0x88, 0x4e, 0x61, 0x27,
+	0xce, 0x2e, 0xf2, 0xeb, 0xd8, 0xab, 0x42, 0xa6, 0xa4, 0x54, 0xb2, 0xf5, 0xc3, 0x3b, 0xee, 0x22,
+	0x48, 0x78, 0x75, 0xec, 0x95, 0x99, 0x9f, 0xdc, 0x35, 0xa0, 0x1f, 0x61, 0x9d, 0xa0, 0x15, 0x60,
+	0x87, 0xf8, 0x04, 0x53, 0x16, 0x16, 0x40, 0x84, 0xf6, 0xf2, 0xb6, 0x2f, 0x3d, 0xe6, 0xcc, 0x18,
+	0x93, 0xe1, 0xed, 0x92, 0x4f, 0x14, 0x3e, 0x14, 0x2f, 0x79, 0x64, 0x21, 0x66, 0x6c, 0x8e, 0x17,
+	0x98, 0x32, 0x8b, 0x50, 0x86, 0x83, 0x2b, 0x7b, 0x1e, 0x17, 0x2b, 0x2b, 0x8a, 0x75, 0x38, 0xc1,
+	0x78, 0x70, 0x03, 0xe9, 0x92, 0x91, 0x65, 0x3b, 0x81, 0x9d, 0x28, 0xbd, 0x2b, 0x3c, 0xf7, 0x7c,
+	0xd9, 0xca, 0x2d, 0xd1, 0xca, 0xbc, 0xa8, 0x89, 0xb4, 0xf3, 0x96, 0x98, 0x50, 0x70, 0x48, 0xe0,
+	0x2c, 0x09, 0xb3, 0xa6, 0x4b, 0x3b, 0x70, 0x89, 0x4d, 0x6f, 0xa6, 0x71, 0xfb, 0x91, 0x69, 0xdc,
+	0x97, 0x27, 0x4f, 0xe5, 0x41, 0xa9, 0xa2, 0xef, 0xa1, 0x18, 0xfb, 0x1c, 0x07, 0xd8, 0xbe, 0xc4,
+	0x81, 0xb5, 0xb0, 0xaf, 0xe3, 0x04, 0x72, 0x22, 0x81, 0x03, 0x49, 0x34, 0x23, 0xe0, 0xdc, 0xbe,
+	0x8e, 0x82, 0x2f, 0xff, 0xa6, 0x00, 0xfa, 0xb4, 0x66, 0x08, 0x41, 0x8a, 0xda, 0x0b, 0x1c, 0x6d,
+	0x99, 0x29, 0x9e, 0xd1, 0x07, 0x48, 0x5d, 0x12, 0xea, 0x8a, 0xad, 0xc9, 0xd5, 0xcb, 0x9f, 0xaf,
+	0xfa, 0x47, 0x42, 0x5d, 0x53, 0xf0, 0xa8, 0x0e, 0xcf, 0xe2, 0x14, 0x93, 0x8f, 0xa4, 0x18, 0x83,
+	0x48, 0x85, 0xe4, 0x7a, 0x73, 0xf8, 0x63, 0xf9, 0x5f, 0x05, 0xf2, 0x1b, 0x83, 0x82, 0x8e, 0x61,
+	0x2b, 0x64, 0x76, 0xc0, 0xac, 0x19, 0x26, 0xd3, 0x19, 0x13, 0xd1, 0x26, 0xcd, 0xac, 0xb0, 0x9d,
+	0x09, 0x13, 0x7a, 0x01, 0x80, 0xa9, 0x1b, 0x03, 0x09, 0x01, 0x64, 0x30, 0x75, 0xa5, 0x7c, 0x08,
+	0x99, 0xc8, 0xc3, 0x7a, 0x9b, 0x9f, 0x0b, 0x03, 0x6f, 0xd6, 0x01, 0x3c, 0xe3, 0x67, 0xd7, 0x81,
+	0xa4, 0x31, 0x75, 0xb9, 0xf0, 0x1e, 0x52, 0x0b, 0xcf, 0xc5, 0x62, 0x5f, 0x73, 0xf5, 0xa3, 0xcf,
+	0xcc, 0xf2, 0xb9, 0xe7, 0x62, 0x53, 0xc0, 0xe8, 0x08, 0xb2, 0x21, 0xc3, 0x7e, 0xdc, 0x97, 0xb4,
+	0xe8, 0x0b, 0x70, 0x93, 0x6c, 0xc5, 0x10, 0x72, 0x77, 0x17, 0x0b, 0xed, 0xc1, 0x53, 0xb1, 0x8c,
+	0xb2, 0x0d, 0xd1, 0x0b, 0x7a, 0x2b, 0x6f, 0x8f, 0xfa, 0x50, 0xd8, 0x58, 0xcc, 0x81, 0x3f, 0x27,
+	0x6c, 0x7d, 0x6d, 0xf9, 0x4f, 0x05, 0xb6, 0xfb, 0x98, 0xba, 0x84, 0x4e, 0xe5, 0x97, 0xe9, 0x1b,
+	0xd8, 0xb1, 0x1d, 0x46, 0xae, 0xa2, 0x75, 0xba, 0x53, 0x3a, 0x75, 0x2d, 0xc8, 0x02, 0x7d, 0x80,
+	0x8c, 0xbd, 0x64, 0x33, 0x2f, 0x20, 0x6c, 0xf5, 0xe8, 0xf7, 0xe5, 0x1a, 0x45, 0xdf, 0x42, 0x3a,
+	0xfa, 0x25, 0x10, 0x55, 0xcd, 0xd6, 0xd1, 0xed, 0x30, 0xa3, 0x40, 0xe4, 0x62, 0x4a, 0x0e, 0xed,
+	0x43, 0x7a, 0x42, 0xf0, 0xdc, 0xe5, 0xc5, 0x4e, 0x56, 0x32, 0xa6, 0x7c, 0x3b, 0xf9, 0x43, 0x81,
+	0xfd, 0xfb, 0xe7, 0x0b, 0x55, 0xe0, 0x95, 0x6e, 0x74, 0xba, 0x8d, 0xa1, 0xde, 0x33, 0x2c, 0x53,
+	0x6b, 0xe9, 0x7d, 0x5d, 0x33, 0x86, 0xd6, 0x47, 0xdd, 0x68, 0x5b, 0x23, 0x63, 0xd0, 0xd7, 0x5a,
+	0x7a, 0x47, 0xd7, 0xda, 0xea, 0x13, 0xf4, 0x0a, 0x4a, 0x0f, 0x92, 0x8d, 0x56, 0xab, 0x37, 0x32,
+	0x86, 0xaa, 0x82, 0xde, 0xc0, 0xd7, 0x0f, 0x52, 0xad, 0xde, 0xf9, 0xf9, 0xc8, 0xd0, 0x87, 0x17,
+	0x56, 0xbf, 0xd7, 0xeb, 0xaa, 0x09, 0xf4, 0x15, 0x1c, 0x3f, 0x08, 0x0f, 0x4d, 0xad, 0x31, 0x18,
+	0x99, 0x17, 0x6a, 0xf2, 0xe4, 0x17, 0x05, 0x76, 0xef, 0x19, 0x0a, 0xf4, 0x1a, 0xca, 0x9d, 0xde,
+	0xc8, 0x68, 0x6b, 0xa6, 0xd5, 0xd1, 0x34, 0xab, 0xad, 0xb5, 0x1a, 0x17, 0xd6, 0x79, 0xaf, 0xad,
+	0x6d, 0x44, 0x7e, 0x0c, 0x2f, 0x1e, 0xe0, 0xba, 0xba, 0xa1, 0x35, 0x4c, 0x55, 0x41, 0x47, 0x70,
+	0xf8, 0x00, 0x32, 0x18, 0x6a, 0x7d, 0x35, 0x71, 0xf2, 0xab, 0x02, 0x5b, 0xb7, 0x47, 0x03, 0xbd,
+	0x84, 0x22, 0x27, 0x07, 0xfd, 0xae, 0x3e, 0xbc, 0xef, 0xd2, 0x03, 0xd8, 0xdd, 0xd0, 0x3b, 0xa3,
+	0x6e, 0x57, 0x55, 0x50, 0x11, 0xf6, 0x37, 0x04, 0xa3, 0x67, 0x35, 0x47, 0xa6, 0xa1, 0x26, 0x50,
+	0x09, 0xbe, 0xdc, 0xd0, 0xe2, 0x32, 0x58, 0x3d, 0xa3, 0x7b, 0xa1, 0x26, 0xef, 0xb9, 0xb6, 0xdf,
+	0x18, 0x0c, 0x86, 0x67, 0x66, 0x6f, 0x74, 0x7a, 0xa6, 0xa6, 0x9a, 0xd5, 0x9f, 0xdf, 0x4e, 0x09,
+	0x9b, 0x2d, 0xc7, 0x55, 0xc7, 0x5b, 0xd4, 0x7e, 0x20, 0xf6, 0xcc, 0xf6, 0x1a, 0xf3, 0xf1, 0x32,
+	0xac, 0x5d, 0x18, 0x3f, 0xd5, 0x9c, 0x99, 0x4d, 0x68, 0x2d, 0xfa, 0xa3, 0xc1, 0x56, 0x3e, 0x0e,
+	0xc7, 0x69, 0xf1, 0x47, 0xe1, 0xfd, 0xff, 0x03, 0x00, 0x93, 0x82, 0x81, 0x79, 0x80, 0x08, 0x00,
+	0x00,
 }
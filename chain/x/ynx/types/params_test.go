@@ -1,6 +1,11 @@
 package types
 
-import "testing"
+import (
+	"reflect"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 func TestDefaultParamsUseZeroFounderFee(t *testing.T) {
 	t.Parallel()
@@ -10,3 +15,249 @@ func TestDefaultParamsUseZeroFounderFee(t *testing.T) {
 		t.Fatalf("expected default founder fee bps to be 0, got %d", params.FeeFounderBps)
 	}
 }
+
+func TestParamsValidateRejectsZeroEpochLength(t *testing.T) {
+	t.Parallel()
+
+	params := DefaultParams()
+	params.EpochLengthBlocks = 0
+	if err := params.Validate(); err == nil {
+		t.Fatal("expected zero epoch length to fail validation")
+	}
+}
+
+func TestParamsValidateRejectsDeveloperBpsOverflow(t *testing.T) {
+	t.Parallel()
+
+	params := DefaultParams()
+	params.FeeDeveloperBps = BPSDenominator - params.FeeBurnBps - params.FeeTreasuryBps
+	if err := params.Validate(); err != nil {
+		t.Fatalf("expected developer bps filling the fee split to validate, got error: %v", err)
+	}
+
+	params.FeeDeveloperBps++
+	if err := params.Validate(); err == nil {
+		t.Fatal("expected fee split above 10000 bps to fail validation")
+	}
+}
+
+func TestParamsValidateFeeDenomPolicies(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		policies []FeeDenomPolicy
+		wantErr  bool
+	}{
+		{name: "valid", policies: []FeeDenomPolicy{{Denom: "ibc/ABC", Mode: FeeSplitMode_FEE_SPLIT_MODE_NO_BURN}}},
+		{name: "invalid denom", policies: []FeeDenomPolicy{{Denom: "!", Mode: FeeSplitMode_FEE_SPLIT_MODE_FULL}}, wantErr: true},
+		{name: "unspecified mode", policies: []FeeDenomPolicy{{Denom: "uatom"}}, wantErr: true},
+		{name: "unknown mode", policies: []FeeDenomPolicy{{Denom: "uatom", Mode: FeeSplitMode(99)}}, wantErr: true},
+		{
+			name: "duplicate denom",
+			policies: []FeeDenomPolicy{
+				{Denom: "uatom", Mode: FeeSplitMode_FEE_SPLIT_MODE_FULL},
+				{Denom: "uatom", Mode: FeeSplitMode_FEE_SPLIT_MODE_PASSTHROUGH},
+			},
+			wantErr: true,
+		},
+	} {
+		params := DefaultParams()
+		params.FeeDenomPolicies = tc.policies
+		if err := params.Validate(); (err != nil) != tc.wantErr {
+			t.Fatalf("%s: unexpected validation result: %v", tc.name, err)
+		}
+	}
+}
+
+func TestParamsFeeSplitModeFor(t *testing.T) {
+	t.Parallel()
+
+	params := DefaultParams()
+	params.FeeDenomPolicies = []FeeDenomPolicy{
+		{Denom: "ibc/ABC", Mode: FeeSplitMode_FEE_SPLIT_MODE_TREASURY_ONLY},
+		{Denom: "anyxt", Mode: FeeSplitMode_FEE_SPLIT_MODE_NO_BURN},
+	}
+
+	for denom, want := range map[string]FeeSplitMode{
+		"ibc/ABC": FeeSplitMode_FEE_SPLIT_MODE_TREASURY_ONLY,
+		"anyxt":   FeeSplitMode_FEE_SPLIT_MODE_NO_BURN,
+		"uatom":   FeeSplitMode_FEE_SPLIT_MODE_PASSTHROUGH,
+	} {
+		if got := params.FeeSplitModeFor(denom, "anyxt"); got != want {
+			t.Fatalf("%s: expected %s, got %s", denom, want, got)
+		}
+	}
+
+	if got := DefaultParams().FeeSplitModeFor("anyxt", "anyxt"); got != FeeSplitMode_FEE_SPLIT_MODE_FULL {
+		t.Fatalf("expected mint denom to default to full split, got %s", got)
+	}
+}
+
+func TestFounderFeeDecayBpsAt(t *testing.T) {
+	t.Parallel()
+
+	linear := FounderFeeDecay{
+		StartHeight: 100,
+		EndHeight:   200,
+		StartBps:    1_000,
+		EndBps:      200,
+		Mode:        FounderFeeDecayMode_FOUNDER_FEE_DECAY_MODE_LINEAR,
+	}
+	step := linear
+	step.Mode = FounderFeeDecayMode_FOUNDER_FEE_DECAY_MODE_STEP
+	step.StepBlocks = 25
+
+	for _, tc := range []struct {
+		decay  FounderFeeDecay
+		height int64
+		want   uint32
+	}{
+		{decay: linear, height: 1, want: 1_000},
+		{decay: linear, height: 100, want: 1_000},
+		{decay: linear, height: 101, want: 992},
+		{decay: linear, height: 150, want: 600},
+		{decay: linear, height: 200, want: 200},
+		{decay: linear, height: 1_000, want: 200},
+		{decay: step, height: 124, want: 1_000},
+		{decay: step, height: 125, want: 800},
+		{decay: step, height: 199, want: 400},
+		{decay: step, height: 200, want: 200},
+	} {
+		if got := tc.decay.BpsAt(tc.height); got != tc.want {
+			t.Fatalf("%s at %d: expected %d, got %d", tc.decay.Mode, tc.height, tc.want, got)
+		}
+	}
+}
+
+func TestParamsValidateFounderFeeDecay(t *testing.T) {
+	t.Parallel()
+
+	valid := FounderFeeDecay{
+		StartHeight: 10,
+		EndHeight:   20,
+		StartBps:    1_000,
+		EndBps:      0,
+		Mode:        FounderFeeDecayMode_FOUNDER_FEE_DECAY_MODE_LINEAR,
+	}
+
+	for _, tc := range []struct {
+		name    string
+		mutate  func(d *FounderFeeDecay)
+		wantErr bool
+	}{
+		{name: "valid", mutate: func(*FounderFeeDecay) {}},
+		{name: "empty range", mutate: func(d *FounderFeeDecay) { d.EndHeight = d.StartHeight }, wantErr: true},
+		{name: "increasing", mutate: func(d *FounderFeeDecay) { d.EndBps = 2_000 }, wantErr: true},
+		{name: "split overflow", mutate: func(d *FounderFeeDecay) { d.StartBps = 6_000 }, wantErr: true},
+		{name: "unspecified mode", mutate: func(d *FounderFeeDecay) { d.Mode = FounderFeeDecayMode_FOUNDER_FEE_DECAY_MODE_UNSPECIFIED }, wantErr: true},
+		{name: "linear with step", mutate: func(d *FounderFeeDecay) { d.StepBlocks = 5 }, wantErr: true},
+		{name: "step without step", mutate: func(d *FounderFeeDecay) { d.Mode = FounderFeeDecayMode_FOUNDER_FEE_DECAY_MODE_STEP }, wantErr: true},
+		{
+			name: "step",
+			mutate: func(d *FounderFeeDecay) {
+				d.Mode = FounderFeeDecayMode_FOUNDER_FEE_DECAY_MODE_STEP
+				d.StepBlocks = 5
+			},
+		},
+	} {
+		decay := valid
+		tc.mutate(&decay)
+
+		params := DefaultParams()
+		params.FounderFeeDecay = &decay
+		if err := params.Validate(); (err != nil) != tc.wantErr {
+			t.Fatalf("%s: unexpected validation result: %v", tc.name, err)
+		}
+	}
+}
+
+func TestParamsValidateInflationRecipients(t *testing.T) {
+	t.Parallel()
+
+	account := InflationRecipient{
+		Name:    "ecosystem_fund",
+		Kind:    InflationRecipientKind_INFLATION_RECIPIENT_KIND_ACCOUNT,
+		Address: sdk.AccAddress(make([]byte, 20)).String(),
+		Bps:     1_000,
+	}
+	pool := InflationRecipient{
+		Name: "community_pool",
+		Kind: InflationRecipientKind_INFLATION_RECIPIENT_KIND_COMMUNITY_POOL,
+		Bps:  1_000,
+	}
+	poolWithAddress := pool
+	poolWithAddress.Address = account.Address
+	unnamed := account
+	unnamed.Name = ""
+	tooLarge := account
+	tooLarge.Bps = 7_001
+
+	for _, tc := range []struct {
+		name       string
+		recipients []InflationRecipient
+		wantErr    bool
+	}{
+		{name: "valid", recipients: []InflationRecipient{account, pool}},
+		{name: "duplicate name", recipients: []InflationRecipient{account, account}, wantErr: true},
+		{name: "pool with address", recipients: []InflationRecipient{poolWithAddress}, wantErr: true},
+		{name: "unnamed", recipients: []InflationRecipient{unnamed}, wantErr: true},
+		{name: "unspecified kind", recipients: []InflationRecipient{{Name: "x", Bps: 1}}, wantErr: true},
+		{name: "total above denominator", recipients: []InflationRecipient{tooLarge}, wantErr: true},
+	} {
+		params := DefaultParams()
+		params.InflationRecipients = tc.recipients
+		if err := params.Validate(); (err != nil) != tc.wantErr {
+			t.Fatalf("%s: unexpected validation result: %v", tc.name, err)
+		}
+	}
+}
+
+func TestPendingParamsFields(t *testing.T) {
+	t.Parallel()
+
+	current := DefaultParams()
+	current.FeeDenomPolicies = nil
+
+	scheduled := DefaultParams()
+	scheduled.FeeBurnBps = 1234
+	scheduled.InflationRecipients = []InflationRecipient{{Name: "ecosystem", Kind: InflationRecipientKind_INFLATION_RECIPIENT_KIND_COMMUNITY_POOL, Bps: 100}}
+
+	// The nil and the empty fee denom policies are equal.
+	fields, err := ChangedParamsFields(current, scheduled)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(fields, []string{"fee_burn_bps", "inflation_recipients"}) {
+		t.Fatalf("unexpected changed fields %v", fields)
+	}
+
+	pending := PendingParams{
+		ActivationHeight: 10,
+		Authority:        sdk.AccAddress(make([]byte, 20)).String(),
+		Params:           scheduled,
+		Fields:           fields,
+	}
+	if err := pending.Validate(); err != nil {
+		t.Fatalf("expected pending params to validate, got error: %v", err)
+	}
+
+	later := current
+	later.FeeTreasuryBps = 500
+	applied := pending.Apply(later)
+	if applied.FeeBurnBps != 1234 || applied.FeeTreasuryBps != 500 || len(applied.InflationRecipients) != 1 {
+		t.Fatalf("expected the scheduled fields on top of the later params, got %+v", applied)
+	}
+
+	pending.Fields = nil
+	if applied := pending.Apply(later); !reflect.DeepEqual(applied, scheduled) {
+		t.Fatalf("expected a change without fields to apply in full, got %+v", applied)
+	}
+
+	for _, fields := range [][]string{{"fee_burn_bps", "fee_burn_bps"}, {"fee_burn"}} {
+		pending.Fields = fields
+		if err := pending.Validate(); err == nil {
+			t.Fatalf("expected fields %v to fail validation", fields)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// MaxPreconfirmSigners bounds the size of a preconfirm signer set.
+const MaxPreconfirmSigners = 64
+
+// Normalize returns the set with its signers as checksummed 0x addresses, the form sets are stored
+// in, and fails if it is invalid.
+func (s PreconfirmSignerSet) Normalize() (PreconfirmSignerSet, error) {
+	if len(s.Signers) > MaxPreconfirmSigners {
+		return PreconfirmSignerSet{}, fmt.Errorf("preconfirm signer set of epoch %d: more than %d signers", s.ActivationEpoch, MaxPreconfirmSigners)
+	}
+
+	signers := make([]string, 0, len(s.Signers))
+	seen := make(map[common.Address]struct{}, len(s.Signers))
+	for _, signer := range s.Signers {
+		if !common.IsHexAddress(signer) {
+			return PreconfirmSignerSet{}, fmt.Errorf("preconfirm signer set of epoch %d: invalid signer address: %q", s.ActivationEpoch, signer)
+		}
+		addr := common.HexToAddress(signer)
+		if addr == (common.Address{}) {
+			return PreconfirmSignerSet{}, fmt.Errorf("preconfirm signer set of epoch %d: signer address must not be zero", s.ActivationEpoch)
+		}
+		if _, ok := seen[addr]; ok {
+			return PreconfirmSignerSet{}, fmt.Errorf("preconfirm signer set of epoch %d: duplicate signer %s", s.ActivationEpoch, addr.Hex())
+		}
+		seen[addr] = struct{}{}
+		signers = append(signers, addr.Hex())
+	}
+
+	switch {
+	case len(signers) == 0 && s.Threshold != 0:
+		return PreconfirmSignerSet{}, fmt.Errorf("preconfirm signer set of epoch %d: threshold must be zero without signers", s.ActivationEpoch)
+	case len(signers) > 0 && (s.Threshold == 0 || int(s.Threshold) > len(signers)):
+		return PreconfirmSignerSet{}, fmt.Errorf(
+			"preconfirm signer set of epoch %d: threshold must be between 1 and %d, got %d", s.ActivationEpoch, len(signers), s.Threshold,
+		)
+	}
+
+	s.Signers = signers
+	return s, nil
+}
+
+func (s PreconfirmSignerSet) Validate() error {
+	normalized, err := s.Normalize()
+	if err != nil {
+		return err
+	}
+	for i, signer := range s.Signers {
+		if signer != normalized.Signers[i] {
+			return fmt.Errorf("preconfirm signer set of epoch %d: signer %s is not checksummed", s.ActivationEpoch, signer)
+		}
+	}
+	return nil
+}
+
+// HasSigner reports whether addr is one of the signers of the set.
+func (s PreconfirmSignerSet) HasSigner(addr common.Address) bool {
+	for _, signer := range s.Signers {
+		if common.HexToAddress(signer) == addr {
+			return true
+		}
+	}
+	return false
+}
+
+func validatePreconfirmSignerSets(sets []PreconfirmSignerSet) error {
+	seen := make(map[uint64]struct{}, len(sets))
+	for _, s := range sets {
+		if err := s.Validate(); err != nil {
+			return err
+		}
+		if _, ok := seen[s.ActivationEpoch]; ok {
+			return fmt.Errorf("duplicate preconfirm signer set activation epoch: %d", s.ActivationEpoch)
+		}
+		seen[s.ActivationEpoch] = struct{}{}
+	}
+	return nil
+}
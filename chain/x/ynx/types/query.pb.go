@@ -9,6 +9,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -170,38 +171,1172 @@ func (m *QuerySystemContractsResponse) GetSystemContracts() SystemContracts {
 	return SystemContracts{}
 }
 
+type QueryVerifySystemContractsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryVerifySystemContractsRequest) Reset()         { *m = QueryVerifySystemContractsRequest{} }
+func (m *QueryVerifySystemContractsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifySystemContractsRequest) ProtoMessage()    {}
+func (*QueryVerifySystemContractsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5dcbb493bb41a18a, []int{4}
+}
+func (m *QueryVerifySystemContractsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryVerifySystemContractsRequest.Unmarshal(m, b)
+}
+func (m *QueryVerifySystemContractsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryVerifySystemContractsRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryVerifySystemContractsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifySystemContractsRequest.Merge(m, src)
+}
+func (m *QueryVerifySystemContractsRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryVerifySystemContractsRequest.Size(m)
+}
+func (m *QueryVerifySystemContractsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifySystemContractsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVerifySystemContractsRequest proto.InternalMessageInfo
+
+type QueryVerifySystemContractsResponse struct {
+	Contracts []SystemContractVerification `protobuf:"bytes,1,rep,name=contracts,proto3" json:"contracts"`
+	// verified is true if every entry with an address matches both its attestation and its artifact.
+	Verified             bool     `protobuf:"varint,2,opt,name=verified,proto3" json:"verified,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryVerifySystemContractsResponse) Reset()         { *m = QueryVerifySystemContractsResponse{} }
+func (m *QueryVerifySystemContractsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifySystemContractsResponse) ProtoMessage()    {}
+func (*QueryVerifySystemContractsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5dcbb493bb41a18a, []int{5}
+}
+func (m *QueryVerifySystemContractsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryVerifySystemContractsResponse.Unmarshal(m, b)
+}
+func (m *QueryVerifySystemContractsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryVerifySystemContractsResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryVerifySystemContractsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifySystemContractsResponse.Merge(m, src)
+}
+func (m *QueryVerifySystemContractsResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryVerifySystemContractsResponse.Size(m)
+}
+func (m *QueryVerifySystemContractsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifySystemContractsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVerifySystemContractsResponse proto.InternalMessageInfo
+
+func (m *QueryVerifySystemContractsResponse) GetContracts() []SystemContractVerification {
+	if m != nil {
+		return m.Contracts
+	}
+	return nil
+}
+
+func (m *QueryVerifySystemContractsResponse) GetVerified() bool {
+	if m != nil {
+		return m.Verified
+	}
+	return false
+}
+
+// SystemContractVerification is the outcome of verifying one system contract entry.
+type SystemContractVerification struct {
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// code_hash is the 0x hex keccak256 hash of the live runtime code.
+	CodeHash string `protobuf:"bytes,3,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
+	// recorded_code_hash is the code hash of the entry's attestation, if any.
+	RecordedCodeHash string `protobuf:"bytes,4,opt,name=recorded_code_hash,json=recordedCodeHash,proto3" json:"recorded_code_hash,omitempty"`
+	// artifact is the artifact the live code is compared with: the attested artifact, or the
+	// default artifact of the entry.
+	Artifact        string `protobuf:"bytes,5,opt,name=artifact,proto3" json:"artifact,omitempty"`
+	ArtifactVersion string `protobuf:"bytes,6,opt,name=artifact_version,json=artifactVersion,proto3" json:"artifact_version,omitempty"`
+	// code_hash_matches is true if the entry has an attestation and the live code hash equals it.
+	CodeHashMatches bool `protobuf:"varint,7,opt,name=code_hash_matches,json=codeHashMatches,proto3" json:"code_hash_matches,omitempty"`
+	// artifact_matches is true if the live code is the artifact's runtime code. Immutable values
+	// are masked out on both sides before comparing.
+	ArtifactMatches      bool     `protobuf:"varint,8,opt,name=artifact_matches,json=artifactMatches,proto3" json:"artifact_matches,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SystemContractVerification) Reset()         { *m = SystemContractVerification{} }
+func (m *SystemContractVerification) String() string { return proto.CompactTextString(m) }
+func (*SystemContractVerification) ProtoMessage()    {}
+func (*SystemContractVerification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5dcbb493bb41a18a, []int{6}
+}
+func (m *SystemContractVerification) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SystemContractVerification.Unmarshal(m, b)
+}
+func (m *SystemContractVerification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SystemContractVerification.Marshal(b, m, deterministic)
+}
+func (m *SystemContractVerification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SystemContractVerification.Merge(m, src)
+}
+func (m *SystemContractVerification) XXX_Size() int {
+	return xxx_messageInfo_SystemContractVerification.Size(m)
+}
+func (m *SystemContractVerification) XXX_DiscardUnknown() {
+	xxx_messageInfo_SystemContractVerification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SystemContractVerification proto.InternalMessageInfo
+
+func (m *SystemContractVerification) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SystemContractVerification) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SystemContractVerification) GetCodeHash() string {
+	if m != nil {
+		return m.CodeHash
+	}
+	return ""
+}
+
+func (m *SystemContractVerification) GetRecordedCodeHash() string {
+	if m != nil {
+		return m.RecordedCodeHash
+	}
+	return ""
+}
+
+func (m *SystemContractVerification) GetArtifact() string {
+	if m != nil {
+		return m.Artifact
+	}
+	return ""
+}
+
+func (m *SystemContractVerification) GetArtifactVersion() string {
+	if m != nil {
+		return m.ArtifactVersion
+	}
+	return ""
+}
+
+func (m *SystemContractVerification) GetCodeHashMatches() bool {
+	if m != nil {
+		return m.CodeHashMatches
+	}
+	return false
+}
+
+func (m *SystemContractVerification) GetArtifactMatches() bool {
+	if m != nil {
+		return m.ArtifactMatches
+	}
+	return false
+}
+
+type QueryRevenueRequest struct {
+	// denom optionally restricts the response to a single denom.
+	Denom                string   `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryRevenueRequest) Reset()         { *m = QueryRevenueRequest{} }
+func (m *QueryRevenueRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRevenueRequest) ProtoMessage()    {}
+func (*QueryRevenueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5dcbb493bb41a18a, []int{7}
+}
+func (m *QueryRevenueRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryRevenueRequest.Unmarshal(m, b)
+}
+func (m *QueryRevenueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryRevenueRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryRevenueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRevenueRequest.Merge(m, src)
+}
+func (m *QueryRevenueRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryRevenueRequest.Size(m)
+}
+func (m *QueryRevenueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRevenueRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRevenueRequest proto.InternalMessageInfo
+
+func (m *QueryRevenueRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type QueryRevenueResponse struct {
+	Revenue              []RevenueRecord `protobuf:"bytes,1,rep,name=revenue,proto3" json:"revenue"`
+	Epoch                EpochInfo       `protobuf:"bytes,2,opt,name=epoch,proto3" json:"epoch"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *QueryRevenueResponse) Reset()         { *m = QueryRevenueResponse{} }
+func (m *QueryRevenueResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRevenueResponse) ProtoMessage()    {}
+func (*QueryRevenueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5dcbb493bb41a18a, []int{8}
+}
+func (m *QueryRevenueResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryRevenueResponse.Unmarshal(m, b)
+}
+func (m *QueryRevenueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryRevenueResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryRevenueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRevenueResponse.Merge(m, src)
+}
+func (m *QueryRevenueResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryRevenueResponse.Size(m)
+}
+func (m *QueryRevenueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRevenueResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRevenueResponse proto.InternalMessageInfo
+
+func (m *QueryRevenueResponse) GetRevenue() []RevenueRecord {
+	if m != nil {
+		return m.Revenue
+	}
+	return nil
+}
+
+func (m *QueryRevenueResponse) GetEpoch() EpochInfo {
+	if m != nil {
+		return m.Epoch
+	}
+	return EpochInfo{}
+}
+
+type QueryRevenueByEpochRequest struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryRevenueByEpochRequest) Reset()         { *m = QueryRevenueByEpochRequest{} }
+func (m *QueryRevenueByEpochRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRevenueByEpochRequest) ProtoMessage()    {}
+func (*QueryRevenueByEpochRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5dcbb493bb41a18a, []int{9}
+}
+func (m *QueryRevenueByEpochRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryRevenueByEpochRequest.Unmarshal(m, b)
+}
+func (m *QueryRevenueByEpochRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryRevenueByEpochRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryRevenueByEpochRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRevenueByEpochRequest.Merge(m, src)
+}
+func (m *QueryRevenueByEpochRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryRevenueByEpochRequest.Size(m)
+}
+func (m *QueryRevenueByEpochRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRevenueByEpochRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRevenueByEpochRequest proto.InternalMessageInfo
+
+func (m *QueryRevenueByEpochRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+type QueryRevenueByEpochResponse struct {
+	Epoch                uint64          `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Revenue              []RevenueRecord `protobuf:"bytes,2,rep,name=revenue,proto3" json:"revenue"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *QueryRevenueByEpochResponse) Reset()         { *m = QueryRevenueByEpochResponse{} }
+func (m *QueryRevenueByEpochResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRevenueByEpochResponse) ProtoMessage()    {}
+func (*QueryRevenueByEpochResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5dcbb493bb41a18a, []int{10}
+}
+func (m *QueryRevenueByEpochResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryRevenueByEpochResponse.Unmarshal(m, b)
+}
+func (m *QueryRevenueByEpochResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryRevenueByEpochResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryRevenueByEpochResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRevenueByEpochResponse.Merge(m, src)
+}
+func (m *QueryRevenueByEpochResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryRevenueByEpochResponse.Size(m)
+}
+func (m *QueryRevenueByEpochResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRevenueByEpochResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRevenueByEpochResponse proto.InternalMessageInfo
+
+func (m *QueryRevenueByEpochResponse) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *QueryRevenueByEpochResponse) GetRevenue() []RevenueRecord {
+	if m != nil {
+		return m.Revenue
+	}
+	return nil
+}
+
+type QueryPendingParamsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryPendingParamsRequest) Reset()         { *m = QueryPendingParamsRequest{} }
+func (m *QueryPendingParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingParamsRequest) ProtoMessage()    {}
+func (*QueryPendingParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5dcbb493bb41a18a, []int{11}
+}
+func (m *QueryPendingParamsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryPendingParamsRequest.Unmarshal(m, b)
+}
+func (m *QueryPendingParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryPendingParamsRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryPendingParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingParamsRequest.Merge(m, src)
+}
+func (m *QueryPendingParamsRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryPendingParamsRequest.Size(m)
+}
+func (m *QueryPendingParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingParamsRequest proto.InternalMessageInfo
+
+type QueryPendingParamsResponse struct {
+	PendingParams        []PendingParams `protobuf:"bytes,1,rep,name=pending_params,json=pendingParams,proto3" json:"pending_params"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *QueryPendingParamsResponse) Reset()         { *m = QueryPendingParamsResponse{} }
+func (m *QueryPendingParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingParamsResponse) ProtoMessage()    {}
+func (*QueryPendingParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5dcbb493bb41a18a, []int{12}
+}
+func (m *QueryPendingParamsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryPendingParamsResponse.Unmarshal(m, b)
+}
+func (m *QueryPendingParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryPendingParamsResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryPendingParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingParamsResponse.Merge(m, src)
+}
+func (m *QueryPendingParamsResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryPendingParamsResponse.Size(m)
+}
+func (m *QueryPendingParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingParamsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingParamsResponse) GetPendingParams() []PendingParams {
+	if m != nil {
+		return m.PendingParams
+	}
+	return nil
+}
+
+type QueryContractRevenueRequest struct {
+	// contract_address is the 0x-prefixed hex address of the contract.
+	ContractAddress      string   `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryContractRevenueRequest) Reset()         { *m = QueryContractRevenueRequest{} }
+func (m *QueryContractRevenueRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContractRevenueRequest) ProtoMessage()    {}
+func (*QueryContractRevenueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5dcbb493bb41a18a, []int{13}
+}
+func (m *QueryContractRevenueRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryContractRevenueRequest.Unmarshal(m, b)
+}
+func (m *QueryContractRevenueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryContractRevenueRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryContractRevenueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractRevenueRequest.Merge(m, src)
+}
+func (m *QueryContractRevenueRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryContractRevenueRequest.Size(m)
+}
+func (m *QueryContractRevenueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractRevenueRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractRevenueRequest proto.InternalMessageInfo
+
+func (m *QueryContractRevenueRequest) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+type QueryContractRevenueResponse struct {
+	ContractRevenue      ContractRevenue `protobuf:"bytes,1,opt,name=contract_revenue,json=contractRevenue,proto3" json:"contract_revenue"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *QueryContractRevenueResponse) Reset()         { *m = QueryContractRevenueResponse{} }
+func (m *QueryContractRevenueResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractRevenueResponse) ProtoMessage()    {}
+func (*QueryContractRevenueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5dcbb493bb41a18a, []int{14}
+}
+func (m *QueryContractRevenueResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryContractRevenueResponse.Unmarshal(m, b)
+}
+func (m *QueryContractRevenueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryContractRevenueResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryContractRevenueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractRevenueResponse.Merge(m, src)
+}
+func (m *QueryContractRevenueResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryContractRevenueResponse.Size(m)
+}
+func (m *QueryContractRevenueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractRevenueResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractRevenueResponse proto.InternalMessageInfo
+
+func (m *QueryContractRevenueResponse) GetContractRevenue() ContractRevenue {
+	if m != nil {
+		return m.ContractRevenue
+	}
+	return ContractRevenue{}
+}
+
+type QueryContractRevenuesRequest struct {
+	// deployer_address optionally restricts the response to contracts registered by a single
+	// deployer.
+	DeployerAddress      string   `protobuf:"bytes,1,opt,name=deployer_address,json=deployerAddress,proto3" json:"deployer_address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryContractRevenuesRequest) Reset()         { *m = QueryContractRevenuesRequest{} }
+func (m *QueryContractRevenuesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContractRevenuesRequest) ProtoMessage()    {}
+func (*QueryContractRevenuesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5dcbb493bb41a18a, []int{15}
+}
+func (m *QueryContractRevenuesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryContractRevenuesRequest.Unmarshal(m, b)
+}
+func (m *QueryContractRevenuesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryContractRevenuesRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryContractRevenuesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractRevenuesRequest.Merge(m, src)
+}
+func (m *QueryContractRevenuesRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryContractRevenuesRequest.Size(m)
+}
+func (m *QueryContractRevenuesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractRevenuesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractRevenuesRequest proto.InternalMessageInfo
+
+func (m *QueryContractRevenuesRequest) GetDeployerAddress() string {
+	if m != nil {
+		return m.DeployerAddress
+	}
+	return ""
+}
+
+type QueryContractRevenuesResponse struct {
+	ContractRevenues     []ContractRevenue `protobuf:"bytes,1,rep,name=contract_revenues,json=contractRevenues,proto3" json:"contract_revenues"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *QueryContractRevenuesResponse) Reset()         { *m = QueryContractRevenuesResponse{} }
+func (m *QueryContractRevenuesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractRevenuesResponse) ProtoMessage()    {}
+func (*QueryContractRevenuesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5dcbb493bb41a18a, []int{16}
+}
+func (m *QueryContractRevenuesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryContractRevenuesResponse.Unmarshal(m, b)
+}
+func (m *QueryContractRevenuesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryContractRevenuesResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryContractRevenuesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractRevenuesResponse.Merge(m, src)
+}
+func (m *QueryContractRevenuesResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryContractRevenuesResponse.Size(m)
+}
+func (m *QueryContractRevenuesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractRevenuesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractRevenuesResponse proto.InternalMessageInfo
+
+func (m *QueryContractRevenuesResponse) GetContractRevenues() []ContractRevenue {
+	if m != nil {
+		return m.ContractRevenues
+	}
+	return nil
+}
+
+type QuerySponsorshipRequest struct {
+	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QuerySponsorshipRequest) Reset()         { *m = QuerySponsorshipRequest{} }
+func (m *QuerySponsorshipRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsorshipRequest) ProtoMessage()    {}
+func (*QuerySponsorshipRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5dcbb493bb41a18a, []int{17}
+}
+func (m *QuerySponsorshipRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuerySponsorshipRequest.Unmarshal(m, b)
+}
+func (m *QuerySponsorshipRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuerySponsorshipRequest.Marshal(b, m, deterministic)
+}
+func (m *QuerySponsorshipRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsorshipRequest.Merge(m, src)
+}
+func (m *QuerySponsorshipRequest) XXX_Size() int {
+	return xxx_messageInfo_QuerySponsorshipRequest.Size(m)
+}
+func (m *QuerySponsorshipRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsorshipRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsorshipRequest proto.InternalMessageInfo
+
+func (m *QuerySponsorshipRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type QuerySponsorshipResponse struct {
+	Sponsorship          Sponsorship `protobuf:"bytes,1,opt,name=sponsorship,proto3" json:"sponsorship"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *QuerySponsorshipResponse) Reset()         { *m = QuerySponsorshipResponse{} }
+func (m *QuerySponsorshipResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsorshipResponse) ProtoMessage()    {}
+func (*QuerySponsorshipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5dcbb493bb41a18a, []int{18}
+}
+func (m *QuerySponsorshipResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuerySponsorshipResponse.Unmarshal(m, b)
+}
+func (m *QuerySponsorshipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuerySponsorshipResponse.Marshal(b, m, deterministic)
+}
+func (m *QuerySponsorshipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsorshipResponse.Merge(m, src)
+}
+func (m *QuerySponsorshipResponse) XXX_Size() int {
+	return xxx_messageInfo_QuerySponsorshipResponse.Size(m)
+}
+func (m *QuerySponsorshipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsorshipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsorshipResponse proto.InternalMessageInfo
+
+func (m *QuerySponsorshipResponse) GetSponsorship() Sponsorship {
+	if m != nil {
+		return m.Sponsorship
+	}
+	return Sponsorship{}
+}
+
+type QuerySponsorshipsRequest struct {
+	// sponsor optionally restricts the response to the sponsorships of a single sponsor.
+	Sponsor              string   `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QuerySponsorshipsRequest) Reset()         { *m = QuerySponsorshipsRequest{} }
+func (m *QuerySponsorshipsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsorshipsRequest) ProtoMessage()    {}
+func (*QuerySponsorshipsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5dcbb493bb41a18a, []int{19}
+}
+func (m *QuerySponsorshipsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuerySponsorshipsRequest.Unmarshal(m, b)
+}
+func (m *QuerySponsorshipsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuerySponsorshipsRequest.Marshal(b, m, deterministic)
+}
+func (m *QuerySponsorshipsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsorshipsRequest.Merge(m, src)
+}
+func (m *QuerySponsorshipsRequest) XXX_Size() int {
+	return xxx_messageInfo_QuerySponsorshipsRequest.Size(m)
+}
+func (m *QuerySponsorshipsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsorshipsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsorshipsRequest proto.InternalMessageInfo
+
+func (m *QuerySponsorshipsRequest) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+type QuerySponsorshipsResponse struct {
+	Sponsorships         []Sponsorship `protobuf:"bytes,1,rep,name=sponsorships,proto3" json:"sponsorships"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *QuerySponsorshipsResponse) Reset()         { *m = QuerySponsorshipsResponse{} }
+func (m *QuerySponsorshipsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsorshipsResponse) ProtoMessage()    {}
+func (*QuerySponsorshipsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5dcbb493bb41a18a, []int{20}
+}
+func (m *QuerySponsorshipsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuerySponsorshipsResponse.Unmarshal(m, b)
+}
+func (m *QuerySponsorshipsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuerySponsorshipsResponse.Marshal(b, m, deterministic)
+}
+func (m *QuerySponsorshipsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsorshipsResponse.Merge(m, src)
+}
+func (m *QuerySponsorshipsResponse) XXX_Size() int {
+	return xxx_messageInfo_QuerySponsorshipsResponse.Size(m)
+}
+func (m *QuerySponsorshipsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsorshipsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsorshipsResponse proto.InternalMessageInfo
+
+func (m *QuerySponsorshipsResponse) GetSponsorships() []Sponsorship {
+	if m != nil {
+		return m.Sponsorships
+	}
+	return nil
+}
+
+type QueryCircuitBreakersRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryCircuitBreakersRequest) Reset()         { *m = QueryCircuitBreakersRequest{} }
+func (m *QueryCircuitBreakersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCircuitBreakersRequest) ProtoMessage()    {}
+func (*QueryCircuitBreakersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5dcbb493bb41a18a, []int{21}
+}
+func (m *QueryCircuitBreakersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryCircuitBreakersRequest.Unmarshal(m, b)
+}
+func (m *QueryCircuitBreakersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryCircuitBreakersRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryCircuitBreakersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCircuitBreakersRequest.Merge(m, src)
+}
+func (m *QueryCircuitBreakersRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryCircuitBreakersRequest.Size(m)
+}
+func (m *QueryCircuitBreakersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCircuitBreakersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCircuitBreakersRequest proto.InternalMessageInfo
+
+type QueryCircuitBreakersResponse struct {
+	CircuitBreakers      []CircuitBreaker `protobuf:"bytes,1,rep,name=circuit_breakers,json=circuitBreakers,proto3" json:"circuit_breakers"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *QueryCircuitBreakersResponse) Reset()         { *m = QueryCircuitBreakersResponse{} }
+func (m *QueryCircuitBreakersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCircuitBreakersResponse) ProtoMessage()    {}
+func (*QueryCircuitBreakersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5dcbb493bb41a18a, []int{22}
+}
+func (m *QueryCircuitBreakersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryCircuitBreakersResponse.Unmarshal(m, b)
+}
+func (m *QueryCircuitBreakersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryCircuitBreakersResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryCircuitBreakersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCircuitBreakersResponse.Merge(m, src)
+}
+func (m *QueryCircuitBreakersResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryCircuitBreakersResponse.Size(m)
+}
+func (m *QueryCircuitBreakersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCircuitBreakersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCircuitBreakersResponse proto.InternalMessageInfo
+
+func (m *QueryCircuitBreakersResponse) GetCircuitBreakers() []CircuitBreaker {
+	if m != nil {
+		return m.CircuitBreakers
+	}
+	return nil
+}
+
+type QueryCircuitStatusRequest struct {
+	// msg_type_url checks a Cosmos message type. Otherwise target and selector check an EVM call.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// target is the 0x-prefixed address of the called contract or precompile.
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// selector is the 0x-prefixed 4-byte function selector of the call. It may be empty.
+	Selector             string   `protobuf:"bytes,3,opt,name=selector,proto3" json:"selector,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryCircuitStatusRequest) Reset()         { *m = QueryCircuitStatusRequest{} }
+func (m *QueryCircuitStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCircuitStatusRequest) ProtoMessage()    {}
+func (*QueryCircuitStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5dcbb493bb41a18a, []int{23}
+}
+func (m *QueryCircuitStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryCircuitStatusRequest.Unmarshal(m, b)
+}
+func (m *QueryCircuitStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryCircuitStatusRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryCircuitStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCircuitStatusRequest.Merge(m, src)
+}
+func (m *QueryCircuitStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryCircuitStatusRequest.Size(m)
+}
+func (m *QueryCircuitStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCircuitStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCircuitStatusRequest proto.InternalMessageInfo
+
+func (m *QueryCircuitStatusRequest) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *QueryCircuitStatusRequest) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *QueryCircuitStatusRequest) GetSelector() string {
+	if m != nil {
+		return m.Selector
+	}
+	return ""
+}
+
+type QueryCircuitStatusResponse struct {
+	Blocked bool `protobuf:"varint,1,opt,name=blocked,proto3" json:"blocked,omitempty"`
+	// circuit_breaker is the breaker that blocks, if blocked.
+	CircuitBreaker       *CircuitBreaker `protobuf:"bytes,2,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *QueryCircuitStatusResponse) Reset()         { *m = QueryCircuitStatusResponse{} }
+func (m *QueryCircuitStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCircuitStatusResponse) ProtoMessage()    {}
+func (*QueryCircuitStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5dcbb493bb41a18a, []int{24}
+}
+func (m *QueryCircuitStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryCircuitStatusResponse.Unmarshal(m, b)
+}
+func (m *QueryCircuitStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryCircuitStatusResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryCircuitStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCircuitStatusResponse.Merge(m, src)
+}
+func (m *QueryCircuitStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryCircuitStatusResponse.Size(m)
+}
+func (m *QueryCircuitStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCircuitStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCircuitStatusResponse proto.InternalMessageInfo
+
+func (m *QueryCircuitStatusResponse) GetBlocked() bool {
+	if m != nil {
+		return m.Blocked
+	}
+	return false
+}
+
+func (m *QueryCircuitStatusResponse) GetCircuitBreaker() *CircuitBreaker {
+	if m != nil {
+		return m.CircuitBreaker
+	}
+	return nil
+}
+
+type QueryPreconfirmSignersRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryPreconfirmSignersRequest) Reset()         { *m = QueryPreconfirmSignersRequest{} }
+func (m *QueryPreconfirmSignersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPreconfirmSignersRequest) ProtoMessage()    {}
+func (*QueryPreconfirmSignersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5dcbb493bb41a18a, []int{25}
+}
+func (m *QueryPreconfirmSignersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryPreconfirmSignersRequest.Unmarshal(m, b)
+}
+func (m *QueryPreconfirmSignersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryPreconfirmSignersRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryPreconfirmSignersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPreconfirmSignersRequest.Merge(m, src)
+}
+func (m *QueryPreconfirmSignersRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryPreconfirmSignersRequest.Size(m)
+}
+func (m *QueryPreconfirmSignersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPreconfirmSignersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPreconfirmSignersRequest proto.InternalMessageInfo
+
+type QueryPreconfirmSignersResponse struct {
+	// current_epoch is the current revenue epoch.
+	CurrentEpoch uint64 `protobuf:"varint,1,opt,name=current_epoch,json=currentEpoch,proto3" json:"current_epoch,omitempty"`
+	// active is the signer set active in current_epoch. It is unset while no set is registered.
+	Active *PreconfirmSignerSet `protobuf:"bytes,2,opt,name=active,proto3" json:"active,omitempty"`
+	// scheduled are the sets that become active in later epochs, ordered by activation epoch.
+	Scheduled            []PreconfirmSignerSet `protobuf:"bytes,3,rep,name=scheduled,proto3" json:"scheduled"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *QueryPreconfirmSignersResponse) Reset()         { *m = QueryPreconfirmSignersResponse{} }
+func (m *QueryPreconfirmSignersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPreconfirmSignersResponse) ProtoMessage()    {}
+func (*QueryPreconfirmSignersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5dcbb493bb41a18a, []int{26}
+}
+func (m *QueryPreconfirmSignersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryPreconfirmSignersResponse.Unmarshal(m, b)
+}
+func (m *QueryPreconfirmSignersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryPreconfirmSignersResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryPreconfirmSignersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPreconfirmSignersResponse.Merge(m, src)
+}
+func (m *QueryPreconfirmSignersResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryPreconfirmSignersResponse.Size(m)
+}
+func (m *QueryPreconfirmSignersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPreconfirmSignersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPreconfirmSignersResponse proto.InternalMessageInfo
+
+func (m *QueryPreconfirmSignersResponse) GetCurrentEpoch() uint64 {
+	if m != nil {
+		return m.CurrentEpoch
+	}
+	return 0
+}
+
+func (m *QueryPreconfirmSignersResponse) GetActive() *PreconfirmSignerSet {
+	if m != nil {
+		return m.Active
+	}
+	return nil
+}
+
+func (m *QueryPreconfirmSignersResponse) GetScheduled() []PreconfirmSignerSet {
+	if m != nil {
+		return m.Scheduled
+	}
+	return nil
+}
+
+// InvariantResult is the outcome of a single x/ynx invariant.
+type InvariantResult struct {
+	Route  string `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+	Broken bool   `protobuf:"varint,2,opt,name=broken,proto3" json:"broken,omitempty"`
+	// message is the formatted invariant message.
+	Message              string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InvariantResult) Reset()         { *m = InvariantResult{} }
+func (m *InvariantResult) String() string { return proto.CompactTextString(m) }
+func (*InvariantResult) ProtoMessage()    {}
+func (*InvariantResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5dcbb493bb41a18a, []int{27}
+}
+func (m *InvariantResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InvariantResult.Unmarshal(m, b)
+}
+func (m *InvariantResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InvariantResult.Marshal(b, m, deterministic)
+}
+func (m *InvariantResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvariantResult.Merge(m, src)
+}
+func (m *InvariantResult) XXX_Size() int {
+	return xxx_messageInfo_InvariantResult.Size(m)
+}
+func (m *InvariantResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvariantResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvariantResult proto.InternalMessageInfo
+
+func (m *InvariantResult) GetRoute() string {
+	if m != nil {
+		return m.Route
+	}
+	return ""
+}
+
+func (m *InvariantResult) GetBroken() bool {
+	if m != nil {
+		return m.Broken
+	}
+	return false
+}
+
+func (m *InvariantResult) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "ynx.ynx.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "ynx.ynx.v1.QueryParamsResponse")
 	proto.RegisterType((*QuerySystemContractsRequest)(nil), "ynx.ynx.v1.QuerySystemContractsRequest")
 	proto.RegisterType((*QuerySystemContractsResponse)(nil), "ynx.ynx.v1.QuerySystemContractsResponse")
+	proto.RegisterType((*QueryVerifySystemContractsRequest)(nil), "ynx.ynx.v1.QueryVerifySystemContractsRequest")
+	proto.RegisterType((*QueryVerifySystemContractsResponse)(nil), "ynx.ynx.v1.QueryVerifySystemContractsResponse")
+	proto.RegisterType((*SystemContractVerification)(nil), "ynx.ynx.v1.SystemContractVerification")
+	proto.RegisterType((*QueryRevenueRequest)(nil), "ynx.ynx.v1.QueryRevenueRequest")
+	proto.RegisterType((*QueryRevenueResponse)(nil), "ynx.ynx.v1.QueryRevenueResponse")
+	proto.RegisterType((*QueryRevenueByEpochRequest)(nil), "ynx.ynx.v1.QueryRevenueByEpochRequest")
+	proto.RegisterType((*QueryRevenueByEpochResponse)(nil), "ynx.ynx.v1.QueryRevenueByEpochResponse")
+	proto.RegisterType((*QueryPendingParamsRequest)(nil), "ynx.ynx.v1.QueryPendingParamsRequest")
+	proto.RegisterType((*QueryPendingParamsResponse)(nil), "ynx.ynx.v1.QueryPendingParamsResponse")
+	proto.RegisterType((*QueryContractRevenueRequest)(nil), "ynx.ynx.v1.QueryContractRevenueRequest")
+	proto.RegisterType((*QueryContractRevenueResponse)(nil), "ynx.ynx.v1.QueryContractRevenueResponse")
+	proto.RegisterType((*QueryContractRevenuesRequest)(nil), "ynx.ynx.v1.QueryContractRevenuesRequest")
+	proto.RegisterType((*QueryContractRevenuesResponse)(nil), "ynx.ynx.v1.QueryContractRevenuesResponse")
+	proto.RegisterType((*QuerySponsorshipRequest)(nil), "ynx.ynx.v1.QuerySponsorshipRequest")
+	proto.RegisterType((*QuerySponsorshipResponse)(nil), "ynx.ynx.v1.QuerySponsorshipResponse")
+	proto.RegisterType((*QuerySponsorshipsRequest)(nil), "ynx.ynx.v1.QuerySponsorshipsRequest")
+	proto.RegisterType((*QuerySponsorshipsResponse)(nil), "ynx.ynx.v1.QuerySponsorshipsResponse")
+	proto.RegisterType((*QueryCircuitBreakersRequest)(nil), "ynx.ynx.v1.QueryCircuitBreakersRequest")
+	proto.RegisterType((*QueryCircuitBreakersResponse)(nil), "ynx.ynx.v1.QueryCircuitBreakersResponse")
+	proto.RegisterType((*QueryCircuitStatusRequest)(nil), "ynx.ynx.v1.QueryCircuitStatusRequest")
+	proto.RegisterType((*QueryCircuitStatusResponse)(nil), "ynx.ynx.v1.QueryCircuitStatusResponse")
+	proto.RegisterType((*QueryPreconfirmSignersRequest)(nil), "ynx.ynx.v1.QueryPreconfirmSignersRequest")
+	proto.RegisterType((*QueryPreconfirmSignersResponse)(nil), "ynx.ynx.v1.QueryPreconfirmSignersResponse")
+	proto.RegisterType((*InvariantResult)(nil), "ynx.ynx.v1.InvariantResult")
 }
 
 func init() { proto.RegisterFile("ynx/ynx/v1/query.proto", fileDescriptor_5dcbb493bb41a18a) }
 
 var fileDescriptor_5dcbb493bb41a18a = []byte{
-	// 321 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xab, 0xcc, 0xab, 0xd0,
-	0x07, 0xe1, 0x32, 0x43, 0xfd, 0xc2, 0xd2, 0xd4, 0xa2, 0x4a, 0xbd, 0x82, 0xa2, 0xfc, 0x92, 0x7c,
-	0x21, 0xae, 0xca, 0xbc, 0x0a, 0x3d, 0x10, 0x2e, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07,
-	0x0b, 0xeb, 0x83, 0x58, 0x10, 0x15, 0x52, 0x12, 0x48, 0x3a, 0xd3, 0x53, 0xf3, 0x52, 0x8b, 0x33,
-	0x8b, 0xa1, 0x32, 0xe2, 0x48, 0x32, 0x05, 0x89, 0x45, 0x89, 0xb9, 0x50, 0x09, 0x25, 0x11, 0x2e,
-	0xa1, 0x40, 0x90, 0x1d, 0x01, 0x60, 0xc1, 0xa0, 0xd4, 0xc2, 0xd2, 0xd4, 0xe2, 0x12, 0x25, 0x77,
-	0x2e, 0x61, 0x14, 0xd1, 0xe2, 0x82, 0xfc, 0xbc, 0xe2, 0x54, 0x21, 0x03, 0x2e, 0x36, 0x88, 0x66,
-	0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x21, 0x3d, 0x84, 0x93, 0xf4, 0x20, 0x6a, 0x9d, 0x58,
-	0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0xaa, 0x53, 0x92, 0xe5, 0x92, 0x06, 0x1b, 0x14, 0x5c, 0x59,
-	0x5c, 0x92, 0x9a, 0xeb, 0x9c, 0x9f, 0x57, 0x52, 0x94, 0x98, 0x5c, 0x02, 0xb7, 0x67, 0x09, 0x23,
-	0x97, 0x0c, 0x76, 0x79, 0xa8, 0x8d, 0x66, 0x5c, 0x6c, 0xc5, 0x60, 0x29, 0xa8, 0x8d, 0x12, 0xc8,
-	0x36, 0xc2, 0x35, 0xa5, 0x65, 0xa6, 0xc3, 0xec, 0x85, 0xa8, 0x16, 0xf2, 0xe1, 0x12, 0x80, 0xb0,
-	0xe2, 0x93, 0x61, 0x66, 0x4a, 0x30, 0x81, 0x4d, 0x90, 0xc6, 0x6a, 0x02, 0x44, 0x09, 0xd4, 0x10,
-	0xfe, 0x62, 0x54, 0x61, 0xa3, 0x1d, 0x8c, 0x5c, 0xac, 0x60, 0x67, 0x0a, 0x79, 0x72, 0xb1, 0x41,
-	0xfc, 0x29, 0x24, 0x87, 0x6c, 0x0e, 0x66, 0x10, 0x4a, 0xc9, 0xe3, 0x94, 0x87, 0x7a, 0x2d, 0x85,
-	0x8b, 0x1f, 0xcd, 0x7a, 0x21, 0x75, 0x0c, 0x3d, 0xd8, 0xc3, 0x4d, 0x4a, 0x83, 0xb0, 0x42, 0x88,
-	0x2d, 0x4e, 0x7a, 0x51, 0x3a, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa,
-	0x5e, 0x99, 0x89, 0x19, 0x89, 0xf9, 0x8e, 0x39, 0x49, 0xa5, 0xc5, 0xfa, 0x91, 0x7e, 0x11, 0xfa,
-	0xc9, 0x19, 0x89, 0x99, 0x79, 0xfa, 0x90, 0x94, 0x51, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06,
-	0x4e, 0x16, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x39, 0x97, 0x16, 0x5b, 0x85, 0x02, 0x00,
-	0x00,
+	// 1460 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0x4f, 0x73, 0xdb, 0x44,
+	0x14, 0x47, 0xf9, 0xe3, 0x24, 0x2f, 0x7f, 0xec, 0x6e, 0xd3, 0x56, 0x95, 0xd3, 0xc4, 0x28, 0x4d,
+	0x9a, 0xb4, 0xc5, 0x6a, 0x03, 0xc3, 0x9f, 0x13, 0xd3, 0x64, 0x80, 0xb6, 0x94, 0x4e, 0x71, 0xa0,
+	0x43, 0x61, 0x06, 0x8f, 0x22, 0x6d, 0x6c, 0x4d, 0x6c, 0xc9, 0xd9, 0x95, 0x3d, 0xf5, 0x64, 0x32,
+	0xcc, 0x74, 0x38, 0x31, 0xc0, 0x01, 0xee, 0x5c, 0xe0, 0x1b, 0xf0, 0x11, 0xb8, 0x70, 0xe7, 0xce,
+	0x89, 0x4f, 0xc1, 0x89, 0xd1, 0xee, 0x5b, 0x59, 0xb2, 0x64, 0x3b, 0x1c, 0x32, 0xd5, 0xbe, 0xf7,
+	0x7b, 0xef, 0xfd, 0xf6, 0xed, 0xee, 0x7b, 0xaf, 0x86, 0xab, 0x7d, 0xff, 0xa5, 0x15, 0xfd, 0xf5,
+	0xee, 0x5b, 0xa7, 0x5d, 0xca, 0xfa, 0xd5, 0x0e, 0x0b, 0xc2, 0x80, 0x40, 0xdf, 0x7f, 0x59, 0x8d,
+	0xfe, 0x7a, 0xf7, 0x8d, 0xd5, 0x46, 0xd0, 0x08, 0x84, 0xd8, 0x8a, 0xbe, 0x24, 0xc2, 0x58, 0x6b,
+	0x04, 0x41, 0xa3, 0x45, 0x2d, 0xbb, 0xe3, 0x59, 0xb6, 0xef, 0x07, 0xa1, 0x1d, 0x7a, 0x81, 0xcf,
+	0x51, 0xab, 0x27, 0xfc, 0x3a, 0x1e, 0x73, 0xba, 0x5e, 0x98, 0xa3, 0x69, 0x50, 0x9f, 0x72, 0x4f,
+	0xd9, 0x5c, 0x4b, 0x68, 0x3a, 0x36, 0xb3, 0xdb, 0x4a, 0x51, 0x4e, 0x2a, 0x18, 0x75, 0x02, 0xff,
+	0xd8, 0x63, 0xed, 0x1c, 0x7f, 0x8c, 0xf6, 0xa8, 0xdf, 0xa5, 0x8a, 0x61, 0x42, 0xc3, 0x3b, 0x81,
+	0xcf, 0x03, 0xc6, 0x9b, 0x5e, 0x47, 0x6a, 0xcd, 0x55, 0x20, 0x9f, 0x46, 0x1b, 0x7e, 0x26, 0x22,
+	0xd5, 0xe8, 0x69, 0x97, 0xf2, 0xd0, 0xfc, 0x08, 0x2e, 0xa7, 0xa4, 0xc2, 0x8e, 0x92, 0x7b, 0x50,
+	0x90, 0x8c, 0x74, 0xad, 0xa2, 0xed, 0x2c, 0xee, 0x91, 0xea, 0x20, 0x3f, 0x55, 0x89, 0xdd, 0x9f,
+	0xf9, 0xf3, 0xef, 0x8d, 0xd7, 0x6a, 0x88, 0x33, 0x6f, 0x40, 0x59, 0x38, 0x3a, 0xec, 0xf3, 0x90,
+	0xb6, 0x0f, 0x02, 0x3f, 0x64, 0xb6, 0x13, 0xc6, 0x71, 0x7e, 0xd3, 0x60, 0x2d, 0x5f, 0x8f, 0x11,
+	0xdf, 0x86, 0x02, 0x17, 0x2a, 0x8c, 0xa8, 0x27, 0x23, 0xc6, 0x46, 0xc7, 0x5e, 0x43, 0xc5, 0x95,
+	0x68, 0xf2, 0x04, 0x4a, 0xf2, 0xab, 0xee, 0x28, 0x9f, 0xfa, 0x94, 0xf0, 0x50, 0xce, 0xf5, 0x20,
+	0x21, 0xe8, 0xa4, 0xc8, 0xd3, 0x62, 0x73, 0x13, 0x5e, 0x17, 0x2c, 0x9f, 0x53, 0xe6, 0x1d, 0x8f,
+	0xda, 0xcb, 0xf7, 0x1a, 0x98, 0xe3, 0x50, 0xb8, 0xa3, 0xc7, 0xb0, 0x30, 0xa0, 0xa4, 0x55, 0xa6,
+	0x77, 0x16, 0xf7, 0xb6, 0x47, 0x53, 0x12, 0xbe, 0x3c, 0x47, 0x5c, 0x2a, 0x64, 0x37, 0x30, 0x27,
+	0x06, 0xcc, 0xf7, 0x04, 0x80, 0xba, 0x62, 0x77, 0xf3, 0xb5, 0x78, 0x6d, 0xfe, 0x3e, 0x05, 0xc6,
+	0x68, 0x5f, 0x84, 0xc0, 0x8c, 0x6f, 0xb7, 0xa9, 0x48, 0xeb, 0x42, 0x4d, 0x7c, 0x13, 0x1d, 0xe6,
+	0x6c, 0xd7, 0x65, 0x94, 0xcb, 0x5c, 0x2d, 0xd4, 0xd4, 0x92, 0x94, 0x23, 0xd2, 0x2e, 0xad, 0x37,
+	0x6d, 0xde, 0xd4, 0xa7, 0x85, 0x6e, 0x3e, 0x12, 0x3c, 0xb4, 0x79, 0x93, 0xdc, 0x05, 0x12, 0xdd,
+	0x46, 0xe6, 0x52, 0xb7, 0x3e, 0x40, 0xcd, 0x08, 0x54, 0x49, 0x69, 0x0e, 0x14, 0xda, 0x80, 0x79,
+	0x9b, 0x85, 0xde, 0xb1, 0xed, 0x84, 0xfa, 0xac, 0xf4, 0xa4, 0xd6, 0x64, 0x17, 0x4a, 0xea, 0xbb,
+	0xde, 0xa3, 0x8c, 0x7b, 0x81, 0xaf, 0x17, 0x04, 0xa6, 0xa8, 0xe4, 0xcf, 0xa5, 0x98, 0xdc, 0x86,
+	0x4b, 0x71, 0xac, 0x7a, 0xdb, 0x0e, 0x9d, 0x26, 0xe5, 0xfa, 0x9c, 0xc8, 0x41, 0x51, 0x31, 0xfb,
+	0x44, 0x8a, 0x53, 0x6e, 0x15, 0x74, 0x5e, 0x42, 0x95, 0x1c, 0xa1, 0xe6, 0x1d, 0xbc, 0xf8, 0x35,
+	0xf9, 0x84, 0xf0, 0x6c, 0xc9, 0x2a, 0xcc, 0xba, 0xd4, 0x0f, 0xda, 0x98, 0x2e, 0xb9, 0x30, 0xbf,
+	0xd5, 0x60, 0x35, 0x8d, 0xc6, 0x33, 0x7e, 0x0f, 0xe6, 0xf0, 0x0d, 0xe2, 0x09, 0x5f, 0x4f, 0x9e,
+	0x70, 0x8c, 0x8e, 0x32, 0x83, 0x87, 0xaa, 0xf0, 0xe4, 0x3e, 0xcc, 0xd2, 0x4e, 0xe0, 0x34, 0xf1,
+	0xb6, 0x5e, 0x49, 0x1a, 0x7e, 0x10, 0x29, 0x1e, 0xf9, 0xc7, 0x01, 0x1a, 0x49, 0xa4, 0xb9, 0x07,
+	0x46, 0x92, 0xc5, 0x7e, 0x5f, 0xe0, 0x12, 0xd4, 0xa5, 0xc3, 0x88, 0xfa, 0x8c, 0xb2, 0xf1, 0xa1,
+	0x9c, 0x6b, 0x83, 0x1b, 0xc8, 0x35, 0x4a, 0x6e, 0x6b, 0xea, 0xff, 0x6d, 0xcb, 0x2c, 0xc3, 0x75,
+	0x59, 0x50, 0xa8, 0xef, 0x7a, 0x7e, 0x23, 0x5d, 0x6d, 0x5c, 0x30, 0xf2, 0x94, 0xc8, 0xe5, 0x43,
+	0x58, 0xe9, 0x48, 0x45, 0x3d, 0x2e, 0x3e, 0x99, 0xe0, 0x29, 0x53, 0x0c, 0xbe, 0xdc, 0x49, 0x0a,
+	0xcd, 0x87, 0xb8, 0x65, 0xf5, 0x1c, 0x86, 0x8e, 0x78, 0x17, 0x4a, 0xea, 0x61, 0xd5, 0xd5, 0x2b,
+	0x90, 0xa7, 0x5d, 0x54, 0xf2, 0x07, 0x52, 0x6c, 0xb6, 0x60, 0x2d, 0xdf, 0x13, 0x32, 0x7e, 0x92,
+	0x70, 0x35, 0xb8, 0x07, 0x99, 0xe2, 0x33, 0x64, 0xae, 0x8a, 0x8f, 0x93, 0x16, 0x9b, 0x8f, 0xf2,
+	0xa3, 0xf1, 0x04, 0x71, 0x97, 0x76, 0x5a, 0x41, 0x9f, 0xb2, 0x61, 0xe2, 0x4a, 0xae, 0x88, 0x07,
+	0x70, 0x63, 0x84, 0x2b, 0x64, 0xfe, 0x34, 0x7a, 0x55, 0x69, 0xe6, 0x2a, 0xdd, 0x17, 0xa0, 0x5e,
+	0x1a, 0xa2, 0xce, 0xcd, 0x5d, 0xb8, 0x26, 0xcb, 0xfb, 0xa0, 0xef, 0x28, 0xda, 0x2b, 0x30, 0xe5,
+	0xb9, 0x78, 0xbf, 0xa6, 0x3c, 0xd7, 0xfc, 0x0a, 0xf4, 0x2c, 0x14, 0x69, 0xbd, 0x0f, 0x8b, 0x89,
+	0xce, 0x85, 0xb9, 0xbc, 0x96, 0xaa, 0x9a, 0x03, 0x35, 0x92, 0x49, 0x5a, 0x98, 0x6f, 0x65, 0x9d,
+	0xc7, 0xf9, 0xd3, 0x61, 0x0e, 0xa1, 0x98, 0x36, 0xb5, 0x34, 0xbf, 0x86, 0xeb, 0x39, 0x56, 0xc8,
+	0xe9, 0x01, 0x2c, 0x25, 0x22, 0xa8, 0x2c, 0x4d, 0x20, 0x95, 0x32, 0x89, 0x9b, 0xe3, 0x81, 0x9c,
+	0x0c, 0xf6, 0x19, 0xb5, 0x4f, 0x28, 0x8b, 0x9f, 0xc5, 0x09, 0xac, 0xe5, 0xab, 0x91, 0xc1, 0xc7,
+	0x50, 0xc2, 0x99, 0xa2, 0x7e, 0x84, 0x3a, 0x64, 0x61, 0xa4, 0xce, 0x2a, 0x65, 0x1e, 0xdf, 0xb2,
+	0xb4, 0x53, 0xf3, 0x14, 0xf7, 0x8a, 0xe8, 0xc3, 0xd0, 0x0e, 0xbb, 0x71, 0x8a, 0x2a, 0xb0, 0xd4,
+	0xe6, 0x8d, 0x7a, 0xd8, 0xef, 0xd0, 0x7a, 0x97, 0xb5, 0x30, 0x4f, 0xd0, 0xe6, 0x8d, 0xcf, 0xfa,
+	0x1d, 0xfa, 0x39, 0x6b, 0x91, 0xab, 0x50, 0x08, 0x6d, 0xd6, 0xa0, 0x21, 0x76, 0x0e, 0x5c, 0x45,
+	0xd5, 0x9e, 0xd3, 0x16, 0x75, 0xc2, 0x80, 0xa9, 0xbe, 0xa1, 0xd6, 0xe6, 0x19, 0x18, 0x79, 0x21,
+	0x71, 0x77, 0x3a, 0xcc, 0x1d, 0xb5, 0x02, 0xe7, 0x84, 0xca, 0x4b, 0x32, 0x5f, 0x53, 0x4b, 0x72,
+	0x00, 0xc5, 0xa1, 0x7d, 0x63, 0xb1, 0x1c, 0xb3, 0xed, 0xda, 0x4a, 0x7a, 0xc3, 0xe6, 0x06, 0x3e,
+	0x85, 0x67, 0xf1, 0x20, 0x75, 0xe8, 0x35, 0xfc, 0x44, 0xf6, 0xff, 0xd0, 0x60, 0x7d, 0x14, 0x02,
+	0x29, 0x6e, 0xc2, 0xb2, 0xd3, 0x65, 0x8c, 0xfa, 0x61, 0x3d, 0x59, 0x2d, 0x97, 0x50, 0x28, 0x4a,
+	0x2a, 0x79, 0x07, 0x0a, 0xb6, 0x13, 0x7a, 0x3d, 0x8a, 0x24, 0x37, 0x52, 0x65, 0x6b, 0xc8, 0xf7,
+	0x21, 0x0d, 0x6b, 0x08, 0x27, 0x07, 0xb0, 0xc0, 0x9d, 0x26, 0x75, 0xbb, 0x2d, 0xea, 0xea, 0xd3,
+	0x95, 0xe9, 0x0b, 0xd8, 0xaa, 0x09, 0x21, 0xb6, 0x33, 0x5f, 0x40, 0xf1, 0x91, 0xdf, 0xb3, 0x99,
+	0x67, 0xfb, 0x61, 0x8d, 0xf2, 0x6e, 0x4b, 0x34, 0x04, 0x16, 0x74, 0x43, 0xd5, 0xfa, 0xe5, 0x22,
+	0x3a, 0xc0, 0x23, 0x16, 0x9c, 0x50, 0x1f, 0x07, 0x09, 0x5c, 0x45, 0xc7, 0xd0, 0xa6, 0x9c, 0xdb,
+	0x0d, 0x8a, 0xe7, 0xa7, 0x96, 0x7b, 0xff, 0x2e, 0xc3, 0xac, 0x48, 0x10, 0xa1, 0x50, 0x90, 0x35,
+	0x96, 0xac, 0x27, 0x09, 0x66, 0xe7, 0x4a, 0x63, 0x63, 0xa4, 0x5e, 0xa6, 0xd4, 0x34, 0x5e, 0xfd,
+	0xf5, 0xcf, 0xcf, 0x53, 0xab, 0x84, 0x58, 0x99, 0x29, 0x98, 0x7c, 0xa7, 0x41, 0x71, 0x68, 0xaa,
+	0x22, 0xb7, 0x32, 0x0e, 0xf3, 0xa7, 0x33, 0x63, 0x67, 0x32, 0x10, 0x29, 0xdc, 0x14, 0x14, 0xd6,
+	0xc9, 0x5a, 0x92, 0xc2, 0xf0, 0x30, 0x49, 0x7e, 0xd5, 0xe0, 0x4a, 0xee, 0xa0, 0x47, 0xde, 0xc8,
+	0x44, 0x1a, 0x37, 0x36, 0x1a, 0xd5, 0x8b, 0xc2, 0x91, 0xde, 0x1d, 0x41, 0x6f, 0x8b, 0x6c, 0x8e,
+	0xa3, 0x67, 0x89, 0x31, 0xb0, 0x4f, 0x3c, 0x98, 0xc3, 0x5a, 0x4c, 0xb2, 0xa9, 0x4f, 0x37, 0x40,
+	0xa3, 0x32, 0x1a, 0x80, 0xa1, 0xcb, 0x22, 0xf4, 0x15, 0x72, 0xd9, 0xca, 0xfe, 0x67, 0x83, 0xfc,
+	0xa0, 0xc1, 0x4a, 0x7a, 0x9a, 0x20, 0xdb, 0xa3, 0x3c, 0xa6, 0x47, 0x14, 0xe3, 0xd6, 0x44, 0x1c,
+	0x12, 0xb8, 0x2d, 0x08, 0xdc, 0x24, 0x66, 0x0e, 0x01, 0x4b, 0x3c, 0x41, 0x6e, 0x9d, 0x89, 0x7f,
+	0xcf, 0xc9, 0x2b, 0x0d, 0x96, 0x53, 0x53, 0x01, 0xd9, 0xca, 0x5e, 0xbe, 0x9c, 0x69, 0xc4, 0xd8,
+	0x9e, 0x04, 0x43, 0x32, 0xa6, 0x20, 0xb3, 0x46, 0x8c, 0xd4, 0x55, 0x4d, 0x4d, 0x2a, 0xe4, 0x17,
+	0x0d, 0x8a, 0x43, 0xbd, 0x32, 0xe7, 0xca, 0xe6, 0x4f, 0x24, 0xc6, 0xce, 0x64, 0x20, 0x52, 0x79,
+	0x57, 0x50, 0xd9, 0x23, 0xf7, 0x92, 0x54, 0x32, 0x8d, 0xdc, 0x3a, 0x1b, 0x1e, 0x70, 0xce, 0xc9,
+	0x8f, 0x1a, 0x94, 0x86, 0xbc, 0x72, 0x32, 0x31, 0x70, 0x9c, 0xab, 0xdd, 0x0b, 0x20, 0x91, 0xe3,
+	0x96, 0xe0, 0xb8, 0x41, 0x6e, 0x8c, 0xe5, 0x48, 0xbe, 0x81, 0xc5, 0x44, 0xdb, 0x24, 0x9b, 0xd9,
+	0x67, 0x9b, 0x19, 0x25, 0x8c, 0x9b, 0xe3, 0x41, 0xe3, 0x08, 0x24, 0xfb, 0xb1, 0x75, 0xe6, 0xb9,
+	0xe7, 0xe4, 0x1c, 0x96, 0x12, 0xd6, 0x9c, 0x8c, 0x75, 0x1e, 0x27, 0x62, 0x6b, 0x02, 0x0a, 0x39,
+	0x54, 0x04, 0x07, 0x83, 0xe8, 0xa3, 0x38, 0x88, 0x22, 0x37, 0xd4, 0xf0, 0xf3, 0x6e, 0x4c, 0xee,
+	0xc4, 0x60, 0xec, 0x4c, 0x06, 0x8e, 0x2b, 0x72, 0xc3, 0xd3, 0x84, 0x78, 0x43, 0xa9, 0xee, 0x9c,
+	0xf3, 0x86, 0xf2, 0x06, 0x06, 0x63, 0x7b, 0x12, 0x6c, 0xdc, 0x1b, 0x52, 0x34, 0xb8, 0x0c, 0xf9,
+	0x93, 0x06, 0x97, 0x32, 0x3d, 0x98, 0x64, 0x6f, 0xde, 0xa8, 0x4e, 0x6e, 0xdc, 0xbe, 0x08, 0x14,
+	0x09, 0x6d, 0x0b, 0x42, 0x15, 0xb2, 0x6e, 0xe5, 0xfe, 0xd8, 0x52, 0xe7, 0x12, 0xbf, 0x5f, 0xfd,
+	0xf2, 0x6e, 0xc3, 0x0b, 0x9b, 0xdd, 0xa3, 0xaa, 0x13, 0xb4, 0xad, 0xc7, 0x9e, 0xdd, 0xb4, 0x83,
+	0x07, 0xad, 0xa3, 0x2e, 0xb7, 0x5e, 0x3c, 0xfd, 0xc2, 0x72, 0x9a, 0xb6, 0xe7, 0x5b, 0xd2, 0x3e,
+	0x1a, 0x9a, 0xf8, 0x51, 0x41, 0xfc, 0xda, 0xf2, 0xe6, 0x7f, 0x03, 0x00, 0x24, 0x61, 0xf6, 0xbd,
+	0x69, 0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -216,8 +1351,33 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryClient interface {
+	// Params returns the active x/ynx params.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// SystemContracts returns the system contract config and the deployed addresses.
 	SystemContracts(ctx context.Context, in *QuerySystemContractsRequest, opts ...grpc.CallOption) (*QuerySystemContractsResponse, error)
+	// VerifySystemContracts compares the live EVM code of each system contract entry with its
+	// attestation and with the embedded hardhat artifact it should have been deployed from.
+	VerifySystemContracts(ctx context.Context, in *QueryVerifySystemContractsRequest, opts ...grpc.CallOption) (*QueryVerifySystemContractsResponse, error)
+	// Revenue returns the cumulative protocol revenue ledger.
+	Revenue(ctx context.Context, in *QueryRevenueRequest, opts ...grpc.CallOption) (*QueryRevenueResponse, error)
+	// RevenueByEpoch returns the protocol revenue recorded during a single epoch.
+	RevenueByEpoch(ctx context.Context, in *QueryRevenueByEpochRequest, opts ...grpc.CallOption) (*QueryRevenueByEpochResponse, error)
+	// PendingParams returns the scheduled params changes ordered by activation height.
+	PendingParams(ctx context.Context, in *QueryPendingParamsRequest, opts ...grpc.CallOption) (*QueryPendingParamsResponse, error)
+	// ContractRevenue returns the developer fee rebate registration of a contract.
+	ContractRevenue(ctx context.Context, in *QueryContractRevenueRequest, opts ...grpc.CallOption) (*QueryContractRevenueResponse, error)
+	// ContractRevenues returns the registered contracts ordered by contract address.
+	ContractRevenues(ctx context.Context, in *QueryContractRevenuesRequest, opts ...grpc.CallOption) (*QueryContractRevenuesResponse, error)
+	// Sponsorship returns a single gas sponsorship.
+	Sponsorship(ctx context.Context, in *QuerySponsorshipRequest, opts ...grpc.CallOption) (*QuerySponsorshipResponse, error)
+	// Sponsorships returns the gas sponsorships ordered by id.
+	Sponsorships(ctx context.Context, in *QuerySponsorshipsRequest, opts ...grpc.CallOption) (*QuerySponsorshipsResponse, error)
+	// CircuitBreakers returns the tripped circuit breakers that have not expired.
+	CircuitBreakers(ctx context.Context, in *QueryCircuitBreakersRequest, opts ...grpc.CallOption) (*QueryCircuitBreakersResponse, error)
+	// CircuitStatus reports whether a message type or an EVM call is blocked by a circuit breaker.
+	CircuitStatus(ctx context.Context, in *QueryCircuitStatusRequest, opts ...grpc.CallOption) (*QueryCircuitStatusResponse, error)
+	// PreconfirmSigners returns the active preconfirm signer set and the sets scheduled to replace it.
+	PreconfirmSigners(ctx context.Context, in *QueryPreconfirmSignersRequest, opts ...grpc.CallOption) (*QueryPreconfirmSignersResponse, error)
 }
 
 type queryClient struct {
@@ -246,10 +1406,134 @@ func (c *queryClient) SystemContracts(ctx context.Context, in *QuerySystemContra
 	return out, nil
 }
 
+func (c *queryClient) VerifySystemContracts(ctx context.Context, in *QueryVerifySystemContractsRequest, opts ...grpc.CallOption) (*QueryVerifySystemContractsResponse, error) {
+	out := new(QueryVerifySystemContractsResponse)
+	err := c.cc.Invoke(ctx, "/ynx.ynx.v1.Query/VerifySystemContracts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Revenue(ctx context.Context, in *QueryRevenueRequest, opts ...grpc.CallOption) (*QueryRevenueResponse, error) {
+	out := new(QueryRevenueResponse)
+	err := c.cc.Invoke(ctx, "/ynx.ynx.v1.Query/Revenue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RevenueByEpoch(ctx context.Context, in *QueryRevenueByEpochRequest, opts ...grpc.CallOption) (*QueryRevenueByEpochResponse, error) {
+	out := new(QueryRevenueByEpochResponse)
+	err := c.cc.Invoke(ctx, "/ynx.ynx.v1.Query/RevenueByEpoch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingParams(ctx context.Context, in *QueryPendingParamsRequest, opts ...grpc.CallOption) (*QueryPendingParamsResponse, error) {
+	out := new(QueryPendingParamsResponse)
+	err := c.cc.Invoke(ctx, "/ynx.ynx.v1.Query/PendingParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContractRevenue(ctx context.Context, in *QueryContractRevenueRequest, opts ...grpc.CallOption) (*QueryContractRevenueResponse, error) {
+	out := new(QueryContractRevenueResponse)
+	err := c.cc.Invoke(ctx, "/ynx.ynx.v1.Query/ContractRevenue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContractRevenues(ctx context.Context, in *QueryContractRevenuesRequest, opts ...grpc.CallOption) (*QueryContractRevenuesResponse, error) {
+	out := new(QueryContractRevenuesResponse)
+	err := c.cc.Invoke(ctx, "/ynx.ynx.v1.Query/ContractRevenues", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Sponsorship(ctx context.Context, in *QuerySponsorshipRequest, opts ...grpc.CallOption) (*QuerySponsorshipResponse, error) {
+	out := new(QuerySponsorshipResponse)
+	err := c.cc.Invoke(ctx, "/ynx.ynx.v1.Query/Sponsorship", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Sponsorships(ctx context.Context, in *QuerySponsorshipsRequest, opts ...grpc.CallOption) (*QuerySponsorshipsResponse, error) {
+	out := new(QuerySponsorshipsResponse)
+	err := c.cc.Invoke(ctx, "/ynx.ynx.v1.Query/Sponsorships", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CircuitBreakers(ctx context.Context, in *QueryCircuitBreakersRequest, opts ...grpc.CallOption) (*QueryCircuitBreakersResponse, error) {
+	out := new(QueryCircuitBreakersResponse)
+	err := c.cc.Invoke(ctx, "/ynx.ynx.v1.Query/CircuitBreakers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CircuitStatus(ctx context.Context, in *QueryCircuitStatusRequest, opts ...grpc.CallOption) (*QueryCircuitStatusResponse, error) {
+	out := new(QueryCircuitStatusResponse)
+	err := c.cc.Invoke(ctx, "/ynx.ynx.v1.Query/CircuitStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PreconfirmSigners(ctx context.Context, in *QueryPreconfirmSignersRequest, opts ...grpc.CallOption) (*QueryPreconfirmSignersResponse, error) {
+	out := new(QueryPreconfirmSignersResponse)
+	err := c.cc.Invoke(ctx, "/ynx.ynx.v1.Query/PreconfirmSigners", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
+	// Params returns the active x/ynx params.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// SystemContracts returns the system contract config and the deployed addresses.
 	SystemContracts(context.Context, *QuerySystemContractsRequest) (*QuerySystemContractsResponse, error)
+	// VerifySystemContracts compares the live EVM code of each system contract entry with its
+	// attestation and with the embedded hardhat artifact it should have been deployed from.
+	VerifySystemContracts(context.Context, *QueryVerifySystemContractsRequest) (*QueryVerifySystemContractsResponse, error)
+	// Revenue returns the cumulative protocol revenue ledger.
+	Revenue(context.Context, *QueryRevenueRequest) (*QueryRevenueResponse, error)
+	// RevenueByEpoch returns the protocol revenue recorded during a single epoch.
+	RevenueByEpoch(context.Context, *QueryRevenueByEpochRequest) (*QueryRevenueByEpochResponse, error)
+	// PendingParams returns the scheduled params changes ordered by activation height.
+	PendingParams(context.Context, *QueryPendingParamsRequest) (*QueryPendingParamsResponse, error)
+	// ContractRevenue returns the developer fee rebate registration of a contract.
+	ContractRevenue(context.Context, *QueryContractRevenueRequest) (*QueryContractRevenueResponse, error)
+	// ContractRevenues returns the registered contracts ordered by contract address.
+	ContractRevenues(context.Context, *QueryContractRevenuesRequest) (*QueryContractRevenuesResponse, error)
+	// Sponsorship returns a single gas sponsorship.
+	Sponsorship(context.Context, *QuerySponsorshipRequest) (*QuerySponsorshipResponse, error)
+	// Sponsorships returns the gas sponsorships ordered by id.
+	Sponsorships(context.Context, *QuerySponsorshipsRequest) (*QuerySponsorshipsResponse, error)
+	// CircuitBreakers returns the tripped circuit breakers that have not expired.
+	CircuitBreakers(context.Context, *QueryCircuitBreakersRequest) (*QueryCircuitBreakersResponse, error)
+	// CircuitStatus reports whether a message type or an EVM call is blocked by a circuit breaker.
+	CircuitStatus(context.Context, *QueryCircuitStatusRequest) (*QueryCircuitStatusResponse, error)
+	// PreconfirmSigners returns the active preconfirm signer set and the sets scheduled to replace it.
+	PreconfirmSigners(context.Context, *QueryPreconfirmSignersRequest) (*QueryPreconfirmSignersResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -262,6 +1546,39 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) SystemContracts(ctx context.Context, req *QuerySystemContractsRequest) (*QuerySystemContractsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SystemContracts not implemented")
 }
+func (*UnimplementedQueryServer) VerifySystemContracts(ctx context.Context, req *QueryVerifySystemContractsRequest) (*QueryVerifySystemContractsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifySystemContracts not implemented")
+}
+func (*UnimplementedQueryServer) Revenue(ctx context.Context, req *QueryRevenueRequest) (*QueryRevenueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Revenue not implemented")
+}
+func (*UnimplementedQueryServer) RevenueByEpoch(ctx context.Context, req *QueryRevenueByEpochRequest) (*QueryRevenueByEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevenueByEpoch not implemented")
+}
+func (*UnimplementedQueryServer) PendingParams(ctx context.Context, req *QueryPendingParamsRequest) (*QueryPendingParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingParams not implemented")
+}
+func (*UnimplementedQueryServer) ContractRevenue(ctx context.Context, req *QueryContractRevenueRequest) (*QueryContractRevenueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractRevenue not implemented")
+}
+func (*UnimplementedQueryServer) ContractRevenues(ctx context.Context, req *QueryContractRevenuesRequest) (*QueryContractRevenuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractRevenues not implemented")
+}
+func (*UnimplementedQueryServer) Sponsorship(ctx context.Context, req *QuerySponsorshipRequest) (*QuerySponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sponsorship not implemented")
+}
+func (*UnimplementedQueryServer) Sponsorships(ctx context.Context, req *QuerySponsorshipsRequest) (*QuerySponsorshipsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sponsorships not implemented")
+}
+func (*UnimplementedQueryServer) CircuitBreakers(ctx context.Context, req *QueryCircuitBreakersRequest) (*QueryCircuitBreakersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CircuitBreakers not implemented")
+}
+func (*UnimplementedQueryServer) CircuitStatus(ctx context.Context, req *QueryCircuitStatusRequest) (*QueryCircuitStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CircuitStatus not implemented")
+}
+func (*UnimplementedQueryServer) PreconfirmSigners(ctx context.Context, req *QueryPreconfirmSignersRequest) (*QueryPreconfirmSignersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreconfirmSigners not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -303,6 +1620,204 @@ func _Query_SystemContracts_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_VerifySystemContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifySystemContractsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifySystemContracts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ynx.ynx.v1.Query/VerifySystemContracts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifySystemContracts(ctx, req.(*QueryVerifySystemContractsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Revenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRevenueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Revenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ynx.ynx.v1.Query/Revenue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Revenue(ctx, req.(*QueryRevenueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RevenueByEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRevenueByEpochRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RevenueByEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ynx.ynx.v1.Query/RevenueByEpoch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RevenueByEpoch(ctx, req.(*QueryRevenueByEpochRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ynx.ynx.v1.Query/PendingParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingParams(ctx, req.(*QueryPendingParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractRevenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractRevenueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractRevenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ynx.ynx.v1.Query/ContractRevenue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractRevenue(ctx, req.(*QueryContractRevenueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractRevenues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractRevenuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractRevenues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ynx.ynx.v1.Query/ContractRevenues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractRevenues(ctx, req.(*QueryContractRevenuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Sponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySponsorshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Sponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ynx.ynx.v1.Query/Sponsorship",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Sponsorship(ctx, req.(*QuerySponsorshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Sponsorships_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySponsorshipsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Sponsorships(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ynx.ynx.v1.Query/Sponsorships",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Sponsorships(ctx, req.(*QuerySponsorshipsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CircuitBreakers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCircuitBreakersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CircuitBreakers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ynx.ynx.v1.Query/CircuitBreakers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CircuitBreakers(ctx, req.(*QueryCircuitBreakersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CircuitStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCircuitStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CircuitStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ynx.ynx.v1.Query/CircuitStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CircuitStatus(ctx, req.(*QueryCircuitStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PreconfirmSigners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPreconfirmSignersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PreconfirmSigners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ynx.ynx.v1.Query/PreconfirmSigners",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PreconfirmSigners(ctx, req.(*QueryPreconfirmSignersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ynx.ynx.v1.Query",
@@ -316,6 +1831,50 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SystemContracts",
 			Handler:    _Query_SystemContracts_Handler,
 		},
+		{
+			MethodName: "VerifySystemContracts",
+			Handler:    _Query_VerifySystemContracts_Handler,
+		},
+		{
+			MethodName: "Revenue",
+			Handler:    _Query_Revenue_Handler,
+		},
+		{
+			MethodName: "RevenueByEpoch",
+			Handler:    _Query_RevenueByEpoch_Handler,
+		},
+		{
+			MethodName: "PendingParams",
+			Handler:    _Query_PendingParams_Handler,
+		},
+		{
+			MethodName: "ContractRevenue",
+			Handler:    _Query_ContractRevenue_Handler,
+		},
+		{
+			MethodName: "ContractRevenues",
+			Handler:    _Query_ContractRevenues_Handler,
+		},
+		{
+			MethodName: "Sponsorship",
+			Handler:    _Query_Sponsorship_Handler,
+		},
+		{
+			MethodName: "Sponsorships",
+			Handler:    _Query_Sponsorships_Handler,
+		},
+		{
+			MethodName: "CircuitBreakers",
+			Handler:    _Query_CircuitBreakers_Handler,
+		},
+		{
+			MethodName: "CircuitStatus",
+			Handler:    _Query_CircuitStatus_Handler,
+		},
+		{
+			MethodName: "PreconfirmSigners",
+			Handler:    _Query_PreconfirmSigners_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ynx/ynx/v1/query.proto",
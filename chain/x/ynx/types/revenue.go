@@ -0,0 +1,179 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewRevenueRecord returns an empty revenue record for denom.
+func NewRevenueRecord(denom string) RevenueRecord {
+	return RevenueRecord{
+		Denom:               denom,
+		FeeBurned:           sdkmath.ZeroInt(),
+		FeeTreasury:         sdkmath.ZeroInt(),
+		FeeFounder:          sdkmath.ZeroInt(),
+		FeeValidators:       sdkmath.ZeroInt(),
+		InflationTreasury:   sdkmath.ZeroInt(),
+		InflationValidators: sdkmath.ZeroInt(),
+		InflationRecipients: sdkmath.ZeroInt(),
+		FeeDevelopers:       sdkmath.ZeroInt(),
+	}
+}
+
+// Add returns the field-wise sum of r and o. The denom of r is kept.
+func (r RevenueRecord) Add(o RevenueRecord) RevenueRecord {
+	return RevenueRecord{
+		Denom:               r.Denom,
+		FeeBurned:           addInt(r.FeeBurned, o.FeeBurned),
+		FeeTreasury:         addInt(r.FeeTreasury, o.FeeTreasury),
+		FeeFounder:          addInt(r.FeeFounder, o.FeeFounder),
+		FeeValidators:       addInt(r.FeeValidators, o.FeeValidators),
+		InflationTreasury:   addInt(r.InflationTreasury, o.InflationTreasury),
+		InflationValidators: addInt(r.InflationValidators, o.InflationValidators),
+		InflationRecipients: addInt(r.InflationRecipients, o.InflationRecipients),
+		FeeDevelopers:       addInt(r.FeeDevelopers, o.FeeDevelopers),
+	}
+}
+
+// Equal reports whether r and o record the same amounts for the same denom. Unset amounts equal
+// zero.
+func (r RevenueRecord) Equal(o RevenueRecord) bool {
+	a, b := NewRevenueRecord(r.Denom).Add(r), NewRevenueRecord(o.Denom).Add(o)
+	return a.Denom == b.Denom &&
+		a.FeeBurned.Equal(b.FeeBurned) &&
+		a.FeeTreasury.Equal(b.FeeTreasury) &&
+		a.FeeFounder.Equal(b.FeeFounder) &&
+		a.FeeValidators.Equal(b.FeeValidators) &&
+		a.InflationTreasury.Equal(b.InflationTreasury) &&
+		a.InflationValidators.Equal(b.InflationValidators) &&
+		a.InflationRecipients.Equal(b.InflationRecipients) &&
+		a.FeeDevelopers.Equal(b.FeeDevelopers)
+}
+
+func (r RevenueRecord) Validate() error {
+	if err := sdk.ValidateDenom(r.Denom); err != nil {
+		return fmt.Errorf("invalid revenue denom: %w", err)
+	}
+
+	for name, v := range map[string]sdkmath.Int{
+		"fee_burned":           r.FeeBurned,
+		"fee_treasury":         r.FeeTreasury,
+		"fee_founder":          r.FeeFounder,
+		"fee_validators":       r.FeeValidators,
+		"inflation_treasury":   r.InflationTreasury,
+		"inflation_validators": r.InflationValidators,
+		"inflation_recipients": r.InflationRecipients,
+		"fee_developers":       r.FeeDevelopers,
+	} {
+		if !v.IsNil() && v.IsNegative() {
+			return fmt.Errorf("revenue %s.%s must not be negative: %s", r.Denom, name, v)
+		}
+	}
+
+	return nil
+}
+
+func (s AccruedFeeShare) Validate() error {
+	if s.Recipient != "" {
+		if _, err := sdk.AccAddressFromBech32(s.Recipient); err != nil {
+			return fmt.Errorf("invalid accrued fee share recipient: %w", err)
+		}
+	}
+	if err := sdk.ValidateDenom(s.Denom); err != nil {
+		return fmt.Errorf("invalid accrued fee share denom: %w", err)
+	}
+	if s.Amount.IsNil() || !s.Amount.IsPositive() {
+		return fmt.Errorf("accrued fee share %q %s must be positive: %s", s.Recipient, s.Denom, s.Amount)
+	}
+	return nil
+}
+
+// NewReconciliationRecord returns an empty reconciliation record for denom.
+func NewReconciliationRecord(denom string) ReconciliationRecord {
+	return ReconciliationRecord{
+		Denom:           denom,
+		Burned:          sdkmath.ZeroInt(),
+		TreasuryInflows: sdkmath.ZeroInt(),
+		SupplyAnchor:    sdkmath.ZeroInt(),
+		Minted:          sdkmath.ZeroInt(),
+		FeeBurnedAnchor: sdkmath.ZeroInt(),
+		TreasuryAnchor:  sdkmath.ZeroInt(),
+	}
+}
+
+func (r ReconciliationRecord) Validate() error {
+	if err := sdk.ValidateDenom(r.Denom); err != nil {
+		return fmt.Errorf("invalid reconciliation denom: %w", err)
+	}
+	for _, f := range []struct {
+		name   string
+		amount sdkmath.Int
+	}{
+		{"burned", r.Burned}, //nolint:staticcheck // deprecated, still validated on import
+		{"treasury_inflows", r.TreasuryInflows},
+		{"supply_anchor", r.SupplyAnchor},
+		{"minted", r.Minted},
+		{"fee_burned_anchor", r.FeeBurnedAnchor},
+		{"treasury_anchor", r.TreasuryAnchor},
+	} {
+		if !f.amount.IsNil() && f.amount.IsNegative() {
+			return fmt.Errorf("reconciliation %s.%s must not be negative: %s", r.Denom, f.name, f.amount)
+		}
+	}
+	return nil
+}
+
+// ParseContractAddress parses a 0x-prefixed hex contract address.
+func ParseContractAddress(addr string) (common.Address, error) {
+	if !common.IsHexAddress(addr) {
+		return common.Address{}, fmt.Errorf("invalid contract address: %q", addr)
+	}
+	contract := common.HexToAddress(addr)
+	if contract == (common.Address{}) {
+		return common.Address{}, fmt.Errorf("contract address must not be zero")
+	}
+	return contract, nil
+}
+
+func (c ContractRevenue) Validate() error {
+	if _, err := ParseContractAddress(c.ContractAddress); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(c.DeployerAddress); err != nil {
+		return fmt.Errorf("invalid contract revenue deployer_address: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(c.WithdrawAddress); err != nil {
+		return fmt.Errorf("invalid contract revenue withdraw_address: %w", err)
+	}
+	return nil
+}
+
+func validateRevenueRecords(records []RevenueRecord) error {
+	seen := make(map[string]struct{}, len(records))
+	for _, r := range records {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+		if _, ok := seen[r.Denom]; ok {
+			return fmt.Errorf("duplicate revenue denom: %s", r.Denom)
+		}
+		seen[r.Denom] = struct{}{}
+	}
+	return nil
+}
+
+// addInt adds two ints, treating nil (unset) values as zero.
+func addInt(a, b sdkmath.Int) sdkmath.Int {
+	if a.IsNil() {
+		a = sdkmath.ZeroInt()
+	}
+	if b.IsNil() {
+		return a
+	}
+	return a.Add(b)
+}
@@ -0,0 +1,404 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ynx/ynx/v1/revenue.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RevenueRecord tracks how protocol revenue of a single denom was split.
+type RevenueRecord struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// fee_burned is the amount of transaction fees burned.
+	FeeBurned cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=fee_burned,json=feeBurned,proto3,customtype=cosmossdk.io/math.Int" json:"fee_burned"`
+	// fee_treasury is the amount of transaction fees sent to treasury_address.
+	FeeTreasury cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=fee_treasury,json=feeTreasury,proto3,customtype=cosmossdk.io/math.Int" json:"fee_treasury"`
+	// fee_founder is the amount of transaction fees sent to founder_address.
+	FeeFounder cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=fee_founder,json=feeFounder,proto3,customtype=cosmossdk.io/math.Int" json:"fee_founder"`
+	// fee_validators is the remainder of transaction fees left in the fee collector for
+	// validator/delegator distribution.
+	FeeValidators cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=fee_validators,json=feeValidators,proto3,customtype=cosmossdk.io/math.Int" json:"fee_validators"`
+	// inflation_treasury is the amount of minted inflation sent to treasury_address.
+	InflationTreasury cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=inflation_treasury,json=inflationTreasury,proto3,customtype=cosmossdk.io/math.Int" json:"inflation_treasury"`
+	// inflation_validators is the remainder of minted inflation left in the fee collector for
+	// validator/delegator distribution.
+	InflationValidators cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=inflation_validators,json=inflationValidators,proto3,customtype=cosmossdk.io/math.Int" json:"inflation_validators"`
+	// inflation_recipients is the amount of minted inflation sent to inflation_recipients.
+	InflationRecipients cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=inflation_recipients,json=inflationRecipients,proto3,customtype=cosmossdk.io/math.Int" json:"inflation_recipients"`
+	// fee_developers is the amount of transaction fees rebated to contract withdraw addresses.
+	FeeDevelopers        cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=fee_developers,json=feeDevelopers,proto3,customtype=cosmossdk.io/math.Int" json:"fee_developers"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *RevenueRecord) Reset()         { *m = RevenueRecord{} }
+func (m *RevenueRecord) String() string { return proto.CompactTextString(m) }
+func (*RevenueRecord) ProtoMessage()    {}
+func (*RevenueRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92b111812d0a0459, []int{0}
+}
+func (m *RevenueRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevenueRecord.Unmarshal(m, b)
+}
+func (m *RevenueRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevenueRecord.Marshal(b, m, deterministic)
+}
+func (m *RevenueRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevenueRecord.Merge(m, src)
+}
+func (m *RevenueRecord) XXX_Size() int {
+	return xxx_messageInfo_RevenueRecord.Size(m)
+}
+func (m *RevenueRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevenueRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevenueRecord proto.InternalMessageInfo
+
+func (m *RevenueRecord) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// EpochInfo identifies the current revenue accounting epoch.
+type EpochInfo struct {
+	Number uint64 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	// start_height is the block height at which the epoch started.
+	StartHeight          int64    `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EpochInfo) Reset()         { *m = EpochInfo{} }
+func (m *EpochInfo) String() string { return proto.CompactTextString(m) }
+func (*EpochInfo) ProtoMessage()    {}
+func (*EpochInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92b111812d0a0459, []int{1}
+}
+func (m *EpochInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EpochInfo.Unmarshal(m, b)
+}
+func (m *EpochInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EpochInfo.Marshal(b, m, deterministic)
+}
+func (m *EpochInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochInfo.Merge(m, src)
+}
+func (m *EpochInfo) XXX_Size() int {
+	return xxx_messageInfo_EpochInfo.Size(m)
+}
+func (m *EpochInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochInfo proto.InternalMessageInfo
+
+func (m *EpochInfo) GetNumber() uint64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *EpochInfo) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+// EpochRevenue is the revenue recorded during a single epoch.
+type EpochRevenue struct {
+	Epoch                uint64          `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Revenue              []RevenueRecord `protobuf:"bytes,2,rep,name=revenue,proto3" json:"revenue"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *EpochRevenue) Reset()         { *m = EpochRevenue{} }
+func (m *EpochRevenue) String() string { return proto.CompactTextString(m) }
+func (*EpochRevenue) ProtoMessage()    {}
+func (*EpochRevenue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92b111812d0a0459, []int{2}
+}
+func (m *EpochRevenue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EpochRevenue.Unmarshal(m, b)
+}
+func (m *EpochRevenue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EpochRevenue.Marshal(b, m, deterministic)
+}
+func (m *EpochRevenue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochRevenue.Merge(m, src)
+}
+func (m *EpochRevenue) XXX_Size() int {
+	return xxx_messageInfo_EpochRevenue.Size(m)
+}
+func (m *EpochRevenue) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochRevenue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochRevenue proto.InternalMessageInfo
+
+func (m *EpochRevenue) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *EpochRevenue) GetRevenue() []RevenueRecord {
+	if m != nil {
+		return m.Revenue
+	}
+	return nil
+}
+
+// AccruedFeeShare is a protocol fee share held in the x/ynx module account until the next fee
+// settlement.
+type AccruedFeeShare struct {
+	// recipient is the bech32 address the share is paid to. Empty for shares that are burned.
+	Recipient            string                `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Denom                string                `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount               cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *AccruedFeeShare) Reset()         { *m = AccruedFeeShare{} }
+func (m *AccruedFeeShare) String() string { return proto.CompactTextString(m) }
+func (*AccruedFeeShare) ProtoMessage()    {}
+func (*AccruedFeeShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92b111812d0a0459, []int{3}
+}
+func (m *AccruedFeeShare) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccruedFeeShare.Unmarshal(m, b)
+}
+func (m *AccruedFeeShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccruedFeeShare.Marshal(b, m, deterministic)
+}
+func (m *AccruedFeeShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccruedFeeShare.Merge(m, src)
+}
+func (m *AccruedFeeShare) XXX_Size() int {
+	return xxx_messageInfo_AccruedFeeShare.Size(m)
+}
+func (m *AccruedFeeShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccruedFeeShare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccruedFeeShare proto.InternalMessageInfo
+
+func (m *AccruedFeeShare) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *AccruedFeeShare) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// ContractRevenue registers the withdraw address that receives the developer fee rebate of an EVM
+// contract.
+type ContractRevenue struct {
+	// contract_address is the 0x-prefixed hex address of the contract.
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// deployer_address is the bech32 address that registered the contract. It is the contract
+	// itself for contracts that registered through the IYNXProtocol precompile.
+	DeployerAddress string `protobuf:"bytes,2,opt,name=deployer_address,json=deployerAddress,proto3" json:"deployer_address,omitempty"`
+	// withdraw_address receives the rebates.
+	WithdrawAddress      string   `protobuf:"bytes,3,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContractRevenue) Reset()         { *m = ContractRevenue{} }
+func (m *ContractRevenue) String() string { return proto.CompactTextString(m) }
+func (*ContractRevenue) ProtoMessage()    {}
+func (*ContractRevenue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92b111812d0a0459, []int{4}
+}
+func (m *ContractRevenue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContractRevenue.Unmarshal(m, b)
+}
+func (m *ContractRevenue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContractRevenue.Marshal(b, m, deterministic)
+}
+func (m *ContractRevenue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractRevenue.Merge(m, src)
+}
+func (m *ContractRevenue) XXX_Size() int {
+	return xxx_messageInfo_ContractRevenue.Size(m)
+}
+func (m *ContractRevenue) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractRevenue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractRevenue proto.InternalMessageInfo
+
+func (m *ContractRevenue) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *ContractRevenue) GetDeployerAddress() string {
+	if m != nil {
+		return m.DeployerAddress
+	}
+	return ""
+}
+
+func (m *ContractRevenue) GetWithdrawAddress() string {
+	if m != nil {
+		return m.WithdrawAddress
+	}
+	return ""
+}
+
+// ReconciliationRecord tracks the bank-side effects of the protocol revenue of a single denom since
+// the record was anchored. The x/ynx invariants reconcile it, and the bank state, with the revenue
+// ledger.
+type ReconciliationRecord struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Deprecated: burned was the drop in total supply observed across fee burns. The supply-burns
+	// invariant checks the bank total supply instead, and burned is no longer updated.
+	Burned cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=burned,proto3,customtype=cosmossdk.io/math.Int" json:"burned"` // Deprecated: Do not use.
+	// treasury_inflows is the increase of the treasury_address balance observed across fee and
+	// inflation payouts to it since the record was anchored.
+	TreasuryInflows cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=treasury_inflows,json=treasuryInflows,proto3,customtype=cosmossdk.io/math.Int" json:"treasury_inflows"`
+	// supply_anchor is the bank total supply when the record was anchored. It is only set for the
+	// x/mint denom, the only denom whose supply is reconciled.
+	SupplyAnchor cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=supply_anchor,json=supplyAnchor,proto3,customtype=cosmossdk.io/math.Int" json:"supply_anchor"`
+	// minted is the amount minted by x/mint and by legacy NYXT redemptions since the record was
+	// anchored.
+	Minted cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=minted,proto3,customtype=cosmossdk.io/math.Int" json:"minted"`
+	// fee_burned_anchor is the ledger fee_burned, less the burns awaiting settlement, when the record
+	// was anchored.
+	FeeBurnedAnchor cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=fee_burned_anchor,json=feeBurnedAnchor,proto3,customtype=cosmossdk.io/math.Int" json:"fee_burned_anchor"`
+	// treasury_anchor is the ledger fee_treasury plus inflation_treasury, less the treasury shares
+	// awaiting settlement, when the record was anchored.
+	TreasuryAnchor       cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=treasury_anchor,json=treasuryAnchor,proto3,customtype=cosmossdk.io/math.Int" json:"treasury_anchor"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ReconciliationRecord) Reset()         { *m = ReconciliationRecord{} }
+func (m *ReconciliationRecord) String() string { return proto.CompactTextString(m) }
+func (*ReconciliationRecord) ProtoMessage()    {}
+func (*ReconciliationRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92b111812d0a0459, []int{5}
+}
+func (m *ReconciliationRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReconciliationRecord.Unmarshal(m, b)
+}
+func (m *ReconciliationRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReconciliationRecord.Marshal(b, m, deterministic)
+}
+func (m *ReconciliationRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReconciliationRecord.Merge(m, src)
+}
+func (m *ReconciliationRecord) XXX_Size() int {
+	return xxx_messageInfo_ReconciliationRecord.Size(m)
+}
+func (m *ReconciliationRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReconciliationRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReconciliationRecord proto.InternalMessageInfo
+
+func (m *ReconciliationRecord) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*RevenueRecord)(nil), "ynx.ynx.v1.RevenueRecord")
+	proto.RegisterType((*EpochInfo)(nil), "ynx.ynx.v1.EpochInfo")
+	proto.RegisterType((*EpochRevenue)(nil), "ynx.ynx.v1.EpochRevenue")
+	proto.RegisterType((*AccruedFeeShare)(nil), "ynx.ynx.v1.AccruedFeeShare")
+	proto.RegisterType((*ContractRevenue)(nil), "ynx.ynx.v1.ContractRevenue")
+	proto.RegisterType((*ReconciliationRecord)(nil), "ynx.ynx.v1.ReconciliationRecord")
+}
+
+func init() { proto.RegisterFile("ynx/ynx/v1/revenue.proto", fileDescriptor_92b111812d0a0459) }
+
+var fileDescriptor_92b111812d0a0459 = []byte{
+	// 693 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0xdd, 0x4e, 0xdb, 0x4a,
+	0x10, 0xc7, 0x4f, 0x3e, 0x08, 0x27, 0x43, 0x20, 0xb0, 0x27, 0xe7, 0xc8, 0x70, 0x03, 0x27, 0x57,
+	0x54, 0x2d, 0xb6, 0x68, 0xa5, 0x4a, 0xbd, 0x4c, 0x28, 0xa8, 0x41, 0x15, 0xaa, 0x0c, 0xa2, 0x2d,
+	0x17, 0xb5, 0x36, 0xf6, 0x24, 0x5e, 0x35, 0xd9, 0xb5, 0xd6, 0xeb, 0x40, 0x1e, 0xa3, 0xef, 0xd0,
+	0x47, 0xe0, 0x19, 0xaa, 0x5e, 0xd3, 0xbb, 0x5e, 0xf0, 0x2c, 0x95, 0xbd, 0x6b, 0x27, 0x5c, 0x54,
+	0x28, 0xe9, 0x85, 0xa5, 0xcc, 0x78, 0xe7, 0x97, 0x99, 0xf1, 0xfe, 0x67, 0xc0, 0x9a, 0xf2, 0x1b,
+	0x27, 0x7d, 0x26, 0x87, 0x8e, 0xc4, 0x09, 0xf2, 0x04, 0xed, 0x48, 0x0a, 0x25, 0x08, 0x4c, 0xf9,
+	0x8d, 0x9d, 0x3e, 0x93, 0xc3, 0x9d, 0x6d, 0x5f, 0xc4, 0x63, 0x11, 0x7b, 0xd9, 0x1b, 0x47, 0x1b,
+	0xfa, 0xd8, 0x4e, 0x6b, 0x28, 0x86, 0x42, 0xfb, 0xd3, 0x5f, 0xda, 0xdb, 0xfe, 0xb1, 0x02, 0xeb,
+	0xae, 0xc6, 0xb9, 0xe8, 0x0b, 0x19, 0x90, 0x16, 0xac, 0x04, 0xc8, 0xc5, 0xd8, 0x2a, 0xed, 0x95,
+	0xf6, 0xeb, 0xae, 0x36, 0xc8, 0x29, 0xc0, 0x00, 0xd1, 0xeb, 0x27, 0x92, 0x63, 0x60, 0x95, 0xd3,
+	0x57, 0xdd, 0xa7, 0xdf, 0xef, 0x77, 0xff, 0xfa, 0x79, 0xbf, 0xfb, 0xaf, 0xfe, 0x9f, 0x38, 0xf8,
+	0x6c, 0x33, 0xe1, 0x8c, 0xa9, 0x0a, 0xed, 0x1e, 0x57, 0x77, 0xb7, 0x07, 0x60, 0x12, 0xe8, 0x71,
+	0xe5, 0xd6, 0x07, 0x88, 0xdd, 0x2c, 0x9a, 0x9c, 0x41, 0x23, 0x65, 0x29, 0x89, 0x34, 0x4e, 0xe4,
+	0xd4, 0xaa, 0x2c, 0x4e, 0x5b, 0x1b, 0x20, 0x5e, 0x98, 0x78, 0xf2, 0x16, 0x52, 0xd3, 0x1b, 0x88,
+	0x84, 0x07, 0x28, 0xad, 0xea, 0xe2, 0xb8, 0xb4, 0xb6, 0x13, 0x1d, 0x4e, 0x5c, 0xd8, 0x48, 0x69,
+	0x13, 0x3a, 0x62, 0x01, 0x55, 0x42, 0xc6, 0xd6, 0xca, 0xe2, 0xc0, 0xf5, 0x01, 0xe2, 0x65, 0x41,
+	0x20, 0x57, 0x40, 0x18, 0x1f, 0x8c, 0xa8, 0x62, 0x82, 0xcf, 0xea, 0xae, 0x2d, 0xce, 0xdd, 0x2a,
+	0x30, 0x45, 0xf5, 0x9f, 0xa0, 0x35, 0x63, 0xcf, 0x65, 0xbd, 0xba, 0x38, 0xfd, 0x9f, 0x02, 0x34,
+	0x97, 0xfb, 0x03, 0xbe, 0x44, 0x9f, 0x45, 0x0c, 0xb9, 0x8a, 0xad, 0xbf, 0xff, 0x84, 0xef, 0x16,
+	0x9c, 0xbc, 0xdf, 0x01, 0x4e, 0x70, 0x24, 0x22, 0x94, 0xb1, 0x55, 0x5f, 0xae, 0xdf, 0xaf, 0x0b,
+	0x42, 0xfb, 0x04, 0xea, 0xc7, 0x91, 0xf0, 0xc3, 0x1e, 0x1f, 0x08, 0xf2, 0x1f, 0xd4, 0x78, 0x32,
+	0xee, 0xa3, 0xcc, 0x6e, 0x74, 0xd5, 0x35, 0x16, 0xf9, 0x1f, 0x1a, 0xb1, 0xa2, 0x52, 0x79, 0x21,
+	0xb2, 0x61, 0xa8, 0xb2, 0x4b, 0x5d, 0x71, 0xd7, 0x32, 0xdf, 0x9b, 0xcc, 0xd5, 0xf6, 0xa0, 0x91,
+	0x71, 0x8c, 0x42, 0x52, 0x6d, 0x60, 0x6a, 0x1b, 0x92, 0x36, 0xc8, 0x2b, 0x58, 0x35, 0x8a, 0xb4,
+	0xca, 0x7b, 0x95, 0xfd, 0xb5, 0xe7, 0xdb, 0xf6, 0x4c, 0x92, 0xf6, 0x03, 0x75, 0x75, 0xab, 0x69,
+	0x55, 0x6e, 0x7e, 0xbe, 0xfd, 0xb5, 0x04, 0xcd, 0x8e, 0xef, 0xcb, 0x04, 0x83, 0x13, 0xc4, 0xf3,
+	0x90, 0x4a, 0x24, 0x2f, 0xa1, 0x5e, 0xb4, 0x59, 0x8b, 0xb0, 0x6b, 0xdd, 0xdd, 0x1e, 0xb4, 0x4c,
+	0xb9, 0x9d, 0x20, 0x90, 0x18, 0xc7, 0xe7, 0x4a, 0x32, 0x3e, 0x74, 0x67, 0x47, 0x67, 0xc2, 0x2d,
+	0xcf, 0x0b, 0xf7, 0x08, 0x6a, 0x74, 0x2c, 0x12, 0xae, 0x96, 0x91, 0x99, 0x09, 0x6d, 0x7f, 0x2b,
+	0x41, 0xf3, 0x48, 0x70, 0x25, 0xa9, 0xaf, 0xf2, 0x5e, 0x3c, 0x81, 0x4d, 0xdf, 0xb8, 0x3c, 0xaa,
+	0x73, 0x32, 0x23, 0xa3, 0x99, 0xfb, 0x4d, 0xaa, 0xe4, 0x08, 0x36, 0x03, 0x8c, 0x46, 0x62, 0x8a,
+	0xb2, 0x38, 0x5a, 0x7e, 0xa4, 0xb0, 0x66, 0x1e, 0x31, 0x07, 0xb9, 0x66, 0x2a, 0x0c, 0x24, 0xbd,
+	0x2e, 0x20, 0x95, 0xc7, 0x20, 0x79, 0x84, 0x71, 0xb7, 0xbf, 0x54, 0xa1, 0x95, 0x7e, 0x09, 0xee,
+	0xb3, 0x11, 0xcb, 0x6f, 0xe2, 0xef, 0xa7, 0xde, 0x31, 0xd4, 0x1e, 0x4c, 0xbc, 0x83, 0x05, 0x9a,
+	0x67, 0x95, 0x5c, 0x13, 0x4c, 0x2e, 0x61, 0x33, 0x17, 0xbd, 0x97, 0x4a, 0x40, 0x5c, 0xc7, 0xcb,
+	0x7c, 0x8d, 0x66, 0x0e, 0xe9, 0x69, 0x06, 0x79, 0x07, 0xeb, 0x71, 0x12, 0x45, 0xa3, 0xa9, 0x47,
+	0xb9, 0x1f, 0x8a, 0xa5, 0x46, 0x5f, 0x43, 0x13, 0x3a, 0x19, 0x20, 0xbd, 0x2d, 0x63, 0xc6, 0x15,
+	0x06, 0xcb, 0x0c, 0x3d, 0x13, 0x4a, 0xde, 0xc3, 0xd6, 0x6c, 0x57, 0xe4, 0xa9, 0x2d, 0x31, 0xec,
+	0x9a, 0xc5, 0xca, 0x30, 0xd9, 0x5d, 0x40, 0xd1, 0x82, 0x1c, 0xbb, 0xc4, 0x94, 0xdb, 0xc8, 0x19,
+	0x9a, 0xda, 0xb5, 0xaf, 0x9e, 0x0d, 0x99, 0x0a, 0x93, 0xbe, 0xed, 0x8b, 0xb1, 0x73, 0xca, 0x68,
+	0x48, 0x45, 0x67, 0xd4, 0x4f, 0x62, 0xe7, 0xe3, 0xd9, 0x07, 0xc7, 0x0f, 0x29, 0xe3, 0x8e, 0x5e,
+	0xbd, 0x6a, 0x1a, 0x61, 0xdc, 0xaf, 0x65, 0x9b, 0xf3, 0xc5, 0xaf, 0x01, 0x00, 0xca, 0xce, 0x9c,
+	0x3e, 0x92, 0x07, 0x00, 0x00,
+}
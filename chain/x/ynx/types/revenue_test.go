@@ -0,0 +1,24 @@
+package types
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+func TestRevenueRecordAddTreatsUnsetAsZero(t *testing.T) {
+	t.Parallel()
+
+	delta := RevenueRecord{Denom: "anyxt", FeeBurned: sdkmath.NewInt(5)}
+	sum := NewRevenueRecord("anyxt").Add(delta).Add(delta)
+
+	if got, want := sum.FeeBurned, sdkmath.NewInt(10); !got.Equal(want) {
+		t.Fatalf("expected fee_burned %s, got %s", want, got)
+	}
+	if !sum.FeeTreasury.IsZero() {
+		t.Fatalf("expected fee_treasury to stay zero, got %s", sum.FeeTreasury)
+	}
+	if err := sum.Validate(); err != nil {
+		t.Fatalf("expected summed record to validate, got error: %v", err)
+	}
+}
@@ -0,0 +1,126 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MaxSponsoredContracts bounds the allowed contracts of a sponsorship policy.
+	MaxSponsoredContracts = 64
+
+	// MaxSponsoredMsgTypes bounds the allowed message type URLs of a sponsorship policy.
+	MaxSponsoredMsgTypes = 64
+
+	// SecondsPerDay is the length of the day that sponsorship daily caps apply to.
+	SecondsPerDay = 24 * 60 * 60
+)
+
+func (p SponsorshipPolicy) Validate() error {
+	if len(p.AllowedContracts) == 0 && len(p.AllowedMsgTypeUrls) == 0 {
+		return fmt.Errorf("sponsorship policy must allow at least one contract or message type")
+	}
+	if len(p.AllowedContracts) > MaxSponsoredContracts {
+		return fmt.Errorf("sponsorship policy allows %d contracts, at most %d", len(p.AllowedContracts), MaxSponsoredContracts)
+	}
+
+	seen := make(map[string]struct{}, len(p.AllowedContracts))
+	for _, addr := range p.AllowedContracts {
+		contract, err := ParseContractAddress(addr)
+		if err != nil {
+			return err
+		}
+		if _, ok := seen[contract.Hex()]; ok {
+			return fmt.Errorf("duplicate sponsored contract: %s", contract.Hex())
+		}
+		seen[contract.Hex()] = struct{}{}
+	}
+
+	if len(p.AllowedMsgTypeUrls) > MaxSponsoredMsgTypes {
+		return fmt.Errorf("sponsorship policy allows %d message types, at most %d", len(p.AllowedMsgTypeUrls), MaxSponsoredMsgTypes)
+	}
+	for _, typeURL := range p.AllowedMsgTypeUrls {
+		if err := ValidateMsgTypeURL(typeURL); err != nil {
+			return err
+		}
+		if _, ok := seen[typeURL]; ok {
+			return fmt.Errorf("duplicate sponsored message type: %s", typeURL)
+		}
+		seen[typeURL] = struct{}{}
+	}
+
+	if p.DailyCap.IsNil() || p.DailyCap.IsNegative() {
+		return fmt.Errorf("sponsorship daily_cap must not be negative: %s", p.DailyCap)
+	}
+	if p.ExpiresAt < 0 {
+		return fmt.Errorf("sponsorship expires_at must not be negative: %d", p.ExpiresAt)
+	}
+
+	return nil
+}
+
+// AllowsMsgTypes reports whether the policy allows every one of typeURLs.
+func (p SponsorshipPolicy) AllowsMsgTypes(typeURLs []string) bool {
+	for _, typeURL := range typeURLs {
+		found := false
+		for _, allowed := range p.AllowedMsgTypeUrls {
+			if allowed == typeURL {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return len(typeURLs) > 0
+}
+
+// ValidateMsgTypeURL checks that typeURL has the form of a message type URL, e.g.
+// "/cosmos.bank.v1beta1.MsgSend".
+func ValidateMsgTypeURL(typeURL string) error {
+	if !strings.HasPrefix(typeURL, "/") || len(typeURL) < 2 || strings.ContainsAny(typeURL, " \t\n") {
+		return fmt.Errorf("invalid message type url: %q", typeURL)
+	}
+	return nil
+}
+
+// Active reports whether the sponsorship still pays fees at blockTime (unix seconds).
+func (p SponsorshipPolicy) Active(blockTime int64) bool {
+	return p.ExpiresAt == 0 || blockTime < p.ExpiresAt
+}
+
+// DailyRemaining returns how much more the sponsorship may pay on day, or nil without a daily cap.
+func (s Sponsorship) DailyRemaining(day uint64) *sdkmath.Int {
+	if s.Policy.DailyCap.IsZero() {
+		return nil
+	}
+	remaining := s.Policy.DailyCap
+	if s.Day == day {
+		remaining = sdkmath.MaxInt(remaining.Sub(s.SpentToday), sdkmath.ZeroInt())
+	}
+	return &remaining
+}
+
+func (s Sponsorship) Validate() error {
+	if s.Id == 0 {
+		return fmt.Errorf("sponsorship id must be positive")
+	}
+	if _, err := sdk.AccAddressFromBech32(s.Sponsor); err != nil {
+		return fmt.Errorf("invalid sponsorship %d sponsor: %w", s.Id, err)
+	}
+	if err := s.Policy.Validate(); err != nil {
+		return fmt.Errorf("invalid sponsorship %d policy: %w", s.Id, err)
+	}
+	if s.Budget.IsNil() || s.Budget.IsNegative() {
+		return fmt.Errorf("sponsorship %d budget must not be negative: %s", s.Id, s.Budget)
+	}
+	if s.SpentToday.IsNil() || s.SpentToday.IsNegative() {
+		return fmt.Errorf("sponsorship %d spent_today must not be negative: %s", s.Id, s.SpentToday)
+	}
+	return nil
+}
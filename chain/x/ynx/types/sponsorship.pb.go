@@ -0,0 +1,206 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ynx/ynx/v1/sponsorship.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SponsorshipPolicy limits which transactions a sponsorship pays for: EVM transactions calling one
+// of allowed_contracts and Cosmos transactions whose messages all have one of
+// allowed_msg_type_urls.
+type SponsorshipPolicy struct {
+	// allowed_contracts are the 0x-prefixed addresses of the contracts whose calls are sponsored.
+	AllowedContracts []string `protobuf:"bytes,1,rep,name=allowed_contracts,json=allowedContracts,proto3" json:"allowed_contracts,omitempty"`
+	// max_gas_per_tx is the highest gas limit a sponsored transaction may set. Zero means no limit.
+	MaxGasPerTx uint64 `protobuf:"varint,2,opt,name=max_gas_per_tx,json=maxGasPerTx,proto3" json:"max_gas_per_tx,omitempty"`
+	// daily_cap bounds the fees paid per UTC day. Zero means no cap.
+	DailyCap cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=daily_cap,json=dailyCap,proto3,customtype=cosmossdk.io/math.Int" json:"daily_cap"`
+	// expires_at is the block time (unix seconds) from which the sponsorship no longer pays fees.
+	// Zero means it never expires.
+	ExpiresAt int64 `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// allowed_msg_type_urls are the type URLs of the Cosmos messages whose transactions are
+	// sponsored, e.g. "/cosmos.bank.v1beta1.MsgSend".
+	AllowedMsgTypeUrls   []string `protobuf:"bytes,5,rep,name=allowed_msg_type_urls,json=allowedMsgTypeUrls,proto3" json:"allowed_msg_type_urls,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SponsorshipPolicy) Reset()         { *m = SponsorshipPolicy{} }
+func (m *SponsorshipPolicy) String() string { return proto.CompactTextString(m) }
+func (*SponsorshipPolicy) ProtoMessage()    {}
+func (*SponsorshipPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f746d6713c58a144, []int{0}
+}
+func (m *SponsorshipPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SponsorshipPolicy.Unmarshal(m, b)
+}
+func (m *SponsorshipPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SponsorshipPolicy.Marshal(b, m, deterministic)
+}
+func (m *SponsorshipPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SponsorshipPolicy.Merge(m, src)
+}
+func (m *SponsorshipPolicy) XXX_Size() int {
+	return xxx_messageInfo_SponsorshipPolicy.Size(m)
+}
+func (m *SponsorshipPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_SponsorshipPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SponsorshipPolicy proto.InternalMessageInfo
+
+func (m *SponsorshipPolicy) GetAllowedContracts() []string {
+	if m != nil {
+		return m.AllowedContracts
+	}
+	return nil
+}
+
+func (m *SponsorshipPolicy) GetMaxGasPerTx() uint64 {
+	if m != nil {
+		return m.MaxGasPerTx
+	}
+	return 0
+}
+
+func (m *SponsorshipPolicy) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *SponsorshipPolicy) GetAllowedMsgTypeUrls() []string {
+	if m != nil {
+		return m.AllowedMsgTypeUrls
+	}
+	return nil
+}
+
+// Sponsorship is a budget that pays the fees of transactions matching its policy instead of their
+// sender.
+type Sponsorship struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// sponsor is the account or contract that funds and controls the sponsorship.
+	Sponsor string            `protobuf:"bytes,2,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	Policy  SponsorshipPolicy `protobuf:"bytes,3,opt,name=policy,proto3" json:"policy"`
+	// budget is the remaining amount of the EVM denom held for the sponsorship.
+	Budget cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=budget,proto3,customtype=cosmossdk.io/math.Int" json:"budget"`
+	// day is the UTC day (block time / 86400) spent_today refers to.
+	Day uint64 `protobuf:"varint,5,opt,name=day,proto3" json:"day,omitempty"`
+	// spent_today is the amount of fees paid during day.
+	SpentToday           cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=spent_today,json=spentToday,proto3,customtype=cosmossdk.io/math.Int" json:"spent_today"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *Sponsorship) Reset()         { *m = Sponsorship{} }
+func (m *Sponsorship) String() string { return proto.CompactTextString(m) }
+func (*Sponsorship) ProtoMessage()    {}
+func (*Sponsorship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f746d6713c58a144, []int{1}
+}
+func (m *Sponsorship) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Sponsorship.Unmarshal(m, b)
+}
+func (m *Sponsorship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Sponsorship.Marshal(b, m, deterministic)
+}
+func (m *Sponsorship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Sponsorship.Merge(m, src)
+}
+func (m *Sponsorship) XXX_Size() int {
+	return xxx_messageInfo_Sponsorship.Size(m)
+}
+func (m *Sponsorship) XXX_DiscardUnknown() {
+	xxx_messageInfo_Sponsorship.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Sponsorship proto.InternalMessageInfo
+
+func (m *Sponsorship) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Sponsorship) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+func (m *Sponsorship) GetPolicy() SponsorshipPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return SponsorshipPolicy{}
+}
+
+func (m *Sponsorship) GetDay() uint64 {
+	if m != nil {
+		return m.Day
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*SponsorshipPolicy)(nil), "ynx.ynx.v1.SponsorshipPolicy")
+	proto.RegisterType((*Sponsorship)(nil), "ynx.ynx.v1.Sponsorship")
+}
+
+func init() { proto.RegisterFile("ynx/ynx/v1/sponsorship.proto", fileDescriptor_f746d6713c58a144) }
+
+var fileDescriptor_f746d6713c58a144 = []byte{
+	// 447 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x41, 0x6f, 0xd3, 0x30,
+	0x18, 0x25, 0x69, 0x57, 0xc8, 0x57, 0x69, 0xda, 0xac, 0x4d, 0x0a, 0x13, 0xd3, 0xaa, 0x71, 0xa9,
+	0x34, 0x96, 0xa8, 0xe3, 0xc8, 0xa9, 0xed, 0x01, 0x86, 0x00, 0x4d, 0x59, 0x91, 0x80, 0x8b, 0xe5,
+	0x26, 0x56, 0x62, 0x91, 0xd8, 0x96, 0x3f, 0x77, 0x24, 0x47, 0x7e, 0x13, 0xfb, 0x11, 0x9c, 0x77,
+	0xe4, 0xb0, 0xdf, 0x82, 0xe2, 0xa4, 0x1a, 0x12, 0xa7, 0x1d, 0x2c, 0xd9, 0xef, 0xf9, 0x59, 0xef,
+	0x3d, 0x7f, 0xf0, 0xa2, 0x91, 0x75, 0xdc, 0xae, 0x9b, 0x59, 0x8c, 0x5a, 0x49, 0x54, 0x06, 0x0b,
+	0xa1, 0x23, 0x6d, 0x94, 0x55, 0x04, 0x1a, 0x59, 0x47, 0xed, 0xba, 0x99, 0x1d, 0x3d, 0x4f, 0x15,
+	0x56, 0x0a, 0xa9, 0x63, 0xe2, 0xee, 0xd0, 0x5d, 0x3b, 0x3a, 0xc8, 0x55, 0xae, 0x3a, 0xbc, 0xdd,
+	0x75, 0xe8, 0xe9, 0x4f, 0x1f, 0xf6, 0xaf, 0x1f, 0x9e, 0xbc, 0x52, 0xa5, 0x48, 0x1b, 0x72, 0x06,
+	0xfb, 0xac, 0x2c, 0xd5, 0x0f, 0x9e, 0xd1, 0x54, 0x49, 0x6b, 0x58, 0x6a, 0x31, 0xf4, 0x26, 0x83,
+	0x69, 0x90, 0xec, 0xf5, 0xc4, 0x72, 0x8b, 0x93, 0x97, 0xb0, 0x5b, 0xb1, 0x9a, 0xe6, 0x0c, 0xa9,
+	0xe6, 0x86, 0xda, 0x3a, 0xf4, 0x27, 0xde, 0x74, 0x98, 0x8c, 0x2b, 0x56, 0xbf, 0x65, 0x78, 0xc5,
+	0xcd, 0xaa, 0x26, 0xef, 0x20, 0xc8, 0x98, 0x28, 0x1b, 0x9a, 0x32, 0x1d, 0x0e, 0x26, 0xde, 0x34,
+	0x58, 0x9c, 0xfd, 0xbe, 0x3f, 0x79, 0xf2, 0xe7, 0xfe, 0xe4, 0xb0, 0xb3, 0x89, 0xd9, 0xf7, 0x48,
+	0xa8, 0xb8, 0x62, 0xb6, 0x88, 0x2e, 0xa5, 0xbd, 0xbb, 0x3d, 0x87, 0xde, 0xff, 0xa5, 0xb4, 0xc9,
+	0x33, 0xa7, 0x5e, 0x32, 0x4d, 0x8e, 0x01, 0x78, 0xad, 0x85, 0xe1, 0x48, 0x99, 0x0d, 0x87, 0x13,
+	0x6f, 0x3a, 0x48, 0x82, 0x1e, 0x99, 0x5b, 0x32, 0x83, 0xc3, 0xad, 0xf5, 0x0a, 0x73, 0x6a, 0x1b,
+	0xcd, 0xe9, 0xc6, 0x94, 0x18, 0xee, 0x38, 0xfb, 0xa4, 0x27, 0x3f, 0x62, 0xbe, 0x6a, 0x34, 0xff,
+	0x6c, 0x4a, 0x3c, 0xfd, 0xe5, 0xc3, 0xf8, 0x9f, 0x0e, 0xc8, 0x2e, 0xf8, 0x22, 0x0b, 0x3d, 0x17,
+	0xc2, 0x17, 0x19, 0xb9, 0x80, 0xa7, 0x7d, 0xeb, 0x2e, 0x59, 0xb0, 0x08, 0xef, 0x6e, 0xcf, 0x0f,
+	0x7a, 0x73, 0xf3, 0x2c, 0x33, 0x1c, 0xf1, 0xda, 0x1a, 0x21, 0xf3, 0x64, 0x7b, 0x91, 0xbc, 0x81,
+	0x91, 0x76, 0x5d, 0xba, 0xb0, 0xe3, 0x8b, 0xe3, 0xe8, 0xe1, 0x97, 0xa2, 0xff, 0x0a, 0x5f, 0x0c,
+	0xdb, 0x2e, 0x92, 0x5e, 0x42, 0x96, 0x30, 0x5a, 0x6f, 0xb2, 0x9c, 0x77, 0xf1, 0x1e, 0xd9, 0x54,
+	0x2f, 0x25, 0x7b, 0x30, 0xc8, 0x58, 0x13, 0xee, 0xb8, 0x18, 0xed, 0x96, 0x7c, 0x80, 0x31, 0x6a,
+	0x2e, 0x2d, 0xb5, 0xaa, 0x65, 0x46, 0x8f, 0x7f, 0x1b, 0x9c, 0x7e, 0xd5, 0xca, 0x17, 0xd1, 0xb7,
+	0x57, 0xb9, 0xb0, 0xc5, 0x66, 0x1d, 0xa5, 0xaa, 0x8a, 0xdf, 0x0b, 0x56, 0x30, 0x35, 0x2f, 0xd7,
+	0x1b, 0x8c, 0xbf, 0x7e, 0xfa, 0x12, 0xa7, 0x05, 0x13, 0x32, 0xee, 0xa6, 0xb6, 0xfd, 0x03, 0x5c,
+	0x8f, 0xdc, 0xc0, 0xbd, 0xfe, 0x3b, 0x00, 0xbe, 0x38, 0x05, 0xe3, 0xcd, 0x02, 0x00, 0x00,
+}
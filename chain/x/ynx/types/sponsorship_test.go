@@ -0,0 +1,85 @@
+package types
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+func TestSponsorshipPolicyValidate(t *testing.T) {
+	t.Parallel()
+
+	policy := SponsorshipPolicy{
+		AllowedContracts: []string{"0x1111111111111111111111111111111111111111"},
+		DailyCap:         sdkmath.ZeroInt(),
+	}
+	if err := policy.Validate(); err != nil {
+		t.Fatalf("expected policy to validate, got error: %v", err)
+	}
+
+	dup := policy
+	dup.AllowedContracts = []string{
+		"0x1111111111111111111111111111111111111111",
+		"0x1111111111111111111111111111111111111111",
+	}
+	if err := dup.Validate(); err == nil {
+		t.Fatal("expected duplicate contracts to fail validation")
+	}
+
+	empty := policy
+	empty.AllowedContracts = nil
+	if err := empty.Validate(); err == nil {
+		t.Fatal("expected a policy without contracts or message types to fail validation")
+	}
+
+	msgs := empty
+	msgs.AllowedMsgTypeUrls = []string{"/cosmos.bank.v1beta1.MsgSend"}
+	if err := msgs.Validate(); err != nil {
+		t.Fatalf("expected a policy with only message types to validate, got error: %v", err)
+	}
+	if !msgs.AllowsMsgTypes([]string{"/cosmos.bank.v1beta1.MsgSend"}) ||
+		msgs.AllowsMsgTypes([]string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.bank.v1beta1.MsgMultiSend"}) ||
+		msgs.AllowsMsgTypes(nil) {
+		t.Fatal("expected only transactions whose messages are all allowed to be allowed")
+	}
+
+	dupMsgs := msgs
+	dupMsgs.AllowedMsgTypeUrls = []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.bank.v1beta1.MsgSend"}
+	if err := dupMsgs.Validate(); err == nil {
+		t.Fatal("expected duplicate message types to fail validation")
+	}
+
+	badMsgs := msgs
+	badMsgs.AllowedMsgTypeUrls = []string{"cosmos.bank.v1beta1.MsgSend"}
+	if err := badMsgs.Validate(); err == nil {
+		t.Fatal("expected a message type without a leading slash to fail validation")
+	}
+
+	negative := policy
+	negative.DailyCap = sdkmath.NewInt(-1)
+	if err := negative.Validate(); err == nil {
+		t.Fatal("expected a negative daily_cap to fail validation")
+	}
+}
+
+func TestSponsorshipDailyRemaining(t *testing.T) {
+	t.Parallel()
+
+	sp := Sponsorship{
+		Policy:     SponsorshipPolicy{DailyCap: sdkmath.NewInt(100)},
+		Day:        3,
+		SpentToday: sdkmath.NewInt(70),
+	}
+
+	if got := sp.DailyRemaining(3); got == nil || !got.Equal(sdkmath.NewInt(30)) {
+		t.Fatalf("expected 30 remaining on the recorded day, got %v", got)
+	}
+	if got := sp.DailyRemaining(4); got == nil || !got.Equal(sdkmath.NewInt(100)) {
+		t.Fatalf("expected the full cap on the next day, got %v", got)
+	}
+
+	sp.Policy.DailyCap = sdkmath.ZeroInt()
+	if got := sp.DailyRemaining(3); got != nil {
+		t.Fatalf("expected no limit without a daily cap, got %v", got)
+	}
+}
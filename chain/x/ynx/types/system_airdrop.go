@@ -0,0 +1,156 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// SystemAirdropContractName is the system_contracts entry of the airdrop distributor.
+	SystemAirdropContractName = "airdrop"
+	// SystemAirdropArtifact is the embedded artifact of the airdrop distributor.
+	SystemAirdropArtifact = "YNXMerkleDistributor"
+)
+
+// Enabled reports whether the airdrop is configured.
+func (a SystemAirdrop) Enabled() bool {
+	return a.MerkleRoot != ""
+}
+
+// Validate checks a configured airdrop. The sweep recipient format is checked when the system
+// contracts are deployed, like the other system addresses.
+func (a SystemAirdrop) Validate() error {
+	if !a.Enabled() {
+		if a.TotalAmount != "" || a.ClaimDeadline != 0 || a.SweepRecipientAddress != "" {
+			return fmt.Errorf("merkle_root is required when the airdrop is configured")
+		}
+		return nil
+	}
+
+	root, err := hexutil.Decode(a.MerkleRoot)
+	if err != nil || len(root) != common.HashLength {
+		return fmt.Errorf("invalid merkle_root %q (0x-prefixed 32 bytes)", a.MerkleRoot)
+	}
+	if common.BytesToHash(root) == (common.Hash{}) {
+		return fmt.Errorf("merkle_root must not be zero")
+	}
+	if amount, ok := new(big.Int).SetString(a.TotalAmount, 10); !ok || amount.Sign() <= 0 {
+		return fmt.Errorf("invalid total_amount %q (positive base-10 uint256 string)", a.TotalAmount)
+	}
+	if a.ClaimDeadline == 0 {
+		return fmt.Errorf("claim_deadline is required")
+	}
+	if a.SweepRecipientAddress == "" {
+		return fmt.Errorf("sweep_recipient_address is required")
+	}
+	return nil
+}
+
+// AirdropClaim is a leaf of the airdrop claim tree.
+type AirdropClaim struct {
+	Index   uint64
+	Account common.Address
+	Amount  *big.Int
+}
+
+// Leaf returns the leaf hash of the claim, as YNXMerkleDistributor computes it:
+// keccak256(keccak256(abi.encode(index, account, amount))).
+func (c AirdropClaim) Leaf() common.Hash {
+	encoded := make([]byte, 0, 3*32)
+	encoded = append(encoded, common.BigToHash(new(big.Int).SetUint64(c.Index)).Bytes()...)
+	encoded = append(encoded, common.BytesToHash(c.Account.Bytes()).Bytes()...)
+	encoded = append(encoded, common.BigToHash(c.Amount).Bytes()...)
+	return crypto.Keccak256Hash(crypto.Keccak256(encoded))
+}
+
+// AirdropTree is a merkle tree over airdrop claims. Pairs are hashed in sorted order, the way
+// OpenZeppelin's MerkleProof verifies them, and a node without a sibling moves up unchanged.
+type AirdropTree struct {
+	// levels holds the leaves first and the root last.
+	levels [][]common.Hash
+}
+
+// NewAirdropTree builds the claim tree of claims. claims[i] must have index i and a positive
+// amount, and an account may only appear once.
+func NewAirdropTree(claims []AirdropClaim) (*AirdropTree, error) {
+	if len(claims) == 0 {
+		return nil, fmt.Errorf("no airdrop claims")
+	}
+
+	leaves := make([]common.Hash, len(claims))
+	seen := make(map[common.Address]bool, len(claims))
+	for i, c := range claims {
+		switch {
+		case c.Index != uint64(i):
+			return nil, fmt.Errorf("claim %d has index %d", i, c.Index)
+		case c.Account == (common.Address{}):
+			return nil, fmt.Errorf("claim %d: zero account", i)
+		case seen[c.Account]:
+			return nil, fmt.Errorf("claim %d: duplicate account %s", i, c.Account.Hex())
+		case c.Amount == nil || c.Amount.Sign() <= 0:
+			return nil, fmt.Errorf("claim %d: amount must be positive", i)
+		case c.Amount.BitLen() > 256:
+			return nil, fmt.Errorf("claim %d: amount overflows uint256", i)
+		}
+		seen[c.Account] = true
+		leaves[i] = c.Leaf()
+	}
+
+	t := &AirdropTree{levels: [][]common.Hash{leaves}}
+	for level := leaves; len(level) > 1; {
+		next := make([]common.Hash, 0, (len(level)+1)/2)
+		for i := 0; i < len(level); i += 2 {
+			if i+1 == len(level) {
+				next = append(next, level[i])
+				continue
+			}
+			next = append(next, hashAirdropPair(level[i], level[i+1]))
+		}
+		t.levels = append(t.levels, next)
+		level = next
+	}
+	return t, nil
+}
+
+// Root returns the merkle root.
+func (t *AirdropTree) Root() common.Hash {
+	return t.levels[len(t.levels)-1][0]
+}
+
+// Proof returns the sibling hashes from the leaf of claim index up to the root.
+func (t *AirdropTree) Proof(index uint64) ([]common.Hash, error) {
+	if index >= uint64(len(t.levels[0])) {
+		return nil, fmt.Errorf("claim index %d out of range", index)
+	}
+
+	var proof []common.Hash
+	i := int(index)
+	for _, level := range t.levels[:len(t.levels)-1] {
+		if sibling := i ^ 1; sibling < len(level) {
+			proof = append(proof, level[sibling])
+		}
+		i /= 2
+	}
+	return proof, nil
+}
+
+// VerifyAirdropProof reports whether proof proves leaf against root.
+func VerifyAirdropProof(root, leaf common.Hash, proof []common.Hash) bool {
+	computed := leaf
+	for _, p := range proof {
+		computed = hashAirdropPair(computed, p)
+	}
+	return computed == root
+}
+
+func hashAirdropPair(a, b common.Hash) common.Hash {
+	if bytes.Compare(a.Bytes(), b.Bytes()) > 0 {
+		a, b = b, a
+	}
+	return crypto.Keccak256Hash(a.Bytes(), b.Bytes())
+}
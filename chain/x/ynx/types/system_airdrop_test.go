@@ -0,0 +1,118 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func testAirdropClaims(n int) []AirdropClaim {
+	claims := make([]AirdropClaim, n)
+	for i := range claims {
+		claims[i] = AirdropClaim{
+			Index:   uint64(i),
+			Account: common.BigToAddress(big.NewInt(int64(i + 1))),
+			Amount:  big.NewInt(int64(1_000 * (i + 1))),
+		}
+	}
+	return claims
+}
+
+func TestAirdropTreeProofs(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{1, 2, 3, 7, 8, 33} {
+		claims := testAirdropClaims(n)
+		tree, err := NewAirdropTree(claims)
+		if err != nil {
+			t.Fatalf("%d claims: %v", n, err)
+		}
+		for _, c := range claims {
+			proof, err := tree.Proof(c.Index)
+			if err != nil {
+				t.Fatalf("%d claims: %v", n, err)
+			}
+			if !VerifyAirdropProof(tree.Root(), c.Leaf(), proof) {
+				t.Fatalf("%d claims: proof of claim %d does not verify", n, c.Index)
+			}
+
+			// The proof does not hold for another amount.
+			other := c
+			other.Amount = new(big.Int).Add(c.Amount, big.NewInt(1))
+			if VerifyAirdropProof(tree.Root(), other.Leaf(), proof) {
+				t.Fatalf("%d claims: proof of claim %d verifies a different amount", n, c.Index)
+			}
+		}
+		if _, err := tree.Proof(uint64(n)); err == nil {
+			t.Fatalf("%d claims: expected an out of range proof to fail", n)
+		}
+	}
+
+	// A single claim is its own root.
+	claims := testAirdropClaims(1)
+	tree, err := NewAirdropTree(claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tree.Root() != claims[0].Leaf() {
+		t.Fatalf("expected the leaf as root, got %s", tree.Root())
+	}
+}
+
+func TestNewAirdropTreeRejectsBadClaims(t *testing.T) {
+	t.Parallel()
+
+	duplicate := testAirdropClaims(2)
+	duplicate[1].Account = duplicate[0].Account
+	badIndex := testAirdropClaims(2)
+	badIndex[1].Index = 5
+	zeroAmount := testAirdropClaims(2)
+	zeroAmount[1].Amount = new(big.Int)
+	zeroAccount := testAirdropClaims(2)
+	zeroAccount[0].Account = common.Address{}
+
+	for name, claims := range map[string][]AirdropClaim{
+		"empty":        nil,
+		"duplicate":    duplicate,
+		"bad index":    badIndex,
+		"zero amount":  zeroAmount,
+		"zero account": zeroAccount,
+	} {
+		if _, err := NewAirdropTree(claims); err == nil {
+			t.Fatalf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestSystemAirdropValidate(t *testing.T) {
+	t.Parallel()
+
+	valid := SystemAirdrop{
+		MerkleRoot:            common.BytesToHash([]byte{0x01}).Hex(),
+		TotalAmount:           "1000",
+		ClaimDeadline:         1_800_000_000,
+		SweepRecipientAddress: common.BytesToAddress([]byte{0x02}).Hex(),
+	}
+	if err := valid.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if err := (SystemAirdrop{}).Validate(); err != nil {
+		t.Fatalf("a disabled airdrop is valid: %v", err)
+	}
+
+	for name, mutate := range map[string]func(*SystemAirdrop){
+		"no root":         func(a *SystemAirdrop) { a.MerkleRoot = "" },
+		"short root":      func(a *SystemAirdrop) { a.MerkleRoot = "0x01" },
+		"zero root":       func(a *SystemAirdrop) { a.MerkleRoot = common.Hash{}.Hex() },
+		"zero amount":     func(a *SystemAirdrop) { a.TotalAmount = "0" },
+		"no deadline":     func(a *SystemAirdrop) { a.ClaimDeadline = 0 },
+		"no sweep target": func(a *SystemAirdrop) { a.SweepRecipientAddress = "" },
+	} {
+		a := valid
+		mutate(&a)
+		if err := a.Validate(); err == nil {
+			t.Fatalf("%s: expected an error", name)
+		}
+	}
+}
@@ -0,0 +1,237 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// MaxSystemContractNameLength is the maximum length of a system contract name.
+const MaxSystemContractNameLength = 64
+
+// LegacySystemContractNames are the v0 system contracts, in the field order of the deprecated
+// SystemContracts fields.
+var LegacySystemContractNames = []string{
+	"nyxt",
+	"timelock",
+	"treasury",
+	"governor",
+	"team_vesting",
+	"org_registry",
+	"subject_registry",
+	"arbitration",
+	"domain_inbox",
+}
+
+// ValidateSystemContractName checks that name is a lowercase snake_case identifier, e.g.
+// "domain_inbox".
+func ValidateSystemContractName(name string) error {
+	if name == "" || len(name) > MaxSystemContractNameLength {
+		return fmt.Errorf("invalid system contract name %q: must be 1 to %d characters", name, MaxSystemContractNameLength)
+	}
+	for i, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z':
+		case (r >= '0' && r <= '9' || r == '_') && i > 0:
+		default:
+			return fmt.Errorf("invalid system contract name %q: expected lowercase letters, digits and underscores, starting with a letter", name)
+		}
+	}
+	return nil
+}
+
+// Get returns the address of the system contract name, empty when unset.
+func (c SystemContracts) Get(name string) string {
+	if i, ok := c.find(name); ok {
+		return c.Contracts[i].Address
+	}
+	return ""
+}
+
+// Names returns the names of the registered system contracts, in order.
+func (c SystemContracts) Names() []string {
+	names := make([]string, 0, len(c.Contracts))
+	for _, e := range c.Contracts {
+		names = append(names, e.Name)
+	}
+	return names
+}
+
+// Set points the system contract name at the 0x-prefixed contract address, registering the name
+// if needed. The attestation of the previous contract is dropped.
+func (c *SystemContracts) Set(name, address string) error {
+	if err := ValidateSystemContractName(name); err != nil {
+		return err
+	}
+	contract, err := ParseContractAddress(address)
+	if err != nil {
+		return err
+	}
+
+	entry := SystemContractEntry{Name: name, Address: contract.Hex()}
+	if i, ok := c.find(name); ok {
+		c.Contracts[i] = entry
+	} else {
+		contracts := make([]SystemContractEntry, 0, len(c.Contracts)+1)
+		contracts = append(contracts, c.Contracts[:i]...)
+		contracts = append(contracts, entry)
+		c.Contracts = append(contracts, c.Contracts[i:]...)
+	}
+	c.dropAttestation(name)
+	return nil
+}
+
+// Rename moves the system contract from to the unset name to, together with its attestation.
+func (c *SystemContracts) Rename(from, to string) error {
+	if err := ValidateSystemContractName(to); err != nil {
+		return err
+	}
+	i, ok := c.find(from)
+	if !ok {
+		return fmt.Errorf("system contract %s is not set", from)
+	}
+	if c.Get(to) != "" {
+		return fmt.Errorf("system contract %s is already set", to)
+	}
+
+	address := c.Contracts[i].Address
+	a, attested := c.Attestation(from)
+	c.Contracts = append(c.Contracts[:i:i], c.Contracts[i+1:]...)
+	c.dropAttestation(from)
+	if err := c.Set(to, address); err != nil {
+		return err
+	}
+	if attested {
+		a.Name = to
+		return c.Attest(a)
+	}
+	return nil
+}
+
+// find returns the index of name in the ordered entries, or the index it would be inserted at.
+func (c SystemContracts) find(name string) (int, bool) {
+	i := sort.Search(len(c.Contracts), func(i int) bool { return c.Contracts[i].Name >= name })
+	return i, i < len(c.Contracts) && c.Contracts[i].Name == name
+}
+
+// legacyEntries returns pointers to the deprecated v0 fields, in LegacySystemContractNames order.
+func (c *SystemContracts) legacyEntries() []*string {
+	return []*string{ //nolint:staticcheck // the deprecated fields are only read to migrate them
+		&c.Nyxt,
+		&c.Timelock,
+		&c.Treasury,
+		&c.Governor,
+		&c.TeamVesting,
+		&c.OrgRegistry,
+		&c.SubjectRegistry,
+		&c.Arbitration,
+		&c.DomainInbox,
+	}
+}
+
+// MigrateLegacyEntries moves the deprecated v0 fields to the registry and clears them. The
+// attestations of the moved entries are kept, ordered by name.
+func (c *SystemContracts) MigrateLegacyEntries() error {
+	attestations := slices.Clone(c.Attestations)
+	for i, field := range c.legacyEntries() {
+		if *field == "" {
+			continue
+		}
+		name := LegacySystemContractNames[i]
+		if c.Get(name) != "" {
+			return fmt.Errorf("system contract %s is set both in the legacy field and in contracts", name)
+		}
+		if err := c.Set(name, *field); err != nil {
+			return fmt.Errorf("system contract %s: %w", name, err)
+		}
+		*field = ""
+	}
+	sort.SliceStable(attestations, func(i, j int) bool { return attestations[i].Name < attestations[j].Name })
+	c.Attestations = attestations
+	return nil
+}
+
+// Attestation returns the attestation of the system contract name.
+func (c SystemContracts) Attestation(name string) (SystemContractAttestation, bool) {
+	for _, a := range c.Attestations {
+		if a.Name == name {
+			return a, true
+		}
+	}
+	return SystemContractAttestation{}, false
+}
+
+// Attest records the attestation of a system contract, replacing the previous one. Attestations
+// are kept ordered by name.
+func (c *SystemContracts) Attest(a SystemContractAttestation) error {
+	if err := a.Validate(); err != nil {
+		return err
+	}
+	c.dropAttestation(a.Name)
+
+	i := sort.Search(len(c.Attestations), func(i int) bool { return c.Attestations[i].Name >= a.Name })
+	attestations := make([]SystemContractAttestation, 0, len(c.Attestations)+1)
+	attestations = append(attestations, c.Attestations[:i]...)
+	attestations = append(attestations, a)
+	c.Attestations = append(attestations, c.Attestations[i:]...)
+	return nil
+}
+
+func (c *SystemContracts) dropAttestation(name string) {
+	for i, a := range c.Attestations {
+		if a.Name == name {
+			c.Attestations = append(c.Attestations[:i:i], c.Attestations[i+1:]...)
+			return
+		}
+	}
+}
+
+// Validate checks the registry entries and that every attestation belongs to a registered
+// contract, at most once. The deprecated v0 fields must have been migrated.
+func (c SystemContracts) Validate() error {
+	for i, field := range c.legacyEntries() {
+		if *field != "" {
+			return fmt.Errorf("system contract %s is set in a deprecated field", LegacySystemContractNames[i])
+		}
+	}
+
+	for i, e := range c.Contracts {
+		if err := ValidateSystemContractName(e.Name); err != nil {
+			return err
+		}
+		if _, err := ParseContractAddress(e.Address); err != nil {
+			return fmt.Errorf("system contract %s: %w", e.Name, err)
+		}
+		if i > 0 && c.Contracts[i-1].Name >= e.Name {
+			return fmt.Errorf("system contracts must be ordered by name without duplicates: %s after %s", e.Name, c.Contracts[i-1].Name)
+		}
+	}
+
+	seen := make(map[string]struct{}, len(c.Attestations))
+	for _, a := range c.Attestations {
+		if err := a.Validate(); err != nil {
+			return err
+		}
+		if c.Get(a.Name) == "" {
+			return fmt.Errorf("attestation for unset system contract %s", a.Name)
+		}
+		if _, dup := seen[a.Name]; dup {
+			return fmt.Errorf("duplicate attestation for system contract %s", a.Name)
+		}
+		seen[a.Name] = struct{}{}
+	}
+	return nil
+}
+
+// Validate checks the name and the code hash of the attestation.
+func (a SystemContractAttestation) Validate() error {
+	if err := ValidateSystemContractName(a.Name); err != nil {
+		return err
+	}
+	if bz, err := hexutil.Decode(a.CodeHash); err != nil || len(bz) != 32 {
+		return fmt.Errorf("invalid code hash %q for system contract %s", a.CodeHash, a.Name)
+	}
+	return nil
+}
@@ -0,0 +1,186 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestSystemContractsGetSet(t *testing.T) {
+	t.Parallel()
+
+	var contracts SystemContracts
+	for i, name := range LegacySystemContractNames {
+		addr := common.BytesToAddress([]byte{byte(i + 1)}).Hex()
+		if err := contracts.Set(name, addr); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if got := contracts.Get(name); got != addr {
+			t.Fatalf("%s: expected %s, got %s", name, addr, got)
+		}
+	}
+
+	// Any well-formed name can be registered; the entries stay ordered by name.
+	if err := contracts.Set("bridge", common.BytesToAddress([]byte{10}).Hex()); err != nil {
+		t.Fatal(err)
+	}
+	names := contracts.Names()
+	if len(names) != 10 || names[0] != "arbitration" || names[1] != "bridge" || names[9] != "treasury" {
+		t.Fatalf("expected the entries ordered by name, got %v", names)
+	}
+	if got := contracts.Get("oracle"); got != "" {
+		t.Fatalf("expected an unregistered entry to be empty, got %s", got)
+	}
+	if err := contracts.Validate(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSystemContractsSetRejectsInvalidInput(t *testing.T) {
+	t.Parallel()
+
+	var contracts SystemContracts
+	for _, name := range []string{"", "Bridge", "bridge!", "1bridge", "_bridge"} {
+		if err := contracts.Set(name, common.BytesToAddress([]byte{1}).Hex()); err == nil {
+			t.Fatalf("expected the name %q to be rejected", name)
+		}
+	}
+	if err := contracts.Set("timelock", "not-an-address"); err == nil {
+		t.Fatal("expected an invalid address to be rejected")
+	}
+	if err := contracts.Set("timelock", common.Address{}.Hex()); err == nil {
+		t.Fatal("expected the zero address to be rejected")
+	}
+	if len(contracts.Contracts) != 0 {
+		t.Fatalf("rejected input changed the registry: %+v", contracts.Contracts)
+	}
+}
+
+func TestSystemContractsMigrateLegacyEntries(t *testing.T) {
+	t.Parallel()
+
+	codeHash := common.BytesToHash([]byte{0xaa}).Hex()
+	timelock := common.BytesToAddress([]byte{2}).Hex()
+	contracts := SystemContracts{
+		Timelock:    timelock,
+		DomainInbox: common.BytesToAddress([]byte{9}).Hex(),
+		Attestations: []SystemContractAttestation{
+			{Name: "timelock", CodeHash: codeHash},
+			{Name: "domain_inbox", CodeHash: codeHash},
+		},
+	}
+	if err := contracts.Validate(); err == nil {
+		t.Fatal("expected the deprecated fields to be rejected")
+	}
+
+	if err := contracts.MigrateLegacyEntries(); err != nil {
+		t.Fatal(err)
+	}
+	if contracts.Timelock != "" || contracts.DomainInbox != "" {
+		t.Fatalf("expected the deprecated fields to be cleared, got %+v", contracts)
+	}
+	if contracts.Get("timelock") != timelock || len(contracts.Contracts) != 2 {
+		t.Fatalf("expected the entries to move to the registry, got %+v", contracts.Contracts)
+	}
+	if len(contracts.Attestations) != 2 || contracts.Attestations[0].Name != "domain_inbox" {
+		t.Fatalf("expected the attestations to be kept in name order, got %+v", contracts.Attestations)
+	}
+	if err := contracts.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	conflict := SystemContracts{Timelock: timelock}
+	if err := conflict.Set("timelock", common.BytesToAddress([]byte{3}).Hex()); err != nil {
+		t.Fatal(err)
+	}
+	if err := conflict.MigrateLegacyEntries(); err == nil {
+		t.Fatal("expected an entry set in both places to be rejected")
+	}
+}
+
+func TestSystemContractsAttestations(t *testing.T) {
+	t.Parallel()
+
+	codeHash := common.BytesToHash([]byte{0xaa}).Hex()
+	var contracts SystemContracts
+	for _, name := range []string{"governor", "timelock"} {
+		if err := contracts.Set(name, common.BytesToAddress([]byte{1}).Hex()); err != nil {
+			t.Fatal(err)
+		}
+		if err := contracts.Attest(SystemContractAttestation{Name: name, CodeHash: codeHash, Artifact: "YNXTimelock"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(contracts.Attestations) != 2 || contracts.Attestations[0].Name != "governor" || contracts.Attestations[1].Name != "timelock" {
+		t.Fatalf("expected attestations in name order, got %+v", contracts.Attestations)
+	}
+	if err := contracts.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Re-attesting replaces the attestation; pointing the entry elsewhere drops it.
+	if err := contracts.Attest(SystemContractAttestation{Name: "timelock", CodeHash: codeHash}); err != nil {
+		t.Fatal(err)
+	}
+	if a, _ := contracts.Attestation("timelock"); a.Artifact != "" || len(contracts.Attestations) != 2 {
+		t.Fatalf("expected the timelock attestation to be replaced, got %+v", contracts.Attestations)
+	}
+	if err := contracts.Set("timelock", common.BytesToAddress([]byte{2}).Hex()); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := contracts.Attestation("timelock"); ok {
+		t.Fatal("expected Set to drop the attestation of the previous contract")
+	}
+
+	for _, tc := range []struct {
+		name         string
+		attestations []SystemContractAttestation
+	}{
+		{"unset entry", []SystemContractAttestation{{Name: "treasury", CodeHash: codeHash}}},
+		{"invalid name", []SystemContractAttestation{{Name: "Governor", CodeHash: codeHash}}},
+		{"bad code hash", []SystemContractAttestation{{Name: "governor", CodeHash: "0xaa"}}},
+		{"duplicate", []SystemContractAttestation{{Name: "governor", CodeHash: codeHash}, {Name: "governor", CodeHash: codeHash}}},
+	} {
+		invalid := contracts
+		invalid.Attestations = tc.attestations
+		if err := invalid.Validate(); err == nil {
+			t.Fatalf("%s: expected validation to fail", tc.name)
+		}
+	}
+}
+
+func TestSystemContractsRename(t *testing.T) {
+	t.Parallel()
+
+	var contracts SystemContracts
+	nyxt := common.BytesToAddress([]byte{1}).Hex()
+	if err := contracts.Set("nyxt", nyxt); err != nil {
+		t.Fatal(err)
+	}
+	if err := contracts.Attest(SystemContractAttestation{Name: "nyxt", CodeHash: common.BytesToHash([]byte{2}).Hex(), Artifact: "NYXT"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := contracts.Rename("nyxt", LegacyNYXTContractName); err != nil {
+		t.Fatal(err)
+	}
+	if contracts.Get("nyxt") != "" || contracts.Get(LegacyNYXTContractName) != nyxt {
+		t.Fatalf("expected nyxt to move to %s, got %+v", LegacyNYXTContractName, contracts.Contracts)
+	}
+	if a, ok := contracts.Attestation(LegacyNYXTContractName); !ok || a.Artifact != "NYXT" {
+		t.Fatalf("expected the attestation to move along, got %+v", contracts.Attestations)
+	}
+	if err := contracts.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := contracts.Rename("nyxt", "nyxt_v2"); err == nil {
+		t.Fatal("expected renaming an unset entry to fail")
+	}
+	if err := contracts.Set("nyxt", nyxt); err != nil {
+		t.Fatal(err)
+	}
+	if err := contracts.Rename("nyxt", LegacyNYXTContractName); err == nil {
+		t.Fatal("expected renaming onto a set entry to fail")
+	}
+}
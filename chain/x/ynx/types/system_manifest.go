@@ -0,0 +1,318 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SystemManifestConfigKeys are the system config values a SystemManifestArg can reference.
+var SystemManifestConfigKeys = []string{
+	// Addresses.
+	"deployer",
+	"team_beneficiary",
+	"community_recipient",
+
+	// NYXT supply and its allocations. community_allocation is net of the airdrop amount.
+	"genesis_supply",
+	"team_allocation",
+	"treasury_allocation",
+	"community_allocation",
+
+	// Native NYXT: its ERC20 interface and the votes precompile.
+	"nyxt_erc20",
+	"nyxt_votes",
+
+	// The stake-weighted votes precompile.
+	"stake_votes",
+
+	// Airdrop.
+	"airdrop_merkle_root",
+	"airdrop_amount",
+	"airdrop_claim_deadline",
+	"airdrop_sweep_recipient",
+
+	// Governance.
+	"voting_delay_blocks",
+	"voting_period_blocks",
+	"proposal_threshold",
+	"proposal_deposit",
+	"quorum_percent",
+	"timelock_delay_seconds",
+
+	// Team vesting: vesting_start is the vesting reference time plus the cliff.
+	"vesting_start",
+	"vesting_duration_seconds",
+}
+
+// DeployManifest returns the manifest InitGenesis deploys: the configured one, or the default
+// manifest of the deploy mode when none is configured. The default manifest deploys the airdrop
+// distributor when the airdrop is enabled, and funds it unless NYXT is native: native allocations
+// are sent by InitGenesis instead of manifest calls. With stake_weighted_votes its governor counts
+// the votes of the stake votes precompile.
+func (cfg SystemConfig) DeployManifest() SystemManifest {
+	if len(cfg.Manifest.Contracts) > 0 || len(cfg.Manifest.Calls) > 0 {
+		return cfg.Manifest
+	}
+
+	var m SystemManifest
+	if cfg.NativeNyxt {
+		m = DefaultNativeSystemManifest(cfg.DeployMode)
+		if cfg.Airdrop.Enabled() {
+			m.Contracts = append(m.Contracts, AirdropManifestContract(true))
+		}
+	} else {
+		m = DefaultSystemManifest(cfg.DeployMode)
+		if cfg.Airdrop.Enabled() {
+			m.Contracts = append(m.Contracts, AirdropManifestContract(false))
+			m.Calls = append(m.Calls, SystemManifestCall{
+				Contract: "nyxt",
+				Method:   "transfer",
+				Args:     []SystemManifestArg{manifestContract(SystemAirdropContractName), manifestConfig("airdrop_amount")},
+			})
+		}
+	}
+	if cfg.StakeWeightedVotes {
+		for i := range m.Contracts {
+			if m.Contracts[i].Name == "governor" {
+				m.Contracts[i].ConstructorArgs[0] = manifestConfig("stake_votes")
+			}
+		}
+	}
+	return m
+}
+
+// AirdropManifestContract returns the airdrop distributor the default manifest deploys when the
+// airdrop is enabled. With native NYXT it pays out through the native NYXT ERC20.
+func AirdropManifestContract(native bool) SystemManifestContract {
+	token := manifestContract("nyxt")
+	if native {
+		token = manifestConfig("nyxt_erc20")
+	}
+	return SystemManifestContract{
+		Name:     SystemAirdropContractName,
+		Artifact: SystemAirdropArtifact,
+		ConstructorArgs: []SystemManifestArg{
+			token,
+			manifestConfig("airdrop_merkle_root"),
+			manifestConfig("airdrop_claim_deadline"),
+			manifestConfig("airdrop_sweep_recipient"),
+		},
+	}
+}
+
+// DefaultSystemManifest returns the manifest of the v0 system contracts.
+//
+// In CREATE mode the addresses only depend on the deployer nonce, so NYXT and the timelock name the
+// timelock and the governor before they exist. CREATE2 addresses depend on the creation code, so
+// the timelock starts with the deployer as admin, which hands the governor its roles and renounces
+// once everything is deployed.
+func DefaultSystemManifest(mode SystemDeployMode) SystemManifest {
+	return defaultSystemManifest(mode, false)
+}
+
+// DefaultNativeSystemManifest returns the manifest of the v0 system contracts for native NYXT: it
+// deploys no NYXT ERC20, and the governor counts the votes of the NYXT votes precompile and takes
+// its deposits through the native NYXT ERC20.
+func DefaultNativeSystemManifest(mode SystemDeployMode) SystemManifest {
+	return defaultSystemManifest(mode, true)
+}
+
+func defaultSystemManifest(mode SystemDeployMode, native bool) SystemManifest {
+	zeroAddress := manifestValue("0x0000000000000000000000000000000000000000")
+	nyxt := SystemManifestContract{
+		Name:            "nyxt",
+		Artifact:        "NYXT",
+		ConstructorArgs: []SystemManifestArg{manifestContract("timelock"), manifestConfig("deployer"), manifestConfig("genesis_supply")},
+	}
+
+	nyxtVotes, nyxtERC20 := manifestContract("nyxt"), manifestContract("nyxt")
+	if native {
+		nyxtVotes, nyxtERC20 = manifestConfig("nyxt_votes"), manifestConfig("nyxt_erc20")
+	}
+
+	var m SystemManifest
+	if mode == SystemDeployMode_SYSTEM_DEPLOY_MODE_CREATE2 {
+		m.Contracts = append(m.Contracts, SystemManifestContract{
+			Name:     "timelock",
+			Artifact: "YNXTimelock",
+			ConstructorArgs: []SystemManifestArg{
+				manifestConfig("timelock_delay_seconds"),
+				manifestList(),
+				manifestList(zeroAddress),
+				manifestConfig("deployer"),
+			},
+		})
+		if !native {
+			m.Contracts = append(m.Contracts, nyxt)
+		}
+	} else {
+		if !native {
+			m.Contracts = append(m.Contracts, nyxt)
+		}
+		m.Contracts = append(m.Contracts, SystemManifestContract{
+			Name:     "timelock",
+			Artifact: "YNXTimelock",
+			ConstructorArgs: []SystemManifestArg{
+				manifestConfig("timelock_delay_seconds"),
+				manifestList(manifestContract("governor")),
+				manifestList(zeroAddress),
+				manifestContract("timelock"),
+			},
+		})
+	}
+
+	m.Contracts = append(m.Contracts,
+		SystemManifestContract{
+			Name:            "treasury",
+			Artifact:        "YNXTreasury",
+			ConstructorArgs: []SystemManifestArg{manifestContract("timelock")},
+		},
+		SystemManifestContract{
+			Name:     "governor",
+			Artifact: "YNXGovernor",
+			ConstructorArgs: []SystemManifestArg{
+				nyxtVotes,
+				nyxtERC20,
+				manifestContract("timelock"),
+				manifestContract("treasury"),
+				manifestConfig("voting_delay_blocks"),
+				manifestConfig("voting_period_blocks"),
+				manifestConfig("proposal_threshold"),
+				manifestConfig("proposal_deposit"),
+				manifestConfig("quorum_percent"),
+			},
+		},
+		SystemManifestContract{Name: "org_registry", Artifact: "YNXOrgRegistry"},
+		SystemManifestContract{
+			Name:            "subject_registry",
+			Artifact:        "YNXSubjectRegistry",
+			ConstructorArgs: []SystemManifestArg{manifestContract("org_registry")},
+		},
+		SystemManifestContract{
+			Name:            "arbitration",
+			Artifact:        "YNXArbitration",
+			ConstructorArgs: []SystemManifestArg{manifestContract("org_registry")},
+		},
+		SystemManifestContract{Name: "domain_inbox", Artifact: "YNXDomainInbox"},
+		SystemManifestContract{
+			Name:     "team_vesting",
+			Artifact: "NYXTTeamVesting",
+			ConstructorArgs: []SystemManifestArg{
+				manifestConfig("team_beneficiary"),
+				manifestConfig("vesting_start"),
+				manifestConfig("vesting_duration_seconds"),
+			},
+		},
+	)
+
+	if mode == SystemDeployMode_SYSTEM_DEPLOY_MODE_CREATE2 {
+		m.Calls = append(m.Calls,
+			SystemManifestCall{Contract: "timelock", Method: "grantRole", Args: []SystemManifestArg{{Keccak256: "PROPOSER_ROLE"}, manifestContract("governor")}},
+			SystemManifestCall{Contract: "timelock", Method: "grantRole", Args: []SystemManifestArg{{Keccak256: "CANCELLER_ROLE"}, manifestContract("governor")}},
+			SystemManifestCall{Contract: "timelock", Method: "renounceRole", Args: []SystemManifestArg{
+				manifestValue("0x0000000000000000000000000000000000000000000000000000000000000000"),
+				manifestConfig("deployer"),
+			}},
+		)
+	}
+	if native {
+		return m
+	}
+	m.Calls = append(m.Calls,
+		SystemManifestCall{Contract: "nyxt", Method: "transfer", Args: []SystemManifestArg{manifestContract("treasury"), manifestConfig("treasury_allocation")}},
+		SystemManifestCall{Contract: "nyxt", Method: "transfer", Args: []SystemManifestArg{manifestContract("team_vesting"), manifestConfig("team_allocation")}},
+		SystemManifestCall{Contract: "nyxt", Method: "transfer", Args: []SystemManifestArg{manifestConfig("community_recipient"), manifestConfig("community_allocation")}},
+	)
+	return m
+}
+
+func manifestContract(name string) SystemManifestArg { return SystemManifestArg{Contract: name} }
+func manifestConfig(key string) SystemManifestArg    { return SystemManifestArg{Config: key} }
+func manifestValue(v string) SystemManifestArg       { return SystemManifestArg{Value: v} }
+
+func manifestList(elements ...SystemManifestArg) SystemManifestArg {
+	return SystemManifestArg{Elements: append([]SystemManifestArg{}, elements...)}
+}
+
+// Validate checks the manifest without its artifacts: contract names are unique and every
+// reference names a manifest contract or a config value.
+func (m SystemManifest) Validate() error {
+	if len(m.Contracts) == 0 {
+		return fmt.Errorf("system manifest deploys no contracts")
+	}
+
+	contracts := make(map[string]struct{}, len(m.Contracts))
+	for _, c := range m.Contracts {
+		if err := ValidateSystemContractName(c.Name); err != nil {
+			return err
+		}
+		if _, dup := contracts[c.Name]; dup {
+			return fmt.Errorf("system manifest deploys %s twice", c.Name)
+		}
+		if c.Artifact == "" {
+			return fmt.Errorf("system manifest contract %s: artifact is required", c.Name)
+		}
+		contracts[c.Name] = struct{}{}
+	}
+
+	for _, c := range m.Contracts {
+		for i, arg := range c.ConstructorArgs {
+			if err := arg.validate(contracts); err != nil {
+				return fmt.Errorf("system manifest contract %s: constructor arg %d: %w", c.Name, i, err)
+			}
+		}
+	}
+	for i, call := range m.Calls {
+		if _, ok := contracts[call.Contract]; !ok {
+			return fmt.Errorf("system manifest call %d: unknown contract %q", i, call.Contract)
+		}
+		if call.Method == "" {
+			return fmt.Errorf("system manifest call %d: method is required", i)
+		}
+		for j, arg := range call.Args {
+			if err := arg.validate(contracts); err != nil {
+				return fmt.Errorf("system manifest call %d (%s.%s): arg %d: %w", i, call.Contract, call.Method, j, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (a SystemManifestArg) validate(contracts map[string]struct{}) error {
+	set := 0
+	for _, v := range []string{a.Contract, a.Config, a.Value, a.Keccak256} {
+		if v != "" {
+			set++
+		}
+	}
+	switch {
+	case set > 1:
+		return fmt.Errorf("more than one of contract, config, value and keccak256 is set")
+	case set == 1 && len(a.Elements) > 0:
+		return fmt.Errorf("elements are only allowed for array arguments")
+	}
+
+	if a.Contract != "" {
+		if _, ok := contracts[a.Contract]; !ok {
+			return fmt.Errorf("unknown contract %q", a.Contract)
+		}
+	}
+	if a.Config != "" && !isSystemManifestConfigKey(a.Config) {
+		return fmt.Errorf("unknown config value %q (expected one of %s)", a.Config, strings.Join(SystemManifestConfigKeys, ", "))
+	}
+	for i, e := range a.Elements {
+		if err := e.validate(contracts); err != nil {
+			return fmt.Errorf("element %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func isSystemManifestConfigKey(key string) bool {
+	for _, k := range SystemManifestConfigKeys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
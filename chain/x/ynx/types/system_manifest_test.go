@@ -0,0 +1,145 @@
+package types
+
+import "testing"
+
+func TestDefaultSystemManifestValidates(t *testing.T) {
+	t.Parallel()
+
+	for _, mode := range []SystemDeployMode{SystemDeployMode_SYSTEM_DEPLOY_MODE_CREATE, SystemDeployMode_SYSTEM_DEPLOY_MODE_CREATE2} {
+		m := DefaultSystemManifest(mode)
+		if err := m.Validate(); err != nil {
+			t.Fatalf("%s: %v", mode, err)
+		}
+		if len(m.Contracts) != len(LegacySystemContractNames) {
+			t.Fatalf("%s: expected the %d v0 contracts, got %d", mode, len(LegacySystemContractNames), len(m.Contracts))
+		}
+	}
+
+	// An empty manifest falls back to the default of the deploy mode.
+	cfg := SystemConfig{DeployMode: SystemDeployMode_SYSTEM_DEPLOY_MODE_CREATE2}
+	if got := cfg.DeployManifest(); got.Contracts[0].Name != "timelock" {
+		t.Fatalf("expected the CREATE2 default manifest, got %+v", got.Contracts[0])
+	}
+}
+
+func TestDefaultNativeSystemManifestDeploysNoNYXT(t *testing.T) {
+	t.Parallel()
+
+	for _, mode := range []SystemDeployMode{SystemDeployMode_SYSTEM_DEPLOY_MODE_CREATE, SystemDeployMode_SYSTEM_DEPLOY_MODE_CREATE2} {
+		cfg := SystemConfig{DeployMode: mode, NativeNyxt: true}
+		m := cfg.DeployManifest()
+		if err := m.Validate(); err != nil {
+			t.Fatalf("%s: %v", mode, err)
+		}
+		if len(m.Contracts) != len(LegacySystemContractNames)-1 {
+			t.Fatalf("%s: expected the v0 contracts without nyxt, got %d", mode, len(m.Contracts))
+		}
+		for _, c := range m.Contracts {
+			if c.Name == "nyxt" {
+				t.Fatalf("%s: native manifest deploys nyxt", mode)
+			}
+			if c.Name == "governor" && (c.ConstructorArgs[0].Config != "nyxt_votes" || c.ConstructorArgs[1].Config != "nyxt_erc20") {
+				t.Fatalf("%s: governor does not use native NYXT: %+v", mode, c.ConstructorArgs[:2])
+			}
+		}
+		// The allocations are sent natively, not by manifest calls.
+		for _, call := range m.Calls {
+			if call.Method == "transfer" {
+				t.Fatalf("%s: native manifest transfers %+v", mode, call)
+			}
+		}
+	}
+}
+
+func TestSystemManifestValidateRejectsBadManifests(t *testing.T) {
+	t.Parallel()
+
+	oracle := SystemManifestContract{Name: "oracle", Artifact: "YNXOracle"}
+	for _, tc := range []struct {
+		name     string
+		manifest SystemManifest
+	}{
+		{"empty", SystemManifest{}},
+		{"invalid name", SystemManifest{Contracts: []SystemManifestContract{{Name: "Oracle", Artifact: "YNXOracle"}}}},
+		{"duplicate", SystemManifest{Contracts: []SystemManifestContract{oracle, oracle}}},
+		{"no artifact", SystemManifest{Contracts: []SystemManifestContract{{Name: "oracle"}}}},
+		{"unknown contract", SystemManifest{Contracts: []SystemManifestContract{{
+			Name: "oracle", Artifact: "YNXOracle", ConstructorArgs: []SystemManifestArg{{Contract: "timelock"}},
+		}}}},
+		{"unknown config", SystemManifest{Contracts: []SystemManifestContract{{
+			Name: "oracle", Artifact: "YNXOracle", ConstructorArgs: []SystemManifestArg{{Config: "owner"}},
+		}}}},
+		{"two sources", SystemManifest{Contracts: []SystemManifestContract{{
+			Name: "oracle", Artifact: "YNXOracle", ConstructorArgs: []SystemManifestArg{{Config: "deployer", Value: "1"}},
+		}}}},
+		{"bad element", SystemManifest{Contracts: []SystemManifestContract{{
+			Name: "oracle", Artifact: "YNXOracle", ConstructorArgs: []SystemManifestArg{{Elements: []SystemManifestArg{{Contract: "feed"}}}},
+		}}}},
+		{"call to unknown contract", SystemManifest{
+			Contracts: []SystemManifestContract{oracle},
+			Calls:     []SystemManifestCall{{Contract: "feed", Method: "init"}},
+		}},
+		{"call without method", SystemManifest{
+			Contracts: []SystemManifestContract{oracle},
+			Calls:     []SystemManifestCall{{Contract: "oracle"}},
+		}},
+	} {
+		if err := tc.manifest.Validate(); err == nil {
+			t.Fatalf("%s: expected validation to fail", tc.name)
+		}
+	}
+}
+
+func TestDeployManifestAddsAirdrop(t *testing.T) {
+	t.Parallel()
+
+	cfg := SystemConfig{DeployMode: SystemDeployMode_SYSTEM_DEPLOY_MODE_CREATE2}
+	cfg.Airdrop.MerkleRoot = "0x0101010101010101010101010101010101010101010101010101010101010101"
+	m := cfg.DeployManifest()
+	if err := m.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if last := m.Contracts[len(m.Contracts)-1]; last.Name != SystemAirdropContractName || last.Artifact != SystemAirdropArtifact {
+		t.Fatalf("expected the airdrop distributor last, got %+v", last)
+	}
+	if call := m.Calls[len(m.Calls)-1]; call.Contract != "nyxt" || call.Args[0].Contract != SystemAirdropContractName {
+		t.Fatalf("expected the airdrop funding last, got %+v", call)
+	}
+
+	// A custom manifest is deployed as is.
+	cfg.Manifest = SystemManifest{Contracts: []SystemManifestContract{{Name: "oracle", Artifact: "YNXOracle"}}}
+	if got := cfg.DeployManifest(); len(got.Contracts) != 1 {
+		t.Fatalf("expected the custom manifest, got %+v", got)
+	}
+}
+
+func TestDeployManifestStakeWeightedVotes(t *testing.T) {
+	t.Parallel()
+
+	for _, native := range []bool{false, true} {
+		cfg := SystemConfig{NativeNyxt: native, StakeWeightedVotes: true}
+		m := cfg.DeployManifest()
+		if err := m.Validate(); err != nil {
+			t.Fatal(err)
+		}
+		var governor *SystemManifestContract
+		for i := range m.Contracts {
+			if m.Contracts[i].Name == "governor" {
+				governor = &m.Contracts[i]
+			}
+		}
+		if governor == nil {
+			t.Fatalf("native=%v: no governor", native)
+		}
+		if governor.ConstructorArgs[0].Config != "stake_votes" {
+			t.Fatalf("native=%v: expected the governor to count stake votes, got %+v", native, governor.ConstructorArgs[0])
+		}
+	}
+
+	// The default manifests are not modified.
+	for _, c := range DefaultSystemManifest(SystemDeployMode_SYSTEM_DEPLOY_MODE_CREATE).Contracts {
+		if c.Name == "governor" && c.ConstructorArgs[0].Contract != "nyxt" {
+			t.Fatalf("default manifest governor changed: %+v", c.ConstructorArgs[0])
+		}
+	}
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
@@ -35,7 +36,10 @@ type MsgUpdateParams struct {
 	// params defines the x/ynx parameters to update.
 	//
 	// NOTE: All parameters must be supplied.
-	Params               Params   `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// activation_height schedules the update for the BeginBlock of the given height.
+	// Zero applies the params immediately.
+	ActivationHeight     int64    `protobuf:"varint,3,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -79,6 +83,13 @@ func (m *MsgUpdateParams) GetParams() Params {
 	return Params{}
 }
 
+func (m *MsgUpdateParams) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
 type MsgUpdateParamsResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -109,65 +120,1522 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "ynx.ynx.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ynx.ynx.v1.MsgUpdateParamsResponse")
+type MsgCancelPendingParams struct {
+	// authority must match the authority that scheduled the change.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// activation_height identifies the scheduled change.
+	ActivationHeight     int64    `protobuf:"varint,2,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgCancelPendingParams) Reset()         { *m = MsgCancelPendingParams{} }
+func (m *MsgCancelPendingParams) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelPendingParams) ProtoMessage()    {}
+func (*MsgCancelPendingParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb8cc29357c6f1e0, []int{2}
+}
+func (m *MsgCancelPendingParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgCancelPendingParams.Unmarshal(m, b)
+}
+func (m *MsgCancelPendingParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgCancelPendingParams.Marshal(b, m, deterministic)
+}
+func (m *MsgCancelPendingParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelPendingParams.Merge(m, src)
+}
+func (m *MsgCancelPendingParams) XXX_Size() int {
+	return xxx_messageInfo_MsgCancelPendingParams.Size(m)
+}
+func (m *MsgCancelPendingParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelPendingParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelPendingParams proto.InternalMessageInfo
+
+func (m *MsgCancelPendingParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgCancelPendingParams) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+type MsgCancelPendingParamsResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgCancelPendingParamsResponse) Reset()         { *m = MsgCancelPendingParamsResponse{} }
+func (m *MsgCancelPendingParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelPendingParamsResponse) ProtoMessage()    {}
+func (*MsgCancelPendingParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb8cc29357c6f1e0, []int{3}
+}
+func (m *MsgCancelPendingParamsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgCancelPendingParamsResponse.Unmarshal(m, b)
+}
+func (m *MsgCancelPendingParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgCancelPendingParamsResponse.Marshal(b, m, deterministic)
+}
+func (m *MsgCancelPendingParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelPendingParamsResponse.Merge(m, src)
+}
+func (m *MsgCancelPendingParamsResponse) XXX_Size() int {
+	return xxx_messageInfo_MsgCancelPendingParamsResponse.Size(m)
+}
+func (m *MsgCancelPendingParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelPendingParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelPendingParamsResponse proto.InternalMessageInfo
+
+type MsgRegisterContractRevenue struct {
+	// deployer_address is the account that deployed the contract.
+	DeployerAddress string `protobuf:"bytes,1,opt,name=deployer_address,json=deployerAddress,proto3" json:"deployer_address,omitempty"`
+	// contract_address is the 0x-prefixed hex address of the contract.
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// nonce is the deployer account nonce of the deploying transaction. The contract address
+	// must be the CREATE address derived from deployer_address and nonce.
+	Nonce uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// withdraw_address receives the rebates. Defaults to deployer_address when empty.
+	WithdrawAddress      string   `protobuf:"bytes,4,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgRegisterContractRevenue) Reset()         { *m = MsgRegisterContractRevenue{} }
+func (m *MsgRegisterContractRevenue) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterContractRevenue) ProtoMessage()    {}
+func (*MsgRegisterContractRevenue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb8cc29357c6f1e0, []int{4}
+}
+func (m *MsgRegisterContractRevenue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgRegisterContractRevenue.Unmarshal(m, b)
+}
+func (m *MsgRegisterContractRevenue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgRegisterContractRevenue.Marshal(b, m, deterministic)
+}
+func (m *MsgRegisterContractRevenue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterContractRevenue.Merge(m, src)
+}
+func (m *MsgRegisterContractRevenue) XXX_Size() int {
+	return xxx_messageInfo_MsgRegisterContractRevenue.Size(m)
+}
+func (m *MsgRegisterContractRevenue) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterContractRevenue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterContractRevenue proto.InternalMessageInfo
+
+func (m *MsgRegisterContractRevenue) GetDeployerAddress() string {
+	if m != nil {
+		return m.DeployerAddress
+	}
+	return ""
+}
+
+func (m *MsgRegisterContractRevenue) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *MsgRegisterContractRevenue) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *MsgRegisterContractRevenue) GetWithdrawAddress() string {
+	if m != nil {
+		return m.WithdrawAddress
+	}
+	return ""
+}
+
+type MsgRegisterContractRevenueResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgRegisterContractRevenueResponse) Reset()         { *m = MsgRegisterContractRevenueResponse{} }
+func (m *MsgRegisterContractRevenueResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterContractRevenueResponse) ProtoMessage()    {}
+func (*MsgRegisterContractRevenueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb8cc29357c6f1e0, []int{5}
+}
+func (m *MsgRegisterContractRevenueResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgRegisterContractRevenueResponse.Unmarshal(m, b)
+}
+func (m *MsgRegisterContractRevenueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgRegisterContractRevenueResponse.Marshal(b, m, deterministic)
+}
+func (m *MsgRegisterContractRevenueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterContractRevenueResponse.Merge(m, src)
+}
+func (m *MsgRegisterContractRevenueResponse) XXX_Size() int {
+	return xxx_messageInfo_MsgRegisterContractRevenueResponse.Size(m)
+}
+func (m *MsgRegisterContractRevenueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterContractRevenueResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterContractRevenueResponse proto.InternalMessageInfo
+
+type MsgUpdateContractRevenue struct {
+	// deployer_address must match the address that registered the contract.
+	DeployerAddress      string   `protobuf:"bytes,1,opt,name=deployer_address,json=deployerAddress,proto3" json:"deployer_address,omitempty"`
+	ContractAddress      string   `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	WithdrawAddress      string   `protobuf:"bytes,3,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgUpdateContractRevenue) Reset()         { *m = MsgUpdateContractRevenue{} }
+func (m *MsgUpdateContractRevenue) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateContractRevenue) ProtoMessage()    {}
+func (*MsgUpdateContractRevenue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb8cc29357c6f1e0, []int{6}
+}
+func (m *MsgUpdateContractRevenue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgUpdateContractRevenue.Unmarshal(m, b)
+}
+func (m *MsgUpdateContractRevenue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgUpdateContractRevenue.Marshal(b, m, deterministic)
+}
+func (m *MsgUpdateContractRevenue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateContractRevenue.Merge(m, src)
+}
+func (m *MsgUpdateContractRevenue) XXX_Size() int {
+	return xxx_messageInfo_MsgUpdateContractRevenue.Size(m)
+}
+func (m *MsgUpdateContractRevenue) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateContractRevenue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateContractRevenue proto.InternalMessageInfo
+
+func (m *MsgUpdateContractRevenue) GetDeployerAddress() string {
+	if m != nil {
+		return m.DeployerAddress
+	}
+	return ""
+}
+
+func (m *MsgUpdateContractRevenue) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *MsgUpdateContractRevenue) GetWithdrawAddress() string {
+	if m != nil {
+		return m.WithdrawAddress
+	}
+	return ""
+}
+
+type MsgUpdateContractRevenueResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgUpdateContractRevenueResponse) Reset()         { *m = MsgUpdateContractRevenueResponse{} }
+func (m *MsgUpdateContractRevenueResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateContractRevenueResponse) ProtoMessage()    {}
+func (*MsgUpdateContractRevenueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb8cc29357c6f1e0, []int{7}
+}
+func (m *MsgUpdateContractRevenueResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgUpdateContractRevenueResponse.Unmarshal(m, b)
+}
+func (m *MsgUpdateContractRevenueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgUpdateContractRevenueResponse.Marshal(b, m, deterministic)
+}
+func (m *MsgUpdateContractRevenueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateContractRevenueResponse.Merge(m, src)
+}
+func (m *MsgUpdateContractRevenueResponse) XXX_Size() int {
+	return xxx_messageInfo_MsgUpdateContractRevenueResponse.Size(m)
+}
+func (m *MsgUpdateContractRevenueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateContractRevenueResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateContractRevenueResponse proto.InternalMessageInfo
+
+type MsgCancelContractRevenue struct {
+	// deployer_address must match the address that registered the contract.
+	DeployerAddress      string   `protobuf:"bytes,1,opt,name=deployer_address,json=deployerAddress,proto3" json:"deployer_address,omitempty"`
+	ContractAddress      string   `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgCancelContractRevenue) Reset()         { *m = MsgCancelContractRevenue{} }
+func (m *MsgCancelContractRevenue) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelContractRevenue) ProtoMessage()    {}
+func (*MsgCancelContractRevenue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb8cc29357c6f1e0, []int{8}
+}
+func (m *MsgCancelContractRevenue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgCancelContractRevenue.Unmarshal(m, b)
+}
+func (m *MsgCancelContractRevenue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgCancelContractRevenue.Marshal(b, m, deterministic)
+}
+func (m *MsgCancelContractRevenue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelContractRevenue.Merge(m, src)
+}
+func (m *MsgCancelContractRevenue) XXX_Size() int {
+	return xxx_messageInfo_MsgCancelContractRevenue.Size(m)
+}
+func (m *MsgCancelContractRevenue) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelContractRevenue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelContractRevenue proto.InternalMessageInfo
+
+func (m *MsgCancelContractRevenue) GetDeployerAddress() string {
+	if m != nil {
+		return m.DeployerAddress
+	}
+	return ""
+}
+
+func (m *MsgCancelContractRevenue) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+type MsgCancelContractRevenueResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgCancelContractRevenueResponse) Reset()         { *m = MsgCancelContractRevenueResponse{} }
+func (m *MsgCancelContractRevenueResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelContractRevenueResponse) ProtoMessage()    {}
+func (*MsgCancelContractRevenueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb8cc29357c6f1e0, []int{9}
+}
+func (m *MsgCancelContractRevenueResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgCancelContractRevenueResponse.Unmarshal(m, b)
+}
+func (m *MsgCancelContractRevenueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgCancelContractRevenueResponse.Marshal(b, m, deterministic)
+}
+func (m *MsgCancelContractRevenueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelContractRevenueResponse.Merge(m, src)
+}
+func (m *MsgCancelContractRevenueResponse) XXX_Size() int {
+	return xxx_messageInfo_MsgCancelContractRevenueResponse.Size(m)
+}
+func (m *MsgCancelContractRevenueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelContractRevenueResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelContractRevenueResponse proto.InternalMessageInfo
+
+type MsgCreateSponsorship struct {
+	Sponsor string            `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	Policy  SponsorshipPolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy"`
+	// budget is the amount of the EVM denom moved from the sponsor to the sponsorship.
+	Budget               cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=budget,proto3,customtype=cosmossdk.io/math.Int" json:"budget"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *MsgCreateSponsorship) Reset()         { *m = MsgCreateSponsorship{} }
+func (m *MsgCreateSponsorship) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateSponsorship) ProtoMessage()    {}
+func (*MsgCreateSponsorship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb8cc29357c6f1e0, []int{10}
+}
+func (m *MsgCreateSponsorship) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgCreateSponsorship.Unmarshal(m, b)
+}
+func (m *MsgCreateSponsorship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgCreateSponsorship.Marshal(b, m, deterministic)
+}
+func (m *MsgCreateSponsorship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateSponsorship.Merge(m, src)
+}
+func (m *MsgCreateSponsorship) XXX_Size() int {
+	return xxx_messageInfo_MsgCreateSponsorship.Size(m)
+}
+func (m *MsgCreateSponsorship) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateSponsorship.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateSponsorship proto.InternalMessageInfo
+
+func (m *MsgCreateSponsorship) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+func (m *MsgCreateSponsorship) GetPolicy() SponsorshipPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return SponsorshipPolicy{}
+}
+
+type MsgCreateSponsorshipResponse struct {
+	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgCreateSponsorshipResponse) Reset()         { *m = MsgCreateSponsorshipResponse{} }
+func (m *MsgCreateSponsorshipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateSponsorshipResponse) ProtoMessage()    {}
+func (*MsgCreateSponsorshipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb8cc29357c6f1e0, []int{11}
+}
+func (m *MsgCreateSponsorshipResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgCreateSponsorshipResponse.Unmarshal(m, b)
+}
+func (m *MsgCreateSponsorshipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgCreateSponsorshipResponse.Marshal(b, m, deterministic)
+}
+func (m *MsgCreateSponsorshipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateSponsorshipResponse.Merge(m, src)
+}
+func (m *MsgCreateSponsorshipResponse) XXX_Size() int {
+	return xxx_messageInfo_MsgCreateSponsorshipResponse.Size(m)
+}
+func (m *MsgCreateSponsorshipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateSponsorshipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateSponsorshipResponse proto.InternalMessageInfo
+
+func (m *MsgCreateSponsorshipResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type MsgUpdateSponsorship struct {
+	// sponsor must match the sponsor of the sponsorship.
+	Sponsor              string            `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	Id                   uint64            `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Policy               SponsorshipPolicy `protobuf:"bytes,3,opt,name=policy,proto3" json:"policy"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *MsgUpdateSponsorship) Reset()         { *m = MsgUpdateSponsorship{} }
+func (m *MsgUpdateSponsorship) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSponsorship) ProtoMessage()    {}
+func (*MsgUpdateSponsorship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb8cc29357c6f1e0, []int{12}
+}
+func (m *MsgUpdateSponsorship) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgUpdateSponsorship.Unmarshal(m, b)
+}
+func (m *MsgUpdateSponsorship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgUpdateSponsorship.Marshal(b, m, deterministic)
+}
+func (m *MsgUpdateSponsorship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSponsorship.Merge(m, src)
+}
+func (m *MsgUpdateSponsorship) XXX_Size() int {
+	return xxx_messageInfo_MsgUpdateSponsorship.Size(m)
+}
+func (m *MsgUpdateSponsorship) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSponsorship.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateSponsorship proto.InternalMessageInfo
+
+func (m *MsgUpdateSponsorship) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+func (m *MsgUpdateSponsorship) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MsgUpdateSponsorship) GetPolicy() SponsorshipPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return SponsorshipPolicy{}
+}
+
+type MsgUpdateSponsorshipResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgUpdateSponsorshipResponse) Reset()         { *m = MsgUpdateSponsorshipResponse{} }
+func (m *MsgUpdateSponsorshipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSponsorshipResponse) ProtoMessage()    {}
+func (*MsgUpdateSponsorshipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb8cc29357c6f1e0, []int{13}
+}
+func (m *MsgUpdateSponsorshipResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgUpdateSponsorshipResponse.Unmarshal(m, b)
+}
+func (m *MsgUpdateSponsorshipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgUpdateSponsorshipResponse.Marshal(b, m, deterministic)
+}
+func (m *MsgUpdateSponsorshipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSponsorshipResponse.Merge(m, src)
+}
+func (m *MsgUpdateSponsorshipResponse) XXX_Size() int {
+	return xxx_messageInfo_MsgUpdateSponsorshipResponse.Size(m)
+}
+func (m *MsgUpdateSponsorshipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSponsorshipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateSponsorshipResponse proto.InternalMessageInfo
+
+type MsgFundSponsorship struct {
+	// sponsor must match the sponsor of the sponsorship.
+	Sponsor              string                `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	Id                   uint64                `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Amount               cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *MsgFundSponsorship) Reset()         { *m = MsgFundSponsorship{} }
+func (m *MsgFundSponsorship) String() string { return proto.CompactTextString(m) }
+func (*MsgFundSponsorship) ProtoMessage()    {}
+func (*MsgFundSponsorship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb8cc29357c6f1e0, []int{14}
+}
+func (m *MsgFundSponsorship) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgFundSponsorship.Unmarshal(m, b)
+}
+func (m *MsgFundSponsorship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgFundSponsorship.Marshal(b, m, deterministic)
+}
+func (m *MsgFundSponsorship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundSponsorship.Merge(m, src)
+}
+func (m *MsgFundSponsorship) XXX_Size() int {
+	return xxx_messageInfo_MsgFundSponsorship.Size(m)
+}
+func (m *MsgFundSponsorship) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundSponsorship.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundSponsorship proto.InternalMessageInfo
+
+func (m *MsgFundSponsorship) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+func (m *MsgFundSponsorship) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type MsgFundSponsorshipResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgFundSponsorshipResponse) Reset()         { *m = MsgFundSponsorshipResponse{} }
+func (m *MsgFundSponsorshipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundSponsorshipResponse) ProtoMessage()    {}
+func (*MsgFundSponsorshipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb8cc29357c6f1e0, []int{15}
+}
+func (m *MsgFundSponsorshipResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgFundSponsorshipResponse.Unmarshal(m, b)
+}
+func (m *MsgFundSponsorshipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgFundSponsorshipResponse.Marshal(b, m, deterministic)
+}
+func (m *MsgFundSponsorshipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundSponsorshipResponse.Merge(m, src)
+}
+func (m *MsgFundSponsorshipResponse) XXX_Size() int {
+	return xxx_messageInfo_MsgFundSponsorshipResponse.Size(m)
+}
+func (m *MsgFundSponsorshipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundSponsorshipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundSponsorshipResponse proto.InternalMessageInfo
+
+type MsgCloseSponsorship struct {
+	// sponsor must match the sponsor of the sponsorship.
+	Sponsor              string   `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	Id                   uint64   `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgCloseSponsorship) Reset()         { *m = MsgCloseSponsorship{} }
+func (m *MsgCloseSponsorship) String() string { return proto.CompactTextString(m) }
+func (*MsgCloseSponsorship) ProtoMessage()    {}
+func (*MsgCloseSponsorship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb8cc29357c6f1e0, []int{16}
+}
+func (m *MsgCloseSponsorship) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgCloseSponsorship.Unmarshal(m, b)
+}
+func (m *MsgCloseSponsorship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgCloseSponsorship.Marshal(b, m, deterministic)
+}
+func (m *MsgCloseSponsorship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCloseSponsorship.Merge(m, src)
+}
+func (m *MsgCloseSponsorship) XXX_Size() int {
+	return xxx_messageInfo_MsgCloseSponsorship.Size(m)
+}
+func (m *MsgCloseSponsorship) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCloseSponsorship.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCloseSponsorship proto.InternalMessageInfo
+
+func (m *MsgCloseSponsorship) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+func (m *MsgCloseSponsorship) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type MsgCloseSponsorshipResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgCloseSponsorshipResponse) Reset()         { *m = MsgCloseSponsorshipResponse{} }
+func (m *MsgCloseSponsorshipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCloseSponsorshipResponse) ProtoMessage()    {}
+func (*MsgCloseSponsorshipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb8cc29357c6f1e0, []int{17}
+}
+func (m *MsgCloseSponsorshipResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgCloseSponsorshipResponse.Unmarshal(m, b)
+}
+func (m *MsgCloseSponsorshipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgCloseSponsorshipResponse.Marshal(b, m, deterministic)
+}
+func (m *MsgCloseSponsorshipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCloseSponsorshipResponse.Merge(m, src)
+}
+func (m *MsgCloseSponsorshipResponse) XXX_Size() int {
+	return xxx_messageInfo_MsgCloseSponsorshipResponse.Size(m)
+}
+func (m *MsgCloseSponsorshipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCloseSponsorshipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCloseSponsorshipResponse proto.InternalMessageInfo
+
+type MsgDeploySystemContract struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// name is the system_contracts entry to replace, e.g. "timelock" or "domain_inbox".
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// artifact is the name of an embedded contract artifact, e.g. "YNXTimelock". Exactly one of
+	// artifact and bytecode must be set.
+	Artifact string `protobuf:"bytes,3,opt,name=artifact,proto3" json:"artifact,omitempty"`
+	// bytecode is the creation bytecode deployed when artifact is empty.
+	Bytecode []byte `protobuf:"bytes,4,opt,name=bytecode,proto3" json:"bytecode,omitempty"`
+	// constructor_args are the ABI-encoded constructor arguments appended to the creation bytecode.
+	ConstructorArgs []byte `protobuf:"bytes,5,opt,name=constructor_args,json=constructorArgs,proto3" json:"constructor_args,omitempty"`
+	// migration_calls are executed in order after the deployment, from the system deployer address.
+	MigrationCalls       []SystemContractCall `protobuf:"bytes,6,rep,name=migration_calls,json=migrationCalls,proto3" json:"migration_calls"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *MsgDeploySystemContract) Reset()         { *m = MsgDeploySystemContract{} }
+func (m *MsgDeploySystemContract) String() string { return proto.CompactTextString(m) }
+func (*MsgDeploySystemContract) ProtoMessage()    {}
+func (*MsgDeploySystemContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb8cc29357c6f1e0, []int{18}
+}
+func (m *MsgDeploySystemContract) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgDeploySystemContract.Unmarshal(m, b)
+}
+func (m *MsgDeploySystemContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgDeploySystemContract.Marshal(b, m, deterministic)
+}
+func (m *MsgDeploySystemContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeploySystemContract.Merge(m, src)
+}
+func (m *MsgDeploySystemContract) XXX_Size() int {
+	return xxx_messageInfo_MsgDeploySystemContract.Size(m)
+}
+func (m *MsgDeploySystemContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeploySystemContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeploySystemContract proto.InternalMessageInfo
+
+func (m *MsgDeploySystemContract) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgDeploySystemContract) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgDeploySystemContract) GetArtifact() string {
+	if m != nil {
+		return m.Artifact
+	}
+	return ""
+}
+
+func (m *MsgDeploySystemContract) GetBytecode() []byte {
+	if m != nil {
+		return m.Bytecode
+	}
+	return nil
+}
+
+func (m *MsgDeploySystemContract) GetConstructorArgs() []byte {
+	if m != nil {
+		return m.ConstructorArgs
+	}
+	return nil
+}
+
+func (m *MsgDeploySystemContract) GetMigrationCalls() []SystemContractCall {
+	if m != nil {
+		return m.MigrationCalls
+	}
+	return nil
+}
+
+// SystemContractCall is an EVM call made by the system deployer after a system contract deployment.
+type SystemContractCall struct {
+	// to is the 0x-prefixed address of the called contract. Empty calls the newly deployed contract.
+	To string `protobuf:"bytes,1,opt,name=to,proto3" json:"to,omitempty"`
+	// data is the call input.
+	Data                 []byte   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SystemContractCall) Reset()         { *m = SystemContractCall{} }
+func (m *SystemContractCall) String() string { return proto.CompactTextString(m) }
+func (*SystemContractCall) ProtoMessage()    {}
+func (*SystemContractCall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb8cc29357c6f1e0, []int{19}
+}
+func (m *SystemContractCall) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SystemContractCall.Unmarshal(m, b)
+}
+func (m *SystemContractCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SystemContractCall.Marshal(b, m, deterministic)
+}
+func (m *SystemContractCall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SystemContractCall.Merge(m, src)
+}
+func (m *SystemContractCall) XXX_Size() int {
+	return xxx_messageInfo_SystemContractCall.Size(m)
+}
+func (m *SystemContractCall) XXX_DiscardUnknown() {
+	xxx_messageInfo_SystemContractCall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SystemContractCall proto.InternalMessageInfo
+
+func (m *SystemContractCall) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *SystemContractCall) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type MsgDeploySystemContractResponse struct {
+	// address is the 0x-prefixed address of the deployed contract.
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgDeploySystemContractResponse) Reset()         { *m = MsgDeploySystemContractResponse{} }
+func (m *MsgDeploySystemContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeploySystemContractResponse) ProtoMessage()    {}
+func (*MsgDeploySystemContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb8cc29357c6f1e0, []int{20}
+}
+func (m *MsgDeploySystemContractResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgDeploySystemContractResponse.Unmarshal(m, b)
+}
+func (m *MsgDeploySystemContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgDeploySystemContractResponse.Marshal(b, m, deterministic)
+}
+func (m *MsgDeploySystemContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeploySystemContractResponse.Merge(m, src)
+}
+func (m *MsgDeploySystemContractResponse) XXX_Size() int {
+	return xxx_messageInfo_MsgDeploySystemContractResponse.Size(m)
+}
+func (m *MsgDeploySystemContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeploySystemContractResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeploySystemContractResponse proto.InternalMessageInfo
+
+func (m *MsgDeploySystemContractResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type MsgSetSystemContract struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// name is the system_contracts entry to replace.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// address is the 0x-prefixed address of the contract. Code must be deployed there.
+	Address              string   `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgSetSystemContract) Reset()         { *m = MsgSetSystemContract{} }
+func (m *MsgSetSystemContract) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSystemContract) ProtoMessage()    {}
+func (*MsgSetSystemContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb8cc29357c6f1e0, []int{21}
+}
+func (m *MsgSetSystemContract) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgSetSystemContract.Unmarshal(m, b)
+}
+func (m *MsgSetSystemContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgSetSystemContract.Marshal(b, m, deterministic)
+}
+func (m *MsgSetSystemContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSystemContract.Merge(m, src)
+}
+func (m *MsgSetSystemContract) XXX_Size() int {
+	return xxx_messageInfo_MsgSetSystemContract.Size(m)
+}
+func (m *MsgSetSystemContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSystemContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSystemContract proto.InternalMessageInfo
+
+func (m *MsgSetSystemContract) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetSystemContract) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgSetSystemContract) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type MsgSetSystemContractResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgSetSystemContractResponse) Reset()         { *m = MsgSetSystemContractResponse{} }
+func (m *MsgSetSystemContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSystemContractResponse) ProtoMessage()    {}
+func (*MsgSetSystemContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb8cc29357c6f1e0, []int{22}
+}
+func (m *MsgSetSystemContractResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgSetSystemContractResponse.Unmarshal(m, b)
+}
+func (m *MsgSetSystemContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgSetSystemContractResponse.Marshal(b, m, deterministic)
+}
+func (m *MsgSetSystemContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSystemContractResponse.Merge(m, src)
+}
+func (m *MsgSetSystemContractResponse) XXX_Size() int {
+	return xxx_messageInfo_MsgSetSystemContractResponse.Size(m)
+}
+func (m *MsgSetSystemContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSystemContractResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSystemContractResponse proto.InternalMessageInfo
+
+type MsgTripCircuitBreaker struct {
+	// guardian must match the circuit_guardian_address param.
+	Guardian string             `protobuf:"bytes,1,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	Kind     CircuitBreakerKind `protobuf:"varint,2,opt,name=kind,proto3,enum=ynx.ynx.v1.CircuitBreakerKind" json:"kind,omitempty"`
+	Target   string             `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	Selector string             `protobuf:"bytes,4,opt,name=selector,proto3" json:"selector,omitempty"`
+	// duration_blocks is how long the breaker stays tripped, at most circuit_breaker_max_blocks.
+	DurationBlocks       uint64   `protobuf:"varint,5,opt,name=duration_blocks,json=durationBlocks,proto3" json:"duration_blocks,omitempty"`
+	Reason               string   `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgTripCircuitBreaker) Reset()         { *m = MsgTripCircuitBreaker{} }
+func (m *MsgTripCircuitBreaker) String() string { return proto.CompactTextString(m) }
+func (*MsgTripCircuitBreaker) ProtoMessage()    {}
+func (*MsgTripCircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb8cc29357c6f1e0, []int{23}
+}
+func (m *MsgTripCircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgTripCircuitBreaker.Unmarshal(m, b)
+}
+func (m *MsgTripCircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgTripCircuitBreaker.Marshal(b, m, deterministic)
+}
+func (m *MsgTripCircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTripCircuitBreaker.Merge(m, src)
+}
+func (m *MsgTripCircuitBreaker) XXX_Size() int {
+	return xxx_messageInfo_MsgTripCircuitBreaker.Size(m)
+}
+func (m *MsgTripCircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTripCircuitBreaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTripCircuitBreaker proto.InternalMessageInfo
+
+func (m *MsgTripCircuitBreaker) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
+	}
+	return ""
+}
+
+func (m *MsgTripCircuitBreaker) GetKind() CircuitBreakerKind {
+	if m != nil {
+		return m.Kind
+	}
+	return CircuitBreakerKind_CIRCUIT_BREAKER_KIND_UNSPECIFIED
+}
+
+func (m *MsgTripCircuitBreaker) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *MsgTripCircuitBreaker) GetSelector() string {
+	if m != nil {
+		return m.Selector
+	}
+	return ""
+}
+
+func (m *MsgTripCircuitBreaker) GetDurationBlocks() uint64 {
+	if m != nil {
+		return m.DurationBlocks
+	}
+	return 0
+}
+
+func (m *MsgTripCircuitBreaker) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type MsgTripCircuitBreakerResponse struct {
+	// expiry_height is the first height at which the breaker no longer blocks.
+	ExpiryHeight         int64    `protobuf:"varint,1,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgTripCircuitBreakerResponse) Reset()         { *m = MsgTripCircuitBreakerResponse{} }
+func (m *MsgTripCircuitBreakerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTripCircuitBreakerResponse) ProtoMessage()    {}
+func (*MsgTripCircuitBreakerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb8cc29357c6f1e0, []int{24}
+}
+func (m *MsgTripCircuitBreakerResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgTripCircuitBreakerResponse.Unmarshal(m, b)
+}
+func (m *MsgTripCircuitBreakerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgTripCircuitBreakerResponse.Marshal(b, m, deterministic)
+}
+func (m *MsgTripCircuitBreakerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTripCircuitBreakerResponse.Merge(m, src)
+}
+func (m *MsgTripCircuitBreakerResponse) XXX_Size() int {
+	return xxx_messageInfo_MsgTripCircuitBreakerResponse.Size(m)
+}
+func (m *MsgTripCircuitBreakerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTripCircuitBreakerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTripCircuitBreakerResponse proto.InternalMessageInfo
+
+func (m *MsgTripCircuitBreakerResponse) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+type MsgResetCircuitBreaker struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority            string             `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Kind                 CircuitBreakerKind `protobuf:"varint,2,opt,name=kind,proto3,enum=ynx.ynx.v1.CircuitBreakerKind" json:"kind,omitempty"`
+	Target               string             `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	Selector             string             `protobuf:"bytes,4,opt,name=selector,proto3" json:"selector,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *MsgResetCircuitBreaker) Reset()         { *m = MsgResetCircuitBreaker{} }
+func (m *MsgResetCircuitBreaker) String() string { return proto.CompactTextString(m) }
+func (*MsgResetCircuitBreaker) ProtoMessage()    {}
+func (*MsgResetCircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb8cc29357c6f1e0, []int{25}
+}
+func (m *MsgResetCircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgResetCircuitBreaker.Unmarshal(m, b)
+}
+func (m *MsgResetCircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgResetCircuitBreaker.Marshal(b, m, deterministic)
+}
+func (m *MsgResetCircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResetCircuitBreaker.Merge(m, src)
+}
+func (m *MsgResetCircuitBreaker) XXX_Size() int {
+	return xxx_messageInfo_MsgResetCircuitBreaker.Size(m)
+}
+func (m *MsgResetCircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResetCircuitBreaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResetCircuitBreaker proto.InternalMessageInfo
+
+func (m *MsgResetCircuitBreaker) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgResetCircuitBreaker) GetKind() CircuitBreakerKind {
+	if m != nil {
+		return m.Kind
+	}
+	return CircuitBreakerKind_CIRCUIT_BREAKER_KIND_UNSPECIFIED
+}
+
+func (m *MsgResetCircuitBreaker) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *MsgResetCircuitBreaker) GetSelector() string {
+	if m != nil {
+		return m.Selector
+	}
+	return ""
+}
+
+type MsgResetCircuitBreakerResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgResetCircuitBreakerResponse) Reset()         { *m = MsgResetCircuitBreakerResponse{} }
+func (m *MsgResetCircuitBreakerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResetCircuitBreakerResponse) ProtoMessage()    {}
+func (*MsgResetCircuitBreakerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb8cc29357c6f1e0, []int{26}
+}
+func (m *MsgResetCircuitBreakerResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgResetCircuitBreakerResponse.Unmarshal(m, b)
+}
+func (m *MsgResetCircuitBreakerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgResetCircuitBreakerResponse.Marshal(b, m, deterministic)
+}
+func (m *MsgResetCircuitBreakerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResetCircuitBreakerResponse.Merge(m, src)
+}
+func (m *MsgResetCircuitBreakerResponse) XXX_Size() int {
+	return xxx_messageInfo_MsgResetCircuitBreakerResponse.Size(m)
+}
+func (m *MsgResetCircuitBreakerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResetCircuitBreakerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResetCircuitBreakerResponse proto.InternalMessageInfo
+
+type MsgSetPreconfirmSigners struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// signer_set is the set to register. Its activation_epoch must not be before the current epoch;
+	// the current epoch activates it immediately. It replaces a set scheduled for the same epoch.
+	SignerSet            PreconfirmSignerSet `protobuf:"bytes,2,opt,name=signer_set,json=signerSet,proto3" json:"signer_set"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *MsgSetPreconfirmSigners) Reset()         { *m = MsgSetPreconfirmSigners{} }
+func (m *MsgSetPreconfirmSigners) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPreconfirmSigners) ProtoMessage()    {}
+func (*MsgSetPreconfirmSigners) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb8cc29357c6f1e0, []int{27}
+}
+func (m *MsgSetPreconfirmSigners) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgSetPreconfirmSigners.Unmarshal(m, b)
+}
+func (m *MsgSetPreconfirmSigners) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgSetPreconfirmSigners.Marshal(b, m, deterministic)
+}
+func (m *MsgSetPreconfirmSigners) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPreconfirmSigners.Merge(m, src)
+}
+func (m *MsgSetPreconfirmSigners) XXX_Size() int {
+	return xxx_messageInfo_MsgSetPreconfirmSigners.Size(m)
+}
+func (m *MsgSetPreconfirmSigners) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPreconfirmSigners.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPreconfirmSigners proto.InternalMessageInfo
+
+func (m *MsgSetPreconfirmSigners) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetPreconfirmSigners) GetSignerSet() PreconfirmSignerSet {
+	if m != nil {
+		return m.SignerSet
+	}
+	return PreconfirmSignerSet{}
+}
+
+type MsgSetPreconfirmSignersResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgSetPreconfirmSignersResponse) Reset()         { *m = MsgSetPreconfirmSignersResponse{} }
+func (m *MsgSetPreconfirmSignersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPreconfirmSignersResponse) ProtoMessage()    {}
+func (*MsgSetPreconfirmSignersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb8cc29357c6f1e0, []int{28}
+}
+func (m *MsgSetPreconfirmSignersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgSetPreconfirmSignersResponse.Unmarshal(m, b)
+}
+func (m *MsgSetPreconfirmSignersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgSetPreconfirmSignersResponse.Marshal(b, m, deterministic)
+}
+func (m *MsgSetPreconfirmSignersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPreconfirmSignersResponse.Merge(m, src)
+}
+func (m *MsgSetPreconfirmSignersResponse) XXX_Size() int {
+	return xxx_messageInfo_MsgSetPreconfirmSignersResponse.Size(m)
+}
+func (m *MsgSetPreconfirmSignersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPreconfirmSignersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPreconfirmSignersResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "ynx.ynx.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ynx.ynx.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgCancelPendingParams)(nil), "ynx.ynx.v1.MsgCancelPendingParams")
+	proto.RegisterType((*MsgCancelPendingParamsResponse)(nil), "ynx.ynx.v1.MsgCancelPendingParamsResponse")
+	proto.RegisterType((*MsgRegisterContractRevenue)(nil), "ynx.ynx.v1.MsgRegisterContractRevenue")
+	proto.RegisterType((*MsgRegisterContractRevenueResponse)(nil), "ynx.ynx.v1.MsgRegisterContractRevenueResponse")
+	proto.RegisterType((*MsgUpdateContractRevenue)(nil), "ynx.ynx.v1.MsgUpdateContractRevenue")
+	proto.RegisterType((*MsgUpdateContractRevenueResponse)(nil), "ynx.ynx.v1.MsgUpdateContractRevenueResponse")
+	proto.RegisterType((*MsgCancelContractRevenue)(nil), "ynx.ynx.v1.MsgCancelContractRevenue")
+	proto.RegisterType((*MsgCancelContractRevenueResponse)(nil), "ynx.ynx.v1.MsgCancelContractRevenueResponse")
+	proto.RegisterType((*MsgCreateSponsorship)(nil), "ynx.ynx.v1.MsgCreateSponsorship")
+	proto.RegisterType((*MsgCreateSponsorshipResponse)(nil), "ynx.ynx.v1.MsgCreateSponsorshipResponse")
+	proto.RegisterType((*MsgUpdateSponsorship)(nil), "ynx.ynx.v1.MsgUpdateSponsorship")
+	proto.RegisterType((*MsgUpdateSponsorshipResponse)(nil), "ynx.ynx.v1.MsgUpdateSponsorshipResponse")
+	proto.RegisterType((*MsgFundSponsorship)(nil), "ynx.ynx.v1.MsgFundSponsorship")
+	proto.RegisterType((*MsgFundSponsorshipResponse)(nil), "ynx.ynx.v1.MsgFundSponsorshipResponse")
+	proto.RegisterType((*MsgCloseSponsorship)(nil), "ynx.ynx.v1.MsgCloseSponsorship")
+	proto.RegisterType((*MsgCloseSponsorshipResponse)(nil), "ynx.ynx.v1.MsgCloseSponsorshipResponse")
+	proto.RegisterType((*MsgDeploySystemContract)(nil), "ynx.ynx.v1.MsgDeploySystemContract")
+	proto.RegisterType((*SystemContractCall)(nil), "ynx.ynx.v1.SystemContractCall")
+	proto.RegisterType((*MsgDeploySystemContractResponse)(nil), "ynx.ynx.v1.MsgDeploySystemContractResponse")
+	proto.RegisterType((*MsgSetSystemContract)(nil), "ynx.ynx.v1.MsgSetSystemContract")
+	proto.RegisterType((*MsgSetSystemContractResponse)(nil), "ynx.ynx.v1.MsgSetSystemContractResponse")
+	proto.RegisterType((*MsgTripCircuitBreaker)(nil), "ynx.ynx.v1.MsgTripCircuitBreaker")
+	proto.RegisterType((*MsgTripCircuitBreakerResponse)(nil), "ynx.ynx.v1.MsgTripCircuitBreakerResponse")
+	proto.RegisterType((*MsgResetCircuitBreaker)(nil), "ynx.ynx.v1.MsgResetCircuitBreaker")
+	proto.RegisterType((*MsgResetCircuitBreakerResponse)(nil), "ynx.ynx.v1.MsgResetCircuitBreakerResponse")
+	proto.RegisterType((*MsgSetPreconfirmSigners)(nil), "ynx.ynx.v1.MsgSetPreconfirmSigners")
+	proto.RegisterType((*MsgSetPreconfirmSignersResponse)(nil), "ynx.ynx.v1.MsgSetPreconfirmSignersResponse")
+}
+
+func init() { proto.RegisterFile("ynx/ynx/v1/tx.proto", fileDescriptor_fb8cc29357c6f1e0) }
+
+var fileDescriptor_fb8cc29357c6f1e0 = []byte{
+	// 1441 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x5d, 0x6f, 0x13, 0x47,
+	0x17, 0x7e, 0xd7, 0x09, 0x86, 0x1c, 0xf2, 0xc6, 0x61, 0x09, 0x60, 0x96, 0x24, 0x36, 0x4b, 0x04,
+	0x21, 0x01, 0x5b, 0x04, 0x5a, 0x55, 0x6e, 0x55, 0x95, 0x18, 0x55, 0xa5, 0x55, 0xaa, 0x68, 0xdd,
+	0x4a, 0xa5, 0xaa, 0x14, 0x8d, 0x77, 0x87, 0xf5, 0x28, 0xf6, 0x8e, 0x3b, 0x33, 0x0e, 0xf1, 0x5d,
+	0xc5, 0x5d, 0x2b, 0xf1, 0x3b, 0xda, 0x5e, 0x15, 0x55, 0xfc, 0x08, 0x2e, 0xda, 0x1b, 0x2e, 0x5b,
+	0x89, 0x5b, 0xae, 0xfa, 0x1f, 0xaa, 0xfd, 0x9a, 0xec, 0xc7, 0x38, 0x9b, 0x06, 0x5a, 0x2e, 0x1c,
+	0xed, 0x9c, 0xf3, 0xcc, 0xf9, 0x78, 0xe6, 0xec, 0xd9, 0x33, 0x81, 0xb3, 0x63, 0x6f, 0xbf, 0xe9,
+	0xff, 0xf6, 0x6e, 0x35, 0xc5, 0x7e, 0x63, 0xc8, 0xa8, 0xa0, 0x3a, 0x8c, 0xbd, 0xfd, 0x86, 0xff,
+	0xdb, 0xbb, 0x65, 0x9c, 0x41, 0x03, 0xe2, 0xd1, 0x66, 0xf0, 0x37, 0x54, 0x1b, 0x17, 0x6c, 0xca,
+	0x07, 0x94, 0x37, 0x07, 0xdc, 0xf5, 0xb7, 0x0d, 0xb8, 0x1b, 0x29, 0x2e, 0x86, 0x8a, 0x9d, 0x60,
+	0xd5, 0x0c, 0x17, 0x91, 0x6a, 0xc1, 0xa5, 0x2e, 0x0d, 0xe5, 0xfe, 0x53, 0x24, 0xad, 0x26, 0xbc,
+	0xdb, 0x84, 0xd9, 0x23, 0x22, 0x62, 0x1f, 0x09, 0xcd, 0x10, 0x31, 0x34, 0x88, 0x0d, 0x5d, 0x4a,
+	0x2a, 0x18, 0xb6, 0xa9, 0xf7, 0x90, 0xb0, 0x41, 0xa4, 0x5c, 0x4c, 0x28, 0xf9, 0x90, 0x7a, 0x9c,
+	0x32, 0xde, 0x23, 0xc3, 0x50, 0x6b, 0xfe, 0xa9, 0x41, 0x65, 0x8b, 0xbb, 0x5f, 0x0e, 0x1d, 0x24,
+	0xf0, 0x76, 0x60, 0x54, 0x7f, 0x17, 0x66, 0xd0, 0x48, 0xf4, 0x28, 0x23, 0x62, 0x5c, 0xd5, 0xea,
+	0xda, 0xea, 0xcc, 0x66, 0xf5, 0xc5, 0xb3, 0x9b, 0x0b, 0x51, 0xf0, 0x77, 0x1d, 0x87, 0x61, 0xce,
+	0x3b, 0x82, 0x11, 0xcf, 0xb5, 0x0e, 0xa0, 0xfa, 0x3b, 0x50, 0x0e, 0xc3, 0xaa, 0x96, 0xea, 0xda,
+	0xea, 0xe9, 0x0d, 0xbd, 0x71, 0xc0, 0x59, 0x23, 0xb4, 0xbd, 0x39, 0xf3, 0xfc, 0x65, 0xed, 0x7f,
+	0x3f, 0xbd, 0x7a, 0xba, 0xa6, 0x59, 0x11, 0x58, 0x5f, 0x87, 0x33, 0xc8, 0x16, 0x64, 0x0f, 0x09,
+	0x42, 0xbd, 0x9d, 0x1e, 0x26, 0x6e, 0x4f, 0x54, 0xa7, 0xea, 0xda, 0xea, 0x94, 0x35, 0x7f, 0xa0,
+	0xf8, 0x24, 0x90, 0xb7, 0x6e, 0x3c, 0x7e, 0xf5, 0x74, 0xed, 0xc0, 0xe7, 0x0f, 0xaf, 0x9e, 0xae,
+	0x5d, 0xf4, 0x93, 0x0b, 0x53, 0xcc, 0x64, 0x62, 0x5e, 0x84, 0x0b, 0x19, 0x91, 0x85, 0x03, 0x0e,
+	0xb0, 0xf9, 0xab, 0x06, 0xe7, 0xb7, 0xb8, 0xdb, 0x46, 0x9e, 0x8d, 0xfb, 0xdb, 0xd8, 0x73, 0x88,
+	0xe7, 0xbe, 0x66, 0xfe, 0xca, 0x44, 0x4a, 0x13, 0x12, 0xb9, 0x9d, 0x4f, 0xa4, 0x9e, 0x4a, 0x44,
+	0x11, 0x99, 0x59, 0x87, 0x65, 0xb5, 0x46, 0xa6, 0xf5, 0x63, 0x09, 0x8c, 0x2d, 0xee, 0x5a, 0xd8,
+	0x25, 0x5c, 0x60, 0xd6, 0xa6, 0x9e, 0x60, 0xc8, 0x16, 0x16, 0xde, 0xc3, 0xde, 0x08, 0xeb, 0x6d,
+	0x98, 0x77, 0xf0, 0xb0, 0x4f, 0xc7, 0x98, 0xed, 0xa0, 0x30, 0x8f, 0xc2, 0x0c, 0x2b, 0xf1, 0x8e,
+	0x48, 0xac, 0x5f, 0x87, 0x79, 0x3b, 0xb2, 0x2b, 0x8d, 0xf8, 0x69, 0xce, 0x58, 0x95, 0x58, 0x1e,
+	0x43, 0x17, 0xe0, 0x84, 0x47, 0x3d, 0x1b, 0x07, 0xe7, 0x39, 0x6d, 0x85, 0x0b, 0x3f, 0x8a, 0x47,
+	0x44, 0xf4, 0x1c, 0x86, 0x1e, 0x49, 0x03, 0xd3, 0x45, 0x51, 0xc4, 0x3b, 0x22, 0x71, 0xeb, 0x43,
+	0x9f, 0xc0, 0x5c, 0x36, 0x3e, 0x8f, 0x2b, 0x29, 0x1e, 0x27, 0x50, 0x61, 0xae, 0x80, 0x39, 0x59,
+	0x2b, 0xf9, 0x7c, 0x52, 0x82, 0xaa, 0x2c, 0xa1, 0xb7, 0xcd, 0xa6, 0x8a, 0xb7, 0xa9, 0x7f, 0xca,
+	0xdb, 0x07, 0x13, 0x79, 0x33, 0x15, 0x2f, 0x52, 0x96, 0x35, 0x13, 0xea, 0x93, 0x74, 0x92, 0xb3,
+	0xe7, 0x1a, 0x54, 0x65, 0x99, 0xbe, 0x65, 0xce, 0x8e, 0x9c, 0xae, 0x32, 0xda, 0x28, 0x5d, 0xa5,
+	0x4e, 0xa6, 0xfb, 0xb8, 0x04, 0x0b, 0x3e, 0x88, 0x61, 0x24, 0x70, 0xe7, 0xa0, 0xc3, 0xea, 0x1b,
+	0x70, 0x32, 0x6a, 0xb8, 0x85, 0x19, 0xc6, 0x40, 0xfd, 0x23, 0x28, 0x0f, 0x69, 0x9f, 0xd8, 0xe3,
+	0xa8, 0x87, 0x2e, 0x25, 0x7b, 0x68, 0xc2, 0xf8, 0x76, 0x00, 0x4a, 0xb7, 0xd3, 0x40, 0xa4, 0xb7,
+	0xa1, 0xdc, 0x1d, 0x39, 0x2e, 0x16, 0x51, 0x69, 0xac, 0xfb, 0x90, 0x3f, 0x5e, 0xd6, 0xce, 0x85,
+	0x8e, 0xb9, 0xb3, 0xdb, 0x20, 0xb4, 0x39, 0x40, 0xa2, 0xd7, 0xb8, 0xef, 0x89, 0x17, 0xcf, 0x6e,
+	0x42, 0x14, 0xd1, 0x7d, 0x4f, 0x58, 0xd1, 0xd6, 0x56, 0xd3, 0x67, 0x2d, 0x0e, 0xca, 0x27, 0x6b,
+	0x39, 0x4d, 0x56, 0x36, 0x57, 0xb3, 0x01, 0x8b, 0x2a, 0x79, 0x4c, 0x92, 0x3e, 0x07, 0x25, 0xe2,
+	0x04, 0x34, 0x4c, 0x5b, 0x25, 0xe2, 0x98, 0xbf, 0x6b, 0xb0, 0x20, 0x0b, 0xe9, 0x75, 0x49, 0x0b,
+	0x8d, 0x97, 0x62, 0xe3, 0x09, 0x12, 0xa7, 0x8e, 0x47, 0x62, 0x51, 0xfe, 0xb9, 0xb0, 0xcd, 0x65,
+	0x58, 0x54, 0xc9, 0x65, 0x91, 0xfc, 0xa6, 0x81, 0xbe, 0xc5, 0xdd, 0x8f, 0x47, 0x9e, 0xf3, 0xa6,
+	0xb3, 0x6d, 0x43, 0x19, 0x0d, 0xe8, 0xc8, 0x3b, 0xde, 0x81, 0x87, 0x5b, 0x5b, 0x37, 0xb3, 0x09,
+	0x2f, 0xa6, 0x12, 0xce, 0xc4, 0x6d, 0x2e, 0x82, 0x91, 0x97, 0xca, 0x64, 0xbf, 0xd7, 0xe0, 0xac,
+	0x5f, 0x0d, 0x7d, 0xca, 0xdf, 0xf4, 0xd9, 0xb6, 0x1a, 0xd9, 0x40, 0x97, 0xd2, 0x95, 0x99, 0xf1,
+	0x69, 0x2e, 0xc1, 0x25, 0x85, 0x58, 0x86, 0xfa, 0xa2, 0x14, 0x8c, 0x08, 0xf7, 0x82, 0xfe, 0xd0,
+	0x19, 0x73, 0x81, 0x07, 0xf1, 0x7b, 0x7e, 0xec, 0x39, 0x40, 0x87, 0x69, 0x0f, 0x0d, 0x70, 0xd4,
+	0x91, 0x82, 0x67, 0xdd, 0x80, 0x53, 0x88, 0x09, 0xf2, 0x10, 0xd9, 0xd1, 0x31, 0x59, 0x72, 0xed,
+	0xeb, 0xba, 0x63, 0x81, 0x6d, 0xea, 0xe0, 0xe0, 0x33, 0x38, 0x6b, 0xc9, 0x75, 0xd4, 0xe9, 0xb8,
+	0x60, 0x23, 0x5b, 0x50, 0xb6, 0x83, 0x98, 0xcb, 0xab, 0x27, 0x02, 0x4c, 0x25, 0x21, 0xbf, 0xcb,
+	0x5c, 0xae, 0x5b, 0x50, 0x19, 0x10, 0x97, 0x85, 0xd3, 0x87, 0x8d, 0xfa, 0x7d, 0x5e, 0x2d, 0xd7,
+	0xa7, 0x56, 0x4f, 0x6f, 0x2c, 0xa7, 0xca, 0x3f, 0x95, 0x63, 0x1b, 0xf5, 0xfb, 0xc9, 0xfa, 0x9f,
+	0x93, 0x16, 0x7c, 0x0d, 0x6f, 0xdd, 0xc9, 0x4f, 0x29, 0x97, 0x53, 0x7c, 0xab, 0x88, 0x33, 0xdf,
+	0x03, 0x3d, 0xef, 0xc6, 0x3f, 0x49, 0x41, 0x43, 0x1e, 0xad, 0x92, 0xa0, 0x3e, 0x4d, 0x0e, 0x12,
+	0x28, 0xa0, 0x69, 0xd6, 0x0a, 0x9e, 0xcd, 0xf7, 0xa1, 0x36, 0xc1, 0xa8, 0xec, 0x24, 0x55, 0x38,
+	0x99, 0xfa, 0x6e, 0x58, 0xf1, 0xd2, 0xfc, 0x25, 0xec, 0x29, 0x1d, 0x2c, 0xfe, 0xc5, 0x83, 0x4c,
+	0xb8, 0x9f, 0x4a, 0xb9, 0x6f, 0xdd, 0xca, 0x73, 0x95, 0xee, 0x1a, 0xb9, 0xc0, 0xa2, 0xae, 0x91,
+	0x93, 0xcb, 0xea, 0xfc, 0xb9, 0x04, 0xe7, 0xb6, 0xb8, 0xfb, 0x05, 0x23, 0xc3, 0x76, 0x78, 0x15,
+	0xd8, 0x64, 0x18, 0xed, 0x62, 0xa6, 0xdf, 0x81, 0x53, 0xee, 0x08, 0x31, 0x87, 0x20, 0xaf, 0x30,
+	0x23, 0x89, 0xd4, 0x37, 0x60, 0x7a, 0x97, 0x78, 0xe1, 0xeb, 0x34, 0x97, 0xae, 0x8b, 0xb4, 0xfd,
+	0xcf, 0x88, 0xe7, 0x58, 0x01, 0x56, 0x3f, 0x0f, 0x65, 0x81, 0x98, 0xfc, 0x9e, 0x58, 0xd1, 0xca,
+	0xaf, 0x5a, 0x8e, 0xfb, 0xd8, 0x2f, 0xbf, 0x70, 0x78, 0xb3, 0xe4, 0x5a, 0xbf, 0x06, 0x15, 0x67,
+	0x14, 0x55, 0x62, 0xb7, 0x4f, 0xed, 0xdd, 0xb0, 0x68, 0xa7, 0xad, 0xb9, 0x58, 0xbc, 0x19, 0x48,
+	0x7d, 0xe3, 0x0c, 0x23, 0x4e, 0xbd, 0x6a, 0x39, 0x34, 0x1e, 0xae, 0x42, 0x2e, 0x65, 0xdc, 0x3e,
+	0x95, 0xb5, 0x14, 0x95, 0x79, 0x46, 0xcc, 0x7b, 0xb0, 0xa4, 0x54, 0xc8, 0xc2, 0xb9, 0x02, 0xff,
+	0xc7, 0xfb, 0x43, 0xc2, 0xc6, 0xf1, 0x68, 0xae, 0x05, 0xa3, 0xf9, 0x6c, 0x28, 0x0c, 0xc7, 0x72,
+	0xf3, 0xaf, 0xf0, 0x5a, 0x60, 0x61, 0x8e, 0x45, 0x86, 0xf2, 0xe3, 0x56, 0xd1, 0x7f, 0x44, 0x7a,
+	0xf1, 0x8d, 0x42, 0x91, 0x54, 0x74, 0xa3, 0x50, 0x68, 0x0e, 0x3a, 0xa4, 0x16, 0x74, 0xc8, 0x0e,
+	0x16, 0xdb, 0xf2, 0x6a, 0xd9, 0x21, 0xae, 0x87, 0xd9, 0xf1, 0x6f, 0x4a, 0xf7, 0x01, 0x78, 0x60,
+	0x62, 0x87, 0x63, 0x11, 0x4d, 0x3a, 0xb5, 0xd4, 0x6d, 0x31, 0xe3, 0xaa, 0x83, 0x45, 0xb2, 0x4d,
+	0xcd, 0xf0, 0x58, 0x5a, 0xdc, 0xa1, 0x54, 0x81, 0x9b, 0x97, 0xa1, 0x36, 0x41, 0x15, 0xe7, 0xbd,
+	0xf1, 0xe4, 0x34, 0x4c, 0x6d, 0x71, 0x57, 0xdf, 0x86, 0xd9, 0xd4, 0xed, 0xf8, 0x52, 0x32, 0xce,
+	0xcc, 0xed, 0xd2, 0xb8, 0x72, 0x88, 0x52, 0x16, 0x22, 0x86, 0xb3, 0xaa, 0x6b, 0xa7, 0x99, 0xd9,
+	0xab, 0xc0, 0x18, 0x6b, 0xc5, 0x18, 0xe9, 0xe6, 0x5b, 0xb8, 0x30, 0xe9, 0x1a, 0x78, 0x35, 0x63,
+	0x66, 0x02, 0xce, 0x68, 0x1c, 0x0d, 0x27, 0x5d, 0xee, 0xc2, 0x39, 0xf5, 0x4d, 0x69, 0x45, 0xc9,
+	0x4b, 0xd6, 0xdd, 0x8d, 0xa3, 0xa0, 0x92, 0xce, 0xd4, 0x57, 0x8c, 0x15, 0x25, 0x49, 0x45, 0xce,
+	0x0e, 0x1d, 0xf2, 0xf5, 0x1d, 0x38, 0x93, 0x1f, 0xf0, 0xeb, 0x59, 0x13, 0x59, 0x84, 0xb1, 0x5a,
+	0x84, 0x48, 0x3a, 0xc8, 0x0f, 0xc3, 0x75, 0x25, 0x21, 0x87, 0x39, 0x98, 0x38, 0x81, 0xea, 0x0f,
+	0xa0, 0x92, 0x9d, 0x3e, 0x97, 0x33, 0x9b, 0x33, 0x7a, 0xe3, 0xea, 0xe1, 0x7a, 0x69, 0xfa, 0x1b,
+	0x98, 0xcf, 0xcd, 0x7a, 0xb5, 0x6c, 0xe6, 0x19, 0x80, 0x71, 0xad, 0x00, 0x20, 0xad, 0xf7, 0x60,
+	0x41, 0x39, 0x9e, 0x65, 0xdf, 0x35, 0x15, 0xc8, 0x58, 0x3f, 0x02, 0x28, 0x79, 0x06, 0xf9, 0xe1,
+	0x21, 0x7b, 0x06, 0x39, 0x84, 0xb1, 0x5a, 0x84, 0x90, 0x0e, 0xba, 0xa0, 0x2b, 0xbe, 0xe5, 0x97,
+	0x33, 0xfb, 0xf3, 0x10, 0xe3, 0x7a, 0x21, 0x24, 0xd9, 0x5d, 0x54, 0x5f, 0x2f, 0x33, 0xf7, 0x2a,
+	0xe7, 0x30, 0xc6, 0x5a, 0x31, 0x26, 0x79, 0x2a, 0xca, 0x4f, 0xc2, 0x95, 0x3c, 0x19, 0x39, 0x90,
+	0xb1, 0x7e, 0x04, 0x50, 0xec, 0xc9, 0x38, 0xf1, 0x9d, 0xdf, 0xf3, 0x37, 0x1b, 0x5f, 0xdf, 0x70,
+	0x89, 0xe8, 0x8d, 0xba, 0x0d, 0x9b, 0x0e, 0x9a, 0x9f, 0x12, 0xd4, 0x43, 0xf4, 0x6e, 0xbf, 0x3b,
+	0xe2, 0xcd, 0x07, 0x9f, 0x7f, 0xd5, 0xb4, 0x7b, 0x88, 0x78, 0x51, 0xd3, 0x17, 0xe3, 0x21, 0xe6,
+	0xdd, 0x72, 0xf0, 0x0f, 0xce, 0xdb, 0x7f, 0x0f, 0x00, 0x34, 0x17, 0xc1, 0x08, 0xce, 0x15, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a governance operation for updating the x/ynx module parameters.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// CancelPendingParams cancels a params change scheduled through UpdateParams.
+	CancelPendingParams(ctx context.Context, in *MsgCancelPendingParams, opts ...grpc.CallOption) (*MsgCancelPendingParamsResponse, error)
+	// RegisterContractRevenue registers a contract for developer fee rebates.
+	RegisterContractRevenue(ctx context.Context, in *MsgRegisterContractRevenue, opts ...grpc.CallOption) (*MsgRegisterContractRevenueResponse, error)
+	// UpdateContractRevenue changes the withdraw address of a registered contract.
+	UpdateContractRevenue(ctx context.Context, in *MsgUpdateContractRevenue, opts ...grpc.CallOption) (*MsgUpdateContractRevenueResponse, error)
+	// CancelContractRevenue removes a contract registration.
+	CancelContractRevenue(ctx context.Context, in *MsgCancelContractRevenue, opts ...grpc.CallOption) (*MsgCancelContractRevenueResponse, error)
+	// CreateSponsorship creates a gas sponsorship funded with budget from the sponsor.
+	CreateSponsorship(ctx context.Context, in *MsgCreateSponsorship, opts ...grpc.CallOption) (*MsgCreateSponsorshipResponse, error)
+	// UpdateSponsorship replaces the policy of a gas sponsorship.
+	UpdateSponsorship(ctx context.Context, in *MsgUpdateSponsorship, opts ...grpc.CallOption) (*MsgUpdateSponsorshipResponse, error)
+	// FundSponsorship tops up the budget of a gas sponsorship.
+	FundSponsorship(ctx context.Context, in *MsgFundSponsorship, opts ...grpc.CallOption) (*MsgFundSponsorshipResponse, error)
+	// CloseSponsorship removes a gas sponsorship and returns its remaining budget to the sponsor.
+	CloseSponsorship(ctx context.Context, in *MsgCloseSponsorship, opts ...grpc.CallOption) (*MsgCloseSponsorshipResponse, error)
+	// DeploySystemContract deploys a new version of a system contract and points its system_contracts
+	// entry at it.
+	DeploySystemContract(ctx context.Context, in *MsgDeploySystemContract, opts ...grpc.CallOption) (*MsgDeploySystemContractResponse, error)
+	// SetSystemContract points a system_contracts entry at an already deployed contract.
+	SetSystemContract(ctx context.Context, in *MsgSetSystemContract, opts ...grpc.CallOption) (*MsgSetSystemContractResponse, error)
+	// TripCircuitBreaker blocks a message type, an EVM call target or a static precompile for a
+	// bounded number of blocks. Only the circuit guardian may trip breakers.
+	TripCircuitBreaker(ctx context.Context, in *MsgTripCircuitBreaker, opts ...grpc.CallOption) (*MsgTripCircuitBreakerResponse, error)
+	// ResetCircuitBreaker lifts a tripped circuit breaker before it expires.
+	ResetCircuitBreaker(ctx context.Context, in *MsgResetCircuitBreaker, opts ...grpc.CallOption) (*MsgResetCircuitBreakerResponse, error)
+	// SetPreconfirmSigners schedules the preconfirm signer set that becomes active at the start of an
+	// epoch.
+	SetPreconfirmSigners(ctx context.Context, in *MsgSetPreconfirmSigners, opts ...grpc.CallOption) (*MsgSetPreconfirmSignersResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/ynx.ynx.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelPendingParams(ctx context.Context, in *MsgCancelPendingParams, opts ...grpc.CallOption) (*MsgCancelPendingParamsResponse, error) {
+	out := new(MsgCancelPendingParamsResponse)
+	err := c.cc.Invoke(ctx, "/ynx.ynx.v1.Msg/CancelPendingParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterContractRevenue(ctx context.Context, in *MsgRegisterContractRevenue, opts ...grpc.CallOption) (*MsgRegisterContractRevenueResponse, error) {
+	out := new(MsgRegisterContractRevenueResponse)
+	err := c.cc.Invoke(ctx, "/ynx.ynx.v1.Msg/RegisterContractRevenue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateContractRevenue(ctx context.Context, in *MsgUpdateContractRevenue, opts ...grpc.CallOption) (*MsgUpdateContractRevenueResponse, error) {
+	out := new(MsgUpdateContractRevenueResponse)
+	err := c.cc.Invoke(ctx, "/ynx.ynx.v1.Msg/UpdateContractRevenue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelContractRevenue(ctx context.Context, in *MsgCancelContractRevenue, opts ...grpc.CallOption) (*MsgCancelContractRevenueResponse, error) {
+	out := new(MsgCancelContractRevenueResponse)
+	err := c.cc.Invoke(ctx, "/ynx.ynx.v1.Msg/CancelContractRevenue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateSponsorship(ctx context.Context, in *MsgCreateSponsorship, opts ...grpc.CallOption) (*MsgCreateSponsorshipResponse, error) {
+	out := new(MsgCreateSponsorshipResponse)
+	err := c.cc.Invoke(ctx, "/ynx.ynx.v1.Msg/CreateSponsorship", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateSponsorship(ctx context.Context, in *MsgUpdateSponsorship, opts ...grpc.CallOption) (*MsgUpdateSponsorshipResponse, error) {
+	out := new(MsgUpdateSponsorshipResponse)
+	err := c.cc.Invoke(ctx, "/ynx.ynx.v1.Msg/UpdateSponsorship", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func init() { proto.RegisterFile("ynx/ynx/v1/tx.proto", fileDescriptor_fb8cc29357c6f1e0) }
-
-var fileDescriptor_fb8cc29357c6f1e0 = []byte{
-	// 322 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xae, 0xcc, 0xab, 0xd0,
-	0x07, 0xe1, 0x32, 0x43, 0xfd, 0x92, 0x0a, 0xbd, 0x82, 0xa2, 0xfc, 0x92, 0x7c, 0x21, 0xae, 0xca,
-	0xbc, 0x0a, 0x3d, 0x10, 0x2e, 0x33, 0x94, 0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7, 0x07, 0x93,
-	0x10, 0x69, 0x29, 0xf1, 0xe4, 0xfc, 0xe2, 0xdc, 0xfc, 0x62, 0xfd, 0xdc, 0xe2, 0x74, 0x90, 0xb6,
-	0xdc, 0xe2, 0x74, 0xa8, 0x84, 0x24, 0x44, 0x22, 0x1e, 0xcc, 0xd3, 0x87, 0x70, 0xa0, 0x52, 0x22,
-	0xe9, 0xf9, 0xe9, 0xf9, 0x10, 0x71, 0x10, 0x0b, 0x66, 0x12, 0x92, 0xed, 0x05, 0x89, 0x45, 0x89,
-	0xb9, 0x50, 0xe5, 0x4a, 0xeb, 0x18, 0xb9, 0xf8, 0x7d, 0x8b, 0xd3, 0x43, 0x0b, 0x52, 0x12, 0x4b,
-	0x52, 0x03, 0xc0, 0x32, 0x42, 0x66, 0x5c, 0x9c, 0x89, 0xa5, 0x25, 0x19, 0xf9, 0x45, 0x99, 0x25,
-	0x95, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x4e, 0x12, 0x97, 0xb6, 0xe8, 0x8a, 0x40, 0xed, 0x71,
-	0x4c, 0x49, 0x29, 0x4a, 0x2d, 0x2e, 0x0e, 0x2e, 0x29, 0xca, 0xcc, 0x4b, 0x0f, 0x42, 0x28, 0x15,
-	0x32, 0xe5, 0x62, 0x83, 0x98, 0x2d, 0xc1, 0xa4, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0xa4, 0x87, 0xf0,
-	0x9e, 0x1e, 0xc4, 0x6c, 0x27, 0xce, 0x13, 0xf7, 0xe4, 0x19, 0x56, 0x3c, 0xdf, 0xa0, 0xc5, 0x18,
-	0x04, 0x55, 0x6c, 0xa5, 0xd3, 0xf4, 0x7c, 0x83, 0x16, 0xc2, 0x98, 0xae, 0xe7, 0x1b, 0xb4, 0x24,
-	0x41, 0x4e, 0x85, 0x38, 0x18, 0xcd, 0x71, 0x4a, 0x92, 0x5c, 0xe2, 0x68, 0x42, 0x41, 0xa9, 0xc5,
-	0x05, 0xf9, 0x79, 0xc5, 0xa9, 0x46, 0x71, 0x5c, 0xcc, 0xbe, 0xc5, 0xe9, 0x42, 0x01, 0x5c, 0x3c,
-	0x28, 0xde, 0x91, 0x46, 0x76, 0x06, 0x9a, 0x5e, 0x29, 0x65, 0x3c, 0x92, 0x30, 0x83, 0xa5, 0x58,
-	0x1b, 0x40, 0x0e, 0x76, 0xd2, 0x8b, 0xd2, 0x49, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce,
-	0xcf, 0xd5, 0xf7, 0xca, 0x4c, 0xcc, 0x48, 0xcc, 0x77, 0xcc, 0x49, 0x2a, 0x2d, 0xd6, 0x8f, 0xf4,
-	0x8b, 0xd0, 0x4f, 0xce, 0x48, 0xcc, 0xcc, 0x83, 0x3a, 0xba, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89,
-	0x0d, 0x1c, 0xc4, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf1, 0x6b, 0xd0, 0x6b, 0xfb, 0x01,
-	0x00, 0x00,
+func (c *msgClient) FundSponsorship(ctx context.Context, in *MsgFundSponsorship, opts ...grpc.CallOption) (*MsgFundSponsorshipResponse, error) {
+	out := new(MsgFundSponsorshipResponse)
+	err := c.cc.Invoke(ctx, "/ynx.ynx.v1.Msg/FundSponsorship", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (c *msgClient) CloseSponsorship(ctx context.Context, in *MsgCloseSponsorship, opts ...grpc.CallOption) (*MsgCloseSponsorshipResponse, error) {
+	out := new(MsgCloseSponsorshipResponse)
+	err := c.cc.Invoke(ctx, "/ynx.ynx.v1.Msg/CloseSponsorship", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (c *msgClient) DeploySystemContract(ctx context.Context, in *MsgDeploySystemContract, opts ...grpc.CallOption) (*MsgDeploySystemContractResponse, error) {
+	out := new(MsgDeploySystemContractResponse)
+	err := c.cc.Invoke(ctx, "/ynx.ynx.v1.Msg/DeploySystemContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a governance operation for updating the x/ynx module parameters.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+func (c *msgClient) SetSystemContract(ctx context.Context, in *MsgSetSystemContract, opts ...grpc.CallOption) (*MsgSetSystemContractResponse, error) {
+	out := new(MsgSetSystemContractResponse)
+	err := c.cc.Invoke(ctx, "/ynx.ynx.v1.Msg/SetSystemContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (c *msgClient) TripCircuitBreaker(ctx context.Context, in *MsgTripCircuitBreaker, opts ...grpc.CallOption) (*MsgTripCircuitBreakerResponse, error) {
+	out := new(MsgTripCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, "/ynx.ynx.v1.Msg/TripCircuitBreaker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (c *msgClient) ResetCircuitBreaker(ctx context.Context, in *MsgResetCircuitBreaker, opts ...grpc.CallOption) (*MsgResetCircuitBreakerResponse, error) {
+	out := new(MsgResetCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, "/ynx.ynx.v1.Msg/ResetCircuitBreaker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/ynx.ynx.v1.Msg/UpdateParams", in, out, opts...)
+func (c *msgClient) SetPreconfirmSigners(ctx context.Context, in *MsgSetPreconfirmSigners, opts ...grpc.CallOption) (*MsgSetPreconfirmSignersResponse, error) {
+	out := new(MsgSetPreconfirmSignersResponse)
+	err := c.cc.Invoke(ctx, "/ynx.ynx.v1.Msg/SetPreconfirmSigners", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -178,6 +1646,35 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 type MsgServer interface {
 	// UpdateParams defines a governance operation for updating the x/ynx module parameters.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// CancelPendingParams cancels a params change scheduled through UpdateParams.
+	CancelPendingParams(context.Context, *MsgCancelPendingParams) (*MsgCancelPendingParamsResponse, error)
+	// RegisterContractRevenue registers a contract for developer fee rebates.
+	RegisterContractRevenue(context.Context, *MsgRegisterContractRevenue) (*MsgRegisterContractRevenueResponse, error)
+	// UpdateContractRevenue changes the withdraw address of a registered contract.
+	UpdateContractRevenue(context.Context, *MsgUpdateContractRevenue) (*MsgUpdateContractRevenueResponse, error)
+	// CancelContractRevenue removes a contract registration.
+	CancelContractRevenue(context.Context, *MsgCancelContractRevenue) (*MsgCancelContractRevenueResponse, error)
+	// CreateSponsorship creates a gas sponsorship funded with budget from the sponsor.
+	CreateSponsorship(context.Context, *MsgCreateSponsorship) (*MsgCreateSponsorshipResponse, error)
+	// UpdateSponsorship replaces the policy of a gas sponsorship.
+	UpdateSponsorship(context.Context, *MsgUpdateSponsorship) (*MsgUpdateSponsorshipResponse, error)
+	// FundSponsorship tops up the budget of a gas sponsorship.
+	FundSponsorship(context.Context, *MsgFundSponsorship) (*MsgFundSponsorshipResponse, error)
+	// CloseSponsorship removes a gas sponsorship and returns its remaining budget to the sponsor.
+	CloseSponsorship(context.Context, *MsgCloseSponsorship) (*MsgCloseSponsorshipResponse, error)
+	// DeploySystemContract deploys a new version of a system contract and points its system_contracts
+	// entry at it.
+	DeploySystemContract(context.Context, *MsgDeploySystemContract) (*MsgDeploySystemContractResponse, error)
+	// SetSystemContract points a system_contracts entry at an already deployed contract.
+	SetSystemContract(context.Context, *MsgSetSystemContract) (*MsgSetSystemContractResponse, error)
+	// TripCircuitBreaker blocks a message type, an EVM call target or a static precompile for a
+	// bounded number of blocks. Only the circuit guardian may trip breakers.
+	TripCircuitBreaker(context.Context, *MsgTripCircuitBreaker) (*MsgTripCircuitBreakerResponse, error)
+	// ResetCircuitBreaker lifts a tripped circuit breaker before it expires.
+	ResetCircuitBreaker(context.Context, *MsgResetCircuitBreaker) (*MsgResetCircuitBreakerResponse, error)
+	// SetPreconfirmSigners schedules the preconfirm signer set that becomes active at the start of an
+	// epoch.
+	SetPreconfirmSigners(context.Context, *MsgSetPreconfirmSigners) (*MsgSetPreconfirmSignersResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -187,6 +1684,45 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) CancelPendingParams(ctx context.Context, req *MsgCancelPendingParams) (*MsgCancelPendingParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPendingParams not implemented")
+}
+func (*UnimplementedMsgServer) RegisterContractRevenue(ctx context.Context, req *MsgRegisterContractRevenue) (*MsgRegisterContractRevenueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterContractRevenue not implemented")
+}
+func (*UnimplementedMsgServer) UpdateContractRevenue(ctx context.Context, req *MsgUpdateContractRevenue) (*MsgUpdateContractRevenueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateContractRevenue not implemented")
+}
+func (*UnimplementedMsgServer) CancelContractRevenue(ctx context.Context, req *MsgCancelContractRevenue) (*MsgCancelContractRevenueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelContractRevenue not implemented")
+}
+func (*UnimplementedMsgServer) CreateSponsorship(ctx context.Context, req *MsgCreateSponsorship) (*MsgCreateSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSponsorship not implemented")
+}
+func (*UnimplementedMsgServer) UpdateSponsorship(ctx context.Context, req *MsgUpdateSponsorship) (*MsgUpdateSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSponsorship not implemented")
+}
+func (*UnimplementedMsgServer) FundSponsorship(ctx context.Context, req *MsgFundSponsorship) (*MsgFundSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundSponsorship not implemented")
+}
+func (*UnimplementedMsgServer) CloseSponsorship(ctx context.Context, req *MsgCloseSponsorship) (*MsgCloseSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseSponsorship not implemented")
+}
+func (*UnimplementedMsgServer) DeploySystemContract(ctx context.Context, req *MsgDeploySystemContract) (*MsgDeploySystemContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeploySystemContract not implemented")
+}
+func (*UnimplementedMsgServer) SetSystemContract(ctx context.Context, req *MsgSetSystemContract) (*MsgSetSystemContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSystemContract not implemented")
+}
+func (*UnimplementedMsgServer) TripCircuitBreaker(ctx context.Context, req *MsgTripCircuitBreaker) (*MsgTripCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TripCircuitBreaker not implemented")
+}
+func (*UnimplementedMsgServer) ResetCircuitBreaker(ctx context.Context, req *MsgResetCircuitBreaker) (*MsgResetCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetCircuitBreaker not implemented")
+}
+func (*UnimplementedMsgServer) SetPreconfirmSigners(ctx context.Context, req *MsgSetPreconfirmSigners) (*MsgSetPreconfirmSignersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPreconfirmSigners not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -210,6 +1746,240 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelPendingParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelPendingParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelPendingParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ynx.ynx.v1.Msg/CancelPendingParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelPendingParams(ctx, req.(*MsgCancelPendingParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterContractRevenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterContractRevenue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterContractRevenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ynx.ynx.v1.Msg/RegisterContractRevenue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterContractRevenue(ctx, req.(*MsgRegisterContractRevenue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateContractRevenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateContractRevenue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateContractRevenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ynx.ynx.v1.Msg/UpdateContractRevenue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateContractRevenue(ctx, req.(*MsgUpdateContractRevenue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelContractRevenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelContractRevenue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelContractRevenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ynx.ynx.v1.Msg/CancelContractRevenue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelContractRevenue(ctx, req.(*MsgCancelContractRevenue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateSponsorship)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ynx.ynx.v1.Msg/CreateSponsorship",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateSponsorship(ctx, req.(*MsgCreateSponsorship))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateSponsorship)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ynx.ynx.v1.Msg/UpdateSponsorship",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateSponsorship(ctx, req.(*MsgUpdateSponsorship))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FundSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundSponsorship)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ynx.ynx.v1.Msg/FundSponsorship",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundSponsorship(ctx, req.(*MsgFundSponsorship))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CloseSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCloseSponsorship)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CloseSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ynx.ynx.v1.Msg/CloseSponsorship",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CloseSponsorship(ctx, req.(*MsgCloseSponsorship))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeploySystemContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeploySystemContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeploySystemContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ynx.ynx.v1.Msg/DeploySystemContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeploySystemContract(ctx, req.(*MsgDeploySystemContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetSystemContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSystemContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSystemContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ynx.ynx.v1.Msg/SetSystemContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSystemContract(ctx, req.(*MsgSetSystemContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TripCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTripCircuitBreaker)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TripCircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ynx.ynx.v1.Msg/TripCircuitBreaker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TripCircuitBreaker(ctx, req.(*MsgTripCircuitBreaker))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResetCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResetCircuitBreaker)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResetCircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ynx.ynx.v1.Msg/ResetCircuitBreaker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResetCircuitBreaker(ctx, req.(*MsgResetCircuitBreaker))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPreconfirmSigners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPreconfirmSigners)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPreconfirmSigners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ynx.ynx.v1.Msg/SetPreconfirmSigners",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPreconfirmSigners(ctx, req.(*MsgSetPreconfirmSigners))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ynx.ynx.v1.Msg",
@@ -219,6 +1989,58 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "CancelPendingParams",
+			Handler:    _Msg_CancelPendingParams_Handler,
+		},
+		{
+			MethodName: "RegisterContractRevenue",
+			Handler:    _Msg_RegisterContractRevenue_Handler,
+		},
+		{
+			MethodName: "UpdateContractRevenue",
+			Handler:    _Msg_UpdateContractRevenue_Handler,
+		},
+		{
+			MethodName: "CancelContractRevenue",
+			Handler:    _Msg_CancelContractRevenue_Handler,
+		},
+		{
+			MethodName: "CreateSponsorship",
+			Handler:    _Msg_CreateSponsorship_Handler,
+		},
+		{
+			MethodName: "UpdateSponsorship",
+			Handler:    _Msg_UpdateSponsorship_Handler,
+		},
+		{
+			MethodName: "FundSponsorship",
+			Handler:    _Msg_FundSponsorship_Handler,
+		},
+		{
+			MethodName: "CloseSponsorship",
+			Handler:    _Msg_CloseSponsorship_Handler,
+		},
+		{
+			MethodName: "DeploySystemContract",
+			Handler:    _Msg_DeploySystemContract_Handler,
+		},
+		{
+			MethodName: "SetSystemContract",
+			Handler:    _Msg_SetSystemContract_Handler,
+		},
+		{
+			MethodName: "TripCircuitBreaker",
+			Handler:    _Msg_TripCircuitBreaker_Handler,
+		},
+		{
+			MethodName: "ResetCircuitBreaker",
+			Handler:    _Msg_ResetCircuitBreaker_Handler,
+		},
+		{
+			MethodName: "SetPreconfirmSigners",
+			Handler:    _Msg_SetPreconfirmSigners_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ynx/ynx/v1/tx.proto",
@@ -0,0 +1,203 @@
+package types
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// NativeNYXTContract is the ERC20 interface of the native denom: the x/erc20 native token pair
+	// of the EVM denom, served by the WERC20 precompile. It is the address cosmos/evm chains use for
+	// the wrapped native token.
+	NativeNYXTContract = "0xD4949664cD82660AaE99bEdc034a0deA8A0bd517"
+
+	// NYXTVotesPrecompileAddress is the precompile that keeps ERC20Votes checkpoints of the native
+	// NYXT locked for voting.
+	NYXTVotesPrecompileAddress = "0x0000000000000000000000000000000000000812"
+
+	// StakeVotesPrecompileAddress is the precompile that keeps ERC20Votes checkpoints of the stake
+	// bonded through x/staking.
+	StakeVotesPrecompileAddress = "0x0000000000000000000000000000000000000813"
+
+	// LegacyNYXTContractName is the system_contracts entry of a retired standalone NYXT ERC20. The
+	// name is reserved: only RetireLegacyNYXT writes it, and redemptions use the LegacyNYXT record.
+	LegacyNYXTContractName = "nyxt_legacy"
+)
+
+// Validate checks the contract address and that no more than the supply has been redeemed.
+func (l LegacyNYXT) Validate() error {
+	if _, err := ParseContractAddress(l.Contract); err != nil {
+		return fmt.Errorf("invalid legacy NYXT contract: %w", err)
+	}
+	if l.Supply.IsNil() || l.Supply.IsNegative() {
+		return fmt.Errorf("legacy NYXT supply must not be negative")
+	}
+	if l.Redeemed.IsNil() || l.Redeemed.IsNegative() || l.Redeemed.GT(l.Supply) {
+		return fmt.Errorf("legacy NYXT redeemed must be between zero and the supply %s", l.Supply)
+	}
+	return nil
+}
+
+// Validate checks the lock amount and the addresses.
+func (l VoteLock) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(l.Account); err != nil {
+		return fmt.Errorf("invalid vote lock account %q: %w", l.Account, err)
+	}
+	if l.Locked.IsNil() || l.Locked.IsNegative() {
+		return fmt.Errorf("vote lock of %s: locked amount must not be negative", l.Account)
+	}
+	if l.Delegatee != "" {
+		if _, err := sdk.AccAddressFromBech32(l.Delegatee); err != nil {
+			return fmt.Errorf("vote lock of %s: invalid delegatee %q: %w", l.Account, l.Delegatee, err)
+		}
+	}
+	return nil
+}
+
+// Validate checks the checkpoint. The account of a total supply checkpoint is empty.
+func (c VoteCheckpoint) Validate() error {
+	if c.Account != "" {
+		if _, err := sdk.AccAddressFromBech32(c.Account); err != nil {
+			return fmt.Errorf("invalid vote checkpoint account %q: %w", c.Account, err)
+		}
+	}
+	if c.Height < 0 {
+		return fmt.Errorf("vote checkpoint of %q: negative height %d", c.Account, c.Height)
+	}
+	if c.Votes.IsNil() || c.Votes.IsNegative() {
+		return fmt.Errorf("vote checkpoint of %q at height %d: votes must not be negative", c.Account, c.Height)
+	}
+	return nil
+}
+
+// validateVotes checks the vote locks and checkpoints of a genesis state: the latest checkpoint of
+// every account holds the locks delegated to it, and the latest total supply checkpoint holds all
+// locks.
+func validateVotes(locks []VoteLock, checkpoints, supplyCheckpoints []VoteCheckpoint) error {
+	seenLocks := make(map[string]struct{}, len(locks))
+	delegated := make(map[string]sdkmath.Int)
+	total := sdkmath.ZeroInt()
+	for _, l := range locks {
+		if err := l.Validate(); err != nil {
+			return err
+		}
+		if _, ok := seenLocks[l.Account]; ok {
+			return fmt.Errorf("duplicate vote lock: %s", l.Account)
+		}
+		seenLocks[l.Account] = struct{}{}
+		total = total.Add(l.Locked)
+		if l.Delegatee != "" {
+			addVotes(delegated, l.Delegatee, l.Locked)
+		}
+	}
+	return validateVoteCheckpoints("", delegated, total, checkpoints, supplyCheckpoints)
+}
+
+// Validate checks the addresses and the credits of the stake voter.
+func (v StakeVoter) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(v.Delegator); err != nil {
+		return fmt.Errorf("invalid stake voter %q: %w", v.Delegator, err)
+	}
+	if v.Delegatee != "" {
+		if _, err := sdk.AccAddressFromBech32(v.Delegatee); err != nil {
+			return fmt.Errorf("stake voter %s: invalid delegatee %q: %w", v.Delegator, v.Delegatee, err)
+		}
+	}
+	for i, c := range v.Credits {
+		if _, err := sdk.AccAddressFromBech32(c.Delegatee); err != nil {
+			return fmt.Errorf("stake voter %s: invalid credit delegatee %q: %w", v.Delegator, c.Delegatee, err)
+		}
+		if i > 0 && v.Credits[i-1].Delegatee >= c.Delegatee {
+			return fmt.Errorf("stake voter %s: credits must be ordered by delegatee without duplicates", v.Delegator)
+		}
+		if c.Votes.IsNil() || !c.Votes.IsPositive() {
+			return fmt.Errorf("stake voter %s: credit of %s must be positive", v.Delegator, c.Delegatee)
+		}
+	}
+	return nil
+}
+
+// validateStakeVotes checks the stake voters and the stake vote checkpoints of a genesis state: the
+// latest checkpoint of every delegatee holds the credits counted for it, and the latest total
+// supply checkpoint holds all credits.
+func validateStakeVotes(voters []StakeVoter, checkpoints, supplyCheckpoints []VoteCheckpoint) error {
+	seenVoters := make(map[string]struct{}, len(voters))
+	credited := make(map[string]sdkmath.Int)
+	total := sdkmath.ZeroInt()
+	for _, v := range voters {
+		if err := v.Validate(); err != nil {
+			return err
+		}
+		if _, ok := seenVoters[v.Delegator]; ok {
+			return fmt.Errorf("duplicate stake voter: %s", v.Delegator)
+		}
+		seenVoters[v.Delegator] = struct{}{}
+		for _, c := range v.Credits {
+			addVotes(credited, c.Delegatee, c.Votes)
+			total = total.Add(c.Votes)
+		}
+	}
+	return validateVoteCheckpoints("stake ", credited, total, checkpoints, supplyCheckpoints)
+}
+
+func addVotes(votes map[string]sdkmath.Int, account string, amount sdkmath.Int) {
+	v, ok := votes[account]
+	if !ok {
+		v = sdkmath.ZeroInt()
+	}
+	votes[account] = v.Add(amount)
+}
+
+// validateVoteCheckpoints checks that the checkpoints are ordered by height per account, that the
+// latest checkpoint of every account holds its votes, and that the latest total supply checkpoint
+// holds total. kind prefixes the errors.
+func validateVoteCheckpoints(kind string, votes map[string]sdkmath.Int, total sdkmath.Int, checkpoints, supplyCheckpoints []VoteCheckpoint) error {
+	latest := make(map[string]VoteCheckpoint, len(checkpoints))
+	for _, c := range checkpoints {
+		if err := c.Validate(); err != nil {
+			return fmt.Errorf("%s%w", kind, err)
+		}
+		if c.Account == "" {
+			return fmt.Errorf("%svote checkpoint at height %d has no account", kind, c.Height)
+		}
+		if prev, ok := latest[c.Account]; ok && prev.Height >= c.Height {
+			return fmt.Errorf("%svote checkpoints of %s must be ordered by height without duplicates", kind, c.Account)
+		}
+		latest[c.Account] = c
+	}
+	for account, c := range latest {
+		v, ok := votes[account]
+		if !ok {
+			v = sdkmath.ZeroInt()
+		}
+		if !c.Votes.Equal(v) {
+			return fmt.Errorf("latest %svote checkpoint of %s has %s votes, but %s are delegated to it", kind, account, c.Votes, v)
+		}
+	}
+	for account, v := range votes {
+		if _, ok := latest[account]; !ok && v.IsPositive() {
+			return fmt.Errorf("%s has %s delegated %svotes but no vote checkpoint", account, v, kind)
+		}
+	}
+
+	supply := sdkmath.ZeroInt()
+	for i, c := range supplyCheckpoints {
+		if err := c.Validate(); err != nil {
+			return fmt.Errorf("%s%w", kind, err)
+		}
+		if c.Account != "" {
+			return fmt.Errorf("total supply %svote checkpoint at height %d has account %s", kind, c.Height, c.Account)
+		}
+		if i > 0 && supplyCheckpoints[i-1].Height >= c.Height {
+			return fmt.Errorf("total supply %svote checkpoints must be ordered by height without duplicates", kind)
+		}
+		supply = c.Votes
+	}
+	if !supply.Equal(total) {
+		return fmt.Errorf("latest total supply %svote checkpoint is %s, but %s is counted", kind, supply, total)
+	}
+	return nil
+}
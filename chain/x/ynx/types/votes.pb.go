@@ -0,0 +1,312 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ynx/ynx/v1/votes.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// VoteLock is the native NYXT an account has locked for voting and the account its votes are
+// delegated to.
+type VoteLock struct {
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// locked is the amount of the EVM denom held for the account by the vote escrow.
+	Locked cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=locked,proto3,customtype=cosmossdk.io/math.Int" json:"locked"`
+	// delegatee receives the votes of locked. Empty means the votes are not delegated, as in
+	// ERC20Votes.
+	Delegatee            string   `protobuf:"bytes,3,opt,name=delegatee,proto3" json:"delegatee,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VoteLock) Reset()         { *m = VoteLock{} }
+func (m *VoteLock) String() string { return proto.CompactTextString(m) }
+func (*VoteLock) ProtoMessage()    {}
+func (*VoteLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2abb17010ad804d, []int{0}
+}
+func (m *VoteLock) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VoteLock.Unmarshal(m, b)
+}
+func (m *VoteLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VoteLock.Marshal(b, m, deterministic)
+}
+func (m *VoteLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteLock.Merge(m, src)
+}
+func (m *VoteLock) XXX_Size() int {
+	return xxx_messageInfo_VoteLock.Size(m)
+}
+func (m *VoteLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteLock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteLock proto.InternalMessageInfo
+
+func (m *VoteLock) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *VoteLock) GetDelegatee() string {
+	if m != nil {
+		return m.Delegatee
+	}
+	return ""
+}
+
+// VoteCheckpoint records the votes of an account, or the total locked supply, from a block height
+// on.
+type VoteCheckpoint struct {
+	// account is empty for the total supply checkpoints.
+	Account              string                `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Height               int64                 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Votes                cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=votes,proto3,customtype=cosmossdk.io/math.Int" json:"votes"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *VoteCheckpoint) Reset()         { *m = VoteCheckpoint{} }
+func (m *VoteCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*VoteCheckpoint) ProtoMessage()    {}
+func (*VoteCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2abb17010ad804d, []int{1}
+}
+func (m *VoteCheckpoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VoteCheckpoint.Unmarshal(m, b)
+}
+func (m *VoteCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VoteCheckpoint.Marshal(b, m, deterministic)
+}
+func (m *VoteCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteCheckpoint.Merge(m, src)
+}
+func (m *VoteCheckpoint) XXX_Size() int {
+	return xxx_messageInfo_VoteCheckpoint.Size(m)
+}
+func (m *VoteCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteCheckpoint proto.InternalMessageInfo
+
+func (m *VoteCheckpoint) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *VoteCheckpoint) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// StakeVoter records the stake-weighted votes of a delegator: the votes its delegations to bonded
+// validators were last counted for.
+type StakeVoter struct {
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// delegatee overrides the validators: all the votes of the delegator go to it. Empty means the
+	// votes of each delegation go to the operator account of its validator.
+	Delegatee string `protobuf:"bytes,2,opt,name=delegatee,proto3" json:"delegatee,omitempty"`
+	// credits are the votes last counted for each delegatee, ordered by delegatee.
+	Credits              []StakeVoteCredit `protobuf:"bytes,3,rep,name=credits,proto3" json:"credits"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *StakeVoter) Reset()         { *m = StakeVoter{} }
+func (m *StakeVoter) String() string { return proto.CompactTextString(m) }
+func (*StakeVoter) ProtoMessage()    {}
+func (*StakeVoter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2abb17010ad804d, []int{2}
+}
+func (m *StakeVoter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StakeVoter.Unmarshal(m, b)
+}
+func (m *StakeVoter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StakeVoter.Marshal(b, m, deterministic)
+}
+func (m *StakeVoter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakeVoter.Merge(m, src)
+}
+func (m *StakeVoter) XXX_Size() int {
+	return xxx_messageInfo_StakeVoter.Size(m)
+}
+func (m *StakeVoter) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakeVoter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakeVoter proto.InternalMessageInfo
+
+func (m *StakeVoter) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *StakeVoter) GetDelegatee() string {
+	if m != nil {
+		return m.Delegatee
+	}
+	return ""
+}
+
+func (m *StakeVoter) GetCredits() []StakeVoteCredit {
+	if m != nil {
+		return m.Credits
+	}
+	return nil
+}
+
+// StakeVoteCredit is the part of a delegator's bonded stake counted as votes of delegatee.
+type StakeVoteCredit struct {
+	Delegatee            string                `protobuf:"bytes,1,opt,name=delegatee,proto3" json:"delegatee,omitempty"`
+	Votes                cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=votes,proto3,customtype=cosmossdk.io/math.Int" json:"votes"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *StakeVoteCredit) Reset()         { *m = StakeVoteCredit{} }
+func (m *StakeVoteCredit) String() string { return proto.CompactTextString(m) }
+func (*StakeVoteCredit) ProtoMessage()    {}
+func (*StakeVoteCredit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2abb17010ad804d, []int{3}
+}
+func (m *StakeVoteCredit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StakeVoteCredit.Unmarshal(m, b)
+}
+func (m *StakeVoteCredit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StakeVoteCredit.Marshal(b, m, deterministic)
+}
+func (m *StakeVoteCredit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakeVoteCredit.Merge(m, src)
+}
+func (m *StakeVoteCredit) XXX_Size() int {
+	return xxx_messageInfo_StakeVoteCredit.Size(m)
+}
+func (m *StakeVoteCredit) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakeVoteCredit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakeVoteCredit proto.InternalMessageInfo
+
+func (m *StakeVoteCredit) GetDelegatee() string {
+	if m != nil {
+		return m.Delegatee
+	}
+	return ""
+}
+
+// LegacyNYXT records the standalone NYXT ERC20 retired in favour of native NYXT. It is written
+// only when the token is retired, and redemptions for native NYXT are capped at its supply.
+type LegacyNYXT struct {
+	// contract is the 0x-prefixed address of the standalone NYXT ERC20.
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// supply is the total supply of the standalone NYXT when it was retired.
+	Supply cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=supply,proto3,customtype=cosmossdk.io/math.Int" json:"supply"`
+	// redeemed is the amount redeemed for native NYXT so far.
+	Redeemed             cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=redeemed,proto3,customtype=cosmossdk.io/math.Int" json:"redeemed"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *LegacyNYXT) Reset()         { *m = LegacyNYXT{} }
+func (m *LegacyNYXT) String() string { return proto.CompactTextString(m) }
+func (*LegacyNYXT) ProtoMessage()    {}
+func (*LegacyNYXT) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2abb17010ad804d, []int{4}
+}
+func (m *LegacyNYXT) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LegacyNYXT.Unmarshal(m, b)
+}
+func (m *LegacyNYXT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LegacyNYXT.Marshal(b, m, deterministic)
+}
+func (m *LegacyNYXT) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LegacyNYXT.Merge(m, src)
+}
+func (m *LegacyNYXT) XXX_Size() int {
+	return xxx_messageInfo_LegacyNYXT.Size(m)
+}
+func (m *LegacyNYXT) XXX_DiscardUnknown() {
+	xxx_messageInfo_LegacyNYXT.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LegacyNYXT proto.InternalMessageInfo
+
+func (m *LegacyNYXT) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*VoteLock)(nil), "ynx.ynx.v1.VoteLock")
+	proto.RegisterType((*VoteCheckpoint)(nil), "ynx.ynx.v1.VoteCheckpoint")
+	proto.RegisterType((*StakeVoter)(nil), "ynx.ynx.v1.StakeVoter")
+	proto.RegisterType((*StakeVoteCredit)(nil), "ynx.ynx.v1.StakeVoteCredit")
+	proto.RegisterType((*LegacyNYXT)(nil), "ynx.ynx.v1.LegacyNYXT")
+}
+
+func init() { proto.RegisterFile("ynx/ynx/v1/votes.proto", fileDescriptor_e2abb17010ad804d) }
+
+var fileDescriptor_e2abb17010ad804d = []byte{
+	// 434 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
+	0x14, 0xc4, 0x09, 0xa4, 0xe9, 0x43, 0x02, 0xc9, 0x2a, 0x95, 0x09, 0x87, 0xa2, 0x9c, 0x2a, 0x41,
+	0x6d, 0xb5, 0x48, 0x5c, 0x38, 0x25, 0x39, 0xa0, 0xa2, 0xaa, 0x07, 0x17, 0xa1, 0x96, 0x0b, 0xda,
+	0xac, 0x9f, 0x6c, 0xcb, 0xc9, 0x3e, 0x6b, 0xf7, 0x25, 0xaa, 0xff, 0x81, 0x7f, 0xe0, 0x27, 0x2a,
+	0x24, 0xc4, 0x0f, 0x70, 0xee, 0x91, 0x43, 0xbf, 0x05, 0xad, 0xd7, 0x4d, 0x28, 0x97, 0x06, 0x1f,
+	0x56, 0xf2, 0x5b, 0xcf, 0xac, 0x67, 0xc6, 0xb3, 0xb0, 0x5b, 0xa9, 0xcb, 0xc8, 0xae, 0xe5, 0x61,
+	0xb4, 0x24, 0x46, 0x13, 0x96, 0x9a, 0x98, 0x7c, 0xa8, 0xd4, 0x65, 0x68, 0xd7, 0xf2, 0x70, 0xf0,
+	0x5c, 0x92, 0x99, 0x93, 0xf9, 0x52, 0xbf, 0x89, 0xdc, 0xe0, 0x60, 0x83, 0x9d, 0x94, 0x52, 0x72,
+	0xfb, 0xf6, 0xc9, 0xed, 0x0e, 0x7f, 0x7a, 0xd0, 0xff, 0x44, 0x8c, 0x27, 0x24, 0x0b, 0xff, 0x08,
+	0xb6, 0x84, 0x94, 0xb4, 0x50, 0x1c, 0x78, 0x2f, 0xbd, 0xfd, 0xed, 0x71, 0x70, 0x7d, 0x75, 0xb0,
+	0xd3, 0x9c, 0x32, 0x4a, 0x12, 0x8d, 0xc6, 0x9c, 0xb1, 0xce, 0x55, 0x1a, 0xdf, 0x02, 0xfd, 0x09,
+	0xf4, 0x66, 0x24, 0x0b, 0x4c, 0x82, 0x4e, 0x4d, 0x79, 0xf5, 0xeb, 0x66, 0xef, 0xc1, 0xef, 0x9b,
+	0xbd, 0x67, 0x8e, 0x66, 0x92, 0x22, 0xcc, 0x29, 0x9a, 0x0b, 0xce, 0xc2, 0x63, 0xc5, 0xd7, 0x57,
+	0x07, 0xd0, 0x9c, 0x77, 0xac, 0x38, 0x6e, 0xa8, 0xfe, 0x5b, 0xd8, 0x4e, 0x70, 0x86, 0xa9, 0x60,
+	0xc4, 0xa0, 0x7b, 0xcf, 0xa7, 0xd7, 0xd0, 0xe1, 0x37, 0x0f, 0x9e, 0x58, 0xf5, 0x93, 0x0c, 0x65,
+	0x51, 0x52, 0xae, 0xb8, 0x95, 0x87, 0x5d, 0xe8, 0x65, 0x98, 0xa7, 0x19, 0xd7, 0x1e, 0xba, 0x71,
+	0x33, 0xf9, 0x23, 0x78, 0x54, 0x07, 0x1d, 0x74, 0xff, 0xdf, 0x9a, 0x63, 0x0e, 0x7f, 0x78, 0x00,
+	0x67, 0x2c, 0x0a, 0xb4, 0x32, 0xf5, 0x5f, 0x46, 0x49, 0xdf, 0xab, 0x6f, 0x0d, 0xbd, 0x1b, 0x50,
+	0x67, 0xe3, 0x80, 0xfc, 0x77, 0xb0, 0x25, 0x35, 0x26, 0x39, 0x5b, 0x0f, 0xdd, 0xfd, 0xc7, 0x47,
+	0x2f, 0xc2, 0x75, 0x5b, 0xc2, 0x95, 0xb0, 0x49, 0x8d, 0x19, 0x3f, 0xb4, 0x06, 0xe3, 0x5b, 0xc6,
+	0xf0, 0xab, 0x07, 0x4f, 0xff, 0x81, 0xdc, 0x15, 0xe2, 0x6d, 0x2e, 0x64, 0x15, 0x65, 0xa7, 0x75,
+	0x94, 0xdf, 0x3d, 0x80, 0x13, 0x4c, 0x85, 0xac, 0x4e, 0x2f, 0xce, 0x3f, 0xfa, 0x03, 0xe8, 0x4b,
+	0x52, 0xac, 0x85, 0x6c, 0xfe, 0x74, 0xbc, 0x9a, 0x6d, 0x29, 0xcd, 0xa2, 0x2c, 0x67, 0x55, 0xab,
+	0x52, 0x3a, 0xaa, 0xff, 0x1e, 0xfa, 0x1a, 0x13, 0xc4, 0x39, 0x26, 0x6d, 0x0a, 0xb0, 0x22, 0x8f,
+	0xc3, 0xcf, 0xaf, 0xd3, 0x9c, 0xb3, 0xc5, 0x34, 0x94, 0x34, 0x8f, 0x3e, 0xe4, 0x22, 0x13, 0x34,
+	0x9a, 0x4d, 0x17, 0x26, 0xba, 0x38, 0x3d, 0x8f, 0x64, 0x26, 0x72, 0x15, 0xb9, 0x9b, 0xcd, 0x55,
+	0x89, 0x66, 0xda, 0xab, 0xaf, 0xe6, 0x9b, 0x3f, 0x03, 0x00, 0xb9, 0x41, 0xaf, 0x00, 0xf1, 0x03,
+	0x00, 0x00,
+}
@@ -1513,6 +1513,51 @@ async function initChainId() {
   }
 }
 
+// systemContractsByName flattens x/ynx SystemContracts into a name -> address map. Genesis files
+// written before the registry existed carry the v0 entries as top-level fields.
+function systemContractsByName(systemContracts) {
+  const out = {};
+  for (const [name, address] of Object.entries(systemContracts || {})) {
+    if (typeof address === "string" && address) out[name] = address;
+  }
+  for (const entry of systemContracts?.contracts || []) {
+    if (entry?.name && entry?.address) out[entry.name] = entry.address;
+  }
+  return out;
+}
+
+// governanceMetaFromYNX maps the x/ynx params and system config onto governanceMeta. The REST API
+// serves them through grpc-gateway, which renders 64-bit integers as strings and emits unset fields
+// as "" or 0, so empty strings keep the current value and numbers are parsed from either form.
+function governanceMetaFromYNX(params, system, feemarket = {}) {
+  return {
+    founder_address: params.founder_address || governanceMeta.founder_address,
+    treasury_address: params.treasury_address || governanceMeta.treasury_address,
+    team_beneficiary_address: system.team_beneficiary_address || governanceMeta.team_beneficiary_address,
+    community_recipient_address: system.community_recipient_address || governanceMeta.community_recipient_address,
+    fee_burn_bps: Number(params.fee_burn_bps ?? governanceMeta.fee_burn_bps),
+    fee_treasury_bps: Number(params.fee_treasury_bps ?? governanceMeta.fee_treasury_bps),
+    fee_founder_bps: Number(params.fee_founder_bps ?? governanceMeta.fee_founder_bps),
+    inflation_treasury_bps: inflationTreasuryBpsFromYNX(params),
+    no_base_fee: feemarket.no_base_fee ?? governanceMeta.no_base_fee,
+    base_fee: feemarket.base_fee || governanceMeta.base_fee,
+  };
+}
+
+// inflationTreasuryBpsFromYNX reads the treasury share of inflation from the treasury entry of
+// inflation_recipients. Params of chains before the v8 upgrade carry it in the deprecated
+// inflation_treasury_bps instead.
+function inflationTreasuryBpsFromYNX(params) {
+  const deprecated = Number(params.inflation_treasury_bps ?? 0);
+  if (!Array.isArray(params.inflation_recipients)) {
+    return deprecated || governanceMeta.inflation_treasury_bps;
+  }
+  const treasury = params.inflation_recipients.find(
+    (r) => r?.kind === "INFLATION_RECIPIENT_KIND_TREASURY" || Number(r?.kind) === 3,
+  );
+  return treasury ? Number(treasury.bps ?? 0) : deprecated;
+}
+
 async function initGovernanceMeta() {
   try {
     const [paramsRes, systemContractsRes] = await Promise.all([
@@ -1520,22 +1565,8 @@ async function initGovernanceMeta() {
       httpJsonRequest(`${YNX_QUERY_REST.replace(/\/$/, "")}/ynx/ynx/v1/system_contracts`),
     ]);
 
-    const params = paramsRes?.params || {};
-    const system = systemContractsRes?.system || {};
-    const contracts = systemContractsRes?.system_contracts || {};
-    governanceMeta = {
-      founder_address: params.founder_address || governanceMeta.founder_address,
-      treasury_address: params.treasury_address || governanceMeta.treasury_address,
-      team_beneficiary_address: system.team_beneficiary_address || governanceMeta.team_beneficiary_address,
-      community_recipient_address: system.community_recipient_address || governanceMeta.community_recipient_address,
-      fee_burn_bps: Number(params.fee_burn_bps ?? governanceMeta.fee_burn_bps),
-      fee_treasury_bps: Number(params.fee_treasury_bps ?? governanceMeta.fee_treasury_bps),
-      fee_founder_bps: Number(params.fee_founder_bps ?? governanceMeta.fee_founder_bps),
-      inflation_treasury_bps: Number(params.inflation_treasury_bps ?? governanceMeta.inflation_treasury_bps),
-      no_base_fee: governanceMeta.no_base_fee,
-      base_fee: governanceMeta.base_fee,
-    };
-    systemContractsMeta = contracts;
+    governanceMeta = governanceMetaFromYNX(paramsRes?.params || {}, systemContractsRes?.system || {});
+    systemContractsMeta = systemContractsByName(systemContractsRes?.system_contracts);
     return;
   } catch {
     // fall back to genesis-derived metadata below
@@ -1545,23 +1576,9 @@ async function initGovernanceMeta() {
     const genesis = await rpcRequest("/genesis");
     const appState = genesis?.result?.genesis?.app_state || {};
     const ynx = appState?.ynx || {};
-    const params = ynx?.params || {};
-    const system = ynx?.system || {};
-    const feemarket = appState?.feemarket?.params || {};
-
-    governanceMeta = {
-      founder_address: params.founder_address || governanceMeta.founder_address,
-      treasury_address: params.treasury_address || governanceMeta.treasury_address,
-      team_beneficiary_address: system.team_beneficiary_address || governanceMeta.team_beneficiary_address,
-      community_recipient_address: system.community_recipient_address || governanceMeta.community_recipient_address,
-      fee_burn_bps: Number(params.fee_burn_bps ?? governanceMeta.fee_burn_bps),
-      fee_treasury_bps: Number(params.fee_treasury_bps ?? governanceMeta.fee_treasury_bps),
-      fee_founder_bps: Number(params.fee_founder_bps ?? governanceMeta.fee_founder_bps),
-      inflation_treasury_bps: Number(params.inflation_treasury_bps ?? governanceMeta.inflation_treasury_bps),
-      no_base_fee: feemarket.no_base_fee ?? governanceMeta.no_base_fee,
-      base_fee: feemarket.base_fee || governanceMeta.base_fee,
-    };
-    systemContractsMeta = ynx?.system_contracts || systemContractsMeta;
+
+    governanceMeta = governanceMetaFromYNX(ynx?.params || {}, ynx?.system || {}, appState?.feemarket?.params || {});
+    if (ynx?.system_contracts) systemContractsMeta = systemContractsByName(ynx.system_contracts);
   } catch {
     return;
   }
@@ -99,6 +99,47 @@ function startMockRpcServer(port) {
       }));
     }
 
+    // x/ynx REST routes are served by grpc-gateway: 64-bit integers and enums are strings, and
+    // unset fields are emitted with their zero value.
+    if (url.pathname === "/ynx/ynx/v1/params") {
+      return res.end(JSON.stringify({
+        params: {
+          founder_address: "",
+          treasury_address: "ynx1treasury",
+          fee_burn_bps: 4000,
+          fee_treasury_bps: 1000,
+          fee_founder_bps: 0,
+          inflation_treasury_bps: 0,
+          epoch_length_blocks: "100",
+          fee_settlement_interval_blocks: "0",
+          fee_denom_policies: [],
+          inflation_recipients: [
+            { name: "ecosystem", kind: "INFLATION_RECIPIENT_KIND_ACCOUNT", address: "ynx1ecosystem", bps: 500 },
+            { name: "treasury", kind: "INFLATION_RECIPIENT_KIND_TREASURY", address: "", bps: 2500 },
+          ],
+          founder_fee_decay: null,
+        },
+      }));
+    }
+
+    if (url.pathname === "/ynx/ynx/v1/system_contracts") {
+      return res.end(JSON.stringify({
+        system: {
+          enabled: true,
+          deployer_address: "ynx1deployer",
+          team_beneficiary_address: "ynx1team",
+          community_recipient_address: "",
+          deploy_mode: "SYSTEM_DEPLOY_MODE_CREATE",
+          vesting_reference_time: "0",
+        },
+        system_contracts: {
+          contracts: [
+            { name: "timelock", address: "0x00000000000000000000000000000000000000AA" },
+          ],
+        },
+      }));
+    }
+
     if (url.pathname === "/cosmos/staking/v1beta1/validators") {
       return res.end(JSON.stringify({
         validators: [
@@ -310,6 +351,12 @@ test("supports validator detail and unified search", async (t) => {
   assert.equal(txSearch.tx.height, 88);
 
   const overview = assertJson(await requestJson(`http://127.0.0.1:${indexerPort}/ynx/overview`), 200);
+  assert.equal(overview.governance.treasury_address, "ynx1treasury");
+  assert.equal(overview.governance.founder_address, "");
+  assert.equal(overview.governance.team_beneficiary_address, "ynx1team");
+  assert.equal(overview.governance.fee_burn_bps, 4000);
+  assert.equal(overview.governance.inflation_treasury_bps, 2500);
+  assert.equal(overview.system_contracts.timelock, "0x00000000000000000000000000000000000000AA");
   assert.equal(overview.endpoints.bridge_health, `http://127.0.0.1:${rpcPort}/bridge/health`);
   assert.equal(overview.bridge.ok, true);
   assert.equal(overview.bridge.route_readiness.summary.deposit_tested, 4);
@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.24;
+
+/// @title IYNXCircuitBreaker
+/// @notice Interface for the YNX circuit breaker precompile at:
+///         0x0000000000000000000000000000000000000814
+/// @dev Kinds: 1 = Cosmos message type URL, 2 = EVM call target, 3 = static precompile. A zero selector stands for
+///      every call to the target. Breakers on x/gov messages, this precompile and the governor and timelock system
+///      contracts are rejected.
+interface IYNXCircuitBreaker {
+    struct CircuitBreaker {
+        uint8 kind;
+        string target;
+        bytes4 selector;
+        address trippedBy;
+        uint64 trippedHeight;
+        uint64 expiryHeight;
+        string reason;
+    }
+
+    event CircuitBreakerTripped(uint8 indexed kind, string target, bytes4 selector, uint64 expiryHeight, string reason);
+
+    event CircuitBreakerReset(uint8 indexed kind, string target, bytes4 selector);
+
+    /// @dev Returns the zero address if no guardian is configured.
+    function guardian() external view returns (address);
+
+    function maxDurationBlocks() external view returns (uint64);
+
+    function isMsgBlocked(string calldata typeUrl) external view returns (bool blocked, uint64 expiryHeight);
+
+    function isCallBlocked(address target, bytes4 selector) external view returns (bool blocked, uint64 expiryHeight);
+
+    /// @dev Returns the tripped breakers that have not expired.
+    function getCircuitBreakers() external view returns (CircuitBreaker[] memory);
+
+    /// @notice Trips a breaker until the current block plus durationBlocks. Only callable by the guardian.
+    function trip(uint8 kind, string calldata target, bytes4 selector, uint64 durationBlocks, string calldata reason)
+        external
+        returns (uint64 expiryHeight);
+
+    /// @notice Lifts a tripped breaker before it expires. Only callable by the timelock.
+    function reset(uint8 kind, string calldata target, bytes4 selector) external returns (bool);
+}
@@ -5,6 +5,61 @@ pragma solidity ^0.8.24;
 /// @notice Interface for the YNX protocol precompile at:
 ///         0x0000000000000000000000000000000000000810
 interface IYNXProtocol {
+    struct PendingParams {
+        uint64 activationHeight;
+        address authority;
+        address founder;
+        address treasury;
+        uint32 feeBurnBps;
+        uint32 feeTreasuryBps;
+        uint32 feeFounderBps;
+        uint32 inflationTreasuryBps;
+    }
+
+    /// @notice kind: 1 = account (recipient), 2 = x/distribution community pool (recipient must be address(0)),
+    ///         3 = treasury address (recipient must be address(0); at most one entry).
+    struct InflationRecipient {
+        string name;
+        uint8 kind;
+        address recipient;
+        uint32 bps;
+    }
+
+    /// @notice An EVM call made by the system deployer after a system contract deployment.
+    ///         target = address(0) calls the newly deployed contract.
+    struct SystemContractCall {
+        address target;
+        bytes data;
+    }
+
+    /// @notice The cumulative revenue ledger of a denom.
+    struct RevenueTotals {
+        uint256 feeBurned;
+        uint256 feeTreasury;
+        uint256 feeFounder;
+        uint256 feeValidators;
+        uint256 feeDevelopers;
+        uint256 inflationTreasury;
+        uint256 inflationValidators;
+        uint256 inflationRecipients;
+    }
+
+    /// @notice Logged by updateParams with the params before and after the update.
+    event ParamsUpdated(
+        address oldFounder,
+        address oldTreasury,
+        uint32 oldFeeBurnBps,
+        uint32 oldFeeTreasuryBps,
+        uint32 oldFeeFounderBps,
+        uint32 oldInflationTreasuryBps,
+        address newFounder,
+        address newTreasury,
+        uint32 newFeeBurnBps,
+        uint32 newFeeTreasuryBps,
+        uint32 newFeeFounderBps,
+        uint32 newInflationTreasuryBps
+    );
+
     function getParams()
         external
         view
@@ -32,6 +87,11 @@ interface IYNXProtocol {
             address domainInbox
         );
 
+    /// @notice Returns the system contract registered under `name`, e.g. "domain_inbox", or the zero address.
+    function getSystemContract(string calldata name) external view returns (address contractAddress);
+
+    /// @notice `inflationTreasuryBps` is the bps of the treasury entry of the inflation recipients; 0 removes the
+    ///         entry and a non-zero value adds one named "treasury" when there is none.
     function updateParams(
         address founder,
         address treasury,
@@ -40,5 +100,76 @@ interface IYNXProtocol {
         uint32 feeFounderBps,
         uint32 inflationTreasuryBps
     ) external returns (bool ok);
-}
 
+    function getPendingParams() external view returns (PendingParams[] memory pending);
+
+    function scheduleParams(
+        address founder,
+        address treasury,
+        uint32 feeBurnBps,
+        uint32 feeTreasuryBps,
+        uint32 feeFounderBps,
+        uint32 inflationTreasuryBps,
+        uint64 activationHeight
+    ) external returns (bool ok);
+
+    function cancelPendingParams(uint64 activationHeight) external returns (bool ok);
+
+    function getInflationRecipients() external view returns (InflationRecipient[] memory recipients);
+
+    function updateInflationRecipients(InflationRecipient[] calldata recipients) external returns (bool ok);
+
+    function getContractRevenue(address contractAddress) external view returns (address deployer, address withdrawer);
+
+    function registerContractRevenue(address contractAddress, uint64 nonce, address withdrawer) external returns (bool ok);
+
+    function updateContractRevenue(address contractAddress, address withdrawer) external returns (bool ok);
+
+    function cancelContractRevenue(address contractAddress) external returns (bool ok);
+
+    /// @notice Deploys a new version of the system contract `name` (e.g. "timelock", "domain_inbox") from the
+    ///         embedded `artifact`, or from `bytecode` when `artifact` is empty, runs `migrationCalls` and points
+    ///         the system contract entry at the deployed contract. Reverts without changes if any step fails.
+    function deploySystemContract(
+        string calldata name,
+        string calldata artifact,
+        bytes calldata bytecode,
+        bytes calldata constructorArgs,
+        SystemContractCall[] calldata migrationCalls
+    ) external returns (address deployed);
+
+    /// @notice Points the system contract entry `name` at an already deployed contract.
+    function setSystemContract(string calldata name, address contractAddress) external returns (bool ok);
+
+    /// @notice Reports whether `account` is an official system contract: a system contract entry points at it
+    ///         and its live runtime code hash is the attested one. `name` and `codeHash` are set for any entry.
+    function isSystemContract(address account) external view returns (bool official, string memory name, bytes32 codeHash);
+
+    /// @notice Returns the cumulative revenue ledger of `denom`, all zero for denoms without revenue.
+    function getRevenue(string calldata denom) external view returns (RevenueTotals memory revenue);
+
+    /// @notice Returns how a transaction fee of `amount` `denom` would be split at the current block, without a
+    ///         developer rebate. `validators` is the remainder left for validators.
+    function previewFeeSplit(string calldata denom, uint256 amount)
+        external
+        view
+        returns (uint256 burned, uint256 treasury, uint256 founder, uint256 validators);
+
+    /// @notice Returns the inflation x/mint mints per block at its current annual provisions.
+    function getBlockProvision() external view returns (string memory denom, uint256 amount);
+
+    /// @notice Returns the preconfirm signer set active in the current epoch, or an empty set if none is registered.
+    function getPreconfirmSigners()
+        external
+        view
+        returns (address[] memory signers, uint32 threshold, uint64 activationEpoch);
+
+    /// @notice Reports whether `account` is a signer of the active preconfirm signer set.
+    function isPreconfirmSigner(address account) external view returns (bool registered);
+
+    /// @notice Registers the preconfirm signer set that becomes active at the start of `activationEpoch`. Only
+    ///         callable by the timelock.
+    function setPreconfirmSigners(address[] calldata signers, uint32 threshold, uint64 activationEpoch)
+        external
+        returns (bool ok);
+}
@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.24;
+
+/// @title IYNXSponsorship
+/// @notice Interface for the YNX gas sponsorship precompile at:
+///         0x0000000000000000000000000000000000000811
+/// @dev Budgets are held in the EVM denom and moved from msg.sender's balance. A sponsorship pays the fee of
+///      zero-value calls to one of its allowed contracts instead of the transaction sender, and the fee of Cosmos
+///      transactions whose messages all have one of its allowed message type URLs.
+interface IYNXSponsorship {
+    /// @notice Returns the sponsorship `id`, or zero values if it does not exist.
+    /// @dev maxGasPerTx, dailyCap and expiresAt are zero when not limited. spentToday is for the current UTC day.
+    function getSponsorship(uint64 id)
+        external
+        view
+        returns (
+            address sponsor,
+            address[] memory allowedContracts,
+            uint64 maxGasPerTx,
+            uint256 dailyCap,
+            int64 expiresAt,
+            uint256 budget,
+            uint256 spentToday
+        );
+
+    function createSponsorship(
+        address[] calldata allowedContracts,
+        uint64 maxGasPerTx,
+        uint256 dailyCap,
+        int64 expiresAt,
+        uint256 budget
+    ) external returns (uint64 id);
+
+    function updateSponsorship(
+        uint64 id,
+        address[] calldata allowedContracts,
+        uint64 maxGasPerTx,
+        uint256 dailyCap,
+        int64 expiresAt
+    ) external returns (bool ok);
+
+    function fundSponsorship(uint64 id, uint256 amount) external returns (bool ok);
+
+    function closeSponsorship(uint64 id) external returns (uint256 refund);
+
+    /// @notice Returns the message type URLs, e.g. "/cosmos.bank.v1beta1.MsgSend", of the Cosmos transactions
+    ///         sponsorship `id` pays for.
+    function getSponsoredMsgTypes(uint64 id) external view returns (string[] memory msgTypeUrls);
+
+    /// @notice Replaces the message type URLs of the Cosmos transactions sponsorship `id` pays for.
+    /// @dev updateSponsorship keeps them unchanged.
+    function setSponsoredMsgTypes(uint64 id, string[] calldata msgTypeUrls) external returns (bool ok);
+}
@@ -0,0 +1,30 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.24;
+
+/// @title IYNXStakeVotes
+/// @notice Interface for the YNX stake votes precompile at:
+///         0x0000000000000000000000000000000000000813
+/// @dev Implements the IVotes / IERC5805 reads used by OpenZeppelin governors over the stake bonded through x/staking.
+///      The stake of each delegation to a bonded validator counts for the validator's operator account unless the
+///      delegator delegates its votes. Checkpoints are taken at the end of each block and timepoints are block
+///      numbers. delegateBySig is not supported.
+interface IYNXStakeVotes {
+    function clock() external view returns (uint48);
+
+    function CLOCK_MODE() external view returns (string memory);
+
+    function getVotes(address account) external view returns (uint256);
+
+    /// @dev Reverts unless timepoint is before the current block.
+    function getPastVotes(address account, uint256 timepoint) external view returns (uint256);
+
+    /// @dev Reverts unless timepoint is before the current block.
+    function getPastTotalSupply(uint256 timepoint) external view returns (uint256);
+
+    /// @dev Returns the zero address while the votes go to the validators account delegates to.
+    function delegates(address account) external view returns (address);
+
+    /// @notice Delegates the votes of msg.sender's bonded stake, now and in the future, to delegatee. The zero address
+    ///         gives them back to the validators.
+    function delegate(address delegatee) external;
+}
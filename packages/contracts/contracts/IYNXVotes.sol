@@ -0,0 +1,35 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.24;
+
+/// @title IYNXVotes
+/// @notice Interface for the YNX native NYXT votes precompile at:
+///         0x0000000000000000000000000000000000000812
+/// @dev Implements the IVotes / IERC5805 reads used by OpenZeppelin governors over native NYXT locked for voting.
+///      lock and unlock move the EVM denom from and to msg.sender's balance. Votes only count once delegated, and
+///      timepoints are block numbers. delegateBySig is not supported.
+interface IYNXVotes {
+    function clock() external view returns (uint48);
+
+    function CLOCK_MODE() external view returns (string memory);
+
+    function getVotes(address account) external view returns (uint256);
+
+    /// @dev Reverts unless timepoint is before the current block.
+    function getPastVotes(address account, uint256 timepoint) external view returns (uint256);
+
+    /// @dev Reverts unless timepoint is before the current block.
+    function getPastTotalSupply(uint256 timepoint) external view returns (uint256);
+
+    function delegates(address account) external view returns (address);
+
+    function lockedBalanceOf(address account) external view returns (uint256);
+
+    function totalLocked() external view returns (uint256);
+
+    /// @notice Delegates the votes of msg.sender's locked NYXT, now and in the future, to delegatee.
+    function delegate(address delegatee) external;
+
+    function lock(uint256 amount) external returns (bool ok);
+
+    function unlock(uint256 amount) external returns (bool ok);
+}
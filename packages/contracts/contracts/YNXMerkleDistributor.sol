@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.24;
+
+import { IERC20 } from "@openzeppelin/contracts/token/ERC20/IERC20.sol";
+import { SafeERC20 } from "@openzeppelin/contracts/token/ERC20/utils/SafeERC20.sol";
+import { MerkleProof } from "@openzeppelin/contracts/utils/cryptography/MerkleProof.sol";
+import { BitMaps } from "@openzeppelin/contracts/utils/structs/BitMaps.sol";
+
+/// @notice Genesis NYXT airdrop. Leaves are keccak256(keccak256(abi.encode(index, account, amount)))
+/// and pairs are hashed in sorted order, as `ynxd genesis ynx airdrop build` computes them. Claims
+/// close at claimDeadline, after which anyone can sweep the unclaimed balance to sweepRecipient.
+contract YNXMerkleDistributor {
+    using SafeERC20 for IERC20;
+    using BitMaps for BitMaps.BitMap;
+
+    IERC20 public immutable token;
+    bytes32 public immutable merkleRoot;
+    uint64 public immutable claimDeadline;
+    address public immutable sweepRecipient;
+
+    BitMaps.BitMap private _claimed;
+
+    event Claimed(uint256 indexed index, address indexed account, uint256 amount);
+    event Swept(address indexed recipient, uint256 amount);
+
+    error AlreadyClaimed();
+    error InvalidProof();
+    error ClaimWindowClosed();
+    error ClaimWindowOpen();
+
+    constructor(IERC20 token_, bytes32 merkleRoot_, uint64 claimDeadline_, address sweepRecipient_) {
+        token = token_;
+        merkleRoot = merkleRoot_;
+        claimDeadline = claimDeadline_;
+        sweepRecipient = sweepRecipient_;
+    }
+
+    function isClaimed(uint256 index) external view returns (bool) {
+        return _claimed.get(index);
+    }
+
+    /// @notice Sends amount to account. Anyone may submit the claim on the account's behalf.
+    function claim(uint256 index, address account, uint256 amount, bytes32[] calldata proof) external {
+        if (block.timestamp > claimDeadline) revert ClaimWindowClosed();
+        if (_claimed.get(index)) revert AlreadyClaimed();
+
+        bytes32 leaf = keccak256(bytes.concat(keccak256(abi.encode(index, account, amount))));
+        if (!MerkleProof.verifyCalldata(proof, merkleRoot, leaf)) revert InvalidProof();
+
+        _claimed.set(index);
+        token.safeTransfer(account, amount);
+        emit Claimed(index, account, amount);
+    }
+
+    /// @notice Sends the unclaimed balance to sweepRecipient once the claim window is closed.
+    function sweep() external {
+        if (block.timestamp <= claimDeadline) revert ClaimWindowOpen();
+
+        uint256 amount = token.balanceOf(address(this));
+        token.safeTransfer(sweepRecipient, amount);
+        emit Swept(sweepRecipient, amount);
+    }
+}
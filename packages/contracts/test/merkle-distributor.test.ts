@@ -0,0 +1,97 @@
+import { expect } from "chai";
+import { network } from "hardhat";
+
+const { ethers } = await network.connect();
+
+const coder = ethers.AbiCoder.defaultAbiCoder();
+
+function leaf(index: number, account: string, amount: bigint): string {
+  const encoded = coder.encode(["uint256", "address", "uint256"], [index, account, amount]);
+  return ethers.keccak256(ethers.keccak256(encoded));
+}
+
+function hashPair(a: string, b: string): string {
+  const sorted = BigInt(a) < BigInt(b) ? [a, b] : [b, a];
+  return ethers.solidityPackedKeccak256(["bytes32", "bytes32"], sorted);
+}
+
+// Builds the tree the way `ynxd genesis ynx airdrop build` does: a node without a sibling moves up.
+function buildTree(leaves: string[]): { root: string; proof: (index: number) => string[] } {
+  const levels = [leaves];
+  while (levels[levels.length - 1].length > 1) {
+    const level = levels[levels.length - 1];
+    const next: string[] = [];
+    for (let i = 0; i < level.length; i += 2) {
+      next.push(i + 1 === level.length ? level[i] : hashPair(level[i], level[i + 1]));
+    }
+    levels.push(next);
+  }
+
+  return {
+    root: levels[levels.length - 1][0],
+    proof: (index: number) => {
+      const proof: string[] = [];
+      for (const level of levels.slice(0, -1)) {
+        const sibling = index ^ 1;
+        if (sibling < level.length) proof.push(level[sibling]);
+        index = Math.floor(index / 2);
+      }
+      return proof;
+    },
+  };
+}
+
+async function expectRevert(p: Promise<unknown>): Promise<void> {
+  let failed = false;
+  try {
+    await p;
+  } catch {
+    failed = true;
+  }
+  expect(failed).to.equal(true);
+}
+
+describe("Merkle distributor (v0)", () => {
+  it("pays out claims until the deadline and sweeps the rest", async () => {
+    const [deployer, alice, bob, carol, sweeper] = await ethers.getSigners();
+
+    const NYXT = await ethers.getContractFactory("NYXT");
+    const token = await NYXT.deploy(deployer.address, deployer.address, ethers.parseUnits("1000", 18));
+
+    const claims = [
+      { account: alice.address, amount: ethers.parseUnits("10", 18) },
+      { account: bob.address, amount: ethers.parseUnits("20", 18) },
+      { account: carol.address, amount: ethers.parseUnits("30", 18) },
+    ];
+    const tree = buildTree(claims.map((c, i) => leaf(i, c.account, c.amount)));
+
+    const latest = await ethers.provider.getBlock("latest");
+    const deadline = BigInt(latest!.timestamp) + 3600n;
+    const Distributor = await ethers.getContractFactory("YNXMerkleDistributor");
+    const distributor = await Distributor.deploy(await token.getAddress(), tree.root, deadline, sweeper.address);
+    await token.transfer(await distributor.getAddress(), ethers.parseUnits("60", 18));
+
+    // Anyone can submit a claim; the tokens go to the account of the leaf.
+    await distributor.connect(carol).claim(1, bob.address, claims[1].amount, tree.proof(1));
+    expect(await token.balanceOf(bob.address)).to.equal(claims[1].amount);
+    expect(await distributor.isClaimed(1)).to.equal(true);
+
+    await expectRevert(distributor.claim(1, bob.address, claims[1].amount, tree.proof(1)));
+    await expectRevert(distributor.claim(0, alice.address, claims[1].amount, tree.proof(0)));
+    await expectRevert(distributor.claim(0, bob.address, claims[0].amount, tree.proof(0)));
+
+    // The last leaf has no sibling on the first level.
+    await distributor.claim(2, carol.address, claims[2].amount, tree.proof(2));
+    expect(await token.balanceOf(carol.address)).to.equal(claims[2].amount);
+
+    await expectRevert(distributor.sweep());
+
+    await ethers.provider.send("evm_increaseTime", [3601]);
+    await ethers.provider.send("evm_mine", []);
+
+    await expectRevert(distributor.claim(0, alice.address, claims[0].amount, tree.proof(0)));
+    await distributor.connect(alice).sweep();
+    expect(await token.balanceOf(sweeper.address)).to.equal(claims[0].amount);
+    expect(await token.balanceOf(await distributor.getAddress())).to.equal(0n);
+  });
+});
@@ -1,6 +1,6 @@
 #!/usr/bin/env node
 import { decodeYNAddress, encodeYNAddress } from "./ynAddress.js";
-import { verifyPreconfirmReceiptV0, type PreconfirmReceiptV0 } from "./preconfirmations.js";
+import { fetchPreconfirmSignersV0, verifyPreconfirmReceiptV0, type PreconfirmReceiptV0 } from "./preconfirmations.js";
 
 function usage(): never {
   console.error(
@@ -8,12 +8,13 @@ function usage(): never {
       "Usage:",
       "  ynx address encode <0x...>",
       "  ynx address decode <YN...>",
-      "  ynx preconfirm verify <0xTxHash> --rpc <url> [--allowlist <addr1,addr2,...>]",
+      "  ynx preconfirm verify <0xTxHash> --rpc <url> [--allowlist <addr1,addr2,...> | --rest <url>]",
       "",
       "Examples:",
       "  ynx address encode 0x0000000000000000000000000000000000000000",
       "  ynx address decode YN...",
       "  ynx preconfirm verify 0x<txHash> --rpc http://127.0.0.1:8545",
+      "  ynx preconfirm verify 0x<txHash> --rpc http://127.0.0.1:8545 --rest http://127.0.0.1:1317",
     ].join("\n"),
   );
   process.exit(2);
@@ -80,10 +81,22 @@ async function main(): Promise<void> {
     if (!rpc) usage();
 
     const allowlistCsv = parseFlagValue(args, "--allowlist");
-    const allowlist = allowlistCsv ? allowlistCsv.split(",").map((s) => s.trim()).filter(Boolean) : undefined;
+    let allowlist = allowlistCsv ? allowlistCsv.split(",").map((s) => s.trim()).filter(Boolean) : undefined;
+    let threshold: number | undefined;
+
+    // --rest checks the receipt against the signer set registered on chain.
+    const rest = parseFlagValue(args, "--rest");
+    if (rest) {
+      const signerSet = await fetchPreconfirmSignersV0(rest);
+      if (!signerSet) {
+        throw new Error("no preconfirm signers are registered on chain");
+      }
+      allowlist = signerSet.signers;
+      threshold = signerSet.threshold;
+    }
 
     const receipt = await jsonRpc<PreconfirmReceiptV0>(rpc, "ynx_preconfirmTx", [txHash]);
-    const verified = verifyPreconfirmReceiptV0(receipt, { allowlist });
+    const verified = verifyPreconfirmReceiptV0(receipt, { allowlist, threshold });
 
     if (!verified.ok) {
       console.error(JSON.stringify(verified, null, 2));
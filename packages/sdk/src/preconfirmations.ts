@@ -18,6 +18,7 @@ export type PreconfirmReceiptV0 = {
   signers?: string[];
   signatures?: string[];
   threshold?: number;
+  signerSetEpoch?: string | number | bigint;
 };
 
 export type ComputePreconfirmDigestV0Input = {
@@ -126,6 +127,8 @@ function normalizeSignatureForRecovery(sigBytes: Uint8Array): string {
 
 export type VerifyPreconfirmReceiptOptions = {
   allowlist?: string[];
+  /** Overrides the threshold claimed by the receipt, e.g. with the one registered on chain. */
+  threshold?: number;
 };
 
 export type VerifyPreconfirmReceiptResult = {
@@ -167,7 +170,13 @@ export function verifyPreconfirmReceiptV0(
     return { ok: false, digest, threshold: 0, validSigners: [], reason: "signers/signatures length mismatch" };
   }
 
-  const threshold = receipt.threshold && receipt.threshold > 0 ? receipt.threshold : signers.length;
+  let threshold = receipt.threshold && receipt.threshold > 0 ? receipt.threshold : signers.length;
+  if (options.threshold !== undefined) {
+    threshold = options.threshold;
+  }
+  if (threshold <= 0) {
+    return { ok: false, digest, threshold, validSigners: [], reason: "invalid threshold" };
+  }
   if (threshold > signers.length) {
     return { ok: false, digest, threshold, validSigners: [], reason: "threshold exceeds signer count" };
   }
@@ -198,3 +207,35 @@ export function verifyPreconfirmReceiptV0(
 
   return { ok: true, digest, threshold, validSigners };
 }
+
+export type PreconfirmSignerSetV0 = {
+  activationEpoch: bigint;
+  signers: string[];
+  threshold: number;
+};
+
+type PreconfirmSignersResponse = {
+  current_epoch?: string;
+  active?: { activation_epoch?: string; signers?: string[]; threshold?: number } | null;
+};
+
+/**
+ * Fetches the preconfirm signer set registered in x/ynx for the current epoch from a node REST API
+ * (`GET /ynx/ynx/v1/preconfirm_signers`). Returns undefined while no set is registered. Use its
+ * signers as the `allowlist` and its threshold as the `threshold` of verifyPreconfirmReceiptV0.
+ */
+export async function fetchPreconfirmSignersV0(restUrl: string): Promise<PreconfirmSignerSetV0 | undefined> {
+  const res = await fetch(`${restUrl.replace(/\/+$/, "")}/ynx/ynx/v1/preconfirm_signers`);
+  if (!res.ok) {
+    throw new Error(`HTTP ${res.status}`);
+  }
+  const payload = (await res.json()) as PreconfirmSignersResponse;
+  if (!payload.active) {
+    return undefined;
+  }
+  return {
+    activationEpoch: BigInt(payload.active.activation_epoch ?? "0"),
+    signers: (payload.active.signers ?? []).map((a) => getAddress(a)),
+    threshold: payload.active.threshold ?? 0,
+  };
+}
@@ -239,4 +239,46 @@ describe("preconfirmations (v0)", () => {
     expect(bad.ok).toBe(false);
     expect(bad.reason).toBe("insufficient valid signatures");
   });
+
+  it("enforces the registered threshold over the one claimed by the receipt", () => {
+    const priv1 = "0x59c6995e998f97a5a0044966f094538b292c0acdf0f39c6a9c3d6f64b87b84c1";
+    const priv2 = "0x8b3a350cf5c34c9194ca3a545d3e122a3c0c1e34b6c1aa7e4e7cf1b0f00a2a2b";
+    const s1 = computeAddress(new SigningKey(priv1).publicKey);
+    const s2 = computeAddress(new SigningKey(priv2).publicKey);
+
+    const digest = computePreconfirmDigestV0({
+      status: "pending",
+      chainId: "ynx_9001-1",
+      evmChainId: 9001n,
+      txHash: "0x" + "77".repeat(32),
+      targetBlock: 5n,
+      issuedAt: 5n,
+    });
+    const sig1 = goStyleSignatureBytes(digest, priv1);
+    const sig2 = goStyleSignatureBytes(digest, priv2);
+
+    const receipt = {
+      status: "pending" as const,
+      chainId: "ynx_9001-1",
+      evmChainId: "0x2329",
+      txHash: "0x" + "77".repeat(32),
+      targetBlock: "0x5",
+      issuedAt: "0x5",
+      signer: s1,
+      digest,
+      signature: sig1,
+      signers: [s1, s2],
+      signatures: [sig1, sig2],
+      threshold: 1,
+      signerSetEpoch: "0x3",
+    };
+
+    expect(verifyPreconfirmReceiptV0(receipt, { allowlist: [s1, s2], threshold: 2 }).ok).toBe(true);
+
+    // Only s1 is registered, so the receipt falls short of the registered threshold.
+    const res = verifyPreconfirmReceiptV0(receipt, { allowlist: [s1], threshold: 2 });
+    expect(res.ok).toBe(false);
+    expect(res.threshold).toBe(2);
+    expect(res.reason).toBe("insufficient valid signatures");
+  });
 });